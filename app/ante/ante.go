@@ -59,6 +59,7 @@ func NewAnteHandler(ak auth.AccountKeeper, evmKeeper EVMKeeper, sk types.SupplyK
 				NewGasLimitDecorator(evmKeeper),
 				NewEthMempoolFeeDecorator(evmKeeper),
 				authante.NewValidateBasicDecorator(),
+				NewEthTxTypeDecorator(evmKeeper),
 				NewEthSigVerificationDecorator(),
 				NewAccountBlockedVerificationDecorator(evmKeeper), //account blocked check AnteDecorator
 				NewAccountVerificationDecorator(ak, evmKeeper),
@@ -2,7 +2,6 @@ package ante
 
 import (
 	"fmt"
-	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"math/big"
 
 	"github.com/okex/exchain/libs/cosmos-sdk/baseapp"
@@ -14,6 +13,7 @@ import (
 	"github.com/okex/exchain/libs/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/common"
 	ethcore "github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	ethermint "github.com/okex/exchain/app/types"
 	evmtypes "github.com/okex/exchain/x/evm/types"
 )
@@ -22,6 +22,7 @@ import (
 type EVMKeeper interface {
 	GetParams(ctx sdk.Context) evmtypes.Params
 	IsAddressBlocked(ctx sdk.Context, addr sdk.AccAddress) bool
+	GetChainConfig(ctx sdk.Context) (evmtypes.ChainConfig, bool)
 }
 
 // EthSetupContextDecorator sets the infinite GasMeter in the Context and wraps
@@ -379,7 +380,7 @@ func (egcd EthGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 	}
 
 	gasLimit := msgEthTx.GetGas()
-	gas, err := ethcore.IntrinsicGas(msgEthTx.Data.Payload, []ethtypes.AccessTuple{}, msgEthTx.To() == nil, true, false)
+	gas, err := ethcore.IntrinsicGas(msgEthTx.Data.Payload, msgEthTx.Data.Accesses, msgEthTx.To() == nil, true, false)
 	if err != nil {
 		return ctx, sdkerrors.Wrap(err, "failed to compute intrinsic gas cost")
 	}
@@ -392,7 +393,7 @@ func (egcd EthGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 	// Charge sender for gas up to limit
 	if gasLimit != 0 {
 		// Cost calculates the fees paid to validators based on gas limit and price
-		cost := new(big.Int).Mul(msgEthTx.Data.Price, new(big.Int).SetUint64(gasLimit))
+		cost := new(big.Int).Mul(msgEthTx.Data.EffectiveGasPrice(nil), new(big.Int).SetUint64(gasLimit))
 
 		evmDenom := sdk.DefaultBondDenom
 
@@ -491,3 +492,35 @@ func (g GasLimitDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool,
 	}
 	return next(ctx, tx, simulate)
 }
+
+// NewEthTxTypeDecorator creates a new EthTxTypeDecorator.
+func NewEthTxTypeDecorator(evm EVMKeeper) EthTxTypeDecorator {
+	return EthTxTypeDecorator{
+		evm: evm,
+	}
+}
+
+// EthTxTypeDecorator rejects the typed transactions whose fork is not activated by the chain config.
+type EthTxTypeDecorator struct {
+	evm EVMKeeper
+}
+
+// AnteHandle checks the type of the ethereum transaction against the chain config.
+func (etd EthTxTypeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	msgEthTx, ok := tx.(evmtypes.MsgEthereumTx)
+	if !ok {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "invalid transaction type: %T", tx)
+	}
+	if msgEthTx.Data.Type == ethtypes.LegacyTxType {
+		return next(ctx, tx, simulate)
+	}
+
+	config, found := etd.evm.GetChainConfig(ctx)
+	if !found {
+		return ctx, evmtypes.ErrChainConfigNotFound
+	}
+	if !config.IsTxTypeSupported(msgEthTx.Data.Type, ctx.BlockHeight()) {
+		return ctx, sdkerrors.Wrapf(evmtypes.ErrTxTypeNotSupported, "transaction type %d is not activated at height %d", msgEthTx.Data.Type, ctx.BlockHeight())
+	}
+	return next(ctx, tx, simulate)
+}
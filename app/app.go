@@ -34,6 +34,7 @@ import (
 	tmos "github.com/okex/exchain/libs/tendermint/libs/os"
 	tendermintTypes "github.com/okex/exchain/libs/tendermint/types"
 	"github.com/okex/exchain/x/ammswap"
+	ammswapclient "github.com/okex/exchain/x/ammswap/client"
 	"github.com/okex/exchain/x/backend"
 	"github.com/okex/exchain/x/common/analyzer"
 	commonversion "github.com/okex/exchain/x/common/version"
@@ -41,9 +42,12 @@ import (
 	"github.com/okex/exchain/x/dex"
 	dexclient "github.com/okex/exchain/x/dex/client"
 	distr "github.com/okex/exchain/x/distribution"
+	"github.com/okex/exchain/x/erc20"
+	erc20client "github.com/okex/exchain/x/erc20/client"
 	"github.com/okex/exchain/x/evidence"
 	"github.com/okex/exchain/x/evm"
 	evmclient "github.com/okex/exchain/x/evm/client"
+	evmprecompile "github.com/okex/exchain/x/evm/precompile"
 	evmtypes "github.com/okex/exchain/x/evm/types"
 	"github.com/okex/exchain/x/farm"
 	farmclient "github.com/okex/exchain/x/farm/client"
@@ -96,6 +100,10 @@ var (
 			evmclient.ManageContractDeploymentWhitelistProposalHandler,
 			evmclient.ManageContractBlockedListProposalHandler,
 			evmclient.ManageContractMethodBlockedListProposalHandler,
+			evmclient.ManageChainConfigForkProposalHandler,
+			ammswapclient.ManageSwapPairFeeRateProposalHandler,
+			ammswapclient.ManageProtocolFeeProposalHandler,
+			erc20client.TokenMappingProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -112,27 +120,30 @@ var (
 		debug.AppModuleBasic{},
 		ammswap.AppModuleBasic{},
 		farm.AppModuleBasic{},
+		erc20.AppModuleBasic{},
 	)
 
 	// module account permissions
 	maccPerms = map[string][]string{
-		auth.FeeCollectorName:     nil,
-		distr.ModuleName:          nil,
-		mint.ModuleName:           {supply.Minter},
-		staking.BondedPoolName:    {supply.Burner, supply.Staking},
-		staking.NotBondedPoolName: {supply.Burner, supply.Staking},
-		gov.ModuleName:            nil,
-		token.ModuleName:          {supply.Minter, supply.Burner},
-		dex.ModuleName:            nil,
-		order.ModuleName:          nil,
-		backend.ModuleName:        nil,
-		ammswap.ModuleName:        {supply.Minter, supply.Burner},
-		farm.ModuleName:           nil,
-		farm.YieldFarmingAccount:  nil,
-		farm.MintFarmingAccount:   {supply.Burner},
+		auth.FeeCollectorName:      nil,
+		distr.ModuleName:           nil,
+		mint.ModuleName:            {supply.Minter},
+		staking.BondedPoolName:     {supply.Burner, supply.Staking},
+		staking.NotBondedPoolName:  {supply.Burner, supply.Staking},
+		gov.ModuleName:             nil,
+		token.ModuleName:           {supply.Minter, supply.Burner},
+		dex.ModuleName:             nil,
+		order.ModuleName:           nil,
+		backend.ModuleName:         nil,
+		ammswap.ModuleName:         {supply.Minter, supply.Burner},
+		ammswap.ProtocolFeeAccount: nil,
+		farm.ModuleName:            nil,
+		farm.YieldFarmingAccount:   nil,
+		farm.MintFarmingAccount:    {supply.Burner},
 	}
 
-	GlobalGpIndex = GasPriceIndex{}
+	GlobalGpIndex   = GasPriceIndex{}
+	GlobalGpHistory = NewGasPriceHistory(GasPriceHistorySize)
 
 	onceLog sync.Once
 )
@@ -174,6 +185,7 @@ type OKExChainApp struct {
 	OrderKeeper    order.Keeper
 	SwapKeeper     ammswap.Keeper
 	FarmKeeper     farm.Keeper
+	Erc20Keeper    erc20.Keeper
 	BackendKeeper  backend.Keeper
 	StreamKeeper   stream.Keeper
 
@@ -240,7 +252,7 @@ func NewOKExChainApp(
 		supply.StoreKey, mint.StoreKey, distr.StoreKey, slashing.StoreKey,
 		gov.StoreKey, params.StoreKey, upgrade.StoreKey, evidence.StoreKey,
 		evm.StoreKey, token.StoreKey, token.KeyLock, dex.StoreKey, dex.TokenPairStoreKey,
-		order.OrderStoreKey, ammswap.StoreKey, farm.StoreKey,
+		order.OrderStoreKey, ammswap.StoreKey, farm.StoreKey, erc20.StoreKey,
 	)
 
 	tkeys := sdk.NewTransientStoreKeys(params.TStoreKey)
@@ -271,6 +283,7 @@ func NewOKExChainApp(
 	app.subspaces[order.ModuleName] = app.ParamsKeeper.Subspace(order.DefaultParamspace)
 	app.subspaces[ammswap.ModuleName] = app.ParamsKeeper.Subspace(ammswap.DefaultParamspace)
 	app.subspaces[farm.ModuleName] = app.ParamsKeeper.Subspace(farm.DefaultParamspace)
+	app.subspaces[erc20.ModuleName] = app.ParamsKeeper.Subspace(erc20.DefaultParamspace)
 
 	// use custom OKExChain account for contracts
 	app.AccountKeeper = auth.NewAccountKeeper(
@@ -319,14 +332,21 @@ func NewOKExChainApp(
 	)
 
 	app.SwapKeeper = ammswap.NewKeeper(app.SupplyKeeper, app.TokenKeeper, app.cdc, app.keys[ammswap.StoreKey], app.subspaces[ammswap.ModuleName])
+	app.SwapKeeper.SetDistrKeeper(app.DistrKeeper)
 
 	app.FarmKeeper = farm.NewKeeper(auth.FeeCollectorName, app.SupplyKeeper, app.TokenKeeper, app.SwapKeeper, *app.EvmKeeper, app.subspaces[farm.StoreKey],
 		app.keys[farm.StoreKey], app.cdc)
 
+	app.Erc20Keeper = erc20.NewKeeper(app.cdc, app.keys[erc20.StoreKey], app.subspaces[erc20.ModuleName], app.BankKeeper,
+		app.TokenKeeper, app.EvmKeeper)
+
 	app.StreamKeeper = stream.NewKeeper(app.OrderKeeper, app.TokenKeeper, &app.DexKeeper, &app.AccountKeeper, &app.SwapKeeper,
 		&app.FarmKeeper, app.cdc, logger, appConfig, streamMetrics)
 	app.BackendKeeper = backend.NewKeeper(app.OrderKeeper, app.TokenKeeper, &app.DexKeeper, &app.SwapKeeper, &app.FarmKeeper,
 		app.MintKeeper, app.StreamKeeper.GetMarketKeeper(), app.cdc, logger, appConfig.BackendConfig)
+	if appConfig.BackendConfig.EnableBackend {
+		app.EvmKeeper.SetObserverKeeper(app.BackendKeeper)
+	}
 
 	// create evidence keeper with router
 	evidenceKeeper := evidence.NewKeeper(
@@ -344,12 +364,16 @@ func NewOKExChainApp(
 		AddRoute(distr.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
 		AddRoute(dex.RouterKey, dex.NewProposalHandler(&app.DexKeeper)).
 		AddRoute(farm.RouterKey, farm.NewManageWhiteListProposalHandler(&app.FarmKeeper)).
-		AddRoute(evm.RouterKey, evm.NewManageContractDeploymentWhitelistProposalHandler(app.EvmKeeper))
+		AddRoute(ammswap.RouterKey, ammswap.NewSwapFeeProposalHandler(&app.SwapKeeper)).
+		AddRoute(evm.RouterKey, evm.NewManageContractDeploymentWhitelistProposalHandler(app.EvmKeeper)).
+		AddRoute(erc20.RouterKey, erc20.NewTokenMappingProposalHandler(&app.Erc20Keeper))
 	govProposalHandlerRouter := keeper.NewProposalHandlerRouter()
 	govProposalHandlerRouter.AddRoute(params.RouterKey, &app.ParamsKeeper).
 		AddRoute(dex.RouterKey, &app.DexKeeper).
 		AddRoute(farm.RouterKey, &app.FarmKeeper).
-		AddRoute(evm.RouterKey, app.EvmKeeper)
+		AddRoute(ammswap.RouterKey, &app.SwapKeeper).
+		AddRoute(evm.RouterKey, app.EvmKeeper).
+		AddRoute(erc20.RouterKey, &app.Erc20Keeper)
 	app.GovKeeper = gov.NewKeeper(
 		app.cdc, app.keys[gov.StoreKey], app.ParamsKeeper, app.subspaces[gov.DefaultParamspace],
 		app.SupplyKeeper, &stakingKeeper, gov.DefaultParamspace, govRouter,
@@ -358,7 +382,9 @@ func NewOKExChainApp(
 	app.ParamsKeeper.SetGovKeeper(app.GovKeeper)
 	app.DexKeeper.SetGovKeeper(app.GovKeeper)
 	app.FarmKeeper.SetGovKeeper(app.GovKeeper)
+	app.SwapKeeper.SetGovKeeper(app.GovKeeper)
 	app.EvmKeeper.SetGovKeeper(app.GovKeeper)
+	app.Erc20Keeper.SetGovKeeper(app.GovKeeper)
 
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
@@ -366,6 +392,14 @@ func NewOKExChainApp(
 		staking.NewMultiStakingHooks(app.DistrKeeper.Hooks(), app.SlashingKeeper.Hooks()),
 	)
 
+	app.EvmKeeper.SetTxReplayer(app.ReplayTx)
+	app.EvmKeeper.RegisterPrecompiles(
+		evmprecompile.NewBankPrecompile(app.BankKeeper),
+		evmprecompile.NewStakingPrecompile(app.BankKeeper, staking.NewHandler(app.StakingKeeper)),
+		evmprecompile.NewTokenPrecompile(app.TokenKeeper),
+		erc20.NewPrecompile(app.Erc20Keeper),
+	)
+
 	// NOTE: Any module instantiated in the module manager that is later modified
 	// must be passed by reference here.
 	app.mm = module.NewManager(
@@ -386,6 +420,7 @@ func NewOKExChainApp(
 		order.NewAppModule(commonversion.ProtocolVersionV0, app.OrderKeeper, app.SupplyKeeper),
 		ammswap.NewAppModule(app.SwapKeeper),
 		farm.NewAppModule(app.FarmKeeper),
+		erc20.NewAppModule(app.Erc20Keeper),
 		backend.NewAppModule(app.BackendKeeper),
 		stream.NewAppModule(app.StreamKeeper),
 		params.NewAppModule(app.ParamsKeeper),
@@ -413,6 +448,7 @@ func NewOKExChainApp(
 		dex.ModuleName,
 		order.ModuleName,
 		staking.ModuleName,
+		farm.ModuleName,
 		backend.ModuleName,
 		stream.ModuleName,
 		evm.ModuleName,
@@ -424,7 +460,7 @@ func NewOKExChainApp(
 		auth.ModuleName, distr.ModuleName, staking.ModuleName, bank.ModuleName,
 		slashing.ModuleName, gov.ModuleName, mint.ModuleName, supply.ModuleName,
 		token.ModuleName, dex.ModuleName, order.ModuleName, ammswap.ModuleName, farm.ModuleName,
-		evm.ModuleName, crisis.ModuleName, genutil.ModuleName, params.ModuleName, evidence.ModuleName,
+		evm.ModuleName, erc20.ModuleName, crisis.ModuleName, genutil.ModuleName, params.ModuleName, evidence.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
@@ -451,6 +487,8 @@ func NewOKExChainApp(
 	// initialize stores
 	app.MountKVStores(keys)
 	app.MountTransientStores(tkeys)
+	// the erc20 store is added to a running chain at the height the erc20 module is enabled
+	app.SetStoreUpgradeHeight(keys[erc20.StoreKey], sdk.GetErc20Height())
 
 	// initialize BaseApp
 	app.SetInitChainer(app.InitChainer)
@@ -487,8 +525,12 @@ func (app *OKExChainApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBloc
 func (app *OKExChainApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
 	if appconfig.GetOecConfig().GetEnableDynamicGp() {
 		GlobalGpIndex = CalBlockGasPriceIndex(app.blockGasPrice, appconfig.GetOecConfig().GetDynamicGpWeight())
-		app.blockGasPrice = app.blockGasPrice[:0]
 	}
+	// the gas price history serves eth_feeHistory, so it is kept even if dynamic gas price is disabled,
+	// without the gas prices of the txs then
+	GlobalGpHistory.Add(NewBlockGasPrice(ctx.BlockHeight(), ctx.BlockGasMeter().GasConsumed(),
+		ctx.BlockGasMeter().Limit(), app.blockGasPrice))
+	app.blockGasPrice = app.blockGasPrice[:0]
 
 	return app.mm.EndBlock(ctx, req)
 }
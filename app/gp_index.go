@@ -4,8 +4,12 @@ import (
 	"math"
 	"math/big"
 	"sort"
+	"sync"
 )
 
+// GasPriceHistorySize is the max number of blocks kept in GlobalGpHistory
+const GasPriceHistorySize = 1024
+
 type GasPriceIndex struct {
 	RecommendGp *big.Int `json:"recommend-gp"`
 }
@@ -20,12 +24,112 @@ func CalBlockGasPriceIndex(blockGasPrice []*big.Int, weight int) GasPriceIndex {
 		return blockGasPrice[i].Cmp(blockGasPrice[j]) < 0
 	})
 
-	idx := int(math.Round(float64(weight) / 100.0 * float64(num)))
+	return GasPriceIndex{
+		RecommendGp: gasPricePercentile(blockGasPrice, float64(weight)),
+	}
+}
+
+// gasPricePercentile returns the percent-th percentile of the sorted gas prices
+func gasPricePercentile(sorted []*big.Int, percent float64) *big.Int {
+	idx := int(math.Round(percent / 100.0 * float64(len(sorted))))
 	if idx > 0 {
 		idx -= 1
 	}
+	return sorted[idx]
+}
 
-	return GasPriceIndex{
-		RecommendGp: blockGasPrice[idx],
+// BlockGasPrice is the gas price summary of a block
+type BlockGasPrice struct {
+	Height    int64
+	GasUsed   uint64
+	GasLimit  uint64
+	GasPrices []*big.Int // sorted in ascending order
+}
+
+// NewBlockGasPrice copies the gas prices of a block and sorts them
+func NewBlockGasPrice(height int64, gasUsed, gasLimit uint64, blockGasPrice []*big.Int) BlockGasPrice {
+	gasPrices := make([]*big.Int, len(blockGasPrice))
+	copy(gasPrices, blockGasPrice)
+	sort.SliceStable(gasPrices, func(i, j int) bool {
+		return gasPrices[i].Cmp(gasPrices[j]) < 0
+	})
+	return BlockGasPrice{
+		Height:    height,
+		GasUsed:   gasUsed,
+		GasLimit:  gasLimit,
+		GasPrices: gasPrices,
+	}
+}
+
+// GasUsedRatio returns gas used / gas limit, 0 if the block gas is unlimited
+func (b BlockGasPrice) GasUsedRatio() float64 {
+	if b.GasLimit == 0 {
+		return 0
+	}
+	return float64(b.GasUsed) / float64(b.GasLimit)
+}
+
+// Percentile returns the percent-th percentile of the gas prices in the block, 0 for an empty block
+func (b BlockGasPrice) Percentile(percent float64) *big.Int {
+	if len(b.GasPrices) == 0 {
+		return new(big.Int)
+	}
+	return gasPricePercentile(b.GasPrices, percent)
+}
+
+// GasPriceHistory keeps the gas price summaries of the latest consecutive blocks
+type GasPriceHistory struct {
+	mtx    sync.RWMutex
+	size   int
+	blocks []BlockGasPrice
+}
+
+func NewGasPriceHistory(size int) *GasPriceHistory {
+	return &GasPriceHistory{size: size}
+}
+
+// Add appends the summary of a new block, the history is restarted if the block does not follow the latest one
+func (h *GasPriceHistory) Add(block BlockGasPrice) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	if n := len(h.blocks); n > 0 && h.blocks[n-1].Height+1 != block.Height {
+		h.blocks = nil
+	}
+	h.blocks = append(h.blocks, block)
+	if len(h.blocks) > h.size {
+		h.blocks = h.blocks[len(h.blocks)-h.size:]
+	}
+}
+
+// Latest returns the height of the latest block in the history, 0 if the history is empty
+func (h *GasPriceHistory) Latest() int64 {
+	h.mtx.RLock()
+	defer h.mtx.RUnlock()
+
+	if len(h.blocks) == 0 {
+		return 0
 	}
-}
\ No newline at end of file
+	return h.blocks[len(h.blocks)-1].Height
+}
+
+// Range returns at most count blocks ending at the newest height, oldest first
+func (h *GasPriceHistory) Range(newest int64, count int) []BlockGasPrice {
+	h.mtx.RLock()
+	defer h.mtx.RUnlock()
+
+	if len(h.blocks) == 0 || count <= 0 {
+		return nil
+	}
+	end := int(newest-h.blocks[0].Height) + 1
+	if end <= 0 || end > len(h.blocks) {
+		return nil
+	}
+	start := end - count
+	if start < 0 {
+		start = 0
+	}
+	res := make([]BlockGasPrice, end-start)
+	copy(res, h.blocks[start:end])
+	return res
+}
@@ -0,0 +1,52 @@
+package app
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGasPriceHistory(t *testing.T) {
+	h := NewGasPriceHistory(3)
+	require.Equal(t, int64(0), h.Latest())
+	require.Nil(t, h.Range(1, 1))
+
+	for height := int64(1); height <= 4; height++ {
+		gps := []*big.Int{big.NewInt(height), big.NewInt(height * 10), big.NewInt(height * 100)}
+		h.Add(NewBlockGasPrice(height, uint64(height), 10, gps))
+	}
+	require.Equal(t, int64(4), h.Latest())
+
+	// block 1 has been dropped
+	blocks := h.Range(4, 5)
+	require.Len(t, blocks, 3)
+	require.Equal(t, int64(2), blocks[0].Height)
+	require.Equal(t, int64(4), blocks[2].Height)
+
+	blocks = h.Range(3, 1)
+	require.Len(t, blocks, 1)
+	require.Equal(t, 0.3, blocks[0].GasUsedRatio())
+	require.Equal(t, big.NewInt(3), blocks[0].Percentile(0))
+	require.Equal(t, big.NewInt(30), blocks[0].Percentile(50))
+	require.Equal(t, big.NewInt(300), blocks[0].Percentile(100))
+
+	require.Nil(t, h.Range(5, 1))
+	require.Nil(t, h.Range(1, 1))
+
+	// a gap in heights restarts the history
+	h.Add(NewBlockGasPrice(10, 0, 0, nil))
+	require.Nil(t, h.Range(4, 1))
+	blocks = h.Range(10, 2)
+	require.Len(t, blocks, 1)
+	require.Equal(t, float64(0), blocks[0].GasUsedRatio())
+	require.Equal(t, big.NewInt(0), blocks[0].Percentile(50))
+}
+
+func TestNewBlockGasPrice(t *testing.T) {
+	gps := []*big.Int{big.NewInt(3), big.NewInt(1), big.NewInt(2)}
+	block := NewBlockGasPrice(1, 0, 0, gps)
+	require.Equal(t, []*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(3)}, block.GasPrices)
+	// the gas prices of the block are copied
+	require.Equal(t, big.NewInt(3), gps[0])
+}
@@ -25,6 +25,7 @@ const (
 	flagWebsocket = "wsport"
 
 	FlagPersonalAPI    = "personal-api"
+	FlagDebugAPI       = "debug-api"
 	FlagRateLimitAPI   = "rpc.rate-limit-api"
 	FlagRateLimitCount = "rpc.rate-limit-count"
 	FlagRateLimitBurst = "rpc.rate-limit-burst"
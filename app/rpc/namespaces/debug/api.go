@@ -0,0 +1,242 @@
+package debug
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/okex/exchain/app/rpc/backend"
+	"github.com/okex/exchain/app/rpc/monitor"
+	rpctypes "github.com/okex/exchain/app/rpc/types"
+	ethermint "github.com/okex/exchain/app/types"
+	clientcontext "github.com/okex/exchain/libs/cosmos-sdk/client/context"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/tendermint/libs/log"
+	tmtypes "github.com/okex/exchain/libs/tendermint/types"
+	evmtypes "github.com/okex/exchain/x/evm/types"
+)
+
+// PublicDebugAPI is the debug_ prefixed set of APIs compatible with geth's tracing API.
+// Transactions are re-executed on demand against the historical state of the node.
+type PublicDebugAPI struct {
+	clientCtx clientcontext.CLIContext
+	logger    log.Logger
+	backend   backend.Backend
+	Metrics   map[string]*monitor.RpcMetrics
+}
+
+// NewAPI creates an instance of the public Debug Web3 API.
+func NewAPI(clientCtx clientcontext.CLIContext, log log.Logger, backend backend.Backend) *PublicDebugAPI {
+	return &PublicDebugAPI{
+		clientCtx: clientCtx,
+		logger:    log.With("module", "json-rpc", "namespace", "debug"),
+		backend:   backend,
+	}
+}
+
+// TraceTransaction returns the trace of the transaction identified by hash.
+func (api *PublicDebugAPI) TraceTransaction(txHash common.Hash, config *evmtypes.TraceConfig) (json.RawMessage, error) {
+	monitor := monitor.GetMonitor("debug_traceTransaction", api.logger, api.Metrics).OnBegin()
+	defer monitor.OnEnd("hash", txHash)
+
+	tx, err := api.clientCtx.Client.Tx(txHash.Bytes(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	resBlock, err := api.clientCtx.Client.Block(&tx.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := api.traceBlockTxs(resBlock.Block, config, int(tx.Index))
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, fmt.Errorf("transaction %s is not an ethereum transaction", txHash.Hex())
+	}
+	if results[0].Error != "" {
+		return nil, fmt.Errorf(results[0].Error)
+	}
+	return results[0].Result, nil
+}
+
+// TraceBlockByNumber returns the traces of all the ethereum transactions of the given block.
+func (api *PublicDebugAPI) TraceBlockByNumber(blockNum rpctypes.BlockNumber, config *evmtypes.TraceConfig) ([]evmtypes.QueryResTraceTx, error) {
+	monitor := monitor.GetMonitor("debug_traceBlockByNumber", api.logger, api.Metrics).OnBegin()
+	defer monitor.OnEnd("number", blockNum)
+
+	height := blockNum.Int64()
+	if blockNum == rpctypes.LatestBlockNumber || blockNum == rpctypes.PendingBlockNumber {
+		latest, err := api.backend.LatestBlockNumber()
+		if err != nil {
+			return nil, err
+		}
+		height = latest
+	}
+
+	resBlock, err := api.clientCtx.Client.Block(&height)
+	if err != nil {
+		return nil, err
+	}
+	return api.traceBlockTxs(resBlock.Block, config, -1)
+}
+
+// TraceBlockByHash returns the traces of all the ethereum transactions of the given block.
+func (api *PublicDebugAPI) TraceBlockByHash(hash common.Hash, config *evmtypes.TraceConfig) ([]evmtypes.QueryResTraceTx, error) {
+	monitor := monitor.GetMonitor("debug_traceBlockByHash", api.logger, api.Metrics).OnBegin()
+	defer monitor.OnEnd("hash", hash)
+
+	blockNum, err := api.backend.ConvertToBlockNumber(rpctypes.BlockNumberOrHashWithHash(hash, false))
+	if err != nil {
+		return nil, err
+	}
+
+	height := blockNum.Int64()
+	resBlock, err := api.clientCtx.Client.Block(&height)
+	if err != nil {
+		return nil, err
+	}
+	return api.traceBlockTxs(resBlock.Block, config, -1)
+}
+
+// TraceCall traces an eth_call on top of the state of the given block.
+func (api *PublicDebugAPI) TraceCall(args rpctypes.CallArgs, blockNrOrHash rpctypes.BlockNumberOrHash, config *evmtypes.TraceConfig) (json.RawMessage, error) {
+	monitor := monitor.GetMonitor("debug_traceCall", api.logger, api.Metrics).OnBegin()
+	defer monitor.OnEnd("args", args, "block number", blockNrOrHash)
+
+	blockNum, err := api.backend.ConvertToBlockNumber(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	height := blockNum.Int64()
+	if blockNum == rpctypes.LatestBlockNumber || blockNum == rpctypes.PendingBlockNumber {
+		if height, err = api.backend.LatestBlockNumber(); err != nil {
+			return nil, err
+		}
+	}
+
+	resBlock, err := api.clientCtx.Client.Block(&height)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := evmtypes.TraceTxMsg{
+		To:       args.To,
+		GasLimit: uint64(ethermint.DefaultRPCGasLimit),
+		Trace:    true,
+	}
+	if args.From != nil {
+		msg.From = *args.From
+	}
+	if args.Gas != nil {
+		msg.GasLimit = uint64(*args.Gas)
+	}
+	if args.GasPrice != nil {
+		msg.GasPrice = args.GasPrice.ToInt()
+	}
+	if args.Value != nil {
+		msg.Value = args.Value.ToInt()
+	}
+	if args.Data != nil {
+		msg.Data = *args.Data
+	}
+
+	params := newTraceTxParams(resBlock.Block, config)
+	params.Msgs = []evmtypes.TraceTxMsg{msg}
+	results, err := api.queryTraceTx(height, params)
+	if err != nil {
+		return nil, err
+	}
+	if results[0].Error != "" {
+		return nil, fmt.Errorf(results[0].Error)
+	}
+	return results[0].Result, nil
+}
+
+// traceBlockTxs replays the transactions of block on top of its parent state. All of the
+// ethereum ones are traced when target is negative, otherwise only the one at index target.
+func (api *PublicDebugAPI) traceBlockTxs(block *tmtypes.Block, config *evmtypes.TraceConfig, target int) ([]evmtypes.QueryResTraceTx, error) {
+	msgs, err := newTraceTxMsgs(api.clientCtx, block, target)
+	if err != nil {
+		return nil, err
+	}
+	if len(msgs) == 0 {
+		return []evmtypes.QueryResTraceTx{}, nil
+	}
+
+	params := newTraceTxParams(block, config)
+	params.Msgs = msgs
+	return api.queryTraceTx(block.Height-1, params)
+}
+
+// newTraceTxMsgs converts the transactions of block up to target into the messages replayed
+// by the evm module. The cosmos transactions are replayed as they are, so that the traced
+// transactions run on the state they were executed on. The transactions following the last
+// traced one are left out.
+func newTraceTxMsgs(clientCtx clientcontext.CLIContext, block *tmtypes.Block, target int) ([]evmtypes.TraceTxMsg, error) {
+	var msgs []evmtypes.TraceTxMsg
+	traced := 0
+	for i, txBytes := range block.Txs {
+		if target >= 0 && i > target {
+			break
+		}
+		ethTx, err := rpctypes.RawTxToEthTx(clientCtx, txBytes)
+		if err != nil {
+			msgs = append(msgs, evmtypes.TraceTxMsg{TxHash: common.BytesToHash(txBytes.Hash()), CosmosTx: txBytes})
+			continue
+		}
+		fromSigCache, err := ethTx.VerifySig(ethTx.ChainID(), block.Height, sdk.EmptyContext().SigCache())
+		if err != nil {
+			return nil, err
+		}
+
+		nonce := ethTx.Data.AccountNonce
+		msgs = append(msgs, evmtypes.TraceTxMsg{
+			From:     fromSigCache.GetFrom(),
+			To:       ethTx.To(),
+			Nonce:    &nonce,
+			GasLimit: ethTx.Data.GasLimit,
+			GasPrice: ethTx.Data.EffectiveGasPrice(nil),
+			Value:    ethTx.Data.Amount,
+			Data:     ethTx.Data.Payload,
+			TxHash:   common.BytesToHash(txBytes.Hash()),
+			Trace:    target < 0 || i == target,
+
+			AccessList: ethTx.AccessList(),
+		})
+		if msgs[len(msgs)-1].Trace {
+			traced = len(msgs)
+		}
+	}
+	return msgs[:traced], nil
+}
+
+func (api *PublicDebugAPI) queryTraceTx(height int64, params evmtypes.QueryTraceTxParams) ([]evmtypes.QueryResTraceTx, error) {
+	bz, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+
+	res, _, err := api.clientCtx.WithHeight(height).QueryWithData(fmt.Sprintf("custom/%s/%s", evmtypes.ModuleName, evmtypes.QueryTraceTx), bz)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []evmtypes.QueryResTraceTx
+	if err := json.Unmarshal(res, &results); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func newTraceTxParams(block *tmtypes.Block, config *evmtypes.TraceConfig) evmtypes.QueryTraceTxParams {
+	return evmtypes.QueryTraceTxParams{
+		BlockHeight:   block.Height,
+		BlockTime:     block.Time,
+		BlockHash:     common.BytesToHash(block.Hash()),
+		BlockProposer: block.ProposerAddress,
+		Config:        config,
+	}
+}
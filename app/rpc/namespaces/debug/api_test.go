@@ -0,0 +1,76 @@
+package debug
+
+import (
+	"math/big"
+	"testing"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/okex/exchain/app/crypto/ethsecp256k1"
+	clientcontext "github.com/okex/exchain/libs/cosmos-sdk/client/context"
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	authtypes "github.com/okex/exchain/libs/cosmos-sdk/x/auth/types"
+	tmtypes "github.com/okex/exchain/libs/tendermint/types"
+	evmtypes "github.com/okex/exchain/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTraceTxMsgsMixedBlock(t *testing.T) {
+	cdc := codec.New()
+	sdk.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	authtypes.RegisterCodec(cdc)
+	evmtypes.RegisterCodec(cdc)
+	clientCtx := clientcontext.NewCLIContext().WithCodec(cdc)
+
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	newEthTx := func(nonce uint64) tmtypes.Tx {
+		to := ethcmn.BytesToAddress([]byte("to"))
+		msg := evmtypes.NewMsgEthereumTx(nonce, &to, big.NewInt(1), 21000, big.NewInt(1), nil)
+		require.NoError(t, msg.Sign(big.NewInt(3), priv.ToECDSA()))
+		return cdc.MustMarshalBinaryLengthPrefixed(msg)
+	}
+	cosmosTx := tmtypes.Tx(cdc.MustMarshalBinaryLengthPrefixed(authtypes.NewStdTx(nil, authtypes.NewStdFee(0, nil), nil, "")))
+	from := ethcmn.BytesToAddress(priv.PubKey().Address())
+
+	// the cosmos tx runs after the replayed ethereum txs
+	block := &tmtypes.Block{Header: tmtypes.Header{Height: 10}}
+	block.Txs = tmtypes.Txs{newEthTx(0), newEthTx(1), cosmosTx}
+	msgs, err := newTraceTxMsgs(clientCtx, block, 1)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(msgs))
+	require.False(t, msgs[0].Trace)
+	require.True(t, msgs[1].Trace)
+	require.Equal(t, from, msgs[1].From)
+	require.Equal(t, uint64(1), *msgs[1].Nonce)
+	require.Equal(t, ethcmn.BytesToHash(block.Txs[1].Hash()), msgs[1].TxHash)
+
+	msgs, err = newTraceTxMsgs(clientCtx, block, -1)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(msgs))
+	require.True(t, msgs[0].Trace)
+	require.True(t, msgs[1].Trace)
+
+	// the cosmos tx runs before the traced ethereum tx and is replayed
+	block.Txs = tmtypes.Txs{newEthTx(0), cosmosTx, newEthTx(1)}
+	msgs, err = newTraceTxMsgs(clientCtx, block, 2)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(msgs))
+	require.Equal(t, []byte(cosmosTx), msgs[1].CosmosTx)
+	require.False(t, msgs[1].Trace)
+	require.True(t, msgs[2].Trace)
+	require.Nil(t, msgs[2].CosmosTx)
+
+	msgs, err = newTraceTxMsgs(clientCtx, block, -1)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(msgs))
+	require.True(t, msgs[0].Trace)
+	require.False(t, msgs[1].Trace)
+	require.True(t, msgs[2].Trace)
+
+	// the trace of a cosmos tx replays nothing
+	msgs, err = newTraceTxMsgs(clientCtx, block, 1)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(msgs))
+}
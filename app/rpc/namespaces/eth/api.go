@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"math/big"
 	"strconv"
 	"sync"
@@ -18,7 +19,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
-	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/rpc"
 	lru "github.com/hashicorp/golang-lru"
 	"github.com/okex/exchain/app"
 	"github.com/okex/exchain/app/config"
@@ -53,6 +54,8 @@ const (
 	CacheOfEthCallLru = 40960
 
 	FlagEnableMultiCall = "rpc.enable-multi-call"
+
+	MaxAddressTxsLimit = 100
 )
 
 // PublicEthereumAPI is the eth_ prefixed set of APIs in the Web3 JSON-RPC spec.
@@ -236,6 +239,82 @@ func (api *PublicEthereumAPI) GasPrice() *hexutil.Big {
 	return api.gasPrice
 }
 
+// MaxPriorityFeePerGas returns the suggested priority fee. There is no base fee on the chain, the
+// priority fee is the whole gas price paid, so it is the recommended gas price, but not below the
+// min gas price of the node like the rewards of eth_feeHistory.
+func (api *PublicEthereumAPI) MaxPriorityFeePerGas() *hexutil.Big {
+	monitor := monitor.GetMonitor("eth_maxPriorityFeePerGas", api.logger, api.Metrics).OnBegin()
+	defer monitor.OnEnd()
+
+	if recommend := app.GlobalGpIndex.RecommendGp; recommend != nil && recommend.Cmp(api.gasPrice.ToInt()) > 0 {
+		return (*hexutil.Big)(recommend)
+	}
+
+	return api.gasPrice
+}
+
+// FeeHistory returns the gas used ratio and the gas price percentiles of at most blockCount blocks
+// ending at lastBlock. It is served from the gas price history kept in memory whether dynamic gas price
+// is enabled or not, which only covers the latest blocks executed since the node started, so the result
+// is empty for the blocks out of it. There is no base fee on the chain, so the base fee is reported as
+// zero and the rewards are the whole gas prices paid. The gas prices of the txs are only recorded with
+// dynamic gas price enabled, and the rewards are never below the min gas price of the node.
+func (api *PublicEthereumAPI) FeeHistory(blockCount rpc.DecimalOrHex, lastBlock rpctypes.BlockNumber, rewardPercentiles []float64) (*rpctypes.FeeHistoryResult, error) {
+	monitor := monitor.GetMonitor("eth_feeHistory", api.logger, api.Metrics).OnBegin()
+	defer monitor.OnEnd("blockCount", blockCount, "lastBlock", lastBlock, "rewardPercentiles", rewardPercentiles)
+
+	for i, p := range rewardPercentiles {
+		if p < 0 || p > 100 {
+			return nil, fmt.Errorf("invalid reward percentile %f", p)
+		}
+		if i > 0 && p < rewardPercentiles[i-1] {
+			return nil, fmt.Errorf("invalid reward percentile %f after %f", p, rewardPercentiles[i-1])
+		}
+	}
+	if blockCount == 0 {
+		return &rpctypes.FeeHistoryResult{OldestBlock: (*hexutil.Big)(new(big.Int))}, nil
+	}
+	if blockCount > app.GasPriceHistorySize {
+		blockCount = app.GasPriceHistorySize
+	}
+
+	newest := lastBlock.Int64()
+	if lastBlock == rpctypes.LatestBlockNumber || lastBlock == rpctypes.PendingBlockNumber {
+		newest = app.GlobalGpHistory.Latest()
+	}
+	blocks := app.GlobalGpHistory.Range(newest, int(blockCount))
+	if len(blocks) == 0 {
+		return &rpctypes.FeeHistoryResult{OldestBlock: (*hexutil.Big)(new(big.Int))}, nil
+	}
+
+	res := &rpctypes.FeeHistoryResult{
+		OldestBlock:  (*hexutil.Big)(big.NewInt(blocks[0].Height)),
+		BaseFee:      make([]*hexutil.Big, len(blocks)+1),
+		GasUsedRatio: make([]float64, len(blocks)),
+	}
+	for i := range res.BaseFee {
+		res.BaseFee[i] = (*hexutil.Big)(new(big.Int))
+	}
+	if len(rewardPercentiles) > 0 {
+		res.Reward = make([][]*hexutil.Big, len(blocks))
+	}
+	for i, block := range blocks {
+		res.GasUsedRatio[i] = block.GasUsedRatio()
+		if res.Reward == nil {
+			continue
+		}
+		res.Reward[i] = make([]*hexutil.Big, len(rewardPercentiles))
+		for j, p := range rewardPercentiles {
+			reward := block.Percentile(p)
+			if reward.Cmp(api.gasPrice.ToInt()) < 0 {
+				reward = api.gasPrice.ToInt()
+			}
+			res.Reward[i][j] = (*hexutil.Big)(reward)
+		}
+	}
+	return res, nil
+}
+
 // Accounts returns the list of accounts available to this node.
 func (api *PublicEthereumAPI) Accounts() ([]common.Address, error) {
 	monitor := monitor.GetMonitor("eth_accounts", api.logger, api.Metrics).OnBegin()
@@ -734,8 +813,8 @@ func (api *PublicEthereumAPI) SendRawTransaction(data hexutil.Bytes) (common.Has
 	defer monitor.OnEnd("data", data)
 	tx := new(evmtypes.MsgEthereumTx)
 
-	// RLP decode raw transaction bytes
-	if err := rlp.DecodeBytes(data, tx); err != nil {
+	// decode raw transaction bytes, either a legacy RLP list or a typed envelope
+	if err := tx.UnmarshalBinary(data); err != nil {
 		// Return nil is for when gasLimit overflows uint64
 		return common.Hash{}, err
 	}
@@ -1302,6 +1381,8 @@ func (api *PublicEthereumAPI) GetTransactionReceipt(hash common.Hash) (*watcher.
 		TransactionIndex:  hexutil.Uint64(tx.Index),
 		From:              from.String(),
 		To:                ethTx.To(),
+		Type:              hexutil.Uint64(ethTx.Data.Type),
+		EffectiveGasPrice: (*hexutil.Big)(ethTx.Data.EffectiveGasPrice(nil)),
 	}
 
 	return receipt, nil
@@ -1392,16 +1473,18 @@ func (api *PublicEthereumAPI) GetTransactionReceiptsByBlock(blockNrOrHash rpctyp
 		receipt := &watcher.TransactionReceipt{
 			Status: status,
 			//CumulativeGasUsed: hexutil.Uint64(cumulativeGasUsed),
-			LogsBloom:        data.Bloom,
-			Logs:             data.Logs,
-			TransactionHash:  common.BytesToHash(tx.Hash.Bytes()).String(),
-			ContractAddress:  contractAddr,
-			GasUsed:          hexutil.Uint64(gasUsed),
-			BlockHash:        blockHash.String(),
-			BlockNumber:      hexutil.Uint64(tx.Height),
-			TransactionIndex: hexutil.Uint64(tx.Index),
-			From:             from.String(),
-			To:               ethTx.To(),
+			LogsBloom:         data.Bloom,
+			Logs:              data.Logs,
+			TransactionHash:   common.BytesToHash(tx.Hash.Bytes()).String(),
+			ContractAddress:   contractAddr,
+			GasUsed:           hexutil.Uint64(gasUsed),
+			BlockHash:         blockHash.String(),
+			BlockNumber:       hexutil.Uint64(tx.Height),
+			TransactionIndex:  hexutil.Uint64(tx.Index),
+			From:              from.String(),
+			To:                ethTx.To(),
+			Type:              hexutil.Uint64(ethTx.Data.Type),
+			EffectiveGasPrice: (*hexutil.Big)(ethTx.Data.EffectiveGasPrice(nil)),
 		}
 		receipts = append(receipts, receipt)
 	}
@@ -1409,6 +1492,47 @@ func (api *PublicEthereumAPI) GetTransactionReceiptsByBlock(blockNrOrHash rpctyp
 	return receipts, nil
 }
 
+// GetTransactionsByAddress returns the transactions sent or received by an address, newest first.
+// direction is "from", "to" or empty for both, fromBlock and toBlock bound the block range, and
+// cursor is the one returned by the previous page. It is served by the fast-query db only.
+func (api *PublicEthereumAPI) GetTransactionsByAddress(address common.Address, direction string, fromBlock, toBlock *rpctypes.BlockNumber, cursor hexutil.Bytes, limit hexutil.Uint) (*rpctypes.AddressTransactions, error) {
+	monitor := monitor.GetMonitor("eth_getTransactionsByAddress", api.logger, api.Metrics).OnBegin()
+	defer monitor.OnEnd("address", address, "direction", direction, "fromBlock", fromBlock, "toBlock", toBlock, "limit", limit)
+
+	var dir byte
+	switch direction {
+	case "":
+		dir = watcher.AddressTxAll
+	case "from":
+		dir = watcher.AddressTxFrom
+	case "to":
+		dir = watcher.AddressTxTo
+	default:
+		return nil, fmt.Errorf("invalid direction %s, expected from, to or empty", direction)
+	}
+	if limit == 0 || limit > MaxAddressTxsLimit {
+		limit = MaxAddressTxsLimit
+	}
+
+	from := uint64(0)
+	if fromBlock != nil && *fromBlock > 0 {
+		from = uint64(*fromBlock)
+	}
+	to := uint64(math.MaxUint64)
+	if toBlock != nil && *toBlock > 0 {
+		to = uint64(*toBlock)
+	}
+
+	txs, next, err := api.wrappedBackend.GetTransactionsByAddress(address, dir, from, to, cursor, uint64(limit))
+	if err != nil {
+		return nil, err
+	}
+	if txs == nil {
+		txs = []*rpctypes.Transaction{}
+	}
+	return &rpctypes.AddressTransactions{Transactions: txs, Cursor: next}, nil
+}
+
 // PendingTransactions returns the transactions that are in the transaction pool
 // and have a from address that is one of the accounts this node manages.
 func (api *PublicEthereumAPI) PendingTransactions() ([]*rpctypes.Transaction, error) {
@@ -1675,6 +1799,44 @@ func (api *PublicEthereumAPI) DeleteTxTrace(txHash common.Hash) string {
 	return "delete trace succeed"
 }
 
+// GetInnerTxs returns the calls, contract creations and self destructs made during the
+// execution of the tx identified by txHash.
+func (api *PublicEthereumAPI) GetInnerTxs(txHash common.Hash) ([]*evmtypes.InnerTx, error) {
+	monitor := monitor.GetMonitor("eth_getInnerTxs", api.logger, api.Metrics).OnBegin()
+	defer monitor.OnEnd("hash", txHash)
+
+	innerTxs, e := api.wrappedBackend.GetInnerTxs(txHash)
+	if e == nil {
+		return innerTxs, nil
+	}
+
+	res, _, err := api.clientCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", evmtypes.ModuleName, evmtypes.QueryInnerTxs, txHash.Hex()), nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(res, &innerTxs); err != nil {
+		return nil, err
+	}
+	return innerTxs, nil
+}
+
+// GetBlockInnerTxs returns the inner txs of all the ethereum txs of the block identified by
+// blockHash, together with the erc20 contracts created in it.
+func (api *PublicEthereumAPI) GetBlockInnerTxs(blockHash common.Hash) (*evmtypes.QueryResBlockInnerTxs, error) {
+	monitor := monitor.GetMonitor("eth_getBlockInnerTxs", api.logger, api.Metrics).OnBegin()
+	defer monitor.OnEnd("hash", blockHash)
+
+	res, _, err := api.clientCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", evmtypes.ModuleName, evmtypes.QueryBlockInnerTxs, blockHash.Hex()), nil)
+	if err != nil {
+		return nil, err
+	}
+	var block evmtypes.QueryResBlockInnerTxs
+	if err := json.Unmarshal(res, &block); err != nil {
+		return nil, err
+	}
+	return &block, nil
+}
+
 func (api *PublicEthereumAPI) saveZeroAccount(address common.Address) {
 	zeroAccount := ethermint.EthAccount{BaseAccount: &auth.BaseAccount{}}
 	zeroAccount.SetAddress(address.Bytes())
@@ -0,0 +1,56 @@
+package eth
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/okex/exchain/app"
+	"github.com/okex/exchain/app/config"
+	rpctypes "github.com/okex/exchain/app/rpc/types"
+	"github.com/okex/exchain/libs/tendermint/libs/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeeHistory(t *testing.T) {
+	config.GetOecConfig().SetEnableDynamicGp(false)
+	app.GlobalGpHistory = app.NewGasPriceHistory(app.GasPriceHistorySize)
+	for height := int64(1); height <= 3; height++ {
+		gps := []*big.Int{big.NewInt(height * 10), big.NewInt(height)}
+		app.GlobalGpHistory.Add(app.NewBlockGasPrice(height, uint64(height), 10, gps))
+	}
+	minGasPrice := (*hexutil.Big)(big.NewInt(7))
+	api := &PublicEthereumAPI{logger: log.NewNopLogger(), gasPrice: minGasPrice}
+
+	// there is no base fee, the rewards are the gas prices paid but not below the min gas price
+	zero := (*hexutil.Big)(new(big.Int))
+	res, err := api.FeeHistory(2, rpctypes.LatestBlockNumber, []float64{0, 100})
+	require.NoError(t, err)
+	require.Equal(t, (*hexutil.Big)(big.NewInt(2)), res.OldestBlock)
+	require.Equal(t, []float64{0.2, 0.3}, res.GasUsedRatio)
+	require.Equal(t, []*hexutil.Big{zero, zero, zero}, res.BaseFee)
+	require.Equal(t, [][]*hexutil.Big{
+		{minGasPrice, (*hexutil.Big)(big.NewInt(20))},
+		{minGasPrice, (*hexutil.Big)(big.NewInt(30))},
+	}, res.Reward)
+
+	// the blocks without recorded gas prices pay the min gas price
+	app.GlobalGpHistory.Add(app.NewBlockGasPrice(4, 4, 10, nil))
+	res, err = api.FeeHistory(1, rpctypes.LatestBlockNumber, []float64{50})
+	require.NoError(t, err)
+	require.Equal(t, [][]*hexutil.Big{{minGasPrice}}, res.Reward)
+	require.Equal(t, minGasPrice, api.MaxPriorityFeePerGas())
+	app.GlobalGpIndex = app.GasPriceIndex{RecommendGp: big.NewInt(5)}
+	require.Equal(t, minGasPrice, api.MaxPriorityFeePerGas())
+	app.GlobalGpIndex = app.GasPriceIndex{RecommendGp: big.NewInt(9)}
+	require.Equal(t, (*hexutil.Big)(big.NewInt(9)), api.MaxPriorityFeePerGas())
+	app.GlobalGpIndex = app.GasPriceIndex{}
+
+	// the blocks out of the history are empty
+	res, err = api.FeeHistory(2, 10, nil)
+	require.NoError(t, err)
+	require.Empty(t, res.GasUsedRatio)
+
+	_, err = api.FeeHistory(2, rpctypes.LatestBlockNumber, []float64{50, 10})
+	require.Error(t, err)
+}
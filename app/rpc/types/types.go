@@ -46,6 +46,13 @@ type Transaction struct {
 	V                *hexutil.Big    `json:"v"`
 	R                *hexutil.Big    `json:"r"`
 	S                *hexutil.Big    `json:"s"`
+
+	// typed transaction fields
+	Type      hexutil.Uint64       `json:"type"`
+	ChainID   *hexutil.Big         `json:"chainId,omitempty"`
+	Accesses  *ethtypes.AccessList `json:"accessList,omitempty"`
+	GasFeeCap *hexutil.Big         `json:"maxFeePerGas,omitempty"`
+	GasTipCap *hexutil.Big         `json:"maxPriorityFeePerGas,omitempty"`
 }
 
 // SendTxArgs represents the arguments to submit a new transaction into the transaction pool.
@@ -159,3 +166,19 @@ type EthHeaderWithBlockHash struct {
 	Nonce       ethtypes.BlockNonce `json:"nonce"`
 	Hash        common.Hash         `json:"hash"`
 }
+
+// AddressTransactions represents a page of the transactions of an address, Cursor is used to query
+// the next page and is omitted once the range is exhausted, a full page may be followed by an empty one.
+type AddressTransactions struct {
+	Transactions []*Transaction `json:"transactions"`
+	Cursor       hexutil.Bytes  `json:"cursor,omitempty"`
+}
+
+// FeeHistoryResult is the result of eth_feeHistory, BaseFee contains one more entry than the other
+// fields for the block following the newest one.
+type FeeHistoryResult struct {
+	OldestBlock  *hexutil.Big     `json:"oldestBlock"`
+	Reward       [][]*hexutil.Big `json:"reward,omitempty"`
+	BaseFee      []*hexutil.Big   `json:"baseFeePerGas,omitempty"`
+	GasUsedRatio []float64        `json:"gasUsedRatio"`
+}
@@ -65,6 +65,22 @@ func NewTransaction(tx *evmtypes.MsgEthereumTx, txHash, blockHash common.Hash, b
 		V:        (*hexutil.Big)(tx.Data.V),
 		R:        (*hexutil.Big)(tx.Data.R),
 		S:        (*hexutil.Big)(tx.Data.S),
+		Type:     hexutil.Uint64(tx.Data.Type),
+	}
+
+	switch tx.Data.Type {
+	case ethtypes.AccessListTxType:
+		rpcTx.ChainID = (*hexutil.Big)(tx.Data.ChainID)
+		rpcTx.Accesses = &tx.Data.Accesses
+	case ethtypes.DynamicFeeTxType:
+		rpcTx.ChainID = (*hexutil.Big)(tx.Data.ChainID)
+		rpcTx.Accesses = &tx.Data.Accesses
+		rpcTx.GasFeeCap = (*hexutil.Big)(tx.Data.Price)
+		rpcTx.GasTipCap = (*hexutil.Big)(tx.Data.GasTipCap)
+		// the gas price of a mined transaction is the one paid
+		if blockHash != (common.Hash{}) {
+			rpcTx.GasPrice = (*hexutil.Big)(tx.Data.EffectiveGasPrice(nil))
+		}
 	}
 
 	if blockHash != (common.Hash{}) {
@@ -6,6 +6,7 @@ import (
 	"io"
 
 	"github.com/okex/exchain/app/rpc"
+	evmkeeper "github.com/okex/exchain/x/evm/keeper"
 	evmtypes "github.com/okex/exchain/x/evm/types"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -110,6 +111,7 @@ func closeApp(iApp abci.Application) {
 	app.StopStore()
 	evmtypes.CloseIndexer()
 	evmtypes.CloseTracer()
+	evmkeeper.CloseInnerDB()
 	rpc.CloseEthBackend()
 }
 
@@ -119,6 +121,11 @@ func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer) abci.Application
 		panic(err)
 	}
 
+	snapshotOpts, err := server.GetSnapshotOptionsFromFlags(viper.GetString(cli.HomeFlag))
+	if err != nil {
+		panic(err)
+	}
+
 	return app.NewOKExChainApp(
 		logger,
 		db,
@@ -126,9 +133,11 @@ func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer) abci.Application
 		true,
 		map[int64]bool{},
 		0,
-		baseapp.SetPruning(pruningOpts),
-		baseapp.SetMinGasPrices(viper.GetString(server.FlagMinGasPrices)),
-		baseapp.SetHaltHeight(uint64(viper.GetInt(server.FlagHaltHeight))),
+		append([]func(*baseapp.BaseApp){
+			baseapp.SetPruning(pruningOpts),
+			baseapp.SetMinGasPrices(viper.GetString(server.FlagMinGasPrices)),
+			baseapp.SetHaltHeight(uint64(viper.GetInt(server.FlagHaltHeight))),
+		}, snapshotOpts...)...,
 	)
 }
 
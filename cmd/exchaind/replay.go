@@ -73,6 +73,10 @@ func replayCmd(ctx *server.Context) *cobra.Command {
 	cmd.Flags().Bool(types.FlagBroadcastP2PDelta, false, "save into deltastore.db, and add delta into bcBlockResponseMessage")
 	cmd.Flags().String(types.FlagRedisUrl, "localhost:6379", "redis url")
 	cmd.Flags().String(types.FlagRedisAuth, "", "redis auth")
+	cmd.Flags().String(types.FlagDeltaBroker, types.DeltaBrokerRedis, "Backend used to upload/download delta (redis|fs|http)")
+	cmd.Flags().String(types.FlagDeltaDir, "", "Directory of the fs delta broker, usually a shared volume")
+	cmd.Flags().String(types.FlagDeltaUrl, "", "Bucket url of the http delta broker, S3 compatible (e.g. http://127.0.0.1:9000/delta/)")
+	cmd.Flags().String(types.FlagDeltaCompress, "none", "Compress codec of uploaded delta (none|zlib|gzip|flate|zstd|snappy), downloaded delta is decoded by its own header")
 
 	cmd.Flags().Bool(types.FlagDataCenter, false, "Use data-center-mode or not")
 	cmd.Flags().String(types.DataCenterUrl, "http://127.0.0.1:8030/", "data-center-url")
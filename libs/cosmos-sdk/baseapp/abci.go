@@ -261,6 +261,10 @@ func (app *BaseApp) Commit(req abci.RequestCommit) abci.ResponseCommit {
 	// empty/reset the deliver state
 	app.deliverState = nil
 
+	if app.snapshotInterval > 0 && uint64(header.Height)%app.snapshotInterval == 0 {
+		app.startSnapshot(header.Height)
+	}
+
 	var halt bool
 
 	switch {
@@ -12,6 +12,7 @@ import (
 	"strings"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/okex/exchain/libs/cosmos-sdk/snapshots"
 	"github.com/okex/exchain/libs/cosmos-sdk/store"
 	"github.com/okex/exchain/libs/cosmos-sdk/store/rootmulti"
 	storetypes "github.com/okex/exchain/libs/cosmos-sdk/store/types"
@@ -165,6 +166,11 @@ type BaseApp struct { // nolint: maligned
 
 	chainCache *sdk.Cache
 	blockCache *sdk.Cache
+
+	// manages the state sync snapshots, i.e. dumps of the app state at certain intervals
+	snapshotManager    *snapshots.Manager
+	snapshotInterval   uint64 // block interval between state sync snapshots
+	snapshotKeepRecent uint32 // recent state sync snapshots to keep
 }
 
 type recordHandle func(string)
@@ -277,6 +283,16 @@ func (app *BaseApp) MountTransientStores(keys map[string]*sdk.TransientStoreKey)
 	}
 }
 
+// SetStoreUpgradeHeight sets the height from which a mounted store is committed, so that the store
+// can be added to a running chain. It must be called before the multistore is loaded.
+func (app *BaseApp) SetStoreUpgradeHeight(key sdk.StoreKey, height int64) {
+	cms, ok := app.cms.(*rootmulti.Store)
+	if !ok {
+		panic(fmt.Sprintf("cannot set the upgrade height of store %s on a %T", key.Name(), app.cms))
+	}
+	cms.SetStoreUpgradeHeight(key, height)
+}
+
 // MountStoreWithDB mounts a store to the provided key in the BaseApp
 // multistore, using a specified DB.
 func (app *BaseApp) MountStoreWithDB(key sdk.StoreKey, typ sdk.StoreType, db dbm.DB) {
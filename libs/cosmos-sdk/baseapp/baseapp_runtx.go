@@ -255,3 +255,63 @@ func (app *BaseApp) commitBlockCache() {
 	app.blockCache.Write(true)
 	app.chainCache.TryDelete(app.logger, app.deliverState.ctx.BlockHeight())
 }
+
+// ReplayTx executes the tx on ctx the way DeliverTx does, to rebuild the state the following txs
+// of its block were executed on. The changes of the ante handler are kept even if the msgs fail,
+// the changes of the msgs only if all of them succeed, and a tx rejected by the ante handler
+// changes nothing. An error is returned only if the tx can't be replayed.
+func (app *BaseApp) ReplayTx(ctx sdk.Context, txBytes []byte) error {
+	tx, err := app.txDecoder(txBytes)
+	if err != nil {
+		return err
+	}
+	if err := validateBasicTxMsgs(tx.GetMsgs()); err != nil {
+		return nil
+	}
+	ctx = ctx.WithTxBytes(txBytes).WithIsCheckTx(false)
+
+	if app.anteHandler != nil {
+		anteCtx, msCacheAnte := app.cacheTxContext(ctx, txBytes)
+		newCtx, err := app.replayAnte(anteCtx.WithEventManager(sdk.NewEventManager()), tx)
+		if err != nil {
+			return nil
+		}
+		msCacheAnte.Write()
+		if !newCtx.IsZero() {
+			ctx = newCtx.WithMultiStore(ctx.MultiStore())
+		}
+	}
+
+	runMsgCtx, msCache := app.cacheTxContext(ctx, txBytes)
+	if err := app.replayMsgs(runMsgCtx, tx); err == nil {
+		msCache.Write()
+	}
+
+	if app.GasRefundHandler != nil {
+		refundCtx, msCache := app.cacheTxContext(ctx, txBytes)
+		if _, err := app.GasRefundHandler(refundCtx, tx); err != nil {
+			return err
+		}
+		msCache.Write()
+	}
+	return nil
+}
+
+func (app *BaseApp) replayAnte(ctx sdk.Context, tx sdk.Tx) (newCtx sdk.Context, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = sdkerrors.Wrap(sdkerrors.ErrPanic, fmt.Sprintf("recovered: %v", r))
+		}
+	}()
+	return app.anteHandler(ctx, tx, false)
+}
+
+func (app *BaseApp) replayMsgs(ctx sdk.Context, tx sdk.Tx) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = sdkerrors.Wrap(sdkerrors.ErrPanic, fmt.Sprintf("recovered: %v", r))
+		}
+	}()
+	_, err = app.runMsgs(ctx, tx.GetMsgs(), runTxModeDeliver)
+	return err
+}
@@ -1366,6 +1366,54 @@ func TestBaseAppAnteHandler(t *testing.T) {
 	app.Commit(abci.RequestCommit{})
 }
 
+func TestReplayTx(t *testing.T) {
+	anteKey := []byte("ante-key")
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey))
+	}
+
+	deliverKey := []byte("deliver-key")
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, deliverKey))
+	}
+
+	cdc := codec.New()
+	app := setupBaseApp(t, anteOpt, routerOpt)
+
+	app.InitChain(abci.RequestInitChain{})
+	registerTestCodec(cdc)
+
+	ctx := app.NewContext(true, abci.Header{Height: 1})
+	store := ctx.KVStore(capKey1)
+
+	// a tx failing the ante handler changes nothing
+	tx := newTxCounter(0, 0)
+	tx.setFailOnAnte(true)
+	txBytes, err := cdc.MarshalBinaryLengthPrefixed(tx)
+	require.NoError(t, err)
+	require.NoError(t, app.ReplayTx(ctx, txBytes))
+	require.Equal(t, int64(0), getIntFromStore(store, anteKey))
+
+	// a tx failing the message handler only keeps the changes of the ante handler
+	tx = newTxCounter(0, 0)
+	tx.setFailOnHandler(true)
+	txBytes, err = cdc.MarshalBinaryLengthPrefixed(tx)
+	require.NoError(t, err)
+	require.NoError(t, app.ReplayTx(ctx, txBytes))
+	require.Equal(t, int64(1), getIntFromStore(store, anteKey))
+	require.Equal(t, int64(0), getIntFromStore(store, deliverKey))
+
+	tx = newTxCounter(1, 0)
+	txBytes, err = cdc.MarshalBinaryLengthPrefixed(tx)
+	require.NoError(t, err)
+	require.NoError(t, app.ReplayTx(ctx, txBytes))
+	require.Equal(t, int64(2), getIntFromStore(store, anteKey))
+	require.Equal(t, int64(1), getIntFromStore(store, deliverKey))
+
+	// an undecodable tx can't be replayed
+	require.Error(t, app.ReplayTx(ctx, []byte("invalid tx")))
+}
+
 func TestGasConsumptionBadTx(t *testing.T) {
 	gasWanted := uint64(5)
 	anteOpt := func(bapp *BaseApp) {
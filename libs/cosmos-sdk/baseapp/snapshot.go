@@ -0,0 +1,206 @@
+package baseapp
+
+import (
+	"errors"
+	"fmt"
+
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/snapshots"
+	snapshottypes "github.com/okex/exchain/libs/cosmos-sdk/snapshots/types"
+)
+
+var _ abci.SnapshotApplication = (*BaseApp)(nil)
+
+// SetSnapshotStore sets the snapshot store the state sync snapshots are saved to and served from
+func (app *BaseApp) SetSnapshotStore(snapshotStore *snapshots.Store) {
+	if app.sealed {
+		panic("SetSnapshotStore() on sealed BaseApp")
+	}
+	if snapshotStore == nil {
+		app.snapshotManager = nil
+		return
+	}
+	snapshotter, ok := app.cms.(snapshottypes.Snapshotter)
+	if !ok {
+		panic(fmt.Sprintf("the multistore %T can't take snapshots", app.cms))
+	}
+	app.snapshotManager = snapshots.NewManager(snapshotStore, snapshotter)
+}
+
+// SetSnapshotInterval sets the block interval between the state sync snapshots, 0 disables them
+func (app *BaseApp) SetSnapshotInterval(snapshotInterval uint64) {
+	if app.sealed {
+		panic("SetSnapshotInterval() on sealed BaseApp")
+	}
+	app.snapshotInterval = snapshotInterval
+}
+
+// SetSnapshotKeepRecent sets the number of recent state sync snapshots to keep, 0 keeps all of them
+func (app *BaseApp) SetSnapshotKeepRecent(snapshotKeepRecent uint32) {
+	if app.sealed {
+		panic("SetSnapshotKeepRecent() on sealed BaseApp")
+	}
+	app.snapshotKeepRecent = snapshotKeepRecent
+}
+
+// heightPinner is implemented by the multistores whose heights can be kept from being pruned
+type heightPinner interface {
+	PinHeight(height int64)
+	UnpinHeight(height int64)
+}
+
+// startSnapshot takes a snapshot of the state committed at the height on its own goroutine. The height
+// is pinned before the goroutine starts, so that the next commits can't prune it before it is exported.
+func (app *BaseApp) startSnapshot(height int64) {
+	if app.snapshotManager == nil {
+		return
+	}
+	pinner, ok := app.cms.(heightPinner)
+	if ok {
+		pinner.PinHeight(height)
+	}
+	go func() {
+		if ok {
+			defer pinner.UnpinHeight(height)
+		}
+		app.snapshot(height)
+	}()
+}
+
+// snapshot takes a snapshot of the state committed at the height and prunes the old snapshots.
+// It is run on its own goroutine since exporting the whole state takes long.
+func (app *BaseApp) snapshot(height int64) {
+	if app.snapshotManager == nil {
+		return
+	}
+
+	app.logger.Info("creating state snapshot", "height", height)
+	snapshot, err := app.snapshotManager.Create(uint64(height))
+	if err != nil {
+		app.logger.Error("failed to create state snapshot", "height", height, "err", err)
+		return
+	}
+	app.logger.Info("completed state snapshot", "height", height, "format", snapshot.Format,
+		"chunks", snapshot.Chunks)
+
+	if app.snapshotKeepRecent > 0 {
+		app.logger.Debug("pruning state snapshots")
+		pruned, err := app.snapshotManager.Prune(app.snapshotKeepRecent)
+		if err != nil {
+			app.logger.Error("failed to prune state snapshots", "err", err)
+			return
+		}
+		app.logger.Debug("pruned state snapshots", "pruned", pruned)
+	}
+}
+
+// ListSnapshots implements the ABCI interface. It returns a list of available snapshots.
+func (app *BaseApp) ListSnapshots(req abci.RequestListSnapshots) abci.ResponseListSnapshots {
+	resp := abci.ResponseListSnapshots{Snapshots: []*abci.Snapshot{}}
+	if app.snapshotManager == nil {
+		return resp
+	}
+
+	snapshots, err := app.snapshotManager.List()
+	if err != nil {
+		app.logger.Error("failed to list snapshots", "err", err)
+		return resp
+	}
+
+	for _, snapshot := range snapshots {
+		abciSnapshot, err := snapshot.ToABCI()
+		if err != nil {
+			app.logger.Error("failed to list snapshots", "err", err)
+			return resp
+		}
+		resp.Snapshots = append(resp.Snapshots, &abciSnapshot)
+	}
+	return resp
+}
+
+// LoadSnapshotChunk implements the ABCI interface. It delegates to the snapshot manager.
+func (app *BaseApp) LoadSnapshotChunk(req abci.RequestLoadSnapshotChunk) abci.ResponseLoadSnapshotChunk {
+	if app.snapshotManager == nil {
+		return abci.ResponseLoadSnapshotChunk{}
+	}
+	chunk, err := app.snapshotManager.LoadChunk(req.Height, req.Format, req.Chunk)
+	if err != nil {
+		app.logger.Error("failed to load snapshot chunk", "height", req.Height, "format", req.Format,
+			"chunk", req.Chunk, "err", err)
+		return abci.ResponseLoadSnapshotChunk{}
+	}
+	return abci.ResponseLoadSnapshotChunk{Chunk: chunk}
+}
+
+// OfferSnapshot implements the ABCI interface. It delegates to the snapshot manager.
+func (app *BaseApp) OfferSnapshot(req abci.RequestOfferSnapshot) abci.ResponseOfferSnapshot {
+	if app.snapshotManager == nil {
+		app.logger.Error("snapshot manager not configured")
+		return abci.ResponseOfferSnapshot{Result: abci.OfferSnapshotAbort}
+	}
+
+	if req.Snapshot == nil {
+		app.logger.Error("received nil snapshot")
+		return abci.ResponseOfferSnapshot{Result: abci.OfferSnapshotReject}
+	}
+
+	snapshot, err := snapshottypes.SnapshotFromABCI(req.Snapshot)
+	if err != nil {
+		app.logger.Error("failed to decode snapshot metadata", "err", err)
+		return abci.ResponseOfferSnapshot{Result: abci.OfferSnapshotReject}
+	}
+
+	err = app.snapshotManager.Restore(snapshot)
+	switch {
+	case err == nil:
+		return abci.ResponseOfferSnapshot{Result: abci.OfferSnapshotAccept}
+
+	case errors.Is(err, snapshottypes.ErrUnknownFormat):
+		return abci.ResponseOfferSnapshot{Result: abci.OfferSnapshotRejectFormat}
+
+	case errors.Is(err, snapshottypes.ErrInvalidMetadata):
+		app.logger.Error("rejecting invalid snapshot", "height", req.Snapshot.Height,
+			"format", req.Snapshot.Format, "err", err)
+		return abci.ResponseOfferSnapshot{Result: abci.OfferSnapshotReject}
+
+	default:
+		app.logger.Error("failed to restore snapshot", "height", req.Snapshot.Height,
+			"format", req.Snapshot.Format, "err", err)
+		// We currently don't support resetting the IAVL stores and retrying a different snapshot,
+		// so we ask Tendermint to abort all snapshot restoration.
+		return abci.ResponseOfferSnapshot{Result: abci.OfferSnapshotAbort}
+	}
+}
+
+// ApplySnapshotChunk implements the ABCI interface. It delegates to the snapshot manager.
+func (app *BaseApp) ApplySnapshotChunk(req abci.RequestApplySnapshotChunk) abci.ResponseApplySnapshotChunk {
+	if app.snapshotManager == nil {
+		app.logger.Error("snapshot manager not configured")
+		return abci.ResponseApplySnapshotChunk{Result: abci.ApplySnapshotChunkAbort}
+	}
+
+	done, err := app.snapshotManager.RestoreChunk(req.Chunk)
+	switch {
+	case err == nil:
+		if done {
+			// the check state must be built on the restored stores
+			app.setCheckState(abci.Header{Height: app.cms.LastCommitID().Version})
+			app.logger.Info("restored state snapshot", "height", app.cms.LastCommitID().Version)
+		}
+		return abci.ResponseApplySnapshotChunk{Result: abci.ApplySnapshotChunkAccept}
+
+	case errors.Is(err, snapshottypes.ErrChunkHashMismatch):
+		app.logger.Error("chunk checksum mismatch; rejecting sender and requesting refetch",
+			"chunk", req.Index, "sender", req.Sender, "err", err)
+		return abci.ResponseApplySnapshotChunk{
+			Result:        abci.ApplySnapshotChunkRetry,
+			RefetchChunks: []uint32{req.Index},
+			RejectSenders: []string{req.Sender},
+		}
+
+	default:
+		app.logger.Error("failed to restore snapshot", "err", err)
+		return abci.ResponseApplySnapshotChunk{Result: abci.ApplySnapshotChunkAbort}
+	}
+}
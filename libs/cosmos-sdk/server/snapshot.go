@@ -0,0 +1,33 @@
+package server
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/viper"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/baseapp"
+	"github.com/okex/exchain/libs/cosmos-sdk/snapshots"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+// GetSnapshotOptionsFromFlags opens the state sync snapshot store under the home directory and
+// returns the BaseApp options of the snapshots set by the command flags. The store is always set,
+// a node restoring from a snapshot needs it even if it doesn't take snapshots itself.
+func GetSnapshotOptionsFromFlags(home string) ([]func(*baseapp.BaseApp), error) {
+	snapshotDir := filepath.Join(home, "data", "snapshots")
+	snapshotDB, err := sdk.NewLevelDB("metadata", snapshotDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open snapshot metadata db: %w", err)
+	}
+	snapshotStore, err := snapshots.NewStore(snapshotDB, snapshotDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return []func(*baseapp.BaseApp){
+		baseapp.SetSnapshotStore(snapshotStore),
+		baseapp.SetSnapshotInterval(viper.GetUint64(FlagStateSyncSnapshotInterval)),
+		baseapp.SetSnapshotKeepRecent(viper.GetUint32(FlagStateSyncSnapshotKeepRecent)),
+	}, nil
+}
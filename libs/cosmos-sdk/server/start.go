@@ -58,6 +58,9 @@ const (
 	FlagGoroutineNum      = "goroutine-num"
 
 	FlagPruningMaxWsNum = "pruning-max-worldstate-num"
+
+	FlagStateSyncSnapshotInterval   = "state-sync-snapshot-interval"
+	FlagStateSyncSnapshotKeepRecent = "state-sync-snapshot-keep-recent"
 )
 
 // StartCmd runs the service passed in, either stand-alone or in-process with
@@ -138,6 +141,8 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Uint64(FlagPruningKeepEvery, 0, "Offset heights to keep on disk after 'keep-every' (ignored if pruning is not 'custom')")
 	cmd.Flags().Uint64(FlagPruningInterval, 0, "Height interval at which pruned heights are removed from disk (ignored if pruning is not 'custom')")
 	cmd.Flags().Uint64(FlagPruningMaxWsNum, 0, "Max number of historic states to keep on disk (ignored if pruning is not 'custom')")
+	cmd.Flags().Uint64(FlagStateSyncSnapshotInterval, 0, "Block interval at which state sync snapshots are taken (0 to disable)")
+	cmd.Flags().Uint32(FlagStateSyncSnapshotKeepRecent, 2, "Number of recent state sync snapshots to keep on disk (0 to keep all)")
 	cmd.Flags().String(FlagLocalRpcPort, "", "Local rpc port for mempool and block monitor on cosmos layer(ignored if mempool/block monitoring is not required)")
 	cmd.Flags().String(FlagPortMonitor, "", "Local target ports for connecting number monitoring(ignored if connecting number monitoring is not required)")
 	cmd.Flags().String(FlagEvmImportMode, "default", "Select import mode for evm state (default|files|db)")
@@ -152,6 +157,10 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().String(tmtypes.DataCenterUrl, "http://127.0.0.1:7002/", "data-center-url")
 	cmd.Flags().String(tmtypes.FlagRedisUrl, "localhost:6379", "redis url")
 	cmd.Flags().String(tmtypes.FlagRedisAuth, "", "redis auth")
+	cmd.Flags().String(tmtypes.FlagDeltaBroker, tmtypes.DeltaBrokerRedis, "Backend used to upload/download delta (redis|fs|http)")
+	cmd.Flags().String(tmtypes.FlagDeltaDir, "", "Directory of the fs delta broker, usually a shared volume")
+	cmd.Flags().String(tmtypes.FlagDeltaUrl, "", "Bucket url of the http delta broker, S3 compatible (e.g. http://127.0.0.1:9000/delta/)")
+	cmd.Flags().String(tmtypes.FlagDeltaCompress, "none", "Compress codec of uploaded delta (none|zlib|gzip|flate|zstd|snappy), downloaded delta is decoded by its own header")
 
 	cmd.Flags().Int(iavl.FlagIavlCacheSize, 1000000, "Max size of iavl cache")
 	cmd.Flags().StringToInt(tmiavl.FlagOutputModules, map[string]int{"evm": 1, "acc": 1}, "decide which module in iavl to be printed")
@@ -185,6 +194,8 @@ which accepts a path for the resulting pprof file.
 	viper.BindPFlag(FlagPruningKeepEvery, cmd.Flags().Lookup(FlagPruningKeepEvery))
 	viper.BindPFlag(FlagPruningInterval, cmd.Flags().Lookup(FlagPruningInterval))
 	viper.BindPFlag(FlagPruningMaxWsNum, cmd.Flags().Lookup(FlagPruningMaxWsNum))
+	viper.BindPFlag(FlagStateSyncSnapshotInterval, cmd.Flags().Lookup(FlagStateSyncSnapshotInterval))
+	viper.BindPFlag(FlagStateSyncSnapshotKeepRecent, cmd.Flags().Lookup(FlagStateSyncSnapshotKeepRecent))
 	viper.BindPFlag(FlagLocalRpcPort, cmd.Flags().Lookup(FlagLocalRpcPort))
 	viper.BindPFlag(FlagPortMonitor, cmd.Flags().Lookup(FlagPortMonitor))
 	viper.BindPFlag(FlagEvmImportMode, cmd.Flags().Lookup(FlagEvmImportMode))
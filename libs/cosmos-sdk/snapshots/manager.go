@@ -0,0 +1,250 @@
+package snapshots
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"hash"
+	"io"
+	"io/ioutil"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/snapshots/types"
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
+)
+
+const (
+	opNone     operation = ""
+	opSnapshot operation = "snapshot"
+	opPrune    operation = "prune"
+	opRestore  operation = "restore"
+
+	// defaultChunkSize is the size of the chunks the snapshots are split into
+	defaultChunkSize = 10e6
+)
+
+// operation represents a Manager operation. Only one operation can be in progress at a time.
+type operation string
+
+// Manager manages snapshot and restore operations for an app, making sure only a single
+// long-running operation is in progress at any given time, and provides convenience methods
+// mirroring the ABCI interface.
+//
+// Although the ABCI interface (and this manager) passes chunks as byte slices, the internal
+// snapshot/restore APIs use streams, so the manager pipes the chunks between the snapshot store
+// and the snapshotter.
+type Manager struct {
+	store     *Store
+	target    types.Snapshotter
+	chunkSize int
+
+	mtx                sync.Mutex
+	operation          operation
+	restoreSnapshot    *types.Snapshot
+	restoreChunkIndex  uint32
+	restoreChunkWriter *io.PipeWriter
+	restoreHasher      hash.Hash
+	restoreDone        <-chan error
+}
+
+// NewManager creates a new manager.
+func NewManager(store *Store, target types.Snapshotter) *Manager {
+	return &Manager{
+		store:     store,
+		target:    target,
+		chunkSize: defaultChunkSize,
+	}
+}
+
+// begin starts an operation, or errors if one is in progress. It manages the mutex itself.
+func (m *Manager) begin(op operation) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	return m.beginLocked(op)
+}
+
+// beginLocked begins an operation while already holding the mutex.
+func (m *Manager) beginLocked(op operation) error {
+	if op == opNone {
+		return errors.New("can't begin a none operation")
+	}
+	if m.operation != opNone {
+		return sdkerrors.Wrapf(types.ErrConflict, "a %v operation is in progress", m.operation)
+	}
+	m.operation = op
+	return nil
+}
+
+// end ends the current operation.
+func (m *Manager) end() {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.endLocked()
+}
+
+// endLocked ends the current operation while already holding the mutex.
+func (m *Manager) endLocked() {
+	m.operation = opNone
+	if m.restoreChunkWriter != nil {
+		_ = m.restoreChunkWriter.CloseWithError(errors.New("restore aborted"))
+	}
+	m.restoreSnapshot = nil
+	m.restoreChunkIndex = 0
+	m.restoreChunkWriter = nil
+	m.restoreHasher = nil
+	m.restoreDone = nil
+}
+
+// Create creates a snapshot of the state at the given height and returns its metadata.
+func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
+	if err := m.begin(opSnapshot); err != nil {
+		return nil, err
+	}
+	defer m.end()
+
+	latest, err := m.store.GetLatest()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to examine latest snapshot")
+	}
+	if latest != nil && latest.Height >= height {
+		return nil, sdkerrors.Wrapf(types.ErrConflict,
+			"a more recent snapshot already exists at height %v", latest.Height)
+	}
+
+	reader, writer := io.Pipe()
+	go func() {
+		_ = writer.CloseWithError(m.target.Snapshot(height, types.CurrentFormat, writer))
+	}()
+	snapshot, err := m.store.Save(height, types.CurrentFormat, reader, m.chunkSize)
+	// unblock the snapshotter if the store failed to consume the whole stream
+	_ = reader.CloseWithError(errors.New("snapshot stream closed"))
+	return snapshot, err
+}
+
+// List lists snapshots, mirroring ABCI ListSnapshots. It can be concurrent with other operations.
+func (m *Manager) List() ([]*types.Snapshot, error) {
+	return m.store.List()
+}
+
+// LoadChunk loads a chunk into a byte slice, mirroring ABCI LoadChunk. It can be called
+// concurrently with other operations. If the chunk does not exist, nil is returned.
+func (m *Manager) LoadChunk(height uint64, format uint32, chunk uint32) ([]byte, error) {
+	reader, err := m.store.LoadChunk(height, format, chunk)
+	if err != nil {
+		return nil, err
+	}
+	if reader == nil {
+		return nil, nil
+	}
+	defer reader.Close()
+
+	return ioutil.ReadAll(reader)
+}
+
+// Prune prunes snapshots, if no other operations are in progress.
+func (m *Manager) Prune(retain uint32) (uint64, error) {
+	if err := m.begin(opPrune); err != nil {
+		return 0, err
+	}
+	defer m.end()
+	return m.store.Prune(retain)
+}
+
+// Restore begins an async snapshot restoration, mirroring ABCI OfferSnapshot. Chunks must be fed
+// via RestoreChunk() until the restore is complete or a chunk fails.
+func (m *Manager) Restore(snapshot types.Snapshot) error {
+	if snapshot.Chunks == 0 {
+		return sdkerrors.Wrap(types.ErrInvalidMetadata, "no chunks")
+	}
+	if uint32(len(snapshot.Metadata.ChunkHashes)) != snapshot.Chunks {
+		return sdkerrors.Wrapf(types.ErrInvalidMetadata, "snapshot has %v chunk hashes, but %v chunks",
+			uint32(len(snapshot.Metadata.ChunkHashes)), snapshot.Chunks)
+	}
+	if snapshot.Format != types.CurrentFormat {
+		return sdkerrors.Wrapf(types.ErrUnknownFormat, "snapshot format %v", snapshot.Format)
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	if err := m.beginLocked(opRestore); err != nil {
+		return err
+	}
+
+	// the snapshotter reads the stream on its own goroutine as the chunks are piped in
+	reader, writer := io.Pipe()
+	done := make(chan error, 1)
+	go func() {
+		err := m.target.Restore(snapshot.Height, snapshot.Format, reader)
+		if err != nil {
+			_ = reader.CloseWithError(err)
+		} else {
+			// drain the trailing bytes so the last chunk write doesn't block
+			_, _ = io.Copy(ioutil.Discard, reader)
+		}
+		done <- err
+		close(done)
+	}()
+
+	m.restoreSnapshot = &snapshot
+	m.restoreChunkIndex = 0
+	m.restoreChunkWriter = writer
+	m.restoreHasher = sha256.New()
+	m.restoreDone = done
+	return nil
+}
+
+// RestoreChunk adds a chunk to an active snapshot restoration, mirroring ABCI ApplySnapshotChunk.
+// It returns true when the restore is complete. A chunk failing the hash verification may be
+// refetched and applied again.
+func (m *Manager) RestoreChunk(chunk []byte) (bool, error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	if m.operation != opRestore {
+		return false, sdkerrors.Wrap(types.ErrNotFound, "no restore operation in progress")
+	}
+
+	if m.restoreChunkIndex >= m.restoreSnapshot.Chunks {
+		return false, errors.New("received unexpected chunk")
+	}
+
+	// check the chunk hash before handing it to the snapshotter
+	hash := sha256.Sum256(chunk)
+	expected := m.restoreSnapshot.Metadata.ChunkHashes[m.restoreChunkIndex]
+	if !bytes.Equal(hash[:], expected) {
+		return false, sdkerrors.Wrapf(types.ErrChunkHashMismatch,
+			"expected %x, got %x", expected, hash)
+	}
+
+	if _, err := m.restoreChunkWriter.Write(chunk); err != nil {
+		// the snapshotter failed, report its error rather than the closed pipe
+		done := m.restoreDone
+		m.restoreChunkWriter = nil
+		m.endLocked()
+		if restoreErr := <-done; restoreErr != nil {
+			return false, errors.Wrap(restoreErr, "snapshot restoration failed")
+		}
+		return false, errors.Wrap(err, "failed to write chunk to restore stream")
+	}
+	m.restoreHasher.Write(chunk)
+	m.restoreChunkIndex++
+
+	if m.restoreChunkIndex < m.restoreSnapshot.Chunks {
+		return false, nil
+	}
+
+	// the last chunk is written, wait for the snapshotter to finish
+	_ = m.restoreChunkWriter.Close()
+	m.restoreChunkWriter = nil
+	done, snapshotHash := m.restoreDone, m.restoreSnapshot.Hash
+	streamHash := m.restoreHasher.Sum(nil)
+	m.endLocked()
+	if err := <-done; err != nil {
+		return false, errors.Wrap(err, "snapshot restoration failed")
+	}
+	if !bytes.Equal(streamHash, snapshotHash) {
+		return false, sdkerrors.Wrapf(types.ErrChunkHashMismatch,
+			"snapshot hash %x doesn't match the restored stream %x", snapshotHash, streamHash)
+	}
+	return true, nil
+}
@@ -0,0 +1,163 @@
+package snapshots
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/snapshots/types"
+)
+
+// mockSnapshotter writes its items as the snapshot and collects the restored stream
+type mockSnapshotter struct {
+	items    [][]byte
+	restored []byte
+}
+
+func (m *mockSnapshotter) Snapshot(height uint64, format uint32, w io.Writer) error {
+	if format != types.CurrentFormat {
+		return types.ErrUnknownFormat
+	}
+	for _, item := range m.items {
+		if _, err := w.Write(item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *mockSnapshotter) Restore(height uint64, format uint32, r io.Reader) error {
+	if format != types.CurrentFormat {
+		return types.ErrUnknownFormat
+	}
+	bz, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	m.restored = bz
+	return nil
+}
+
+func setupStore(t *testing.T) (*Store, func()) {
+	dir, err := ioutil.TempDir("", "snapshots")
+	require.NoError(t, err)
+	store, err := NewStore(dbm.NewMemDB(), dir)
+	require.NoError(t, err)
+	return store, func() { os.RemoveAll(dir) }
+}
+
+func setupManager(t *testing.T, snapshotter types.Snapshotter) (*Manager, func()) {
+	store, teardown := setupStore(t)
+	manager := NewManager(store, snapshotter)
+	manager.chunkSize = 4
+	return manager, teardown
+}
+
+func TestStore_SaveListPrune(t *testing.T) {
+	store, teardown := setupStore(t)
+	defer teardown()
+
+	for _, height := range []uint64{1, 2, 3} {
+		snapshot, err := store.Save(height, 1, bytes.NewReader([]byte{1, 2, 3, 4, 5}), 2)
+		require.NoError(t, err)
+		assert.EqualValues(t, 3, snapshot.Chunks)
+		assert.Len(t, snapshot.Metadata.ChunkHashes, 3)
+	}
+
+	// saving the same height and format again is a conflict
+	_, err := store.Save(3, 1, bytes.NewReader([]byte{1}), 2)
+	require.Error(t, err)
+	assert.True(t, types.ErrConflict.Is(err))
+
+	snapshots, err := store.List()
+	require.NoError(t, err)
+	require.Len(t, snapshots, 3)
+	assert.EqualValues(t, 3, snapshots[0].Height)
+	assert.EqualValues(t, 1, snapshots[2].Height)
+
+	latest, err := store.GetLatest()
+	require.NoError(t, err)
+	assert.Equal(t, snapshots[0], latest)
+
+	chunk, err := store.LoadChunk(2, 1, 2)
+	require.NoError(t, err)
+	require.NotNil(t, chunk)
+	bz, err := ioutil.ReadAll(chunk)
+	require.NoError(t, err)
+	require.NoError(t, chunk.Close())
+	assert.Equal(t, []byte{5}, bz)
+
+	pruned, err := store.Prune(1)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, pruned)
+
+	snapshots, err = store.List()
+	require.NoError(t, err)
+	require.Len(t, snapshots, 1)
+	assert.EqualValues(t, 3, snapshots[0].Height)
+
+	chunk, err = store.LoadChunk(2, 1, 0)
+	require.NoError(t, err)
+	assert.Nil(t, chunk)
+}
+
+func TestManager_CreateRestore(t *testing.T) {
+	source := &mockSnapshotter{items: [][]byte{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}}
+	manager, teardown := setupManager(t, source)
+	defer teardown()
+
+	snapshot, err := manager.Create(5)
+	require.NoError(t, err)
+	assert.EqualValues(t, 5, snapshot.Height)
+	assert.EqualValues(t, 3, snapshot.Chunks)
+
+	// a snapshot can't be older than the latest one
+	_, err = manager.Create(4)
+	require.Error(t, err)
+
+	target := &mockSnapshotter{}
+	restorer, teardown := setupManager(t, target)
+	defer teardown()
+
+	// a restore is refused if the metadata doesn't match the chunks
+	invalid := *snapshot
+	invalid.Metadata.ChunkHashes = invalid.Metadata.ChunkHashes[1:]
+	err = restorer.Restore(invalid)
+	require.Error(t, err)
+	assert.True(t, types.ErrInvalidMetadata.Is(err))
+
+	require.NoError(t, restorer.Restore(*snapshot))
+
+	// no other operation can run while restoring
+	_, err = restorer.Create(10)
+	require.Error(t, err)
+	assert.True(t, types.ErrConflict.Is(err))
+
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk, err := manager.LoadChunk(snapshot.Height, snapshot.Format, i)
+		require.NoError(t, err)
+
+		// a corrupted chunk is rejected, but the restore can go on
+		_, err = restorer.RestoreChunk(append(chunk, 0xff))
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, types.ErrChunkHashMismatch))
+
+		done, err := restorer.RestoreChunk(chunk)
+		require.NoError(t, err)
+		assert.Equal(t, i == snapshot.Chunks-1, done)
+	}
+	assert.Equal(t, []byte{1, 2, 3, 4, 5, 6, 7, 8, 9}, target.restored)
+
+	// the restore is over, so the manager accepts new operations
+	_, err = restorer.RestoreChunk([]byte{1})
+	require.Error(t, err)
+	_, err = restorer.Create(1)
+	require.NoError(t, err)
+}
@@ -0,0 +1,270 @@
+package snapshots
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+
+	"github.com/pkg/errors"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	"github.com/okex/exchain/libs/cosmos-sdk/snapshots/types"
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
+)
+
+const (
+	// keyPrefixSnapshot is the prefix for snapshot database keys
+	keyPrefixSnapshot byte = 0x01
+)
+
+var cdc = codec.New()
+
+// Store is a snapshot store, containing snapshot metadata and binary chunks.
+type Store struct {
+	db  dbm.DB
+	dir string
+
+	mtx    sync.Mutex
+	saving map[uint64]bool // heights currently being saved
+}
+
+// NewStore creates a new snapshot store.
+func NewStore(db dbm.DB, dir string) (*Store, error) {
+	if dir == "" {
+		return nil, errors.New("snapshot directory not given")
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, errors.Wrapf(err, "failed to create snapshot directory %q", dir)
+	}
+
+	return &Store{
+		db:     db,
+		dir:    dir,
+		saving: make(map[uint64]bool),
+	}, nil
+}
+
+// Delete deletes a snapshot.
+func (s *Store) Delete(height uint64, format uint32) error {
+	s.mtx.Lock()
+	saving := s.saving[height]
+	s.mtx.Unlock()
+	if saving {
+		return sdkerrors.Wrapf(types.ErrConflict,
+			"snapshot for height %v format %v is currently being saved", height, format)
+	}
+	if err := s.db.Delete(encodeKey(height, format)); err != nil {
+		return errors.Wrapf(err, "failed to delete snapshot for height %v format %v", height, format)
+	}
+	if err := os.RemoveAll(s.pathSnapshot(height, format)); err != nil {
+		return errors.Wrapf(err, "failed to delete snapshot chunks for height %v format %v", height, format)
+	}
+	return nil
+}
+
+// Get fetches snapshot info from the database, it returns nil if the snapshot is not found.
+func (s *Store) Get(height uint64, format uint32) (*types.Snapshot, error) {
+	bz, err := s.db.Get(encodeKey(height, format))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch snapshot metadata for height %v format %v",
+			height, format)
+	}
+	if bz == nil {
+		return nil, nil
+	}
+	snapshot := &types.Snapshot{}
+	if err := cdc.UnmarshalBinaryBare(bz, snapshot); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode snapshot metadata for height %v format %v",
+			height, format)
+	}
+	return snapshot, nil
+}
+
+// GetLatest fetches the latest snapshot from the database, it returns nil if there is none.
+func (s *Store) GetLatest() (*types.Snapshot, error) {
+	snapshots, err := s.List()
+	if err != nil || len(snapshots) == 0 {
+		return nil, err
+	}
+	return snapshots[0], nil
+}
+
+// List lists snapshots, in reverse order (newest first).
+func (s *Store) List() ([]*types.Snapshot, error) {
+	iter, err := s.db.ReverseIterator(encodeKey(0, 0), encodeKey(math.MaxUint64, math.MaxUint32))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list snapshots")
+	}
+	defer iter.Close()
+
+	snapshots := make([]*types.Snapshot, 0)
+	for ; iter.Valid(); iter.Next() {
+		snapshot := &types.Snapshot{}
+		if err := cdc.UnmarshalBinaryBare(iter.Value(), snapshot); err != nil {
+			return nil, errors.Wrap(err, "failed to decode snapshot info")
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	return snapshots, nil
+}
+
+// LoadChunk loads a chunk from disk, or returns nil if it does not exist. The caller must call
+// Close() on it when done.
+func (s *Store) LoadChunk(height uint64, format uint32, chunk uint32) (io.ReadCloser, error) {
+	file, err := os.Open(s.pathChunk(height, format, chunk))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	return file, err
+}
+
+// Prune removes old snapshots. The given number of most recent heights (regardless of format)
+// are retained. It returns the number of snapshots pruned.
+func (s *Store) Prune(retain uint32) (uint64, error) {
+	snapshots, err := s.List()
+	if err != nil {
+		return 0, err
+	}
+
+	pruned := uint64(0)
+	prunedHeights := make(map[uint64]bool)
+	skip := make(map[uint64]bool)
+	for _, snapshot := range snapshots {
+		switch {
+		case skip[snapshot.Height]:
+			continue
+		case uint32(len(skip)) < retain:
+			skip[snapshot.Height] = true
+			continue
+		}
+		if err := s.Delete(snapshot.Height, snapshot.Format); err != nil {
+			return 0, errors.Wrapf(err, "failed to prune snapshots")
+		}
+		pruned++
+		prunedHeights[snapshot.Height] = true
+	}
+
+	// since Delete() deletes a specific format, the height directory is removed once it's empty
+	for height := range prunedHeights {
+		_ = os.Remove(s.pathHeight(height))
+	}
+	return pruned, nil
+}
+
+// Save saves a snapshot to disk, splitting the snapshot stream read from r into chunks of
+// chunkSize bytes. It returns the snapshot metadata once the stream is exhausted.
+func (s *Store) Save(height uint64, format uint32, r io.Reader, chunkSize int) (*types.Snapshot, error) {
+	if height == 0 {
+		return nil, errors.New("snapshot height cannot be 0")
+	}
+	if chunkSize <= 0 {
+		return nil, errors.New("snapshot chunk size must be positive")
+	}
+
+	s.mtx.Lock()
+	saving := s.saving[height]
+	s.saving[height] = true
+	s.mtx.Unlock()
+	if saving {
+		return nil, sdkerrors.Wrapf(types.ErrConflict,
+			"a snapshot for height %v is already being saved", height)
+	}
+	defer func() {
+		s.mtx.Lock()
+		delete(s.saving, height)
+		s.mtx.Unlock()
+	}()
+
+	exists, err := s.db.Has(encodeKey(height, format))
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, sdkerrors.Wrapf(types.ErrConflict,
+			"snapshot already exists for height %v format %v", height, format)
+	}
+
+	if err := os.MkdirAll(s.pathSnapshot(height, format), 0755); err != nil {
+		return nil, errors.Wrapf(err, "failed to create snapshot directory")
+	}
+
+	snapshot := &types.Snapshot{
+		Height: height,
+		Format: format,
+	}
+	snapshotHasher := sha256.New()
+	buf := make([]byte, chunkSize)
+	for {
+		n, err := io.ReadFull(r, buf)
+		if err == io.EOF {
+			break
+		} else if err != nil && err != io.ErrUnexpectedEOF {
+			_ = os.RemoveAll(s.pathSnapshot(height, format))
+			return nil, errors.Wrap(err, "failed to read snapshot stream")
+		}
+
+		chunk := buf[:n]
+		if err := ioutil.WriteFile(s.pathChunk(height, format, snapshot.Chunks), chunk, 0644); err != nil {
+			_ = os.RemoveAll(s.pathSnapshot(height, format))
+			return nil, errors.Wrapf(err, "failed to write snapshot chunk %v", snapshot.Chunks)
+		}
+		chunkHash := sha256.Sum256(chunk)
+		snapshot.Metadata.ChunkHashes = append(snapshot.Metadata.ChunkHashes, chunkHash[:])
+		snapshotHasher.Write(chunk)
+		snapshot.Chunks++
+
+		if err == io.ErrUnexpectedEOF {
+			break
+		}
+	}
+	snapshot.Hash = snapshotHasher.Sum(nil)
+
+	if err := s.saveSnapshot(snapshot); err != nil {
+		_ = os.RemoveAll(s.pathSnapshot(height, format))
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// saveSnapshot saves snapshot metadata to the database.
+func (s *Store) saveSnapshot(snapshot *types.Snapshot) error {
+	bz, err := cdc.MarshalBinaryBare(snapshot)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode snapshot metadata")
+	}
+	if err := s.db.SetSync(encodeKey(snapshot.Height, snapshot.Format), bz); err != nil {
+		return errors.Wrap(err, "failed to store snapshot")
+	}
+	return nil
+}
+
+// pathHeight generates the path to a height, containing multiple snapshot formats.
+func (s *Store) pathHeight(height uint64) string {
+	return filepath.Join(s.dir, strconv.FormatUint(height, 10))
+}
+
+// pathSnapshot generates a snapshot path, as a specific format under a height.
+func (s *Store) pathSnapshot(height uint64, format uint32) string {
+	return filepath.Join(s.pathHeight(height), strconv.FormatUint(uint64(format), 10))
+}
+
+// pathChunk generates a snapshot chunk path.
+func (s *Store) pathChunk(height uint64, format uint32, chunk uint32) string {
+	return filepath.Join(s.pathSnapshot(height, format), strconv.FormatUint(uint64(chunk), 10))
+}
+
+// encodeKey encodes a snapshot key.
+func encodeKey(height uint64, format uint32) []byte {
+	key := make([]byte, 1+8+4)
+	key[0] = keyPrefixSnapshot
+	binary.BigEndian.PutUint64(key[1:], height)
+	binary.BigEndian.PutUint32(key[1+8:], format)
+	return key
+}
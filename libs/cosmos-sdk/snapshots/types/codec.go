@@ -0,0 +1,7 @@
+package types
+
+import (
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+)
+
+var cdc = codec.New()
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"io"
+
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+)
+
+// CurrentFormat is the currently used format for snapshots. Snapshots using the same format
+// must be identical across all nodes for a given height, so this must be bumped when the binary
+// snapshot output changes.
+const CurrentFormat uint32 = 1
+
+// Snapshotter is something that can take and restore snapshots, the snapshot itself is a binary
+// stream which the snapshot manager splits into chunks.
+type Snapshotter interface {
+	// Snapshot writes the snapshot of the state at the given height in the given format into w
+	Snapshot(height uint64, format uint32, w io.Writer) error
+
+	// Restore restores the state at the given height from the snapshot in the given format read from r
+	Restore(height uint64, format uint32, r io.Reader) error
+}
+
+// Snapshot contains the metadata of a snapshot
+type Snapshot struct {
+	Height   uint64   `json:"height"`
+	Format   uint32   `json:"format"`
+	Chunks   uint32   `json:"chunks"`
+	Hash     []byte   `json:"hash"`
+	Metadata Metadata `json:"metadata"`
+}
+
+// Metadata contains the snapshot metadata which lets the chunks be verified one by one while restoring
+type Metadata struct {
+	ChunkHashes [][]byte `json:"chunk_hashes"`
+}
+
+// ToABCI converts a Snapshot to its ABCI representation
+func (s Snapshot) ToABCI() (abci.Snapshot, error) {
+	metadata, err := cdc.MarshalBinaryBare(s.Metadata)
+	if err != nil {
+		return abci.Snapshot{}, sdkerrors.Wrapf(ErrInvalidMetadata, "failed to marshal snapshot metadata: %v", err)
+	}
+	return abci.Snapshot{
+		Height:   s.Height,
+		Format:   s.Format,
+		Chunks:   s.Chunks,
+		Hash:     s.Hash,
+		Metadata: metadata,
+	}, nil
+}
+
+// SnapshotFromABCI converts an ABCI snapshot to a Snapshot
+func SnapshotFromABCI(in *abci.Snapshot) (Snapshot, error) {
+	snapshot := Snapshot{
+		Height: in.Height,
+		Format: in.Format,
+		Chunks: in.Chunks,
+		Hash:   in.Hash,
+	}
+	if err := cdc.UnmarshalBinaryBare(in.Metadata, &snapshot.Metadata); err != nil {
+		return Snapshot{}, sdkerrors.Wrapf(ErrInvalidMetadata, "failed to unmarshal snapshot metadata: %v", err)
+	}
+	return snapshot, nil
+}
+
+// SnapshotItem is an item contained in a snapshot stream, it is either the metadata of a store
+// which starts the nodes of the store, or an exported IAVL node of the store
+type SnapshotItem struct {
+	Store *SnapshotStoreItem `json:"store"`
+	IAVL  *SnapshotIAVLItem  `json:"iavl"`
+}
+
+// SnapshotStoreItem contains the metadata of a store in a snapshot
+type SnapshotStoreItem struct {
+	Name string `json:"name"`
+}
+
+// SnapshotIAVLItem is an exported IAVL node
+type SnapshotIAVLItem struct {
+	Key     []byte `json:"key"`
+	Value   []byte `json:"value"`
+	Version int64  `json:"version"`
+	Height  int32  `json:"height"`
+}
@@ -0,0 +1,269 @@
+package rootmulti
+
+import (
+	"bufio"
+	"compress/zlib"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+
+	iavltree "github.com/okex/exchain/libs/iavl"
+	"github.com/pkg/errors"
+
+	snapshottypes "github.com/okex/exchain/libs/cosmos-sdk/snapshots/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/store/iavl"
+	"github.com/okex/exchain/libs/cosmos-sdk/store/transient"
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
+)
+
+// maxSnapshotItemSize is the max size of a single item in a snapshot stream
+const maxSnapshotItemSize = 64 << 20
+
+var _ snapshottypes.Snapshotter = (*Store)(nil)
+
+// Snapshot implements snapshottypes.Snapshotter. The IAVL stores are exported in the order of
+// their names as a zlib-compressed stream of length-prefixed SnapshotItems: a SnapshotStoreItem
+// opens every store, it's followed by the exported nodes of the store. The output for a given
+// format must be identical across nodes so that chunks from different peers fit together, the
+// format has to be bumped if it changes at the byte level.
+func (rs *Store) Snapshot(height uint64, format uint32, w io.Writer) error {
+	if format != snapshottypes.CurrentFormat {
+		return sdkerrors.Wrapf(snapshottypes.ErrUnknownFormat, "format %v", format)
+	}
+	if height == 0 {
+		return errors.New("cannot snapshot height 0")
+	}
+
+	stores, err := rs.snapshotStores(int64(height))
+	if err != nil {
+		return err
+	}
+
+	// the height mustn't be pruned while it is being exported
+	rs.snapshotting.add(int64(height))
+	defer rs.snapshotting.remove(int64(height))
+
+	zWriter, err := zlib.NewWriterLevel(w, zlib.BestSpeed)
+	if err != nil {
+		return err
+	}
+	for _, name := range sortedStoreNames(stores) {
+		if err := writeSnapshotItem(zWriter, snapshottypes.SnapshotItem{
+			Store: &snapshottypes.SnapshotStoreItem{Name: name},
+		}); err != nil {
+			return err
+		}
+
+		if err := exportStore(stores[name], int64(height), zWriter); err != nil {
+			return errors.Wrapf(err, "failed to export store %s", name)
+		}
+	}
+	return zWriter.Close()
+}
+
+// Restore implements snapshottypes.Snapshotter. It imports the IAVL stores from a snapshot stream
+// written by Snapshot, the stores must be empty. The restored height becomes the latest version.
+func (rs *Store) Restore(height uint64, format uint32, r io.Reader) error {
+	if format != snapshottypes.CurrentFormat {
+		return sdkerrors.Wrapf(snapshottypes.ErrUnknownFormat, "format %v", format)
+	}
+	if height == 0 {
+		return errors.New("cannot restore snapshot at height 0")
+	}
+
+	zReader, err := zlib.NewReader(r)
+	if err != nil {
+		return errors.Wrap(err, "failed to create zlib reader")
+	}
+	defer zReader.Close()
+	reader := bufio.NewReader(zReader)
+
+	var importer *iavltree.Importer
+	defer func() {
+		if importer != nil {
+			importer.Close()
+		}
+	}()
+	for {
+		item, err := readSnapshotItem(reader)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return errors.Wrap(err, "invalid snapshot item")
+		}
+
+		switch {
+		case item.Store != nil:
+			if importer != nil {
+				if err := importer.Commit(); err != nil {
+					return errors.Wrap(err, "failed to commit the import")
+				}
+			}
+			store, ok := rs.getStoreByName(item.Store.Name).(*iavl.Store)
+			if !ok || store == nil {
+				return fmt.Errorf("cannot import into non-IAVL store %q", item.Store.Name)
+			}
+			importer, err = store.Import(int64(height))
+			if err != nil {
+				return errors.Wrapf(err, "failed to import store %s", item.Store.Name)
+			}
+
+		case item.IAVL != nil:
+			if importer == nil {
+				return errors.New("received IAVL node item before store item")
+			}
+			node := &iavltree.ExportNode{
+				Key:     item.IAVL.Key,
+				Value:   item.IAVL.Value,
+				Version: item.IAVL.Version,
+				Height:  int8(item.IAVL.Height),
+			}
+			// amino doesn't differentiate between []byte{} and nil, but IAVL doesn't allow nil values
+			if node.Key == nil {
+				node.Key = []byte{}
+			}
+			if node.Height == 0 && node.Value == nil {
+				node.Value = []byte{}
+			}
+			if err := importer.Add(node); err != nil {
+				return errors.Wrap(err, "failed to add IAVL node")
+			}
+
+		default:
+			return errors.New("unknown snapshot item")
+		}
+	}
+
+	if importer != nil {
+		if err := importer.Commit(); err != nil {
+			return errors.Wrap(err, "failed to commit the import")
+		}
+	}
+
+	flushMetadata(rs.db, int64(height), rs.buildCommitInfo(int64(height)), []int64{}, []int64{})
+	return rs.LoadLatestVersion()
+}
+
+// snapshotStores collects the stores committed at the height to snapshot by name, only the IAVL stores
+// are supported
+func (rs *Store) snapshotStores(height int64) (map[string]*iavl.Store, error) {
+	stores := make(map[string]*iavl.Store)
+	for key := range rs.upgradedStores(height) {
+		switch store := rs.GetCommitKVStore(key).(type) {
+		case *iavl.Store:
+			stores[key.Name()] = store
+		case *transient.Store:
+			// non-persisted stores aren't snapshotted
+			continue
+		default:
+			return nil, fmt.Errorf("don't know how to snapshot store %q of type %T", key.Name(), store)
+		}
+	}
+	return stores, nil
+}
+
+// PinHeight keeps the height from being pruned until UnpinHeight is called, so that a snapshot of it taken
+// asynchronously can't lose it to the pruning of the blocks committed in the meantime
+func (rs *Store) PinHeight(height int64) {
+	rs.snapshotting.add(height)
+}
+
+// UnpinHeight releases the height pinned by PinHeight, it is pruned later as usual
+func (rs *Store) UnpinHeight(height int64) {
+	rs.snapshotting.remove(height)
+}
+
+// heightSet counts the exports in progress by height, it is shared by the copies of a Store
+type heightSet struct {
+	mtx     sync.Mutex
+	heights map[int64]uint32
+}
+
+func newHeightSet() *heightSet {
+	return &heightSet{heights: make(map[int64]uint32)}
+}
+
+func (hs *heightSet) add(height int64) {
+	hs.mtx.Lock()
+	defer hs.mtx.Unlock()
+	hs.heights[height]++
+}
+
+func (hs *heightSet) remove(height int64) {
+	hs.mtx.Lock()
+	defer hs.mtx.Unlock()
+	if hs.heights[height]--; hs.heights[height] == 0 {
+		delete(hs.heights, height)
+	}
+}
+
+func (hs *heightSet) contains(height int64) bool {
+	hs.mtx.Lock()
+	defer hs.mtx.Unlock()
+	return hs.heights[height] > 0
+}
+
+func sortedStoreNames(stores map[string]*iavl.Store) []string {
+	names := make([]string, 0, len(stores))
+	for name := range stores {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func exportStore(store *iavl.Store, height int64, w io.Writer) error {
+	exporter, err := store.Export(height)
+	if err != nil {
+		return err
+	}
+	defer exporter.Close()
+
+	for {
+		node, err := exporter.Next()
+		if err == iavltree.ExportDone {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		if err := writeSnapshotItem(w, snapshottypes.SnapshotItem{
+			IAVL: &snapshottypes.SnapshotIAVLItem{
+				Key:     node.Key,
+				Value:   node.Value,
+				Version: node.Version,
+				Height:  int32(node.Height),
+			},
+		}); err != nil {
+			return err
+		}
+	}
+}
+
+func writeSnapshotItem(w io.Writer, item snapshottypes.SnapshotItem) error {
+	bz, err := cdc.MarshalBinaryLengthPrefixed(item)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(bz)
+	return err
+}
+
+func readSnapshotItem(r *bufio.Reader) (item snapshottypes.SnapshotItem, err error) {
+	size, err := binary.ReadUvarint(r)
+	if err != nil {
+		return item, err
+	}
+	if size > maxSnapshotItemSize {
+		return item, fmt.Errorf("snapshot item size %d exceeds the max size %d", size, maxSnapshotItemSize)
+	}
+
+	bz := make([]byte, size)
+	if _, err := io.ReadFull(r, bz); err != nil {
+		return item, err
+	}
+	err = cdc.UnmarshalBinaryBare(bz, &item)
+	return item, err
+}
@@ -0,0 +1,100 @@
+package rootmulti
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"testing"
+
+	iavltree "github.com/okex/exchain/libs/iavl"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	snapshottypes "github.com/okex/exchain/libs/cosmos-sdk/snapshots/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/store/types"
+)
+
+func newSnapshotMultiStore(t *testing.T, versions int) *Store {
+	ms := newMultiStoreWithMounts(dbm.NewMemDB(), types.PruneNothing)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	for v := 1; v <= versions; v++ {
+		for _, name := range []string{"store1", "store2", "store3"} {
+			store := ms.GetKVStore(ms.keysByName[name])
+			for i := 0; i < 10; i++ {
+				store.Set([]byte(fmt.Sprintf("key%03d", i)), []byte(fmt.Sprintf("%s-%d-%d", name, v, i)))
+			}
+			store.Delete([]byte(fmt.Sprintf("key%03d", v)))
+		}
+		ms.Commit(&iavltree.TreeDelta{}, nil)
+	}
+	return ms
+}
+
+func TestMultiStore_SnapshotRestore(t *testing.T) {
+	source := newSnapshotMultiStore(t, 3)
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, source.Snapshot(2, snapshottypes.CurrentFormat, buf))
+
+	target := newMultiStoreWithMounts(dbm.NewMemDB(), types.PruneNothing)
+	require.NoError(t, target.LoadLatestVersion())
+	require.NoError(t, target.Restore(2, snapshottypes.CurrentFormat, buf))
+
+	require.Equal(t, int64(2), target.LastCommitID().Version)
+	sourceInfo, err := getCommitInfo(source.db, 2)
+	require.NoError(t, err)
+	require.Equal(t, sourceInfo.CommitID(), target.LastCommitID())
+
+	for name, key := range target.keysByName {
+		sourceStore, err := source.CacheMultiStoreWithVersion(2)
+		require.NoError(t, err)
+		expected := sourceStore.GetKVStore(source.keysByName[name])
+		got := target.GetKVStore(key)
+		for i := 0; i < 10; i++ {
+			k := []byte(fmt.Sprintf("key%03d", i))
+			require.Equal(t, expected.Get(k), got.Get(k), "store %s key %s", name, k)
+		}
+		require.Nil(t, got.Get([]byte("key002")))
+	}
+}
+
+func TestMultiStore_Snapshot_Deterministic(t *testing.T) {
+	a := &bytes.Buffer{}
+	b := &bytes.Buffer{}
+	require.NoError(t, newSnapshotMultiStore(t, 2).Snapshot(2, snapshottypes.CurrentFormat, a))
+	require.NoError(t, newSnapshotMultiStore(t, 2).Snapshot(2, snapshottypes.CurrentFormat, b))
+	require.Equal(t, a.Bytes(), b.Bytes())
+}
+
+func TestMultiStore_Snapshot_Errors(t *testing.T) {
+	ms := newSnapshotMultiStore(t, 1)
+
+	err := ms.Snapshot(1, 9, ioutil.Discard)
+	require.Error(t, err)
+	require.True(t, snapshottypes.ErrUnknownFormat.Is(err))
+
+	require.Error(t, ms.Snapshot(0, snapshottypes.CurrentFormat, ioutil.Discard))
+	require.Error(t, ms.Restore(1, snapshottypes.CurrentFormat, bytes.NewReader([]byte("invalid"))))
+}
+
+func TestMultiStore_PinHeight(t *testing.T) {
+	ms := newMultiStoreWithMounts(dbm.NewMemDB(), types.NewPruningOptions(1, 0, 1, math.MaxInt64))
+	require.NoError(t, ms.LoadLatestVersion())
+	ms.Commit(&iavltree.TreeDelta{}, nil)
+	ms.Commit(&iavltree.TreeDelta{}, nil)
+
+	// the pinned height is deferred by the pruning of the blocks committed later
+	ms.PinHeight(2)
+	ms.Commit(&iavltree.TreeDelta{}, nil)
+	ms.Commit(&iavltree.TreeDelta{}, nil)
+	require.Equal(t, []int64{2}, ms.pruneHeights)
+	require.NoError(t, ms.Snapshot(2, snapshottypes.CurrentFormat, ioutil.Discard))
+	require.Equal(t, []int64{2}, ms.pruneHeights)
+
+	// and pruned as usual once it is unpinned
+	ms.UnpinHeight(2)
+	ms.Commit(&iavltree.TreeDelta{}, nil)
+	require.NotContains(t, ms.pruneHeights, int64(2))
+}
@@ -56,6 +56,9 @@ type Store struct {
 	interBlockCache types.MultiStorePersistentCache
 
 	logger tmlog.Logger
+
+	// the heights being exported as state sync snapshots, they are not pruned
+	snapshotting *heightSet
 }
 
 var (
@@ -76,6 +79,7 @@ func NewStore(db dbm.DB) *Store {
 		keysByName:   make(map[string]types.StoreKey),
 		pruneHeights: make([]int64, 0),
 		versions:     make([]int64, 0),
+		snapshotting: newHeightSet(),
 	}
 }
 
@@ -127,6 +131,41 @@ func (rs *Store) MountStoreWithDB(key types.StoreKey, typ types.StoreType, db db
 	rs.keysByName[key.Name()] = key
 }
 
+// SetStoreUpgradeHeight sets the height from which a mounted store is committed. The store is kept
+// out of the commit info until then, so that it can be added to a running chain, and a negative
+// height keeps it out for good. It must be called before the store is loaded.
+func (rs *Store) SetStoreUpgradeHeight(key types.StoreKey, height int64) {
+	params, ok := rs.storesParams[key]
+	if !ok {
+		panic(fmt.Sprintf("Store %v is not mounted", key))
+	}
+	params.upgradeHeight = height
+	rs.storesParams[key] = params
+}
+
+// isStoreUpgraded returns whether the store is committed at the version, see SetStoreUpgradeHeight
+func (rs *Store) isStoreUpgraded(key types.StoreKey, version int64) bool {
+	height := rs.storesParams[key].upgradeHeight
+	return height >= 0 && version >= height
+}
+
+// upgradedStores returns the stores committed at the version
+func (rs *Store) upgradedStores(version int64) map[types.StoreKey]types.CommitKVStore {
+	for key := range rs.stores {
+		if rs.isStoreUpgraded(key, version) {
+			continue
+		}
+		stores := make(map[types.StoreKey]types.CommitKVStore, len(rs.stores))
+		for k, v := range rs.stores {
+			if rs.isStoreUpgraded(k, version) {
+				stores[k] = v
+			}
+		}
+		return stores
+	}
+	return rs.stores
+}
+
 // GetCommitStore returns a mounted CommitStore for a given StoreKey. If the
 // store is wrapped in an inter-block cache, it will be unwrapped before returning.
 func (rs *Store) GetCommitStore(key types.StoreKey) types.CommitStore {
@@ -220,6 +259,10 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 		if upgrades.IsAdded(key.Name()) {
 			storeParams.initialVersion = uint64(ver) + 1
 		}
+		// a store not committed yet saves its first version at its upgrade height
+		if storeParams.upgradeHeight > ver && commitID.Version == 0 {
+			storeParams.initialVersion = uint64(storeParams.upgradeHeight - 1)
+		}
 
 		// Load it
 		store, err := rs.loadCommitStoreFromParams(key, commitID, storeParams)
@@ -407,7 +450,7 @@ func (rs *Store) LastCommitID() types.CommitID {
 func (rs *Store) Commit(_ *iavltree.TreeDelta, deltas []byte) (types.CommitID, iavltree.TreeDelta, []byte) {
 	previousHeight := rs.lastCommitInfo.Version
 	version := previousHeight + 1
-	rs.lastCommitInfo, deltas = commitStores(version, rs.stores, deltas)
+	rs.lastCommitInfo, deltas = commitStores(version, rs.upgradedStores(version), deltas)
 
 	if !iavltree.EnableAsyncCommit {
 		// Determine if pruneHeight height needs to be added to the list of heights to
@@ -468,13 +511,23 @@ func (rs *Store) pruneStores() {
 			rs.logger.Info("pruning end")
 		}
 	}()
+	// the heights being exported as snapshots are pruned later
+	var pruneHeights, deferredHeights []int64
+	for _, height := range rs.pruneHeights {
+		if rs.snapshotting.contains(height) {
+			deferredHeights = append(deferredHeights, height)
+		} else {
+			pruneHeights = append(pruneHeights, height)
+		}
+	}
+
 	for key, store := range rs.stores {
 		if store.GetStoreType() == types.StoreTypeIAVL {
 			// If the store is wrapped with an inter-block cache, we must first unwrap
 			// it to get the underlying IAVL store.
 			store = rs.GetCommitKVStore(key)
 
-			if err := store.(*iavl.Store).DeleteVersions(rs.pruneHeights...); err != nil {
+			if err := store.(*iavl.Store).DeleteVersions(pruneHeights...); err != nil {
 				if errCause := errors.Cause(err); errCause != nil && errCause != iavltree.ErrVersionDoesNotExist {
 					panic(err)
 				}
@@ -482,7 +535,7 @@ func (rs *Store) pruneStores() {
 		}
 	}
 
-	rs.pruneHeights = make([]int64, 0)
+	rs.pruneHeights = append(make([]int64, 0), deferredHeights...)
 }
 
 func (rs *Store) FlushPruneHeights(pruneHeights []int64, versions []int64) {
@@ -522,6 +575,12 @@ func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStor
 	for key, store := range rs.stores {
 		switch store.GetStoreType() {
 		case types.StoreTypeIAVL:
+			// the stores committed after the version were empty at the version
+			if !rs.isStoreUpgraded(key, version) {
+				cachedStores[key] = dbadapter.Store{DB: dbm.NewMemDB()}
+				continue
+			}
+
 			// If the store is wrapped with an inter-block cache, we must first unwrap
 			// it to get the underlying IAVL store.
 			store = rs.GetCommitKVStore(key)
@@ -776,6 +835,7 @@ type storeParams struct {
 	db             dbm.DB
 	typ            types.StoreType
 	initialVersion uint64
+	upgradeHeight  int64
 }
 
 //----------------------------------------
@@ -1101,7 +1161,7 @@ func (rs *Store) Export(to *Store, initVersion int64) error {
 
 func (rs *Store) buildCommitInfo(version int64) commitInfo {
 	storeInfos := []storeInfo{}
-	for key, store := range rs.stores {
+	for key, store := range rs.upgradedStores(version) {
 		if store.GetStoreType() == types.StoreTypeTransient {
 			continue
 		}
@@ -1132,6 +1192,7 @@ func (src Store) Copy() *Store {
 		traceWriter:     src.traceWriter,
 		traceContext:    src.traceContext,
 		interBlockCache: src.interBlockCache,
+		snapshotting:    src.snapshotting,
 	}
 
 	dst.lastCommitInfo = commitInfo{
@@ -294,6 +294,53 @@ func TestMultistoreLoadWithUpgrade(t *testing.T) {
 	checkContains(t, ci.StoreInfos, []string{"store1", "restore2", "store3", "store4"})
 }
 
+func TestMultistoreStoreUpgradeHeight(t *testing.T) {
+	var db dbm.DB = dbm.NewMemDB()
+	newStore := func() *Store {
+		store := newMultiStoreWithMounts(db, types.PruneNothing)
+		store.SetStoreUpgradeHeight(store.keysByName["store3"], 3)
+		require.NoError(t, store.LoadLatestVersion())
+		return store
+	}
+
+	// the store is kept out of the commit info before the upgrade height
+	store := newStore()
+	commitID, _, _ := store.Commit(&iavltree.TreeDelta{}, nil)
+	ci, err := getCommitInfo(db, 1)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(ci.StoreInfos))
+	checkContains(t, ci.StoreInfos, []string{"store1", "store2"})
+	require.Equal(t, hashStores(store.upgradedStores(1)), commitID.Hash)
+
+	// a restarted store keeps it out as well
+	store = newStore()
+	store.Commit(&iavltree.TreeDelta{}, nil)
+	ci, err = getCommitInfo(db, 2)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(ci.StoreInfos))
+
+	// the store is committed from the upgrade height on, with its first version at the height
+	k, v := []byte("key"), []byte("value")
+	store.getStoreByName("store3").(types.KVStore).Set(k, v)
+	commitID, _, _ = store.Commit(&iavltree.TreeDelta{}, nil)
+	checkStore(t, store, getExpectedCommitID(store, 3), commitID)
+	ci, err = getCommitInfo(db, 3)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(ci.StoreInfos))
+	require.Equal(t, int64(3), store.getStoreByName("store3").(types.CommitKVStore).LastCommitID().Version)
+
+	store = newStore()
+	require.Equal(t, v, store.getStoreByName("store3").(types.KVStore).Get(k))
+
+	// the store is empty at the versions before the upgrade height
+	cms, err := store.CacheMultiStoreWithVersion(2)
+	require.NoError(t, err)
+	require.Nil(t, cms.GetKVStore(store.keysByName["store3"]).Get(k))
+	cms, err = store.CacheMultiStoreWithVersion(3)
+	require.NoError(t, err)
+	require.Equal(t, v, cms.GetKVStore(store.keysByName["store3"]).Get(k))
+}
+
 func TestParsePath(t *testing.T) {
 	_, _, err := parsePath("foo")
 	require.Error(t, err)
@@ -10,11 +10,15 @@ import (
 // 2. ChangeEvmDenomByProposal
 // 3. BankTransferBlock
 
+// The erc20 module is enabled from milestoneErc20Height, < 0 disabled, 0 = enabled since genesis
+
 var (
-	MILESTONE_MERCURY_HEIGHT     string
-	milestoneMercuryHeight       int64
+	MILESTONE_MERCURY_HEIGHT string
+	milestoneMercuryHeight   int64
+	MILESTONE_ERC20_HEIGHT   string
+	milestoneErc20Height     int64
 
-	once                         sync.Once
+	once sync.Once
 )
 
 func string2number(input string) int64 {
@@ -31,6 +35,7 @@ func string2number(input string) int64 {
 func initVersionBlockHeight() {
 	once.Do(func() {
 		milestoneMercuryHeight = string2number(MILESTONE_MERCURY_HEIGHT)
+		milestoneErc20Height = string2number(MILESTONE_ERC20_HEIGHT)
 	})
 }
 
@@ -47,6 +52,16 @@ func HigherThanMercury(height int64) bool {
 	return height > milestoneMercuryHeight
 }
 
+// GetErc20Height returns the height from which the erc20 module is enabled
+func GetErc20Height() int64 {
+	return milestoneErc20Height
+}
+
+// IsErc20Enabled returns whether the erc20 module is enabled at the height
+func IsErc20Enabled(height int64) bool {
+	return milestoneErc20Height >= 0 && height >= milestoneErc20Height
+}
+
 ////disable transfer tokens to contract address by cli
 //func IsDisableTransferToContractBlock(height int64) bool {
 //	return higherThanMercury(height)
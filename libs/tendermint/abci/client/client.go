@@ -1,6 +1,7 @@
 package abcicli
 
 import (
+	"errors"
 	"fmt"
 	"sync"
 
@@ -50,6 +51,18 @@ type Client interface {
 	ParallelTxs([][]byte) []*types.ResponseDeliverTx
 }
 
+// SnapshotClient is implemented by the clients which are able to reach a types.SnapshotApplication.
+// Only the local client does so, the snapshot messages are not part of the socket and grpc protocols.
+type SnapshotClient interface {
+	ListSnapshotsSync(types.RequestListSnapshots) (*types.ResponseListSnapshots, error)
+	OfferSnapshotSync(types.RequestOfferSnapshot) (*types.ResponseOfferSnapshot, error)
+	LoadSnapshotChunkSync(types.RequestLoadSnapshotChunk) (*types.ResponseLoadSnapshotChunk, error)
+	ApplySnapshotChunkSync(types.RequestApplySnapshotChunk) (*types.ResponseApplySnapshotChunk, error)
+}
+
+// ErrSnapshotsUnsupported is returned by a SnapshotClient whose application can't take snapshots
+var ErrSnapshotsUnsupported = errors.New("application does not support snapshots")
+
 //----------------------------------------
 
 // NewClient returns a new ABCI client of the specified transport type.
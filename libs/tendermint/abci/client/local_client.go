@@ -7,7 +7,10 @@ import (
 	"github.com/okex/exchain/libs/tendermint/libs/service"
 )
 
-var _ Client = (*localClient)(nil)
+var (
+	_ Client         = (*localClient)(nil)
+	_ SnapshotClient = (*localClient)(nil)
+)
 
 // NOTE: use defer to unlock mutex because Application might panic (e.g., in
 // case of malicious tx or query). It only makes sense for publicly exposed
@@ -254,6 +257,56 @@ func (app *localClient) EndBlockSync(req types.RequestEndBlock) (*types.Response
 	return &res, nil
 }
 
+func (app *localClient) ListSnapshotsSync(req types.RequestListSnapshots) (*types.ResponseListSnapshots, error) {
+	snapshotApp, ok := app.Application.(types.SnapshotApplication)
+	if !ok {
+		return nil, ErrSnapshotsUnsupported
+	}
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+
+	res := snapshotApp.ListSnapshots(req)
+	return &res, nil
+}
+
+func (app *localClient) OfferSnapshotSync(req types.RequestOfferSnapshot) (*types.ResponseOfferSnapshot, error) {
+	snapshotApp, ok := app.Application.(types.SnapshotApplication)
+	if !ok {
+		return nil, ErrSnapshotsUnsupported
+	}
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+
+	res := snapshotApp.OfferSnapshot(req)
+	return &res, nil
+}
+
+func (app *localClient) LoadSnapshotChunkSync(
+	req types.RequestLoadSnapshotChunk) (*types.ResponseLoadSnapshotChunk, error) {
+	snapshotApp, ok := app.Application.(types.SnapshotApplication)
+	if !ok {
+		return nil, ErrSnapshotsUnsupported
+	}
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+
+	res := snapshotApp.LoadSnapshotChunk(req)
+	return &res, nil
+}
+
+func (app *localClient) ApplySnapshotChunkSync(
+	req types.RequestApplySnapshotChunk) (*types.ResponseApplySnapshotChunk, error) {
+	snapshotApp, ok := app.Application.(types.SnapshotApplication)
+	if !ok {
+		return nil, ErrSnapshotsUnsupported
+	}
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+
+	res := snapshotApp.ApplySnapshotChunk(req)
+	return &res, nil
+}
+
 //-------------------------------------------------------
 
 func (app *localClient) callback(req *types.Request, res *types.Response) *ReqRes {
@@ -0,0 +1,89 @@
+package types
+
+// SnapshotApplication is an optional extension of Application for the apps which are able to take
+// snapshots of their state and to restore from them, it is what state sync is built on.
+// NOTE: it is only reachable through the local client, the socket and grpc clients don't carry it
+type SnapshotApplication interface {
+	ListSnapshots(RequestListSnapshots) ResponseListSnapshots                // List available snapshots
+	OfferSnapshot(RequestOfferSnapshot) ResponseOfferSnapshot                // Offer a snapshot to the application
+	LoadSnapshotChunk(RequestLoadSnapshotChunk) ResponseLoadSnapshotChunk    // Load a snapshot chunk
+	ApplySnapshotChunk(RequestApplySnapshotChunk) ResponseApplySnapshotChunk // Apply a snapshot chunk
+}
+
+// Snapshot contains the metadata of a state snapshot taken by the application
+type Snapshot struct {
+	Height   uint64 `json:"height"`   // The height at which the snapshot was taken
+	Format   uint32 `json:"format"`   // The application-specific snapshot format
+	Chunks   uint32 `json:"chunks"`   // Number of chunks in the snapshot
+	Hash     []byte `json:"hash"`     // Arbitrary snapshot hash, equal only if identical
+	Metadata []byte `json:"metadata"` // Arbitrary application metadata
+}
+
+// RequestListSnapshots asks the application for its local snapshots
+type RequestListSnapshots struct{}
+
+// ResponseListSnapshots returns the local snapshots of the application
+type ResponseListSnapshots struct {
+	Snapshots []*Snapshot `json:"snapshots"`
+}
+
+// RequestOfferSnapshot offers a snapshot discovered from a peer to the application
+type RequestOfferSnapshot struct {
+	Snapshot *Snapshot `json:"snapshot"` // The snapshot offered for restoration
+	AppHash  []byte    `json:"app_hash"` // The light client-verified app hash for the snapshot height
+}
+
+// OfferSnapshotResult is the decision of the application on an offered snapshot
+type OfferSnapshotResult int32
+
+const (
+	OfferSnapshotUnknown      OfferSnapshotResult = 0 // Unknown result, abort all snapshot restoration
+	OfferSnapshotAccept       OfferSnapshotResult = 1 // Snapshot accepted, apply chunks
+	OfferSnapshotAbort        OfferSnapshotResult = 2 // Abort all snapshot restoration
+	OfferSnapshotReject       OfferSnapshotResult = 3 // Reject this specific snapshot, try others
+	OfferSnapshotRejectFormat OfferSnapshotResult = 4 // Reject all snapshots of this format, try others
+	OfferSnapshotRejectSender OfferSnapshotResult = 5 // Reject all snapshots from the sender(s), try others
+)
+
+// ResponseOfferSnapshot returns the decision of the application on an offered snapshot
+type ResponseOfferSnapshot struct {
+	Result OfferSnapshotResult `json:"result"`
+}
+
+// RequestLoadSnapshotChunk asks the application for a chunk of one of its local snapshots
+type RequestLoadSnapshotChunk struct {
+	Height uint64 `json:"height"`
+	Format uint32 `json:"format"`
+	Chunk  uint32 `json:"chunk"`
+}
+
+// ResponseLoadSnapshotChunk returns the chunk asked for, it is empty if the chunk is not found
+type ResponseLoadSnapshotChunk struct {
+	Chunk []byte `json:"chunk"`
+}
+
+// RequestApplySnapshotChunk hands a chunk of the accepted snapshot to the application
+type RequestApplySnapshotChunk struct {
+	Index  uint32 `json:"index"`
+	Chunk  []byte `json:"chunk"`
+	Sender string `json:"sender"`
+}
+
+// ApplySnapshotChunkResult is the outcome of applying a snapshot chunk
+type ApplySnapshotChunkResult int32
+
+const (
+	ApplySnapshotChunkUnknown        ApplySnapshotChunkResult = 0 // Unknown result, abort all snapshot restoration
+	ApplySnapshotChunkAccept         ApplySnapshotChunkResult = 1 // Chunk successfully accepted
+	ApplySnapshotChunkAbort          ApplySnapshotChunkResult = 2 // Abort all snapshot restoration
+	ApplySnapshotChunkRetry          ApplySnapshotChunkResult = 3 // Retry chunk (combine with refetch and reject)
+	ApplySnapshotChunkRetrySnapshot  ApplySnapshotChunkResult = 4 // Retry snapshot (combine with refetch and reject)
+	ApplySnapshotChunkRejectSnapshot ApplySnapshotChunkResult = 5 // Reject this snapshot, try others
+)
+
+// ResponseApplySnapshotChunk returns the outcome of applying a snapshot chunk
+type ResponseApplySnapshotChunk struct {
+	Result        ApplySnapshotChunkResult `json:"result"`
+	RefetchChunks []uint32                 `json:"refetch_chunks"` // Chunks to refetch and reapply
+	RejectSenders []string                 `json:"reject_senders"` // Chunk senders to reject and ban
+}
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"encoding/hex"
 	"fmt"
 	"net/http"
 	"os"
@@ -69,6 +70,7 @@ type Config struct {
 	RPC             *RPCConfig             `mapstructure:"rpc"`
 	P2P             *P2PConfig             `mapstructure:"p2p"`
 	Mempool         *MempoolConfig         `mapstructure:"mempool"`
+	StateSync       *StateSyncConfig       `mapstructure:"statesync"`
 	FastSync        *FastSyncConfig        `mapstructure:"fastsync"`
 	Consensus       *ConsensusConfig       `mapstructure:"consensus"`
 	TxIndex         *TxIndexConfig         `mapstructure:"tx_index"`
@@ -82,6 +84,7 @@ func DefaultConfig() *Config {
 		RPC:             DefaultRPCConfig(),
 		P2P:             DefaultP2PConfig(),
 		Mempool:         DefaultMempoolConfig(),
+		StateSync:       DefaultStateSyncConfig(),
 		FastSync:        DefaultFastSyncConfig(),
 		Consensus:       DefaultConsensusConfig(),
 		TxIndex:         DefaultTxIndexConfig(),
@@ -96,6 +99,7 @@ func TestConfig() *Config {
 		RPC:             TestRPCConfig(),
 		P2P:             TestP2PConfig(),
 		Mempool:         TestMempoolConfig(),
+		StateSync:       TestStateSyncConfig(),
 		FastSync:        TestFastSyncConfig(),
 		Consensus:       TestConsensusConfig(),
 		TxIndex:         TestTxIndexConfig(),
@@ -133,12 +137,18 @@ func (cfg *Config) ValidateBasic() error {
 	if err := cfg.Mempool.ValidateBasic(); err != nil {
 		return errors.Wrap(err, "Error in [mempool] section")
 	}
+	if err := cfg.StateSync.ValidateBasic(); err != nil {
+		return errors.Wrap(err, "Error in [statesync] section")
+	}
 	if err := cfg.FastSync.ValidateBasic(); err != nil {
 		return errors.Wrap(err, "Error in [fastsync] section")
 	}
 	if err := cfg.Consensus.ValidateBasic(); err != nil {
 		return errors.Wrap(err, "Error in [consensus] section")
 	}
+	if err := cfg.TxIndex.ValidateBasic(); err != nil {
+		return errors.Wrap(err, "Error in [tx_index] section")
+	}
 	return errors.Wrap(
 		cfg.Instrumentation.ValidateBasic(),
 		"Error in [instrumentation] section",
@@ -744,6 +754,90 @@ func (cfg *MempoolConfig) ValidateBasic() error {
 	return nil
 }
 
+//-----------------------------------------------------------------------------
+// StateSyncConfig
+
+// StateSyncConfig defines the configuration for the Tendermint state sync service
+type StateSyncConfig struct {
+	// State sync rapidly bootstraps a new node by discovering, fetching, and restoring a state
+	// machine snapshot from peers instead of fetching and replaying historical blocks
+	Enable bool `mapstructure:"enable"`
+
+	// The directory the snapshot chunks are stored in while they are fetched, the system
+	// temporary directory is used if empty
+	TempDir string `mapstructure:"temp_dir"`
+
+	// RPC servers (comma-separated) for light client verification of the synced state machine
+	// and retrieval of state data for node bootstrapping, at least two are required
+	RPCServers []string `mapstructure:"rpc_servers"`
+
+	// The trusted height and hash of a header obtained from a trusted source, and a period
+	// during which validators can be trusted
+	TrustHeight int64         `mapstructure:"trust_height"`
+	TrustHash   string        `mapstructure:"trust_hash"`
+	TrustPeriod time.Duration `mapstructure:"trust_period"`
+
+	// Time spent discovering snapshots before picking one to restore
+	DiscoveryTime time.Duration `mapstructure:"discovery_time"`
+}
+
+// DefaultStateSyncConfig returns a default configuration for the state sync service
+func DefaultStateSyncConfig() *StateSyncConfig {
+	return &StateSyncConfig{
+		TrustPeriod:   168 * time.Hour,
+		DiscoveryTime: 15 * time.Second,
+	}
+}
+
+// TestStateSyncConfig returns a default configuration for the state sync service
+func TestStateSyncConfig() *StateSyncConfig {
+	return DefaultStateSyncConfig()
+}
+
+// TrustHashBytes returns the hash of the trusted header as bytes
+func (cfg *StateSyncConfig) TrustHashBytes() []byte {
+	// validated in ValidateBasic, so we can safely panic here
+	bytes, err := hex.DecodeString(cfg.TrustHash)
+	if err != nil {
+		panic(err)
+	}
+	return bytes
+}
+
+// ValidateBasic performs basic validation.
+func (cfg *StateSyncConfig) ValidateBasic() error {
+	if !cfg.Enable {
+		return nil
+	}
+	if len(cfg.RPCServers) == 0 {
+		return errors.New("rpc_servers is required")
+	}
+	if len(cfg.RPCServers) < 2 {
+		return errors.New("at least two rpc_servers entries is required")
+	}
+	for _, server := range cfg.RPCServers {
+		if len(server) == 0 {
+			return errors.New("found empty rpc_servers entry")
+		}
+	}
+	if cfg.TrustHeight <= 0 {
+		return errors.New("trusted_height is required")
+	}
+	if len(cfg.TrustHash) == 0 {
+		return errors.New("trusted_hash is required")
+	}
+	if _, err := hex.DecodeString(cfg.TrustHash); err != nil {
+		return fmt.Errorf("invalid trusted_hash: %w", err)
+	}
+	if cfg.TrustPeriod <= 0 {
+		return errors.New("trusted_period is required")
+	}
+	if cfg.DiscoveryTime != 0 && cfg.DiscoveryTime < 5*time.Second {
+		return errors.New("discovery_time must be 0s or larger than 5 seconds")
+	}
+	return nil
+}
+
 //-----------------------------------------------------------------------------
 // FastSyncConfig
 
@@ -948,8 +1042,18 @@ type TxIndexConfig struct {
 	//   1) "null"
 	//   2) "kv" (default) - the simplest possible indexer,
 	//      backed by key-value storage (defaults to levelDB; see DBBackend).
+	//   3) "sqlite" - all the blocks, txs and events are written to a SQLite
+	//      database, see SQLConn.
+	//   4) "mysql" - the same as "sqlite", but written to a MySQL database.
 	Indexer string `mapstructure:"indexer"`
 
+	// The data source name of the SQL database used by the "sqlite" and "mysql"
+	// indexers, e.g. "user:password@tcp(127.0.0.1:3306)/tm_events" for MySQL.
+	// It defaults to "data/tx_index.sqlite" in the root directory for SQLite.
+	// The SQL indexers can't be searched by the tx_search and block_search
+	// RPC routes, the database is meant to be queried directly.
+	SQLConn string `mapstructure:"sql_conn"`
+
 	// Comma-separated list of compositeKeys to index (by default the only key is "tx.hash")
 	//
 	// You can also index transactions by height by adding "tx.height" key here.
@@ -972,11 +1076,27 @@ type TxIndexConfig struct {
 func DefaultTxIndexConfig() *TxIndexConfig {
 	return &TxIndexConfig{
 		Indexer:      "kv",
+		SQLConn:      "",
 		IndexKeys:    "",
 		IndexAllKeys: false,
 	}
 }
 
+// ValidateBasic performs basic validation (checking param bounds, etc.) and
+// returns an error if any check fails.
+func (cfg *TxIndexConfig) ValidateBasic() error {
+	switch cfg.Indexer {
+	case "", "null", "kv", "sqlite":
+	case "mysql":
+		if cfg.SQLConn == "" {
+			return errors.New("sql_conn can't be empty for the mysql indexer")
+		}
+	default:
+		return fmt.Errorf("unknown indexer %q", cfg.Indexer)
+	}
+	return nil
+}
+
 // TestTxIndexConfig returns a default configuration for the transaction indexer.
 func TestTxIndexConfig() *TxIndexConfig {
 	return DefaultTxIndexConfig()
@@ -0,0 +1,100 @@
+package fs_cgi
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/okex/exchain/libs/tendermint/libs/log"
+)
+
+// FsClient stores blocks and deltas as files in a directory, which is
+// usually a shared volume (e.g. NFS) mounted by every node of the fleet.
+type FsClient struct {
+	dir    string
+	logger log.Logger
+}
+
+func NewFsClient(dir string, l log.Logger) (*FsClient, error) {
+	if dir == "" {
+		return nil, fmt.Errorf("delta dir is empty")
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &FsClient{dir, l}, nil
+}
+
+func (f *FsClient) SetBlock(height int64, bytes []byte) error {
+	if len(bytes) == 0 {
+		return fmt.Errorf("block is empty")
+	}
+	return f.setNX(setBlockKey(height), bytes)
+}
+
+func (f *FsClient) SetDeltas(height int64, bytes []byte) error {
+	if len(bytes) == 0 {
+		return fmt.Errorf("delta is empty")
+	}
+	return f.setNX(setDeltaKey(height), bytes)
+}
+
+func (f *FsClient) GetBlock(height int64) ([]byte, error) {
+	bytes, err := ioutil.ReadFile(filepath.Join(f.dir, setBlockKey(height)))
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("get empty block")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return bytes, nil
+}
+
+func (f *FsClient) GetDeltas(height int64) ([]byte, error) {
+	bytes, err := ioutil.ReadFile(filepath.Join(f.dir, setDeltaKey(height)))
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("get empty delta")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return bytes, nil
+}
+
+// setNX writes bytes to a temp file and links it into place, so readers never
+// observe a partially written file and an existing key is left untouched.
+func (f *FsClient) setNX(key string, bytes []byte) error {
+	tmp, err := ioutil.TempFile(f.dir, "."+key+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err = tmp.Write(bytes); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+
+	err = os.Link(tmp.Name(), filepath.Join(f.dir, key))
+	if os.IsExist(err) {
+		return nil
+	}
+	return err
+}
+
+func setBlockKey(height int64) string {
+	return "BH-" + strconv.Itoa(int(height))
+}
+
+func setDeltaKey(height int64) string {
+	return "DH-" + strconv.Itoa(int(height))
+}
@@ -0,0 +1,57 @@
+package fs_cgi
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/exchain/libs/tendermint/libs/log"
+)
+
+func TestFsClient(t *testing.T) {
+	dir, err := ioutil.TempDir("", "delta-fs")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	_, err = NewFsClient("", log.NewNopLogger())
+	require.Error(t, err)
+
+	c, err := NewFsClient(dir, log.NewNopLogger())
+	require.NoError(t, err)
+
+	_, err = c.GetBlock(1)
+	require.EqualError(t, err, "get empty block")
+	_, err = c.GetDeltas(1)
+	require.EqualError(t, err, "get empty delta")
+
+	require.EqualError(t, c.SetBlock(1, nil), "block is empty")
+	require.EqualError(t, c.SetDeltas(1, []byte{}), "delta is empty")
+
+	require.NoError(t, c.SetBlock(1, []byte("block1")))
+	require.NoError(t, c.SetDeltas(1, []byte("delta1")))
+
+	// the first write of a height wins
+	require.NoError(t, c.SetBlock(1, []byte("block1'")))
+	require.NoError(t, c.SetDeltas(1, []byte("delta1'")))
+
+	bz, err := c.GetBlock(1)
+	require.NoError(t, err)
+	require.Equal(t, []byte("block1"), bz)
+	bz, err = c.GetDeltas(1)
+	require.NoError(t, err)
+	require.Equal(t, []byte("delta1"), bz)
+
+	// no temp files are left behind
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+
+	// another node sharing the directory sees the same data
+	other, err := NewFsClient(dir, log.NewNopLogger())
+	require.NoError(t, err)
+	bz, err = other.GetDeltas(1)
+	require.NoError(t, err)
+	require.Equal(t, []byte("delta1"), bz)
+}
@@ -0,0 +1,125 @@
+package http_cgi
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/okex/exchain/libs/tendermint/libs/log"
+)
+
+const timeout = 10 * time.Second
+
+// HttpClient stores blocks and deltas as objects behind a generic
+// S3-compatible HTTP endpoint, e.g. http://minio:9000/bucket/. Objects are
+// written with PUT and read with GET, so the bucket must accept anonymous
+// or pre-authorized requests from the nodes.
+type HttpClient struct {
+	url    string
+	client *http.Client
+	logger log.Logger
+}
+
+func NewHttpClient(url string, l log.Logger) (*HttpClient, error) {
+	if url == "" {
+		return nil, fmt.Errorf("delta url is empty")
+	}
+	if !strings.HasSuffix(url, "/") {
+		url += "/"
+	}
+	return &HttpClient{url, &http.Client{Timeout: timeout}, l}, nil
+}
+
+func (h *HttpClient) SetBlock(height int64, bytes []byte) error {
+	if len(bytes) == 0 {
+		return fmt.Errorf("block is empty")
+	}
+	return h.put(setBlockKey(height), bytes)
+}
+
+func (h *HttpClient) SetDeltas(height int64, bytes []byte) error {
+	if len(bytes) == 0 {
+		return fmt.Errorf("delta is empty")
+	}
+	return h.put(setDeltaKey(height), bytes)
+}
+
+func (h *HttpClient) GetBlock(height int64) ([]byte, error) {
+	bytes, found, err := h.get(setBlockKey(height))
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("get empty block")
+	}
+	return bytes, nil
+}
+
+func (h *HttpClient) GetDeltas(height int64) ([]byte, error) {
+	bytes, found, err := h.get(setDeltaKey(height))
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("get empty delta")
+	}
+	return bytes, nil
+}
+
+// put uploads an object. If-None-Match keeps the first upload of a key, like
+// SetNX does for redis; stores that already hold the key answer 412.
+func (h *HttpClient) put(key string, body []byte) error {
+	req, err := http.NewRequest(http.MethodPut, h.url+key, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("If-None-Match", "*")
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusCreated, http.StatusNoContent, http.StatusPreconditionFailed:
+		return nil
+	default:
+		return fmt.Errorf("put %s failed, status: %s", key, resp.Status)
+	}
+}
+
+func (h *HttpClient) get(key string) ([]byte, bool, error) {
+	resp, err := h.client.Get(h.url + key)
+	if err != nil {
+		return nil, false, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, false, nil
+	default:
+		return nil, false, fmt.Errorf("get %s failed, status: %s", key, resp.Status)
+	}
+
+	bytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, false, err
+	}
+	return bytes, true, nil
+}
+
+func setBlockKey(height int64) string {
+	return "BH-" + strconv.Itoa(int(height))
+}
+
+func setDeltaKey(height int64) string {
+	return "DH-" + strconv.Itoa(int(height))
+}
@@ -0,0 +1,87 @@
+package http_cgi
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/exchain/libs/tendermint/libs/log"
+)
+
+// objectStore is a minimal MinIO-style stand-in serving PUT/GET on /<bucket>/<key>.
+type objectStore struct {
+	mtx     sync.Mutex
+	objects map[string][]byte
+}
+
+func (s *objectStore) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if !strings.HasPrefix(r.URL.Path, "/delta/") {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+	switch r.Method {
+	case http.MethodPut:
+		if _, ok := s.objects[r.URL.Path]; ok && r.Header.Get("If-None-Match") == "*" {
+			w.WriteHeader(http.StatusPreconditionFailed)
+			return
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		s.objects[r.URL.Path] = body
+	case http.MethodGet:
+		body, ok := s.objects[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write(body)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func TestHttpClient(t *testing.T) {
+	server := httptest.NewServer(&objectStore{objects: make(map[string][]byte)})
+	defer server.Close()
+
+	_, err := NewHttpClient("", log.NewNopLogger())
+	require.Error(t, err)
+
+	c, err := NewHttpClient(server.URL+"/delta", log.NewNopLogger())
+	require.NoError(t, err)
+
+	_, err = c.GetBlock(1)
+	require.EqualError(t, err, "get empty block")
+	_, err = c.GetDeltas(1)
+	require.EqualError(t, err, "get empty delta")
+
+	require.EqualError(t, c.SetBlock(1, nil), "block is empty")
+	require.EqualError(t, c.SetDeltas(1, []byte{}), "delta is empty")
+
+	require.NoError(t, c.SetBlock(1, []byte("block1")))
+	require.NoError(t, c.SetDeltas(1, []byte("delta1")))
+
+	// the first write of a height wins
+	require.NoError(t, c.SetBlock(1, []byte("block1'")))
+	require.NoError(t, c.SetDeltas(1, []byte("delta1'")))
+
+	bz, err := c.GetBlock(1)
+	require.NoError(t, err)
+	require.Equal(t, []byte("block1"), bz)
+	bz, err = c.GetDeltas(1)
+	require.NoError(t, err)
+	require.Equal(t, []byte("delta1"), bz)
+
+	// unexpected status codes are reported
+	bad, err := NewHttpClient(server.URL+"/other/", log.NewNopLogger())
+	require.NoError(t, err)
+	require.Error(t, bad.SetBlock(1, []byte("block1")))
+	_, err = bad.GetBlock(1)
+	require.Error(t, err)
+}
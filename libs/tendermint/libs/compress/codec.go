@@ -0,0 +1,110 @@
+package compress
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// Codec identifies the algorithm used to compress a payload.
+type Codec byte
+
+const (
+	CodecNone Codec = iota
+	CodecZlib
+	CodecGzip
+	CodecFlate
+	CodecZstd
+	CodecSnappy
+)
+
+var codecNames = map[Codec]string{
+	CodecNone:   "none",
+	CodecZlib:   "zlib",
+	CodecGzip:   "gzip",
+	CodecFlate:  "flate",
+	CodecZstd:   "zstd",
+	CodecSnappy: "snappy",
+}
+
+// headerMagic prefixes every payload compressed by Compress. The leading zero
+// byte never starts an amino encoded struct (field number 0 is invalid), so
+// payloads without a header can be told apart and are returned as they are.
+var headerMagic = []byte{0x00, 'C', 'M', 'P'}
+
+// headerLen is the length of the magic plus one byte for the codec.
+var headerLen = len(headerMagic) + 1
+
+func (c Codec) String() string {
+	if name, ok := codecNames[c]; ok {
+		return name
+	}
+	return fmt.Sprintf("unknown(%d)", byte(c))
+}
+
+// ParseCodec returns the codec with the given name.
+func ParseCodec(name string) (Codec, error) {
+	for c, n := range codecNames {
+		if n == name {
+			return c, nil
+		}
+	}
+	return CodecNone, fmt.Errorf("unknown compress codec: %s", name)
+}
+
+// NewCompressBroker returns the CompressBroker of the codec, or nil for CodecNone.
+func NewCompressBroker(c Codec) (CompressBroker, error) {
+	switch c {
+	case CodecNone:
+		return nil, nil
+	case CodecZlib:
+		return &Zlib{}, nil
+	case CodecGzip:
+		return &Gzip{}, nil
+	case CodecFlate:
+		return &Flate{}, nil
+	case CodecZstd:
+		return &Zstd{}, nil
+	case CodecSnappy:
+		return &Snappy{}, nil
+	default:
+		return nil, fmt.Errorf("unknown compress codec: %s", c)
+	}
+}
+
+// Compress compresses src with the codec and prefixes the header naming the
+// codec. CodecNone returns src unchanged, without a header, so the payload
+// stays readable by nodes that don't know about the header.
+func Compress(c Codec, src []byte) ([]byte, error) {
+	broker, err := NewCompressBroker(c)
+	if err != nil {
+		return nil, err
+	}
+	if broker == nil {
+		return src, nil
+	}
+	compressed, err := broker.DefaultCompress(src)
+	if err != nil {
+		return nil, err
+	}
+	payload := make([]byte, 0, headerLen+len(compressed))
+	payload = append(payload, headerMagic...)
+	payload = append(payload, byte(c))
+	return append(payload, compressed...), nil
+}
+
+// UnCompress decompresses a payload produced by Compress with any codec.
+// Payloads without a header are returned unchanged.
+func UnCompress(payload []byte) ([]byte, error) {
+	if len(payload) < headerLen || !bytes.Equal(payload[:len(headerMagic)], headerMagic) {
+		return payload, nil
+	}
+	c := Codec(payload[len(headerMagic)])
+	broker, err := NewCompressBroker(c)
+	if err != nil {
+		return nil, err
+	}
+	if broker == nil {
+		return payload[headerLen:], nil
+	}
+	return broker.UnCompress(payload[headerLen:])
+}
@@ -0,0 +1,73 @@
+package compress
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCodec(t *testing.T) {
+	for c, name := range codecNames {
+		parsed, err := ParseCodec(name)
+		require.NoError(t, err)
+		require.Equal(t, c, parsed)
+		require.Equal(t, name, c.String())
+	}
+	_, err := ParseCodec("lz4")
+	require.Error(t, err)
+}
+
+func TestCompressBrokers(t *testing.T) {
+	src := bytes.Repeat([]byte("exchain delta payload "), 1000)
+	for c := range codecNames {
+		broker, err := NewCompressBroker(c)
+		require.NoError(t, err)
+		if broker == nil {
+			require.Equal(t, CodecNone, c)
+			continue
+		}
+		for _, compress := range []func([]byte) ([]byte, error){
+			broker.DefaultCompress, broker.BestCompress, broker.FastCompress,
+		} {
+			compressed, err := compress(src)
+			require.NoError(t, err, c.String())
+			require.True(t, len(compressed) < len(src), c.String())
+			uncompressed, err := broker.UnCompress(compressed)
+			require.NoError(t, err, c.String())
+			require.Equal(t, src, uncompressed, c.String())
+		}
+	}
+}
+
+func TestCompressHeader(t *testing.T) {
+	// amino encoded structs never start with a zero byte
+	src := append([]byte{0x0a, 0x03}, bytes.Repeat([]byte("abc"), 100)...)
+
+	// every codec can be decoded without knowing which one was used
+	for c := range codecNames {
+		payload, err := Compress(c, src)
+		require.NoError(t, err, c.String())
+		if c == CodecNone {
+			require.Equal(t, src, payload)
+		} else {
+			require.Equal(t, headerMagic, payload[:len(headerMagic)], c.String())
+			require.Equal(t, byte(c), payload[len(headerMagic)], c.String())
+		}
+		uncompressed, err := UnCompress(payload)
+		require.NoError(t, err, c.String())
+		require.Equal(t, src, uncompressed, c.String())
+	}
+
+	// unknown codec in the header
+	_, err := UnCompress(append(append([]byte{}, headerMagic...), 0xff, 0x01))
+	require.Error(t, err)
+	_, err = Compress(Codec(0xff), src)
+	require.Error(t, err)
+
+	// corrupted payload
+	payload, err := Compress(CodecZstd, src)
+	require.NoError(t, err)
+	_, err = UnCompress(payload[:len(payload)-4])
+	require.Error(t, err)
+}
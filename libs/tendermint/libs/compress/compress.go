@@ -6,6 +6,9 @@ import (
 	"compress/gzip"
 	"compress/zlib"
 	"io"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
 )
 
 type CompressBroker interface {
@@ -125,4 +128,62 @@ func (f *Flate) UnCompress(compressSrc []byte) ([]byte, error) {
 	r := flate.NewReader(b)
 	_, err := io.Copy(&out, r)
 	return out.Bytes(), err
-}
\ No newline at end of file
+}
+
+// -------------------------------------------------------------
+
+// Zstd
+type Zstd struct {
+}
+
+func (z *Zstd) DefaultCompress(src []byte) ([]byte, error) {
+	return zstdCompress(src, zstd.SpeedDefault)
+}
+
+func (z *Zstd) BestCompress(src []byte) ([]byte, error) {
+	return zstdCompress(src, zstd.SpeedBestCompression)
+}
+
+func (z *Zstd) FastCompress(src []byte) ([]byte, error) {
+	return zstdCompress(src, zstd.SpeedFastest)
+}
+
+func (z *Zstd) UnCompress(compressSrc []byte) ([]byte, error) {
+	r, err := zstd.NewReader(nil)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return r.DecodeAll(compressSrc, nil)
+}
+
+func zstdCompress(src []byte, level zstd.EncoderLevel) ([]byte, error) {
+	w, err := zstd.NewWriter(nil, zstd.WithEncoderLevel(level))
+	if err != nil {
+		return nil, err
+	}
+	defer w.Close()
+	return w.EncodeAll(src, nil), nil
+}
+
+// -------------------------------------------------------------
+
+// Snappy has a single compression level
+type Snappy struct {
+}
+
+func (s *Snappy) DefaultCompress(src []byte) ([]byte, error) {
+	return snappy.Encode(nil, src), nil
+}
+
+func (s *Snappy) BestCompress(src []byte) ([]byte, error) {
+	return snappy.Encode(nil, src), nil
+}
+
+func (s *Snappy) FastCompress(src []byte) ([]byte, error) {
+	return snappy.Encode(nil, src), nil
+}
+
+func (s *Snappy) UnCompress(compressSrc []byte) ([]byte, error) {
+	return snappy.Decode(nil, compressSrc)
+}
@@ -1,12 +1,16 @@
 package core
 
 import (
+	"errors"
 	"fmt"
+	"sort"
 
 	tmmath "github.com/okex/exchain/libs/tendermint/libs/math"
+	tmquery "github.com/okex/exchain/libs/tendermint/libs/pubsub/query"
 	ctypes "github.com/okex/exchain/libs/tendermint/rpc/core/types"
 	rpctypes "github.com/okex/exchain/libs/tendermint/rpc/jsonrpc/types"
 	sm "github.com/okex/exchain/libs/tendermint/state"
+	blockidxnull "github.com/okex/exchain/libs/tendermint/state/indexer/block/null"
 	"github.com/okex/exchain/libs/tendermint/types"
 )
 
@@ -155,3 +159,57 @@ func BlockResults(ctx *rpctypes.Context, heightPtr *int64) (*ctypes.ResultBlockR
 		ConsensusParamUpdates: results.EndBlock.ConsensusParamUpdates,
 	}, nil
 }
+
+// BlockSearch searches for a paginated set of blocks matching BeginBlock and
+// EndBlock event search criteria. It returns a list of blocks (maximum
+// ?per_page entries) and the total count.
+func BlockSearch(ctx *rpctypes.Context, query string, page, perPage int, orderBy string) (
+	*ctypes.ResultBlockSearch, error) {
+	// if index is disabled, return error
+	if _, ok := env.BlockIndexer.(*blockidxnull.BlockerIndexer); ok {
+		return nil, errors.New("indexing is disabled")
+	}
+
+	q, err := tmquery.New(query)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := env.BlockIndexer.Search(ctx.Context(), q)
+	if err != nil {
+		return nil, err
+	}
+
+	// sort results (must be done before pagination)
+	switch orderBy {
+	case "desc":
+		sort.Slice(results, func(i, j int) bool { return results[i] > results[j] })
+	case "asc", "":
+		sort.Slice(results, func(i, j int) bool { return results[i] < results[j] })
+	default:
+		return nil, errors.New("expected order_by to be either `asc` or `desc` or empty")
+	}
+
+	// paginate results
+	totalCount := len(results)
+	perPage = validatePerPage(perPage)
+	page, err = validatePage(page, perPage, totalCount)
+	if err != nil {
+		return nil, err
+	}
+	skipCount := validateSkipCount(page, perPage)
+	pageSize := tmmath.MinInt(perPage, totalCount-skipCount)
+
+	apiResults := make([]*ctypes.ResultBlock, 0, pageSize)
+	for i := skipCount; i < skipCount+pageSize; i++ {
+		block := env.BlockStore.LoadBlock(results[i])
+		if block == nil {
+			// the block may have been pruned
+			continue
+		}
+		blockMeta := env.BlockStore.LoadBlockMeta(block.Height)
+		apiResults = append(apiResults, &ctypes.ResultBlock{BlockID: blockMeta.BlockID, Block: block})
+	}
+
+	return &ctypes.ResultBlockSearch{Blocks: apiResults, TotalCount: totalCount}, nil
+}
@@ -22,6 +22,7 @@ var Routes = map[string]*rpc.RPCFunc{
 	"block":                    rpc.NewRPCFunc(Block, "height"),
 	"block_by_hash":            rpc.NewRPCFunc(BlockByHash, "hash"),
 	"block_results":            rpc.NewRPCFunc(BlockResults, "height"),
+	"block_search":             rpc.NewRPCFunc(BlockSearch, "query,page,per_page,order_by"),
 	"commit":                   rpc.NewRPCFunc(Commit, "height"),
 	"tx":                       rpc.NewRPCFunc(Tx, "hash,prove"),
 	"tx_search":                rpc.NewRPCFunc(TxSearch, "query,prove,page,per_page,order_by"),
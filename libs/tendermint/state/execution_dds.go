@@ -1,9 +1,13 @@
 package state
 
 import (
+	"fmt"
+
 	gorid "github.com/okex/exchain/libs/goroutine"
 	"github.com/okex/exchain/libs/iavl"
 	"github.com/okex/exchain/libs/tendermint/delta"
+	fs_cgi "github.com/okex/exchain/libs/tendermint/delta/fs-cgi"
+	http_cgi "github.com/okex/exchain/libs/tendermint/delta/http-cgi"
 	redis_cgi "github.com/okex/exchain/libs/tendermint/delta/redis-cgi"
 	"github.com/okex/exchain/libs/tendermint/libs/compress"
 	"github.com/okex/exchain/libs/tendermint/libs/log"
@@ -21,7 +25,7 @@ type DeltaContext struct {
 	downloadDelta bool
 	uploadDelta bool
 	logger log.Logger
-	compressBroker compress.CompressBroker
+	compressCodec compress.Codec
 }
 
 func newDeltaContext() *DeltaContext {
@@ -36,8 +40,11 @@ func newDeltaContext() *DeltaContext {
 		panic("download delta is not allowed if upload delta enabled")
 	}
 
-	// todo can config different compress algorithm
-	dp.compressBroker = &compress.Flate{}
+	codec, err := compress.ParseCodec(types.DeltaCompress())
+	if err != nil {
+		panic(err)
+	}
+	dp.compressCodec = codec
 
 	return dp
 }
@@ -48,11 +55,15 @@ func (dc *DeltaContext) init(l log.Logger) {
 	dc.logger.Info("DeltaContext init",
 		"uploadDelta", dc.uploadDelta,
 		"downloadDelta", dc.downloadDelta,
+		"compress", dc.compressCodec,
 	)
 
 	if dc.uploadDelta || dc.downloadDelta {
-		dc.deltaBroker = redis_cgi.NewRedisClient(types.RedisUrl(), types.RedisAuth(), l)
-		dc.logger.Info("Init delta broker", "url", types.RedisUrl())
+		broker, err := newDeltaBroker(l)
+		if err != nil {
+			panic(err)
+		}
+		dc.deltaBroker = broker
 	}
 
 	// control if iavl produce delta or not
@@ -63,6 +74,22 @@ func (dc *DeltaContext) init(l log.Logger) {
 	}
 }
 
+// newDeltaBroker creates the delta broker selected by FlagDeltaBroker.
+func newDeltaBroker(l log.Logger) (delta.DeltaBroker, error) {
+	switch types.DeltaBroker() {
+	case types.DeltaBrokerRedis:
+		l.Info("Init delta broker", "broker", types.DeltaBrokerRedis, "url", types.RedisUrl())
+		return redis_cgi.NewRedisClient(types.RedisUrl(), types.RedisAuth(), l), nil
+	case types.DeltaBrokerFs:
+		l.Info("Init delta broker", "broker", types.DeltaBrokerFs, "dir", types.DeltaDir())
+		return fs_cgi.NewFsClient(types.DeltaDir(), l)
+	case types.DeltaBrokerHttp:
+		l.Info("Init delta broker", "broker", types.DeltaBrokerHttp, "url", types.DeltaUrl())
+		return http_cgi.NewHttpClient(types.DeltaUrl(), l)
+	default:
+		return nil, fmt.Errorf("unknown delta broker: %s", types.DeltaBroker())
+	}
+}
 
 func (dc *DeltaContext) postApplyBlock(height int64, delta *types.Deltas,
 	abciResponses *ABCIResponses, res []byte) {
@@ -129,14 +156,15 @@ func (dc *DeltaContext) uploadData(deltas *types.Deltas) {
 
 	t1 := time.Now()
 	// compress
-	//compressBytes, err := dc.compressBroker.DefaultCompress(deltaBytes)
-	//if err != nil {
-	//	return
-	//}
+	compressBytes, err := compress.Compress(dc.compressCodec, deltaBytes)
+	if err != nil {
+		dc.logger.Error("Failed to upload delta", "target-height", deltas.Height, "error", err)
+		return
+	}
 
 	t2 := time.Now()
 	// set into dds
-	if err = dc.deltaBroker.SetDeltas(deltas.Height, deltaBytes); err != nil {
+	if err = dc.deltaBroker.SetDeltas(deltas.Height, compressBytes); err != nil {
 		dc.logger.Error("Failed to upload delta", "target-height", deltas.Height, "error", err)
 		return
 	}
@@ -215,11 +243,12 @@ func (dc *DeltaContext) download(height int64) (error, *types.Deltas){
 	}
 
 	t1 := time.Now()
-	// uncompress
-	//compressBytes, err := dc.compressBroker.UnCompress(deltaBytes)
-	//if err != nil {
-	//	continue
-	//}
+	// uncompress, the codec is read from the payload header
+	deltaBytes, err = compress.UnCompress(deltaBytes)
+	if err != nil {
+		dc.logger.Error("Downloaded an invalid delta:", "target-height", height, "err", err)
+		return err, nil
+	}
 
 	t2 := time.Now()
 	// unmarshal
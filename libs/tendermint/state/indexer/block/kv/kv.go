@@ -0,0 +1,369 @@
+package kv
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	dbm "github.com/tendermint/tm-db"
+
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	"github.com/okex/exchain/libs/tendermint/libs/pubsub/query"
+	tmstring "github.com/okex/exchain/libs/tendermint/libs/strings"
+	"github.com/okex/exchain/libs/tendermint/state/indexer"
+	"github.com/okex/exchain/libs/tendermint/types"
+)
+
+const (
+	tagKeySeparator = "/"
+
+	eventTypeBeginBlock = "begin_block"
+	eventTypeEndBlock   = "end_block"
+)
+
+var _ indexer.BlockIndexer = (*BlockerIndexer)(nil)
+
+// BlockerIndexer implements a block indexer, indexing BeginBlock and EndBlock
+// events with an underlying KV store. Block events are indexed by their height,
+// such that matching search criteria returns the respective block height(s).
+type BlockerIndexer struct {
+	store                dbm.DB
+	compositeKeysToIndex []string
+	indexAllEvents       bool
+}
+
+// New creates new KV block indexer.
+func New(store dbm.DB, options ...func(*BlockerIndexer)) *BlockerIndexer {
+	idx := &BlockerIndexer{store: store, compositeKeysToIndex: make([]string, 0), indexAllEvents: false}
+	for _, o := range options {
+		o(idx)
+	}
+	return idx
+}
+
+// IndexEvents is an option for setting which composite keys to index.
+func IndexEvents(compositeKeys []string) func(*BlockerIndexer) {
+	return func(idx *BlockerIndexer) {
+		idx.compositeKeysToIndex = compositeKeys
+	}
+}
+
+// IndexAllEvents is an option for indexing all events.
+func IndexAllEvents() func(*BlockerIndexer) {
+	return func(idx *BlockerIndexer) {
+		idx.indexAllEvents = true
+	}
+}
+
+// Has returns true if the given height has been indexed. An error is returned
+// upon database query failure.
+func (idx *BlockerIndexer) Has(height int64) (bool, error) {
+	return idx.store.Has(keyForHeight(height))
+}
+
+// Index indexes BeginBlock and EndBlock events for a given block by its height.
+// The following is indexed:
+//
+// primary key: encode(block.height | height) => encode(height)
+// BeginBlock events: encode(eventType.eventAttr|eventValue|height|begin_block) => encode(height)
+// EndBlock events: encode(eventType.eventAttr|eventValue|height|end_block) => encode(height)
+//
+// Only the composite keys given by IndexEvents are indexed, unless IndexAllEvents
+// is set. Any event with an empty type is not indexed.
+func (idx *BlockerIndexer) Index(bh types.EventDataNewBlockHeader) error {
+	batch := idx.store.NewBatch()
+	defer batch.Close()
+
+	height := bh.Header.Height
+
+	// 1. index by height
+	batch.Set(keyForHeight(height), heightValue(height))
+
+	// 2. index BeginBlock events
+	idx.indexEvents(batch, bh.ResultBeginBlock.Events, eventTypeBeginBlock, height)
+
+	// 3. index EndBlock events
+	idx.indexEvents(batch, bh.ResultEndBlock.Events, eventTypeEndBlock, height)
+
+	return batch.WriteSync()
+}
+
+func (idx *BlockerIndexer) indexEvents(batch dbm.SetDeleter, events []abci.Event, typ string, height int64) {
+	for _, event := range events {
+		// only index events with a non-empty type
+		if len(event.Type) == 0 {
+			continue
+		}
+
+		for _, attr := range event.Attributes {
+			if len(attr.Key) == 0 {
+				continue
+			}
+
+			compositeKey := fmt.Sprintf("%s.%s", event.Type, string(attr.Key))
+			// the height is always indexed by the primary key
+			if compositeKey == types.BlockHeightKey {
+				continue
+			}
+			if idx.indexAllEvents || tmstring.StringInSlice(compositeKey, idx.compositeKeysToIndex) {
+				batch.Set(keyForEvent(compositeKey, attr.Value, height, typ), heightValue(height))
+			}
+		}
+	}
+}
+
+// Search performs a query for block heights that match a given BeginBlock
+// and Endblock event search criteria. The given query can match against zero,
+// one or more block heights. In the case of height queries, i.e. block.height=5,
+// if the height is indexed, that height alone will be returned. The heights are
+// returned in ascending order.
+//
+// It breaks the query into conditions (like "block.height > 5"). For each
+// condition, it queries the DB index. Range conditions are evaluated as a
+// whole, so it is better for the client to provide both lower and upper bounds.
+// Results from querying indexes are then intersected and returned to the caller.
+//
+// Search will exit early and return any result fetched so far, when a message
+// is received on the context chan.
+func (idx *BlockerIndexer) Search(ctx context.Context, q *query.Query) ([]int64, error) {
+	results := make([]int64, 0)
+	select {
+	case <-ctx.Done():
+		return results, nil
+	default:
+	}
+
+	conditions, err := q.Conditions()
+	if err != nil {
+		return nil, errors.Wrap(err, "error during parsing conditions from query")
+	}
+
+	var heightsInitialized bool
+	filteredHeights := make(map[string][]byte)
+
+	// conditions to skip because they're handled before "everything else"
+	skipIndexes := make([]int, 0)
+
+	// extract ranges
+	// if both upper and lower bounds exist, it's better to get them in order not
+	// no iterate over kvs that are not within range.
+	ranges, rangeIndexes := lookForRanges(conditions)
+	if len(ranges) > 0 {
+		skipIndexes = append(skipIndexes, rangeIndexes...)
+
+		for _, r := range ranges {
+			filteredHeights = idx.matchRange(ctx, r, startKey(r.key), filteredHeights, !heightsInitialized)
+			heightsInitialized = true
+
+			// Ignore any remaining conditions if the first condition resulted
+			// in no matches (assuming implicit AND operand).
+			if len(filteredHeights) == 0 {
+				return results, nil
+			}
+		}
+	}
+
+	// for all other conditions
+	for i, c := range conditions {
+		if intInSlice(i, skipIndexes) {
+			continue
+		}
+
+		filteredHeights = idx.match(ctx, c, startKey(c.CompositeKey, c.Operand), filteredHeights, !heightsInitialized)
+		heightsInitialized = true
+
+		// Ignore any remaining conditions if the first condition resulted
+		// in no matches (assuming implicit AND operand).
+		if len(filteredHeights) == 0 {
+			return results, nil
+		}
+	}
+
+	for _, hBz := range filteredHeights {
+		h, err := strconv.ParseInt(string(hBz), 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse indexed height %q", hBz)
+		}
+		results = append(results, h)
+
+		// Potentially exit early.
+		select {
+		case <-ctx.Done():
+			break
+		default:
+		}
+	}
+
+	sort.Slice(results, func(i, j int) bool { return results[i] < results[j] })
+	return results, nil
+}
+
+// match returns all matching heights that meet a given condition and start
+// key. An already filtered result (filteredHeights) is provided such that any
+// non-intersecting matches are removed.
+//
+// NOTE: filteredHeights may be empty if no previous condition has matched.
+func (idx *BlockerIndexer) match(
+	ctx context.Context,
+	c query.Condition,
+	startKeyBz []byte,
+	filteredHeights map[string][]byte,
+	firstRun bool,
+) map[string][]byte {
+	// A previous match was attempted but resulted in no matches, so we return
+	// no matches (assuming AND operand).
+	if !firstRun && len(filteredHeights) == 0 {
+		return filteredHeights
+	}
+
+	tmpHeights := make(map[string][]byte)
+
+	switch c.Op {
+	case query.OpEqual:
+		it, err := dbm.IteratePrefix(idx.store, startKeyBz)
+		if err != nil {
+			panic(err)
+		}
+		defer it.Close()
+
+		for ; it.Valid(); it.Next() {
+			tmpHeights[string(it.Value())] = it.Value()
+
+			// Potentially exit early.
+			select {
+			case <-ctx.Done():
+				break
+			default:
+			}
+		}
+
+	case query.OpContains:
+		// XXX: startKey does not apply here, the value of the key is matched instead.
+		it, err := dbm.IteratePrefix(idx.store, startKey(c.CompositeKey))
+		if err != nil {
+			panic(err)
+		}
+		defer it.Close()
+
+		for ; it.Valid(); it.Next() {
+			if !isTagKey(it.Key()) {
+				continue
+			}
+
+			if strings.Contains(extractValueFromKey(it.Key()), c.Operand.(string)) {
+				tmpHeights[string(it.Value())] = it.Value()
+			}
+
+			// Potentially exit early.
+			select {
+			case <-ctx.Done():
+				break
+			default:
+			}
+		}
+
+	default:
+		panic("other operators should be handled already")
+	}
+
+	return intersect(ctx, filteredHeights, tmpHeights, firstRun)
+}
+
+// matchRange returns all matching heights that meet a given queryRange and
+// start key. An already filtered result (filteredHeights) is provided such that
+// any non-intersecting matches are removed.
+//
+// NOTE: filteredHeights may be empty if no previous condition has matched.
+func (idx *BlockerIndexer) matchRange(
+	ctx context.Context,
+	r queryRange,
+	startKey []byte,
+	filteredHeights map[string][]byte,
+	firstRun bool,
+) map[string][]byte {
+	// A previous match was attempted but resulted in no matches, so we return
+	// no matches (assuming AND operand).
+	if !firstRun && len(filteredHeights) == 0 {
+		return filteredHeights
+	}
+
+	tmpHeights := make(map[string][]byte)
+	lowerBound := r.lowerBoundValue()
+	upperBound := r.upperBoundValue()
+
+	it, err := dbm.IteratePrefix(idx.store, startKey)
+	if err != nil {
+		panic(err)
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		if !isTagKey(it.Key()) {
+			continue
+		}
+
+		// XXX: passing time in a ABCI Events is not yet implemented
+		if _, ok := r.AnyBound().(int64); ok {
+			v, err := strconv.ParseInt(extractValueFromKey(it.Key()), 10, 64)
+			if err != nil {
+				continue
+			}
+
+			include := true
+			if lowerBound != nil && v < lowerBound.(int64) {
+				include = false
+			}
+
+			if upperBound != nil && v > upperBound.(int64) {
+				include = false
+			}
+
+			if include {
+				tmpHeights[string(it.Value())] = it.Value()
+			}
+		}
+
+		// Potentially exit early.
+		select {
+		case <-ctx.Done():
+			break
+		default:
+		}
+	}
+
+	return intersect(ctx, filteredHeights, tmpHeights, firstRun)
+}
+
+// intersect removes the heights in filteredHeights not found in the matches of
+// the current condition (tmpHeights).
+func intersect(ctx context.Context, filteredHeights, tmpHeights map[string][]byte, firstRun bool) map[string][]byte {
+	if len(tmpHeights) == 0 || firstRun {
+		// Either:
+		//
+		// 1. Regardless if a previous match was attempted, which may have had
+		// results, but no match was found for the current condition, then we
+		// return no matches (assuming AND operand).
+		//
+		// 2. A previous match was not attempted, so we return all results.
+		return tmpHeights
+	}
+
+	for k := range filteredHeights {
+		if tmpHeights[k] == nil {
+			delete(filteredHeights, k)
+
+			// Potentially exit early.
+			select {
+			case <-ctx.Done():
+				break
+			default:
+			}
+		}
+	}
+
+	return filteredHeights
+}
@@ -0,0 +1,188 @@
+package kv
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	db "github.com/tendermint/tm-db"
+
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	"github.com/okex/exchain/libs/tendermint/libs/kv"
+	"github.com/okex/exchain/libs/tendermint/libs/pubsub/query"
+	"github.com/okex/exchain/libs/tendermint/types"
+)
+
+func TestBlockIndexer(t *testing.T) {
+	store := db.NewPrefixDB(db.NewMemDB(), []byte("block_events"))
+	indexer := New(store, IndexAllEvents())
+
+	require.NoError(t, indexer.Index(types.EventDataNewBlockHeader{
+		Header: types.Header{Height: 1},
+		ResultBeginBlock: abci.ResponseBeginBlock{
+			Events: []abci.Event{
+				{
+					Type: "begin_event",
+					Attributes: []kv.Pair{
+						{Key: []byte("proposer"), Value: []byte("FCAA001")},
+					},
+				},
+			},
+		},
+		ResultEndBlock: abci.ResponseEndBlock{
+			Events: []abci.Event{
+				{
+					Type: "end_event",
+					Attributes: []kv.Pair{
+						{Key: []byte("foo"), Value: []byte("100")},
+					},
+				},
+			},
+		},
+	}))
+
+	for i := 2; i < 12; i++ {
+		var index bool
+		if i%2 == 0 {
+			index = true
+		}
+
+		require.NoError(t, indexer.Index(types.EventDataNewBlockHeader{
+			Header: types.Header{Height: int64(i)},
+			ResultBeginBlock: abci.ResponseBeginBlock{
+				Events: []abci.Event{
+					{
+						Type: "begin_event",
+						Attributes: []kv.Pair{
+							{Key: []byte("proposer"), Value: []byte("FCAA001")},
+						},
+					},
+				},
+			},
+			ResultEndBlock: abci.ResponseEndBlock{
+				Events: []abci.Event{
+					{
+						Type: "end_event",
+						Attributes: []kv.Pair{
+							{Key: []byte("foo"), Value: []byte(fmt.Sprintf("%d", i))},
+						},
+					},
+					{
+						// empty event types are not indexed
+						Type: "",
+						Attributes: []kv.Pair{
+							{Key: []byte("bar"), Value: []byte(fmt.Sprintf("%t", index))},
+						},
+					},
+					{
+						Type: "parity",
+						Attributes: []kv.Pair{
+							{Key: []byte("even"), Value: []byte(fmt.Sprintf("%t", index))},
+						},
+					},
+				},
+			},
+		}))
+	}
+
+	ok, err := indexer.Has(11)
+	require.NoError(t, err)
+	require.True(t, ok)
+	ok, err = indexer.Has(12)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	testCases := map[string]struct {
+		q       *query.Query
+		results []int64
+	}{
+		"block.height = 100": {
+			q:       query.MustParse("block.height = 100"),
+			results: []int64{},
+		},
+		"block.height = 5": {
+			q:       query.MustParse("block.height = 5"),
+			results: []int64{5},
+		},
+		"begin_event.key1 = 'value1'": {
+			q:       query.MustParse("begin_event.key1 = 'value1'"),
+			results: []int64{},
+		},
+		"begin_event.proposer = 'FCAA001'": {
+			q:       query.MustParse("begin_event.proposer = 'FCAA001'"),
+			results: []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11},
+		},
+		"end_event.foo <= 5": {
+			q:       query.MustParse("end_event.foo <= 5"),
+			results: []int64{2, 3, 4, 5},
+		},
+		"end_event.foo >= 100": {
+			q:       query.MustParse("end_event.foo >= 100"),
+			results: []int64{1},
+		},
+		"block.height > 2 AND end_event.foo <= 8": {
+			q:       query.MustParse("block.height > 2 AND end_event.foo <= 8"),
+			results: []int64{3, 4, 5, 6, 7, 8},
+		},
+		"begin_event.proposer CONTAINS 'FFFFFFF'": {
+			q:       query.MustParse("begin_event.proposer CONTAINS 'FFFFFFF'"),
+			results: []int64{},
+		},
+		"begin_event.proposer CONTAINS 'FCAA001'": {
+			q:       query.MustParse("begin_event.proposer CONTAINS 'FCAA001'"),
+			results: []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11},
+		},
+		"parity.even = 'true' AND block.height <= 6": {
+			q:       query.MustParse("parity.even = 'true' AND block.height <= 6"),
+			results: []int64{2, 4, 6},
+		},
+		"end_event.bar = 'true'": {
+			q:       query.MustParse("end_event.bar = 'true'"),
+			results: []int64{},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			results, err := indexer.Search(context.Background(), tc.q)
+			require.NoError(t, err)
+			require.Equal(t, tc.results, results)
+		})
+	}
+}
+
+func TestBlockIndexerIndexEvents(t *testing.T) {
+	indexer := New(db.NewMemDB(), IndexEvents([]string{"end_event.foo"}))
+
+	require.NoError(t, indexer.Index(types.EventDataNewBlockHeader{
+		Header: types.Header{Height: 1},
+		ResultEndBlock: abci.ResponseEndBlock{
+			Events: []abci.Event{
+				{
+					Type: "end_event",
+					Attributes: []kv.Pair{
+						{Key: []byte("foo"), Value: []byte("1")},
+						{Key: []byte("bar"), Value: []byte("1")},
+					},
+				},
+			},
+		},
+	}))
+
+	results, err := indexer.Search(context.Background(), query.MustParse("end_event.foo = 1"))
+	require.NoError(t, err)
+	require.Equal(t, []int64{1}, results)
+
+	// the keys not given are not indexed
+	results, err = indexer.Search(context.Background(), query.MustParse("end_event.bar = 1"))
+	require.NoError(t, err)
+	require.Empty(t, results)
+
+	// the height is always indexed
+	results, err = indexer.Search(context.Background(), query.MustParse("block.height >= 1"))
+	require.NoError(t, err)
+	require.Equal(t, []int64{1}, results)
+}
@@ -0,0 +1,160 @@
+package kv
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/okex/exchain/libs/tendermint/libs/pubsub/query"
+	"github.com/okex/exchain/libs/tendermint/types"
+)
+
+// special map to hold range conditions
+// Example: account.number => queryRange{lowerBound: 1, upperBound: 5}
+type queryRanges map[string]queryRange
+
+type queryRange struct {
+	lowerBound        interface{} // int || time.Time
+	upperBound        interface{} // int || time.Time
+	key               string
+	includeLowerBound bool
+	includeUpperBound bool
+}
+
+func (r queryRange) lowerBoundValue() interface{} {
+	if r.lowerBound == nil {
+		return nil
+	}
+
+	if r.includeLowerBound {
+		return r.lowerBound
+	}
+
+	switch t := r.lowerBound.(type) {
+	case int64:
+		return t + 1
+	case time.Time:
+		return t.Unix() + 1
+	default:
+		panic("not implemented")
+	}
+}
+
+func (r queryRange) AnyBound() interface{} {
+	if r.lowerBound != nil {
+		return r.lowerBound
+	}
+
+	return r.upperBound
+}
+
+func (r queryRange) upperBoundValue() interface{} {
+	if r.upperBound == nil {
+		return nil
+	}
+
+	if r.includeUpperBound {
+		return r.upperBound
+	}
+
+	switch t := r.upperBound.(type) {
+	case int64:
+		return t - 1
+	case time.Time:
+		return t.Unix() - 1
+	default:
+		panic("not implemented")
+	}
+}
+
+func lookForRanges(conditions []query.Condition) (ranges queryRanges, indexes []int) {
+	ranges = make(queryRanges)
+	for i, c := range conditions {
+		if isRangeOperation(c.Op) {
+			r, ok := ranges[c.CompositeKey]
+			if !ok {
+				r = queryRange{key: c.CompositeKey}
+			}
+			switch c.Op {
+			case query.OpGreater:
+				r.lowerBound = c.Operand
+			case query.OpGreaterEqual:
+				r.includeLowerBound = true
+				r.lowerBound = c.Operand
+			case query.OpLess:
+				r.upperBound = c.Operand
+			case query.OpLessEqual:
+				r.includeUpperBound = true
+				r.upperBound = c.Operand
+			}
+			ranges[c.CompositeKey] = r
+			indexes = append(indexes, i)
+		}
+	}
+	return ranges, indexes
+}
+
+func isRangeOperation(op query.Operator) bool {
+	switch op {
+	case query.OpGreater, query.OpGreaterEqual, query.OpLess, query.OpLessEqual:
+		return true
+	default:
+		return false
+	}
+}
+
+// intInSlice returns true if a is found in the list.
+func intInSlice(a int, list []int) bool {
+	for _, b := range list {
+		if b == a {
+			return true
+		}
+	}
+	return false
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// Keys
+
+// isTagKey returns true for the keys of the events and of the heights, both of
+// which have the value of the composite key as the second field.
+func isTagKey(key []byte) bool {
+	n := strings.Count(string(key), tagKeySeparator)
+	return n == 2 || n == 3
+}
+
+func extractValueFromKey(key []byte) string {
+	parts := strings.SplitN(string(key), tagKeySeparator, 3)
+	return parts[1]
+}
+
+func keyForHeight(height int64) []byte {
+	return []byte(fmt.Sprintf("%s/%d/%d",
+		types.BlockHeightKey,
+		height,
+		height,
+	))
+}
+
+func keyForEvent(key string, value []byte, height int64, typ string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%d/%s",
+		key,
+		value,
+		height,
+		typ,
+	))
+}
+
+func heightValue(height int64) []byte {
+	return []byte(strconv.FormatInt(height, 10))
+}
+
+func startKey(fields ...interface{}) []byte {
+	var b bytes.Buffer
+	for _, f := range fields {
+		b.Write([]byte(fmt.Sprintf("%v", f) + tagKeySeparator))
+	}
+	return b.Bytes()
+}
@@ -0,0 +1,124 @@
+package sql
+
+import (
+	"fmt"
+	"strings"
+)
+
+// nolint
+const (
+	DriverSQLite = "sqlite3"
+	DriverMySQL  = "mysql"
+
+	TableBlocks     = "blocks"
+	TableTxResults  = "tx_results"
+	TableEvents     = "events"
+	TableAttributes = "attributes"
+)
+
+// dialect holds the SQL differences between the supported drivers
+type dialect struct {
+	primaryKey     string
+	blob           string
+	insertOrIgnore string
+	// inlineIndexes is true if the indexes are created with the table, as MySQL
+	// doesn't support "CREATE INDEX IF NOT EXISTS"
+	inlineIndexes bool
+}
+
+var dialects = map[string]dialect{
+	DriverSQLite: {
+		primaryKey:     "INTEGER PRIMARY KEY AUTOINCREMENT",
+		blob:           "BLOB",
+		insertOrIgnore: "INSERT OR IGNORE",
+	},
+	DriverMySQL: {
+		primaryKey:     "BIGINT PRIMARY KEY AUTO_INCREMENT",
+		blob:           "LONGBLOB",
+		insertOrIgnore: "INSERT IGNORE",
+		inlineIndexes:  true,
+	},
+}
+
+type table struct {
+	name    string
+	columns []string
+	// indexes maps the names of the indexes to their column
+	indexes [][2]string
+}
+
+// tables returns the schema of the event sink. It is stable, the tables are only ever extended.
+//   - blocks: one row per indexed block
+//   - tx_results: one row per indexed tx, with the amino encoded types.TxResult
+//   - events: one row per event of BeginBlock/EndBlock (tx_id is NULL) or of DeliverTx, besides the meta
+//     events "block" and "tx" holding the height of the block and the hash and height of the tx
+//   - attributes: one row per attribute of an event, composite_key is "type.key" as in the queries of tx_search
+func (d dialect) tables() []table {
+	return []table{
+		{
+			name: TableBlocks,
+			columns: []string{
+				"rowid " + d.primaryKey,
+				"height BIGINT NOT NULL",
+				"chain_id VARCHAR(64) NOT NULL",
+				"created_at TIMESTAMP NOT NULL",
+				"UNIQUE (height, chain_id)",
+			},
+		},
+		{
+			name: TableTxResults,
+			columns: []string{
+				"rowid " + d.primaryKey,
+				"block_id BIGINT NOT NULL REFERENCES " + TableBlocks + "(rowid)",
+				"tx_index INTEGER NOT NULL",
+				"tx_hash VARCHAR(64) NOT NULL",
+				"tx_result " + d.blob + " NOT NULL",
+				"created_at TIMESTAMP NOT NULL",
+				"UNIQUE (block_id, tx_index)",
+			},
+			indexes: [][2]string{{"idx_tx_results_tx_hash", "tx_hash"}},
+		},
+		{
+			name: TableEvents,
+			columns: []string{
+				"rowid " + d.primaryKey,
+				"block_id BIGINT NOT NULL REFERENCES " + TableBlocks + "(rowid)",
+				"tx_id BIGINT NULL REFERENCES " + TableTxResults + "(rowid)",
+				"type VARCHAR(255) NOT NULL",
+			},
+			indexes: [][2]string{{"idx_events_block_id", "block_id"}, {"idx_events_tx_id", "tx_id"}},
+		},
+		{
+			name: TableAttributes,
+			columns: []string{
+				"event_id BIGINT NOT NULL REFERENCES " + TableEvents + "(rowid)",
+				"attr_key VARCHAR(255) NOT NULL",
+				"composite_key VARCHAR(512) NOT NULL",
+				"value TEXT NULL",
+			},
+			indexes: [][2]string{{"idx_attributes_event_id", "event_id"}, {"idx_attributes_composite_key", "composite_key"}},
+		},
+	}
+}
+
+// schema returns the statements creating the tables and their indexes if they don't exist
+func (d dialect) schema() []string {
+	var stmts []string
+	for _, t := range d.tables() {
+		columns := t.columns
+		if d.inlineIndexes {
+			for _, idx := range t.indexes {
+				columns = append(columns, fmt.Sprintf("INDEX %s (%s)", idx[0], idx[1]))
+			}
+		}
+		stmts = append(stmts, fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (\n\t%s\n)",
+			t.name, strings.Join(columns, ",\n\t")))
+
+		if !d.inlineIndexes {
+			for _, idx := range t.indexes {
+				stmts = append(stmts, fmt.Sprintf("CREATE INDEX IF NOT EXISTS %s ON %s (%s)", idx[0], t.name, idx[1]))
+			}
+		}
+	}
+	return stmts
+}
@@ -0,0 +1,318 @@
+package sql
+
+import (
+	"context"
+	dbsql "database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	amino "github.com/tendermint/go-amino"
+
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	"github.com/okex/exchain/libs/tendermint/libs/kv"
+	"github.com/okex/exchain/libs/tendermint/libs/pubsub/query"
+	"github.com/okex/exchain/libs/tendermint/state/indexer"
+	"github.com/okex/exchain/libs/tendermint/state/txindex"
+	"github.com/okex/exchain/libs/tendermint/types"
+)
+
+const (
+	eventTypeBlock = "block"
+	eventTypeTx    = "tx"
+)
+
+var (
+	_ txindex.TxIndexer    = (*TxIndex)(nil)
+	_ indexer.BlockIndexer = (*BlockIndexer)(nil)
+
+	cdc = amino.NewCodec()
+
+	// ErrSearchNotSupported is returned by the searches of the event sink
+	ErrSearchNotSupported = errors.New("searching is not supported by the sql event sink, query the database instead")
+)
+
+// EventSink writes the blocks, txs, events and attributes to a SQL database, see the schema of dialect.tables.
+// All the events are written, the composite keys to index of the config don't apply. The events can be
+// searched by SQL in the database only. It is used as the tx and the block indexers through TxIndexer and
+// BlockIndexer.
+type EventSink struct {
+	store   *dbsql.DB
+	dialect dialect
+	chainID string
+}
+
+// NewEventSink creates the event sink of chainID writing to store, which is opened with driver. The tables
+// are created if they don't exist.
+func NewEventSink(store *dbsql.DB, driver, chainID string) (*EventSink, error) {
+	d, ok := dialects[driver]
+	if !ok {
+		return nil, fmt.Errorf("unsupported sql driver %q", driver)
+	}
+	for _, stmt := range d.schema() {
+		if _, err := store.Exec(stmt); err != nil {
+			return nil, fmt.Errorf("failed to create the schema: %w", err)
+		}
+	}
+	return &EventSink{store: store, dialect: d, chainID: chainID}, nil
+}
+
+// Open opens the database at dsn with driver, and creates the event sink of chainID writing to it
+func Open(driver, dsn, chainID string) (*EventSink, error) {
+	store, err := dbsql.Open(driver, dsn)
+	if err != nil {
+		return nil, err
+	}
+	if driver == DriverSQLite {
+		// sqlite doesn't support concurrent writers
+		store.SetMaxOpenConns(1)
+	}
+	if err := store.Ping(); err != nil {
+		store.Close()
+		return nil, err
+	}
+	sink, err := NewEventSink(store, driver, chainID)
+	if err != nil {
+		store.Close()
+	}
+	return sink, err
+}
+
+// DB returns the underlying database
+func (es *EventSink) DB() *dbsql.DB {
+	return es.store
+}
+
+// Stop closes the underlying database
+func (es *EventSink) Stop() error {
+	return es.store.Close()
+}
+
+// TxIndexer returns the tx indexer writing to the event sink
+func (es *EventSink) TxIndexer() *TxIndex {
+	return &TxIndex{es}
+}
+
+// BlockIndexer returns the block indexer writing to the event sink
+func (es *EventSink) BlockIndexer() *BlockIndexer {
+	return &BlockIndexer{es}
+}
+
+// IndexBlock writes the block with its BeginBlock and EndBlock events. It is a noop if the block has been written.
+func (es *EventSink) IndexBlock(bh types.EventDataNewBlockHeader) error {
+	return es.runInTx(func(dbTx *dbsql.Tx) error {
+		blockID, created, err := es.insertBlock(dbTx, bh.Header.Height)
+		if err != nil || !created {
+			return err
+		}
+
+		// the meta event holding the height of the block
+		if err := es.insertEvents(dbTx, blockID, nil, []abci.Event{makeEvent(eventTypeBlock,
+			"height", strconv.FormatInt(bh.Header.Height, 10))}); err != nil {
+			return err
+		}
+		if err := es.insertEvents(dbTx, blockID, nil, bh.ResultBeginBlock.Events); err != nil {
+			return err
+		}
+		return es.insertEvents(dbTx, blockID, nil, bh.ResultEndBlock.Events)
+	})
+}
+
+// IndexTxs writes the txs with their events in a single database transaction. The txs written are skipped.
+func (es *EventSink) IndexTxs(results []*types.TxResult) error {
+	return es.runInTx(func(dbTx *dbsql.Tx) error {
+		for _, result := range results {
+			if err := es.insertTx(dbTx, result); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// GetTx returns the tx with hash or nil if it isn't written
+func (es *EventSink) GetTx(hash []byte) (*types.TxResult, error) {
+	if len(hash) == 0 {
+		return nil, txindex.ErrorEmptyHash
+	}
+
+	var bz []byte
+	err := es.store.QueryRow(fmt.Sprintf(`SELECT tx_result FROM %s WHERE tx_hash = ? ORDER BY rowid DESC LIMIT 1`,
+		TableTxResults), fmt.Sprintf("%X", hash)).Scan(&bz)
+	if err == dbsql.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	txResult := new(types.TxResult)
+	if err := cdc.UnmarshalBinaryBare(bz, txResult); err != nil {
+		return nil, fmt.Errorf("error reading TxResult: %v", err)
+	}
+	return txResult, nil
+}
+
+// HasBlock returns true if the block at height has been written
+func (es *EventSink) HasBlock(height int64) (bool, error) {
+	var n int
+	err := es.store.QueryRow(fmt.Sprintf(`SELECT COUNT(*) FROM %s WHERE height = ? AND chain_id = ?`, TableBlocks),
+		height, es.chainID).Scan(&n)
+	return n > 0, err
+}
+
+func (es *EventSink) runInTx(f func(*dbsql.Tx) error) error {
+	dbTx, err := es.store.Begin()
+	if err != nil {
+		return err
+	}
+	if err := f(dbTx); err != nil {
+		_ = dbTx.Rollback()
+		return err
+	}
+	return dbTx.Commit()
+}
+
+// insertBlock inserts the block at height if it doesn't exist, and returns its row id and whether it is created
+func (es *EventSink) insertBlock(dbTx *dbsql.Tx, height int64) (int64, bool, error) {
+	res, err := dbTx.Exec(fmt.Sprintf(`%s INTO %s (height, chain_id, created_at) VALUES (?, ?, ?)`,
+		es.dialect.insertOrIgnore, TableBlocks), height, es.chainID, time.Now().UTC())
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to insert block %d: %w", height, err)
+	}
+	created, err := res.RowsAffected()
+	if err != nil {
+		return 0, false, err
+	}
+
+	var blockID int64
+	err = dbTx.QueryRow(fmt.Sprintf(`SELECT rowid FROM %s WHERE height = ? AND chain_id = ?`, TableBlocks),
+		height, es.chainID).Scan(&blockID)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to query block %d: %w", height, err)
+	}
+	return blockID, created > 0, nil
+}
+
+// insertTx inserts the tx with its events, the block of the tx is inserted first if it doesn't exist. It is a noop
+// if the tx has been written.
+func (es *EventSink) insertTx(dbTx *dbsql.Tx, result *types.TxResult) error {
+	blockID, _, err := es.insertBlock(dbTx, result.Height)
+	if err != nil {
+		return err
+	}
+
+	bz, err := cdc.MarshalBinaryBare(result)
+	if err != nil {
+		return err
+	}
+	hash := fmt.Sprintf("%X", result.Tx.Hash())
+	res, err := dbTx.Exec(fmt.Sprintf(`%s INTO %s (block_id, tx_index, tx_hash, tx_result, created_at) VALUES (?, ?, ?, ?, ?)`,
+		es.dialect.insertOrIgnore, TableTxResults), blockID, result.Index, hash, bz, time.Now().UTC())
+	if err != nil {
+		return fmt.Errorf("failed to insert tx %s: %w", hash, err)
+	}
+	if created, err := res.RowsAffected(); err != nil || created == 0 {
+		return err
+	}
+
+	var txID int64
+	err = dbTx.QueryRow(fmt.Sprintf(`SELECT rowid FROM %s WHERE block_id = ? AND tx_index = ?`, TableTxResults),
+		blockID, result.Index).Scan(&txID)
+	if err != nil {
+		return fmt.Errorf("failed to query tx %s: %w", hash, err)
+	}
+
+	// the meta event holding the hash and the height of the tx
+	events := append([]abci.Event{makeEvent(eventTypeTx,
+		"hash", hash, "height", strconv.FormatInt(result.Height, 10))}, result.Result.Events...)
+	return es.insertEvents(dbTx, blockID, &txID, events)
+}
+
+// insertEvents inserts the events of the block or of the tx if txID is not nil. The events with an empty type and
+// the attributes with an empty key are skipped as the kv indexer.
+func (es *EventSink) insertEvents(dbTx *dbsql.Tx, blockID int64, txID *int64, events []abci.Event) error {
+	insertEvent := fmt.Sprintf(`INSERT INTO %s (block_id, tx_id, type) VALUES (?, ?, ?)`, TableEvents)
+	insertAttr := fmt.Sprintf(`INSERT INTO %s (event_id, attr_key, composite_key, value) VALUES (?, ?, ?, ?)`,
+		TableAttributes)
+
+	for _, event := range events {
+		if len(event.Type) == 0 {
+			continue
+		}
+
+		res, err := dbTx.Exec(insertEvent, blockID, txID, event.Type)
+		if err != nil {
+			return fmt.Errorf("failed to insert event %s: %w", event.Type, err)
+		}
+		eventID, err := res.LastInsertId()
+		if err != nil {
+			return err
+		}
+
+		for _, attr := range event.Attributes {
+			if len(attr.Key) == 0 {
+				continue
+			}
+			compositeKey := fmt.Sprintf("%s.%s", event.Type, attr.Key)
+			if _, err := dbTx.Exec(insertAttr, eventID, string(attr.Key), compositeKey, string(attr.Value)); err != nil {
+				return fmt.Errorf("failed to insert attribute %s: %w", compositeKey, err)
+			}
+		}
+	}
+	return nil
+}
+
+// makeEvent creates an event of typ with the attributes given as key value pairs
+func makeEvent(typ string, kvs ...string) abci.Event {
+	event := abci.Event{Type: typ}
+	for i := 0; i+1 < len(kvs); i += 2 {
+		event.Attributes = append(event.Attributes, kv.Pair{Key: []byte(kvs[i]), Value: []byte(kvs[i+1])})
+	}
+	return event
+}
+
+// TxIndex implements txindex.TxIndexer with the event sink
+type TxIndex struct {
+	*EventSink
+}
+
+// AddBatch writes a batch of txs with their events
+func (txi *TxIndex) AddBatch(b *txindex.Batch) error {
+	return txi.IndexTxs(b.Ops)
+}
+
+// Index writes a single tx with its events
+func (txi *TxIndex) Index(result *types.TxResult) error {
+	return txi.IndexTxs([]*types.TxResult{result})
+}
+
+// Get returns the tx with hash or nil if it isn't written
+func (txi *TxIndex) Get(hash []byte) (*types.TxResult, error) {
+	return txi.GetTx(hash)
+}
+
+// Search is not supported, see ErrSearchNotSupported
+func (txi *TxIndex) Search(ctx context.Context, q *query.Query) ([]*types.TxResult, error) {
+	return nil, ErrSearchNotSupported
+}
+
+// BlockIndexer implements indexer.BlockIndexer with the event sink
+type BlockIndexer struct {
+	*EventSink
+}
+
+// Has returns true if the block at height has been written
+func (idx *BlockIndexer) Has(height int64) (bool, error) {
+	return idx.HasBlock(height)
+}
+
+// Index writes the block with its BeginBlock and EndBlock events
+func (idx *BlockIndexer) Index(bh types.EventDataNewBlockHeader) error {
+	return idx.IndexBlock(bh)
+}
+
+// Search is not supported, see ErrSearchNotSupported
+func (idx *BlockIndexer) Search(ctx context.Context, q *query.Query) ([]int64, error) {
+	return nil, ErrSearchNotSupported
+}
@@ -0,0 +1,135 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	"github.com/okex/exchain/libs/tendermint/libs/pubsub/query"
+	"github.com/okex/exchain/libs/tendermint/state/txindex"
+	"github.com/okex/exchain/libs/tendermint/types"
+)
+
+func newTestSink(t *testing.T) *EventSink {
+	sink, err := Open(DriverSQLite, filepath.Join(t.TempDir(), "events.sqlite"), "test-chain")
+	require.NoError(t, err)
+	t.Cleanup(func() { sink.Stop() })
+	return sink
+}
+
+func txResult(height int64, index uint32, tx string, events ...abci.Event) *types.TxResult {
+	return &types.TxResult{
+		Height: height,
+		Index:  index,
+		Tx:     types.Tx(tx),
+		Result: abci.ResponseDeliverTx{Code: abci.CodeTypeOK, Events: events},
+	}
+}
+
+func TestEventSinkIndexBlock(t *testing.T) {
+	sink := newTestSink(t)
+	idx := sink.BlockIndexer()
+
+	header := types.EventDataNewBlockHeader{
+		Header: types.Header{Height: 1},
+		ResultBeginBlock: abci.ResponseBeginBlock{Events: []abci.Event{
+			makeEvent("begin_event", "proposer", "FCAA001"),
+		}},
+		ResultEndBlock: abci.ResponseEndBlock{Events: []abci.Event{
+			makeEvent("end_event", "foo", "100", "", "skipped"),
+			makeEvent("", "bar", "skipped"),
+		}},
+	}
+	require.NoError(t, idx.Index(header))
+	// indexing the block again is a noop
+	require.NoError(t, idx.Index(header))
+
+	ok, err := idx.Has(1)
+	require.NoError(t, err)
+	require.True(t, ok)
+	ok, err = idx.Has(2)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	var n int
+	require.NoError(t, sink.DB().QueryRow(`SELECT COUNT(*) FROM blocks`).Scan(&n))
+	require.Equal(t, 1, n)
+	require.NoError(t, sink.DB().QueryRow(`SELECT COUNT(*) FROM events WHERE tx_id IS NULL`).Scan(&n))
+	require.Equal(t, 3, n)
+
+	var value string
+	require.NoError(t, sink.DB().QueryRow(`SELECT value FROM attributes WHERE composite_key = 'end_event.foo'`).Scan(&value))
+	require.Equal(t, "100", value)
+	require.NoError(t, sink.DB().QueryRow(`SELECT a.value FROM attributes a JOIN events e ON a.event_id = e.rowid
+		WHERE e.type = 'block' AND a.attr_key = 'height'`).Scan(&value))
+	require.Equal(t, "1", value)
+	require.NoError(t, sink.DB().QueryRow(`SELECT COUNT(*) FROM attributes WHERE value = 'skipped'`).Scan(&n))
+	require.Equal(t, 0, n)
+
+	_, err = idx.Search(context.Background(), query.MustParse("block.height = 1"))
+	require.Equal(t, ErrSearchNotSupported, err)
+}
+
+func TestEventSinkIndexTxs(t *testing.T) {
+	sink := newTestSink(t)
+	txi := sink.TxIndexer()
+
+	batch := txindex.NewBatch(2)
+	tx1 := txResult(2, 0, "foo", makeEvent("transfer", "sender", "alice", "amount", "10"))
+	tx2 := txResult(2, 1, "bar", makeEvent("transfer", "sender", "bob", "amount", "20"))
+	require.NoError(t, batch.Add(tx1))
+	require.NoError(t, batch.Add(tx2))
+	require.NoError(t, txi.AddBatch(batch))
+	// indexing the txs again is a noop
+	require.NoError(t, txi.Index(tx1))
+
+	// the block of the txs is created
+	ok, err := sink.BlockIndexer().Has(2)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	res, err := txi.Get(types.Tx("foo").Hash())
+	require.NoError(t, err)
+	require.Equal(t, tx1, res)
+	res, err = txi.Get(types.Tx("baz").Hash())
+	require.NoError(t, err)
+	require.Nil(t, res)
+
+	var hash string
+	require.NoError(t, sink.DB().QueryRow(`SELECT t.tx_hash FROM tx_results t
+		JOIN events e ON e.tx_id = t.rowid JOIN attributes a ON a.event_id = e.rowid
+		WHERE a.composite_key = 'transfer.sender' AND a.value = 'bob'`).Scan(&hash))
+	require.Equal(t, fmt.Sprintf("%X", types.Tx("bar").Hash()), hash)
+
+	var n int
+	require.NoError(t, sink.DB().QueryRow(`SELECT COUNT(*) FROM events WHERE type = 'tx'`).Scan(&n))
+	require.Equal(t, 2, n)
+	require.NoError(t, sink.DB().QueryRow(`SELECT COUNT(*) FROM attributes WHERE composite_key = 'transfer.amount'`).Scan(&n))
+	require.Equal(t, 2, n)
+
+	_, err = txi.Search(context.Background(), query.MustParse("transfer.sender = 'bob'"))
+	require.Equal(t, ErrSearchNotSupported, err)
+}
+
+func TestSchema(t *testing.T) {
+	// the schema is created again on the existing tables
+	dsn := filepath.Join(t.TempDir(), "events.sqlite")
+	sink, err := Open(DriverSQLite, dsn, "test-chain")
+	require.NoError(t, err)
+	require.NoError(t, sink.Stop())
+	sink, err = Open(DriverSQLite, dsn, "test-chain")
+	require.NoError(t, err)
+	require.NoError(t, sink.Stop())
+
+	_, err = Open("postgres", dsn, "test-chain")
+	require.Error(t, err)
+
+	for _, stmt := range dialects[DriverMySQL].schema() {
+		require.NotContains(t, stmt, "IF NOT EXISTS idx_")
+	}
+}
@@ -101,6 +101,20 @@ func SaveState(db dbm.DB, state State) {
 	saveState(db, state, stateKey)
 }
 
+// BootstrapState saves a new state, used e.g. by state sync when starting from non-zero height.
+// Unlike SaveState it persists the validator sets of the last, current and next heights, since
+// there is no history to look them up from.
+func BootstrapState(db dbm.DB, state State) error {
+	height := state.LastBlockHeight + 1
+	if height > types.GetStartBlockHeight()+1 && state.LastValidators != nil && state.LastValidators.Size() > 0 {
+		saveValidatorsInfo(db, height-1, height-1, state.LastValidators)
+	}
+	saveValidatorsInfo(db, height, height, state.Validators)
+	saveValidatorsInfo(db, height+1, height+1, state.NextValidators)
+	saveConsensusParamsInfo(db, height, height, state.ConsensusParams)
+	return db.SetSync(stateKey, state.Bytes())
+}
+
 func saveState(db dbm.DB, state State, key []byte) {
 	nextHeight := state.LastBlockHeight + 1
 	// If first block, save validators for block 1.
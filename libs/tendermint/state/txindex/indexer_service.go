@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/okex/exchain/libs/tendermint/libs/service"
+	"github.com/okex/exchain/libs/tendermint/state/indexer"
 
 	"github.com/okex/exchain/libs/tendermint/types"
 )
@@ -12,24 +13,25 @@ const (
 	subscriber = "IndexerService"
 )
 
-// IndexerService connects event bus and transaction indexer together in order
-// to index transactions coming from event bus.
+// IndexerService connects event bus, transaction and block indexers together in
+// order to index transactions and blocks coming from the event bus.
 type IndexerService struct {
 	service.BaseService
 
-	idr      TxIndexer
-	eventBus *types.EventBus
+	txIdxr    TxIndexer
+	blockIdxr indexer.BlockIndexer
+	eventBus  *types.EventBus
 }
 
 // NewIndexerService returns a new service instance.
-func NewIndexerService(idr TxIndexer, eventBus *types.EventBus) *IndexerService {
-	is := &IndexerService{idr: idr, eventBus: eventBus}
+func NewIndexerService(txIdxr TxIndexer, blockIdxr indexer.BlockIndexer, eventBus *types.EventBus) *IndexerService {
+	is := &IndexerService{txIdxr: txIdxr, blockIdxr: blockIdxr, eventBus: eventBus}
 	is.BaseService = *service.NewBaseService(nil, "IndexerService", is)
 	return is
 }
 
-// OnStart implements service.Service by subscribing for all transactions
-// and indexing them by events.
+// OnStart implements service.Service by subscribing for all blocks and
+// transactions and indexing them by events.
 func (is *IndexerService) OnStart() error {
 	// Use SubscribeUnbuffered here to ensure both subscriptions does not get
 	// cancelled due to not pulling messages fast enough. Cause this might
@@ -64,7 +66,10 @@ func (is *IndexerService) OnStart() error {
 						"err", err)
 				}
 			}
-			if err = is.idr.AddBatch(batch); err != nil {
+			if err = is.blockIdxr.Index(eventDataHeader); err != nil {
+				is.Logger.Error("Failed to index block events", "height", height, "err", err)
+			}
+			if err = is.txIdxr.AddBatch(batch); err != nil {
 				is.Logger.Error("Failed to index block", "height", height, "err", err)
 			} else {
 				is.Logger.Info("Indexed block", "height", height)
@@ -11,6 +11,7 @@ import (
 
 	abci "github.com/okex/exchain/libs/tendermint/abci/types"
 	"github.com/okex/exchain/libs/tendermint/libs/log"
+	blockidxkv "github.com/okex/exchain/libs/tendermint/state/indexer/block/kv"
 	"github.com/okex/exchain/libs/tendermint/state/txindex"
 	"github.com/okex/exchain/libs/tendermint/state/txindex/kv"
 	"github.com/okex/exchain/libs/tendermint/types"
@@ -27,8 +28,9 @@ func TestIndexerServiceIndexesBlocks(t *testing.T) {
 	// tx indexer
 	store := db.NewMemDB()
 	txIndexer := kv.NewTxIndex(store, kv.IndexAllEvents())
+	blockIndexer := blockidxkv.New(db.NewPrefixDB(store, []byte("block_events")), blockidxkv.IndexAllEvents())
 
-	service := txindex.NewIndexerService(txIndexer, eventBus)
+	service := txindex.NewIndexerService(txIndexer, blockIndexer, eventBus)
 	service.SetLogger(log.TestingLogger())
 	err = service.Start()
 	require.NoError(t, err)
@@ -63,4 +65,8 @@ func TestIndexerServiceIndexesBlocks(t *testing.T) {
 	res, err = txIndexer.Get(types.Tx("bar").Hash())
 	assert.NoError(t, err)
 	assert.Equal(t, txResult2, res)
+
+	ok, err := blockIndexer.Has(1)
+	assert.NoError(t, err)
+	assert.True(t, ok)
 }
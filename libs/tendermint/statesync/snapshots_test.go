@@ -0,0 +1,165 @@
+package statesync
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/exchain/libs/tendermint/p2p"
+	"github.com/okex/exchain/libs/tendermint/p2p/mock"
+	sm "github.com/okex/exchain/libs/tendermint/state"
+	"github.com/okex/exchain/libs/tendermint/types"
+)
+
+// testStateProvider returns a fixed app hash for every height
+type testStateProvider struct {
+	appHash []byte
+}
+
+func (p testStateProvider) AppHash(height uint64) ([]byte, error) { return p.appHash, nil }
+
+func (p testStateProvider) Commit(height uint64) (*types.Commit, error) { return &types.Commit{}, nil }
+
+func (p testStateProvider) State(height uint64) (sm.State, error) { return sm.State{}, nil }
+
+func newTestPeer(t *testing.T, ip byte) p2p.Peer {
+	peer := mock.NewPeer(net.IP{10, 0, 0, ip})
+	require.NotEmpty(t, peer.ID())
+	return peer
+}
+
+func TestSnapshot_Key(t *testing.T) {
+	testcases := map[string]struct {
+		modify func(*snapshot)
+	}{
+		"new height":      {func(s *snapshot) { s.Height = 9 }},
+		"new format":      {func(s *snapshot) { s.Format = 9 }},
+		"new chunk count": {func(s *snapshot) { s.Chunks = 9 }},
+		"new hash":        {func(s *snapshot) { s.Hash = []byte{9} }},
+		"no metadata":     {func(s *snapshot) { s.Metadata = nil }},
+	}
+	for name, tc := range testcases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			s := snapshot{
+				Height:   3,
+				Format:   1,
+				Chunks:   7,
+				Hash:     []byte{1, 2, 3},
+				Metadata: []byte{255},
+			}
+			before := s.Key()
+			tc.modify(&s)
+			after := s.Key()
+			assert.NotEqual(t, before, after)
+		})
+	}
+}
+
+func TestSnapshotPool_Add(t *testing.T) {
+	pool := newSnapshotPool(testStateProvider{appHash: []byte("app_hash")})
+	peer := newTestPeer(t, 1)
+
+	added, err := pool.Add(peer, &snapshot{Height: 1, Format: 1, Chunks: 1, Hash: []byte{1}})
+	require.NoError(t, err)
+	assert.True(t, added)
+
+	// Adding the same snapshot again from a different peer only adds the peer
+	otherPeer := newTestPeer(t, 2)
+	added, err = pool.Add(otherPeer, &snapshot{Height: 1, Format: 1, Chunks: 1, Hash: []byte{1}})
+	require.NoError(t, err)
+	assert.False(t, added)
+
+	snapshot := pool.Best()
+	require.NotNil(t, snapshot)
+	assert.Equal(t, []byte("app_hash"), snapshot.trustedAppHash)
+	assert.Len(t, pool.GetPeers(snapshot), 2)
+}
+
+func TestSnapshotPool_Ranked_Best(t *testing.T) {
+	pool := newSnapshotPool(testStateProvider{})
+
+	// snapshots in expected order (best to worst). Highest height wins, then highest format.
+	// Snapshots with the same height and format are ordered by the number of peers.
+	expectSnapshots := []struct {
+		snapshot *snapshot
+		peers    []byte
+	}{
+		{&snapshot{Height: 2, Format: 2, Chunks: 4, Hash: []byte{1, 3}}, []byte{1, 2, 3}},
+		{&snapshot{Height: 1, Format: 1, Chunks: 4, Hash: []byte{1, 2}}, []byte{1, 2, 3}},
+		{&snapshot{Height: 1, Format: 1, Chunks: 4, Hash: []byte{2, 2}}, []byte{1}},
+	}
+
+	// add the snapshots in reverse order
+	for i := len(expectSnapshots) - 1; i >= 0; i-- {
+		for _, ip := range expectSnapshots[i].peers {
+			_, err := pool.Add(newTestPeer(t, ip), expectSnapshots[i].snapshot)
+			require.NoError(t, err)
+		}
+	}
+
+	ranked := pool.Ranked()
+	require.Len(t, ranked, len(expectSnapshots))
+	for i := range ranked {
+		assert.Equal(t, expectSnapshots[i].snapshot, ranked[i])
+	}
+
+	// check that best snapshots are returned in expected order
+	for i := range expectSnapshots {
+		snapshot := expectSnapshots[i].snapshot
+		require.Equal(t, snapshot, pool.Best())
+		pool.Reject(snapshot)
+	}
+	assert.Nil(t, pool.Best())
+}
+
+func TestSnapshotPool_RejectFormat(t *testing.T) {
+	pool := newSnapshotPool(testStateProvider{})
+	peer := newTestPeer(t, 1)
+
+	_, err := pool.Add(peer, &snapshot{Height: 2, Format: 2, Chunks: 1, Hash: []byte{1}})
+	require.NoError(t, err)
+	_, err = pool.Add(peer, &snapshot{Height: 1, Format: 1, Chunks: 1, Hash: []byte{2}})
+	require.NoError(t, err)
+
+	pool.RejectFormat(2)
+	best := pool.Best()
+	require.NotNil(t, best)
+	assert.EqualValues(t, 1, best.Format)
+
+	// snapshots of a rejected format are not added again
+	added, err := pool.Add(peer, &snapshot{Height: 3, Format: 2, Chunks: 1, Hash: []byte{3}})
+	require.NoError(t, err)
+	assert.False(t, added)
+}
+
+func TestSnapshotPool_RejectPeer(t *testing.T) {
+	pool := newSnapshotPool(testStateProvider{})
+	peerA := newTestPeer(t, 1)
+	peerB := newTestPeer(t, 2)
+
+	s1 := &snapshot{Height: 1, Format: 1, Chunks: 1, Hash: []byte{1}}
+	s2 := &snapshot{Height: 2, Format: 1, Chunks: 1, Hash: []byte{2}}
+	_, err := pool.Add(peerA, s1)
+	require.NoError(t, err)
+	_, err = pool.Add(peerA, s2)
+	require.NoError(t, err)
+	_, err = pool.Add(peerB, s1)
+	require.NoError(t, err)
+
+	// s2 only had peer A, so it goes away with it
+	pool.RejectPeer(peerA.ID())
+	assert.Equal(t, []*snapshot{s1}, pool.Ranked())
+	assert.Equal(t, []p2p.Peer{peerB}, pool.GetPeers(s1))
+
+	// the rejected peer can't add snapshots anymore
+	added, err := pool.Add(peerA, &snapshot{Height: 3, Format: 1, Chunks: 1, Hash: []byte{3}})
+	require.NoError(t, err)
+	assert.False(t, added)
+
+	// removing the last peer of a snapshot removes the snapshot
+	pool.RemovePeer(peerB.ID())
+	assert.Empty(t, pool.Ranked())
+}
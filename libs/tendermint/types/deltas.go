@@ -16,10 +16,25 @@ const (
 	// send delta to dc/redis
 	FlagUploadDDS = "upload-delta"
 
+	// delta broker backend (redis|fs|http)
+	FlagDeltaBroker  = "delta-broker"
+	DeltaBrokerRedis = "redis"
+	DeltaBrokerFs    = "fs"
+	DeltaBrokerHttp  = "http"
+
 	// redis
 	FlagRedisUrl  = "redis-url"
 	FlagRedisAuth = "redis-auth"
 
+	// fs
+	FlagDeltaDir = "delta-dir"
+
+	// http (s3 compatible)
+	FlagDeltaUrl = "delta-url"
+
+	// compress codec of uploaded delta (none|zlib|gzip|flate|zstd|snappy)
+	FlagDeltaCompress = "delta-compress"
+
 	// data-center
 	FlagDataCenter = "data-center-mode"
 	DataCenterUrl  = "data-center-url"
@@ -41,6 +56,13 @@ var (
 	redisUrl  = "127.0.0.1:6379"
 	redisAuth = "auth"
 
+	deltaBroker = DeltaBrokerRedis
+	deltaDir    = ""
+	// fmt (http://ip:port/bucket/)
+	deltaUrl = ""
+
+	deltaCompress = "none"
+
 	applyP2PDelta    = false
 	broadcatP2PDelta = false
 	downloadDelta    = false
@@ -52,6 +74,12 @@ var (
 	onceRedisUrl   sync.Once
 	onceRedisAuth  sync.Once
 
+	onceDeltaBroker sync.Once
+	onceDeltaDir    sync.Once
+	onceDeltaUrl    sync.Once
+
+	onceDeltaCompress sync.Once
+
 	onceApplyP2P     sync.Once
 	onceBroadcastP2P sync.Once
 	onceDownload     sync.Once
@@ -107,6 +135,38 @@ func RedisAuth() string {
 	return redisAuth
 }
 
+func DeltaBroker() string {
+	onceDeltaBroker.Do(func() {
+		if b := viper.GetString(FlagDeltaBroker); b != "" {
+			deltaBroker = b
+		}
+	})
+	return deltaBroker
+}
+
+func DeltaDir() string {
+	onceDeltaDir.Do(func() {
+		deltaDir = viper.GetString(FlagDeltaDir)
+	})
+	return deltaDir
+}
+
+func DeltaUrl() string {
+	onceDeltaUrl.Do(func() {
+		deltaUrl = viper.GetString(FlagDeltaUrl)
+	})
+	return deltaUrl
+}
+
+func DeltaCompress() string {
+	onceDeltaCompress.Do(func() {
+		if c := viper.GetString(FlagDeltaCompress); c != "" {
+			deltaCompress = c
+		}
+	})
+	return deltaCompress
+}
+
 func GetCenterUrl() string {
 	onceCenterUrl.Do(func() {
 		centerUrl = viper.GetString(DataCenterUrl)
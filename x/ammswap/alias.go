@@ -12,6 +12,9 @@ const (
 	StoreKey          = types.StoreKey
 	DefaultParamspace = types.DefaultParamspace
 	QuerierRoute      = types.QuerierRoute
+
+	// nolint
+	ProtocolFeeAccount = types.ProtocolFeeAccount
 )
 
 var (
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/okex/exchain/libs/cosmos-sdk/client"
 	"github.com/okex/exchain/libs/cosmos-sdk/client/context"
@@ -32,6 +33,9 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 			GetCmdAllSwapTokenPairs(queryRoute, cdc),
 			GetCmdRedeemableAssets(queryRoute, cdc),
 			GetCmdQueryBuyAmount(queryRoute, cdc),
+			GetCmdQueryBestPath(queryRoute, cdc),
+			GetCmdQuerySwapPairFees(queryRoute, cdc),
+			GetCmdQueryTWAP(queryRoute, cdc),
 		)...,
 	)
 
@@ -109,6 +113,109 @@ $ %s query swap amount 100eth-245 xxb`, version.ClientName,
 	}
 }
 
+// GetCmdQueryBestPath queries the swap path which buys the most token by the given amount of token to sell
+func GetCmdQueryBestPath(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "best-path [token-to-sell] [token-name-to-buy]",
+		Short: "Query the swap path which buys the most token by the given amount of token to sell",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query the swap path which buys the most token by the given amount of token to sell, and the quoted output of it.
+
+Example:
+$ %s query swap best-path 100eth-245 xxb`, version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			bz, err := cdc.MarshalJSON(types.NewQuerySwapBestPathParams(args[0], args[1]))
+			if err != nil {
+				return err
+			}
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QuerySwapBestPath), bz)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+}
+
+// GetCmdQuerySwapPairFees queries the fee rates and the realized fees of swap token pairs
+func GetCmdQuerySwapPairFees(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "pair-fees [token-pair-name]",
+		Short: "Query the fee rates and the realized fees of swap token pairs",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query the fee rates and the realized fees of the swap token pair, or of all the swap token pairs
+without it.
+
+Example:
+$ %s query swap pair-fees eth-245_xxb`, version.ClientName,
+			),
+		),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QuerySwapPairFees)
+			if len(args) == 1 {
+				route = fmt.Sprintf("%s/%s", route, args[0])
+			}
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+}
+
+// GetCmdQueryTWAP queries the time weighted average prices of a swap token pair between two heights
+func GetCmdQueryTWAP(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "twap [token-pair-name] [start-height] [end-height]",
+		Short: "Query the time weighted average prices of a swap token pair between two heights",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query the time weighted average prices of a swap token pair, which average the prices at the end of
+every block from the start height to the one before the end height.
+
+Example:
+$ %s query swap twap eth-245_xxb 100 200`, version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			startHeight, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			endHeight, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+			bz, err := cdc.MarshalJSON(types.NewQueryTWAPParams(args[0], startHeight, endHeight))
+			if err != nil {
+				return err
+			}
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryTWAP), bz)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+}
+
 // GetCmdQueryParams queries the parameters of the AMM swap system
 func GetCmdQueryParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -10,9 +10,12 @@ import (
 	client "github.com/okex/exchain/libs/cosmos-sdk/client/flags"
 	"github.com/okex/exchain/libs/cosmos-sdk/codec"
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/version"
 	"github.com/okex/exchain/libs/cosmos-sdk/x/auth"
 	"github.com/okex/exchain/libs/cosmos-sdk/x/auth/client/utils"
+	swaputils "github.com/okex/exchain/x/ammswap/client/utils"
 	"github.com/okex/exchain/x/ammswap/types"
+	"github.com/okex/exchain/x/gov"
 	"github.com/spf13/cobra"
 )
 
@@ -30,6 +33,7 @@ const (
 	flagRecipient        = "recipient"
 	flagToken0           = "token0"
 	flagToken1           = "token1"
+	flagPath             = "path"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -44,6 +48,7 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		getCmdRemoveLiquidity(cdc),
 		getCmdCreateExchange(cdc),
 		getCmdTokenSwap(cdc),
+		getCmdTokenSwapByPath(cdc),
 	)...)
 
 	return txCmd
@@ -257,3 +262,171 @@ $ exchaincli tx swap token --sell-amount 1eth-355 --min-buy-amount 60btc-366
 
 	return cmd
 }
+
+func getCmdTokenSwapByPath(cdc *codec.Codec) *cobra.Command {
+	// flags
+	var soldTokenAmount string
+	var minBoughtTokenAmount string
+	var path string
+	var deadline string
+	var recipient string
+	cmd := &cobra.Command{
+		Use:   "token-by-path",
+		Short: "swap token along a path of tokens",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`swap token across the swap pairs along a path of tokens, the min buy amount is checked once
+for the whole path.
+
+Example:
+$ exchaincli tx swap token-by-path --sell-amount 1eth-355 --min-buy-amount 60btc-366 --path eth-355,okt,usdt-a2b,btc-366
+
+`),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			soldTokenAmount, err := sdk.ParseDecCoin(soldTokenAmount)
+			if err != nil {
+				return err
+			}
+			minBoughtTokenAmount, err := sdk.ParseDecCoin(minBoughtTokenAmount)
+			if err != nil {
+				return err
+			}
+			dur, err := time.ParseDuration(deadline)
+			if err != nil {
+				return err
+			}
+			deadline := time.Now().Add(dur).Unix()
+			var recip sdk.AccAddress
+			if recipient == "" {
+				recip = cliCtx.FromAddress
+			} else {
+				recip, err = sdk.AccAddressFromBech32(recipient)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgTokenToTokenByPath(soldTokenAmount, minBoughtTokenAmount, strings.Split(path, ","),
+				deadline, recip, cliCtx.FromAddress)
+
+			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().StringVarP(&soldTokenAmount, flagSellAmount, "", "",
+		"Amount expected to sell")
+	cmd.Flags().StringVarP(&minBoughtTokenAmount, flagMinBuyAmount, "", "",
+		"Minimum amount expected to buy at the end of the path")
+	cmd.Flags().StringVarP(&path, flagPath, "", "",
+		"Tokens to swap through separated by commas, from the token to sell to the token to buy")
+	cmd.Flags().StringVarP(&recipient, flagRecipient, "", "",
+		"The address to receive the amount bought")
+	cmd.Flags().StringVarP(&deadline, flagDeadlineDuration, "", "100s",
+		"Duration after which this transaction can no longer be executed. such as \"300ms\", \"1.5h\" or \"2h45m\". Valid time units are \"ns\", \"us\" (or \"µs\"), \"ms\", \"s\", \"m\", \"h\".")
+	cmd.MarkFlagRequired(flagSellAmount)
+	cmd.MarkFlagRequired(flagMinBuyAmount)
+	cmd.MarkFlagRequired(flagPath)
+
+	return cmd
+}
+
+// GetCmdManageSwapPairFeeRateProposal implements a command handler for submitting a swap pair fee rate proposal transaction
+func GetCmdManageSwapPairFeeRateProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "manage-swap-pair-fee-rate [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to set or delete the fee rate of a swap token pair",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to set or delete the fee rate of a swap token pair along with an initial deposit.
+The pair falls back to the global fee rate once its own one is deleted.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal manage-swap-pair-fee-rate <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+ "title": "set the fee rate of a swap token pair",
+ "description": "lower the fee rate of the stable swap token pair",
+ "token_pair_name": "usdk_usdt",
+ "fee_rate": "0.0005",
+ "is_added": true,
+ "deposit": [
+   {
+     "denom": "%s",
+     "amount": "100"
+   }
+ ]
+}
+`, version.ClientName, sdk.DefaultBondDenom,
+			)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := swaputils.ParseManageSwapPairFeeRateProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			content := types.NewManageSwapPairFeeRateProposal(proposal.Title, proposal.Description,
+				proposal.TokenPairName, proposal.FeeRate, proposal.IsAdded)
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, cliCtx.GetFromAddress())
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdManageProtocolFeeProposal implements a command handler for submitting a protocol fee proposal transaction
+func GetCmdManageProtocolFeeProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "manage-swap-protocol-fee [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to set the share of the swap fees skimmed as protocol fees",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to set the share of the swap fees skimmed as protocol fees along with an initial
+deposit. The protocol fees go to the community pool or the protocol fee module account.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal manage-swap-protocol-fee <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+ "title": "set the swap protocol fee",
+ "description": "skim a sixth of the swap fees into the community pool",
+ "fee_share": "0.166666666666666667",
+ "to_community_pool": true,
+ "deposit": [
+   {
+     "denom": "%s",
+     "amount": "100"
+   }
+ ]
+}
+`, version.ClientName, sdk.DefaultBondDenom,
+			)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := swaputils.ParseManageProtocolFeeProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			content := types.NewManageProtocolFeeProposal(proposal.Title, proposal.Description,
+				proposal.FeeShare, proposal.ToCommunityPool)
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, cliCtx.GetFromAddress())
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
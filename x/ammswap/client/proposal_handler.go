@@ -0,0 +1,21 @@
+package client
+
+import (
+	"github.com/okex/exchain/x/ammswap/client/cli"
+	"github.com/okex/exchain/x/ammswap/client/rest"
+	govcli "github.com/okex/exchain/x/gov/client"
+)
+
+var (
+	// ManageSwapPairFeeRateProposalHandler alias gov NewProposalHandler
+	ManageSwapPairFeeRateProposalHandler = govcli.NewProposalHandler(
+		cli.GetCmdManageSwapPairFeeRateProposal,
+		rest.ManageSwapPairFeeRateProposalRESTHandler,
+	)
+
+	// ManageProtocolFeeProposalHandler alias gov NewProposalHandler
+	ManageProtocolFeeProposalHandler = govcli.NewProposalHandler(
+		cli.GetCmdManageProtocolFeeProposal,
+		rest.ManageProtocolFeeProposalRESTHandler,
+	)
+)
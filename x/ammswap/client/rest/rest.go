@@ -2,6 +2,7 @@ package rest
 
 import (
 	"github.com/gorilla/mux"
+	govRest "github.com/okex/exchain/x/gov/client/rest"
 
 	"github.com/okex/exchain/libs/cosmos-sdk/client/context"
 )
@@ -10,3 +11,13 @@ import (
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	registerQueryRoutes(cliCtx, r)
 }
+
+// ManageSwapPairFeeRateProposalRESTHandler defines ammswap swap pair fee rate proposal handler
+func ManageSwapPairFeeRateProposalRESTHandler(context.CLIContext) govRest.ProposalRESTHandler {
+	return govRest.ProposalRESTHandler{}
+}
+
+// ManageProtocolFeeProposalRESTHandler defines ammswap protocol fee proposal handler
+func ManageProtocolFeeProposalRESTHandler(context.CLIContext) govRest.ProposalRESTHandler {
+	return govRest.ProposalRESTHandler{}
+}
@@ -0,0 +1,59 @@
+package utils
+
+import (
+	"io/ioutil"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+// ManageSwapPairFeeRateProposalJSON defines a ManageSwapPairFeeRateProposal with a deposit used to parse manage swap
+// pair fee rate proposals from a JSON file.
+type ManageSwapPairFeeRateProposalJSON struct {
+	Title         string       `json:"title" yaml:"title"`
+	Description   string       `json:"description" yaml:"description"`
+	TokenPairName string       `json:"token_pair_name" yaml:"token_pair_name"`
+	FeeRate       sdk.Dec      `json:"fee_rate" yaml:"fee_rate"`
+	IsAdded       bool         `json:"is_added" yaml:"is_added"`
+	Deposit       sdk.SysCoins `json:"deposit" yaml:"deposit"`
+}
+
+// ManageProtocolFeeProposalJSON defines a ManageProtocolFeeProposal with a deposit used to parse manage protocol fee
+// proposals from a JSON file.
+type ManageProtocolFeeProposalJSON struct {
+	Title           string       `json:"title" yaml:"title"`
+	Description     string       `json:"description" yaml:"description"`
+	FeeShare        sdk.Dec      `json:"fee_share" yaml:"fee_share"`
+	ToCommunityPool bool         `json:"to_community_pool" yaml:"to_community_pool"`
+	Deposit         sdk.SysCoins `json:"deposit" yaml:"deposit"`
+}
+
+// ParseManageSwapPairFeeRateProposalJSON parses json from proposal file to ManageSwapPairFeeRateProposalJSON struct
+func ParseManageSwapPairFeeRateProposalJSON(cdc *codec.Codec, proposalFilePath string) (
+	proposal ManageSwapPairFeeRateProposalJSON, err error) {
+	contents, err := ioutil.ReadFile(proposalFilePath)
+	if err != nil {
+		return
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return
+	}
+	// the fee rate is not required when the swap token pair falls back to the global one
+	if proposal.FeeRate.IsNil() {
+		proposal.FeeRate = sdk.ZeroDec()
+	}
+	return
+}
+
+// ParseManageProtocolFeeProposalJSON parses json from proposal file to ManageProtocolFeeProposalJSON struct
+func ParseManageProtocolFeeProposalJSON(cdc *codec.Codec, proposalFilePath string) (
+	proposal ManageProtocolFeeProposalJSON, err error) {
+	contents, err := ioutil.ReadFile(proposalFilePath)
+	if err != nil {
+		return
+	}
+
+	err = cdc.UnmarshalJSON(contents, &proposal)
+	return
+}
@@ -10,8 +10,12 @@ import (
 
 // GenesisState stores genesis data, all slashing state that must be provided at genesis
 type GenesisState struct {
-	Params               Params          `json:"params"`
-	SwapTokenPairRecords []SwapTokenPair `json:"swap_token_pair_records"`
+	Params               Params                           `json:"params"`
+	SwapTokenPairRecords []SwapTokenPair                  `json:"swap_token_pair_records"`
+	SwapPairFeeRates     []types.SwapPairFeeRate          `json:"swap_pair_fee_rates"`
+	ProtocolFee          types.ProtocolFee                `json:"protocol_fee"`
+	PriceObservations    []types.SwapPairPriceObservation `json:"price_observations"`
+	SwapPairFees         []types.SwapPairFees             `json:"swap_pair_fees"`
 }
 
 // nolint
@@ -32,6 +36,25 @@ func ValidateGenesis(data GenesisState) error {
 			return fmt.Errorf("invalid SwapTokenPairRecord: PoolToken: %s. Error: invalid PoolToken", record.PoolTokenName)
 		}
 	}
+	for _, feeRate := range data.SwapPairFeeRates {
+		if err := types.ValidateFeeRate(feeRate.FeeRate); err != nil {
+			return fmt.Errorf("invalid SwapPairFeeRate: %s. Error: %s", feeRate.TokenPairName, err)
+		}
+	}
+	for _, observation := range data.PriceObservations {
+		if err := observation.Validate(); err != nil {
+			return fmt.Errorf("invalid SwapPairPriceObservation: %s. Error: %s", observation.TokenPairName, err)
+		}
+	}
+	for _, fees := range data.SwapPairFees {
+		if !fees.LiquidityFees.IsValid() || !fees.ProtocolFees.IsValid() {
+			return fmt.Errorf("invalid SwapPairFees: %s", fees.TokenPairName)
+		}
+	}
+	// the protocol fee is missing in the genesis exported before it was introduced
+	if !data.ProtocolFee.FeeShare.IsNil() {
+		return data.ProtocolFee.Validate()
+	}
 	return nil
 }
 
@@ -40,6 +63,7 @@ func DefaultGenesisState() GenesisState {
 	return GenesisState{
 		Params:               types.DefaultParams(),
 		SwapTokenPairRecords: nil,
+		ProtocolFee:          types.DefaultProtocolFee(),
 	}
 }
 
@@ -49,6 +73,20 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 	for _, record := range data.SwapTokenPairRecords {
 		keeper.SetSwapTokenPair(ctx, record.TokenPairName(), record)
 	}
+	for _, feeRate := range data.SwapPairFeeRates {
+		keeper.SetPairFeeRate(ctx, feeRate.TokenPairName, feeRate.FeeRate)
+	}
+	if !data.ProtocolFee.FeeShare.IsNil() {
+		keeper.SetProtocolFee(ctx, data.ProtocolFee)
+	}
+	// the observations recorded by setting the swap token pairs above are replaced by the exported ones at the
+	// same heights
+	for _, observation := range data.PriceObservations {
+		keeper.SetPriceObservation(ctx, observation.TokenPairName, observation.PriceObservation)
+	}
+	for _, fees := range data.SwapPairFees {
+		keeper.SetSwapPairFees(ctx, fees)
+	}
 }
 
 // ExportGenesis exports genesis from keeper
@@ -62,5 +100,12 @@ func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 
 	}
 	params := k.GetParams(ctx)
-	return GenesisState{SwapTokenPairRecords: records, Params: params}
+	return GenesisState{
+		SwapTokenPairRecords: records,
+		Params:               params,
+		SwapPairFeeRates:     k.GetPairFeeRates(ctx),
+		ProtocolFee:          k.GetProtocolFee(ctx),
+		PriceObservations:    k.GetPriceObservations(ctx),
+		SwapPairFees:         k.GetAllSwapPairFees(ctx),
+	}
 }
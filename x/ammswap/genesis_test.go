@@ -59,8 +59,41 @@ func TestInitAndExportGenesis(t *testing.T) {
 	}
 	InitGenesis(ctx, keeper, defaultGenesisState)
 	exportedGenesis := ExportGenesis(ctx, keeper)
+	// the price observation is recorded by setting the swap token pair
+	require.Equal(t, 1, len(exportedGenesis.PriceObservations))
+	require.Equal(t, testSwapTokenPair.TokenPairName(), exportedGenesis.PriceObservations[0].TokenPairName)
+	require.Equal(t, int64(10), exportedGenesis.PriceObservations[0].PriceObservation.Height)
+	defaultGenesisState.PriceObservations = exportedGenesis.PriceObservations
 	require.Equal(t, defaultGenesisState, exportedGenesis)
 
+	// the exported price observations are restored
+	observation := exportedGenesis.PriceObservations[0]
+	observation.PriceObservation.Height = 5
+	exportedGenesis.PriceObservations = append(exportedGenesis.PriceObservations, observation)
+	require.NoError(t, ValidateGenesis(exportedGenesis))
+	ctx = ctx.WithBlockHeight(20)
+	InitGenesis(ctx, keeper, exportedGenesis)
+	require.Equal(t, int64(5), keeper.GetPriceObservation(ctx, observation.TokenPairName, 9).Height)
+
+	observation.PriceObservation.BasePrice = sdk.NewDec(-1)
+	exportedGenesis.PriceObservations = []types.SwapPairPriceObservation{observation}
+	require.Error(t, ValidateGenesis(exportedGenesis))
+
+	// the realized fees are exported and restored
+	tokenPairName := testSwapTokenPair.TokenPairName()
+	keeper.AddSwapPairFees(ctx, tokenPairName, sdk.NewDecCoinFromDec(testSwapTokenPair.QuotePooledCoin.Denom, sdk.NewDec(3)),
+		sdk.NewDecCoinFromDec(testSwapTokenPair.QuotePooledCoin.Denom, sdk.NewDec(1)))
+	exportedGenesis = ExportGenesis(ctx, keeper)
+	require.Equal(t, []types.SwapPairFees{keeper.GetSwapPairFees(ctx, tokenPairName)}, exportedGenesis.SwapPairFees)
+	require.NoError(t, ValidateGenesis(exportedGenesis))
+
+	keeper.AddSwapPairFees(ctx, tokenPairName, sdk.NewDecCoinFromDec(testSwapTokenPair.QuotePooledCoin.Denom, sdk.NewDec(5)),
+		sdk.NewDecCoinFromDec(testSwapTokenPair.QuotePooledCoin.Denom, sdk.NewDec(5)))
+	InitGenesis(ctx, keeper, exportedGenesis)
+	require.Equal(t, exportedGenesis.SwapPairFees[0], keeper.GetSwapPairFees(ctx, tokenPairName))
+
+	exportedGenesis.SwapPairFees[0].ProtocolFees = sdk.SysCoins{{Denom: "1abc", Amount: sdk.OneDec()}}
+	require.Error(t, ValidateGenesis(exportedGenesis))
 }
 
 func TestExportSupplyGenesisWithZeroLiquidity(t *testing.T) {
@@ -1,6 +1,8 @@
 package ammswap
 
 import (
+	"strings"
+
 	"github.com/okex/exchain/x/ammswap/keeper"
 	"github.com/okex/exchain/x/ammswap/types"
 	"github.com/okex/exchain/x/common"
@@ -36,6 +38,11 @@ func NewHandler(k Keeper) sdk.Handler {
 			handlerFun = func() (*sdk.Result, error) {
 				return handleMsgTokenToToken(ctx, k, msg)
 			}
+		case types.MsgTokenToTokenByPath:
+			name = "handleMsgTokenToTokenByPath"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgTokenToTokenByPath(ctx, k, msg)
+			}
 		default:
 			return nil, types.ErrSwapUnknownMsgType()
 		}
@@ -247,8 +254,8 @@ func swapToken(ctx sdk.Context, k Keeper, msg types.MsgTokenToToken) (*sdk.Resul
 	if swapTokenPair.BasePooledCoin.IsZero() || swapTokenPair.QuotePooledCoin.IsZero() {
 		return types.ErrIsZeroValue("base pooled coin or quote pooled coin").Result()
 	}
-	params := k.GetParams(ctx)
-	tokenBuy := keeper.CalculateTokenToBuy(swapTokenPair, msg.SoldTokenAmount, msg.MinBoughtTokenAmount.Denom, params)
+	feeRate := k.GetSwapTokenPairFeeRate(ctx, msg.GetSwapTokenPairName())
+	tokenBuy := keeper.CalculateTokenToBuy(swapTokenPair, msg.SoldTokenAmount, msg.MinBoughtTokenAmount.Denom, feeRate)
 	if tokenBuy.IsZero() {
 		return types.ErrIsZeroValue("token buy").Result()
 	}
@@ -294,16 +301,17 @@ func swapTokenByRouter(ctx sdk.Context, k Keeper, msg types.MsgTokenToToken) (*s
 	}
 
 	nativeAmount := sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.MustNewDecFromStr("0"))
-	params := k.GetParams(ctx)
 	msgOne := msg
 	msgOne.MinBoughtTokenAmount = nativeAmount
-	tokenNative := keeper.CalculateTokenToBuy(swapTokenPairOne, msgOne.SoldTokenAmount, msgOne.MinBoughtTokenAmount.Denom, params)
+	tokenNative := keeper.CalculateTokenToBuy(swapTokenPairOne, msgOne.SoldTokenAmount, msgOne.MinBoughtTokenAmount.Denom,
+		k.GetSwapTokenPairFeeRate(ctx, tokenPairOne))
 	if tokenNative.IsZero() {
 		return types.ErrIsZeroValue("token native").Result()
 	}
 	msgTwo := msg
 	msgTwo.SoldTokenAmount = tokenNative
-	tokenBuy := keeper.CalculateTokenToBuy(swapTokenPairTwo, msgTwo.SoldTokenAmount, msgTwo.MinBoughtTokenAmount.Denom, params)
+	tokenBuy := keeper.CalculateTokenToBuy(swapTokenPairTwo, msgTwo.SoldTokenAmount, msgTwo.MinBoughtTokenAmount.Denom,
+		k.GetSwapTokenPairFeeRate(ctx, tokenPairTwo))
 	// sanity check. user may set MinBoughtTokenAmount to zero on front end.
 	// if set zero,this will not return err
 	if tokenBuy.IsZero() {
@@ -328,16 +336,70 @@ func swapTokenByRouter(ctx sdk.Context, k Keeper, msg types.MsgTokenToToken) (*s
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
 
+// handleMsgTokenToTokenByPath swaps across every swap token pair along the path, the min bought token amount
+// and the deadline are checked once for the whole path
+func handleMsgTokenToTokenByPath(ctx sdk.Context, k Keeper, msg types.MsgTokenToTokenByPath) (*sdk.Result, error) {
+	event := sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName))
+
+	if msg.Deadline < ctx.BlockTime().Unix() {
+		return types.ErrBlockTimeBigThanDeadline().Result()
+	}
+	if err := common.HasSufficientCoins(msg.Sender, k.GetTokenKeeper().GetCoins(ctx, msg.Sender),
+		sdk.SysCoins{msg.SoldTokenAmount}); err != nil {
+		return common.ErrInsufficientCoins(DefaultParamspace, err.Error()).Result()
+	}
+	swapTokenPairs, tokensBuy, err := k.CalculateTokenToBuyByPath(ctx, msg.SoldTokenAmount, msg.Path)
+	if err != nil {
+		return nil, err
+	}
+	tokenBuy := tokensBuy[len(tokensBuy)-1]
+	if tokenBuy.Amount.LT(msg.MinBoughtTokenAmount.Amount) {
+		return types.ErrLessThan("token buy amount", "min bought token amount").Result()
+	}
+
+	// the tokens bought at the intermediate hops are sold by the sender at the next hop
+	soldTokenAmount := msg.SoldTokenAmount
+	for i, swapTokenPair := range swapTokenPairs {
+		recipient := msg.Sender
+		if i == len(swapTokenPairs)-1 {
+			recipient = msg.Recipient
+		}
+		hopMsg := types.NewMsgTokenToToken(soldTokenAmount, sdk.NewDecCoinFromDec(tokensBuy[i].Denom, sdk.ZeroDec()),
+			msg.Deadline, recipient, msg.Sender)
+		res, err := swapTokenNativeToken(ctx, k, swapTokenPair, tokensBuy[i], hopMsg)
+		if err != nil {
+			return res, err
+		}
+		soldTokenAmount = tokensBuy[i]
+	}
+
+	event = event.AppendAttributes(sdk.NewAttribute("bought_token_amount", tokenBuy.String()))
+	event = event.AppendAttributes(sdk.NewAttribute("recipient", msg.Recipient.String()))
+	event = event.AppendAttributes(sdk.NewAttribute("path", strings.Join(msg.Path, ",")))
+	ctx.EventManager().EmitEvent(event)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
 func swapTokenNativeToken(
 	ctx sdk.Context, k Keeper, swapTokenPair SwapTokenPair, tokenBuy sdk.SysCoin,
 	msg types.MsgTokenToToken,
 ) (*sdk.Result, error) {
+	// the protocol fee is skimmed from the sold token before it goes into the pool
+	tokenPairName := msg.GetSwapTokenPairName()
+	liquidityFee, protocolFee := k.CalculateSwapFees(ctx, tokenPairName, msg.SoldTokenAmount)
+	soldTokenToPool := msg.SoldTokenAmount.Sub(protocolFee)
+
 	// transfer coins
-	err := k.SendCoinsToPool(ctx, sdk.SysCoins{msg.SoldTokenAmount}, msg.Sender)
+	err := k.SendCoinsToPool(ctx, sdk.SysCoins{soldTokenToPool}, msg.Sender)
 	if err != nil {
 		return types.ErrSendCoinsToPoolFailed(err.Error()).Result()
 	}
 
+	err = k.SendProtocolFee(ctx, protocolFee, msg.Sender)
+	if err != nil {
+		return types.ErrSendCoinsFailed(err).Result()
+	}
+
 	err = k.SendCoinsFromPoolToAccount(ctx, sdk.SysCoins{tokenBuy}, msg.Recipient)
 	if err != nil {
 		return types.ErrSendCoinsFromPoolToAccountFailed(err.Error()).Result()
@@ -345,13 +407,14 @@ func swapTokenNativeToken(
 
 	// update swapTokenPair
 	if msg.MinBoughtTokenAmount.Denom < msg.SoldTokenAmount.Denom {
-		swapTokenPair.QuotePooledCoin = swapTokenPair.QuotePooledCoin.Add(msg.SoldTokenAmount)
+		swapTokenPair.QuotePooledCoin = swapTokenPair.QuotePooledCoin.Add(soldTokenToPool)
 		swapTokenPair.BasePooledCoin = swapTokenPair.BasePooledCoin.Sub(tokenBuy)
 	} else {
 		swapTokenPair.QuotePooledCoin = swapTokenPair.QuotePooledCoin.Sub(tokenBuy)
-		swapTokenPair.BasePooledCoin = swapTokenPair.BasePooledCoin.Add(msg.SoldTokenAmount)
+		swapTokenPair.BasePooledCoin = swapTokenPair.BasePooledCoin.Add(soldTokenToPool)
 	}
-	k.SetSwapTokenPair(ctx, msg.GetSwapTokenPairName(), swapTokenPair)
+	k.SetSwapTokenPair(ctx, tokenPairName, swapTokenPair)
+	k.AddSwapPairFees(ctx, tokenPairName, liquidityFee, protocolFee)
 	k.OnSwapToken(ctx, msg.Recipient, swapTokenPair, msg.SoldTokenAmount, tokenBuy)
 	return &sdk.Result{}, nil
 }
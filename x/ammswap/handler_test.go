@@ -454,6 +454,107 @@ func TestHandleMsgTokenToTokenDirectly(t *testing.T) {
 	}
 }
 
+func TestHandleMsgTokenToTokenByPath(t *testing.T) {
+	mapp, addrKeysSlice := getMockAppWithBalance(t, 1, 100000)
+	keeper := mapp.swapKeeper
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 2}})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{}).WithBlockHeight(10).WithBlockTime(time.Now())
+	mapp.swapKeeper.SetParams(ctx, types.DefaultParams())
+	mapp.supplyKeeper.SetSupply(ctx, supply.NewSupply(mapp.TotalCoinsSupply))
+	for _, symbol := range []string{types.TestBasePooledToken, types.TestBasePooledToken2, types.TestBasePooledToken3,
+		types.TestQuotePooledToken} {
+		mapp.tokenKeeper.NewToken(ctx, token.InitTestToken(symbol))
+	}
+	handler := NewHandler(keeper)
+	addr := addrKeysSlice[0].Address
+	addrList := []sdk.AccAddress{addr}
+
+	// aab -> okt -> ccb -> ddb, and a shallow pool of aab_ddb
+	NewTestSwapTokenPairWithInitLiquidity(t, ctx, keeper, sdk.NewDecCoin(types.TestBasePooledToken, sdk.NewInt(100)),
+		sdk.NewDecCoin(types.TestQuotePooledToken, sdk.NewInt(100)), addrList)
+	NewTestSwapTokenPairWithInitLiquidity(t, ctx, keeper, sdk.NewDecCoin(types.TestBasePooledToken2, sdk.NewInt(100)),
+		sdk.NewDecCoin(types.TestQuotePooledToken, sdk.NewInt(100)), addrList)
+	NewTestSwapTokenPairWithInitLiquidity(t, ctx, keeper, sdk.NewDecCoin(types.TestBasePooledToken2, sdk.NewInt(100)),
+		sdk.NewDecCoin(types.TestBasePooledToken3, sdk.NewInt(100)), addrList)
+	NewTestSwapTokenPairWithInitLiquidity(t, ctx, keeper, sdk.NewDecCoin(types.TestBasePooledToken, sdk.NewInt(1)),
+		sdk.NewDecCoin(types.TestBasePooledToken3, sdk.NewInt(1)), addrList)
+
+	path := []string{types.TestBasePooledToken, types.TestQuotePooledToken, types.TestBasePooledToken2,
+		types.TestBasePooledToken3}
+	soldTokenAmount := sdk.NewDecCoinFromDec(types.TestBasePooledToken, sdk.NewDec(100))
+	_, tokensBuy, err := keeper.CalculateTokenToBuyByPath(ctx, soldTokenAmount, path)
+	require.Nil(t, err)
+	tokenBuy := tokensBuy[len(tokensBuy)-1]
+
+	// the best path is the deep one
+	bestPath, bestTokenBuy, err := keeper.GetBestSwapPath(ctx, soldTokenAmount, types.TestBasePooledToken3)
+	require.Nil(t, err)
+	require.Equal(t, path, bestPath)
+	require.Equal(t, tokenBuy, bestTokenBuy)
+	querier := NewQuerier(keeper)
+	bz, err := types.ModuleCdc.MarshalJSON(types.NewQuerySwapBestPathParams(soldTokenAmount.String(), types.TestBasePooledToken3))
+	require.Nil(t, err)
+	res, err := querier(ctx, []string{types.QuerySwapBestPath}, abci.RequestQuery{Data: bz})
+	require.Nil(t, err)
+	require.Contains(t, string(res), `"path":["aab","okt","ccb","ddb"]`)
+	_, _, err = keeper.GetBestSwapPath(ctx, soldTokenAmount, "eeb")
+	require.NotNil(t, err)
+
+	deadLine := time.Now().Unix()
+	tests := []struct {
+		testCase             string
+		minBoughtTokenAmount sdk.SysCoin
+		path                 []string
+		deadLine             int64
+		exceptResultCode     uint32
+	}{
+		{
+			testCase:             "min bought token amount is not reached",
+			minBoughtTokenAmount: sdk.NewDecCoinFromDec(types.TestBasePooledToken3, tokenBuy.Amount.Add(sdk.OneDec())),
+			path:                 path,
+			deadLine:             deadLine,
+			exceptResultCode:     types.CodeLessThan},
+		{
+			testCase:             "block time is after deadline",
+			minBoughtTokenAmount: tokenBuy,
+			path:                 path,
+			deadLine:             deadLine - 1,
+			exceptResultCode:     types.CodeBlockTimeBigThanDeadline},
+		{
+			testCase:             "swap token pair does not exist",
+			minBoughtTokenAmount: tokenBuy,
+			path:                 []string{types.TestBasePooledToken, types.TestBasePooledToken2, types.TestBasePooledToken3},
+			deadLine:             deadLine,
+			exceptResultCode:     types.CodeNonExistSwapTokenPair},
+		{
+			testCase:             "success",
+			minBoughtTokenAmount: tokenBuy,
+			path:                 path,
+			deadLine:             deadLine,
+			exceptResultCode:     sdk.CodeOK},
+	}
+
+	for _, testCase := range tests {
+		msg := types.NewMsgTokenToTokenByPath(soldTokenAmount, testCase.minBoughtTokenAmount, testCase.path,
+			testCase.deadLine, addr, addr)
+		require.Nil(t, msg.ValidateBasic())
+		beforeCoins := mapp.AccountKeeper.GetAccount(ctx, addr).GetCoins()
+		_, err := handler(ctx, msg)
+		testCode(t, err, testCase.exceptResultCode)
+		afterCoins := mapp.AccountKeeper.GetAccount(ctx, addr).GetCoins()
+		if err != nil {
+			continue
+		}
+		require.Equal(t, beforeCoins.AmountOf(types.TestBasePooledToken).Sub(soldTokenAmount.Amount),
+			afterCoins.AmountOf(types.TestBasePooledToken))
+		require.Equal(t, beforeCoins.AmountOf(types.TestBasePooledToken3).Add(tokenBuy.Amount),
+			afterCoins.AmountOf(types.TestBasePooledToken3))
+		// the intermediate tokens are swapped through
+		require.Equal(t, beforeCoins.AmountOf(types.TestQuotePooledToken), afterCoins.AmountOf(types.TestQuotePooledToken))
+		require.Equal(t, beforeCoins.AmountOf(types.TestBasePooledToken2), afterCoins.AmountOf(types.TestBasePooledToken2))
+	}
+}
+
 func TestGetInputPrice(t *testing.T) {
 	tests := []struct {
 		testCase           string
@@ -0,0 +1,153 @@
+package keeper
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/ammswap/types"
+)
+
+// GetSwapTokenPairFeeRate gets the fee rate of the swap token pair, it is the global one in params unless the
+// pair has its own one
+func (k Keeper) GetSwapTokenPairFeeRate(ctx sdk.Context, tokenPairName string) sdk.Dec {
+	if feeRate, found := k.GetPairFeeRate(ctx, tokenPairName); found {
+		return feeRate
+	}
+	return k.GetParams(ctx).FeeRate
+}
+
+// GetPairFeeRate gets the fee rate overriding the global one for the swap token pair
+func (k Keeper) GetPairFeeRate(ctx sdk.Context, tokenPairName string) (feeRate sdk.Dec, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPairFeeRateKey(tokenPairName))
+	if bz == nil {
+		return feeRate, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &feeRate)
+	return feeRate, true
+}
+
+// SetPairFeeRate sets the fee rate overriding the global one for the swap token pair
+func (k Keeper) SetPairFeeRate(ctx sdk.Context, tokenPairName string, feeRate sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPairFeeRateKey(tokenPairName), k.cdc.MustMarshalBinaryLengthPrefixed(feeRate))
+}
+
+// DeletePairFeeRate deletes the fee rate of the swap token pair, the pair falls back to the global one
+func (k Keeper) DeletePairFeeRate(ctx sdk.Context, tokenPairName string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPairFeeRateKey(tokenPairName))
+}
+
+// GetPairFeeRates gets all the fee rates overriding the global one
+func (k Keeper) GetPairFeeRates(ctx sdk.Context) []types.SwapPairFeeRate {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PairFeeRatePrefixKey)
+	defer iterator.Close()
+
+	var feeRates []types.SwapPairFeeRate
+	for ; iterator.Valid(); iterator.Next() {
+		var feeRate sdk.Dec
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &feeRate)
+		feeRates = append(feeRates, types.SwapPairFeeRate{
+			TokenPairName: string(iterator.Key()[len(types.PairFeeRatePrefixKey):]),
+			FeeRate:       feeRate,
+		})
+	}
+	return feeRates
+}
+
+// GetProtocolFee gets the share of the swap fees skimmed as protocol fees, none of them is skimmed before it is set
+func (k Keeper) GetProtocolFee(ctx sdk.Context) types.ProtocolFee {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ProtocolFeeKey)
+	if bz == nil {
+		return types.DefaultProtocolFee()
+	}
+	var protocolFee types.ProtocolFee
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &protocolFee)
+	return protocolFee
+}
+
+// SetProtocolFee sets the share of the swap fees skimmed as protocol fees
+func (k Keeper) SetProtocolFee(ctx sdk.Context, protocolFee types.ProtocolFee) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ProtocolFeeKey, k.cdc.MustMarshalBinaryLengthPrefixed(protocolFee))
+}
+
+// GetSwapPairFees gets the fees realized by the swap token pair
+func (k Keeper) GetSwapPairFees(ctx sdk.Context, tokenPairName string) types.SwapPairFees {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPairFeesKey(tokenPairName))
+	if bz == nil {
+		return types.NewSwapPairFees(tokenPairName)
+	}
+	var fees types.SwapPairFees
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &fees)
+	return fees
+}
+
+// AddSwapPairFees adds the fees realized by a swap to the swap token pair
+func (k Keeper) AddSwapPairFees(ctx sdk.Context, tokenPairName string, liquidityFee, protocolFee sdk.SysCoin) {
+	fees := k.GetSwapPairFees(ctx, tokenPairName)
+	if liquidityFee.IsPositive() {
+		fees.LiquidityFees = fees.LiquidityFees.Add(liquidityFee)
+	}
+	if protocolFee.IsPositive() {
+		fees.ProtocolFees = fees.ProtocolFees.Add(protocolFee)
+	}
+	k.SetSwapPairFees(ctx, fees)
+}
+
+// SetSwapPairFees sets the fees realized by the swap token pair
+func (k Keeper) SetSwapPairFees(ctx sdk.Context, fees types.SwapPairFees) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPairFeesKey(fees.TokenPairName), k.cdc.MustMarshalBinaryLengthPrefixed(fees))
+}
+
+// GetAllSwapPairFees gets the fees realized by all the swap token pairs
+func (k Keeper) GetAllSwapPairFees(ctx sdk.Context) []types.SwapPairFees {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PairFeesPrefixKey)
+	defer iterator.Close()
+
+	var allFees []types.SwapPairFees
+	for ; iterator.Valid(); iterator.Next() {
+		var fees types.SwapPairFees
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &fees)
+		allFees = append(allFees, fees)
+	}
+	return allFees
+}
+
+// GetSwapPairFeeInfo gets the fee rates and the realized fees of the swap token pair
+func (k Keeper) GetSwapPairFeeInfo(ctx sdk.Context, tokenPairName string) types.SwapPairFeeInfo {
+	fees := k.GetSwapPairFees(ctx, tokenPairName)
+	return types.SwapPairFeeInfo{
+		TokenPairName:    tokenPairName,
+		FeeRate:          k.GetSwapTokenPairFeeRate(ctx, tokenPairName),
+		ProtocolFeeShare: k.GetProtocolFee(ctx).FeeShare,
+		LiquidityFees:    fees.LiquidityFees,
+		ProtocolFees:     fees.ProtocolFees,
+	}
+}
+
+// CalculateSwapFees splits the fee charged on the sold token into the part staying in the pool and the part
+// skimmed as protocol fee
+func (k Keeper) CalculateSwapFees(ctx sdk.Context, tokenPairName string,
+	soldToken sdk.SysCoin) (liquidityFee, protocolFee sdk.SysCoin) {
+	fee := soldToken.Amount.MulTruncate(k.GetSwapTokenPairFeeRate(ctx, tokenPairName))
+	protocolFeeAmount := fee.MulTruncate(k.GetProtocolFee(ctx).FeeShare)
+	return sdk.NewDecCoinFromDec(soldToken.Denom, fee.Sub(protocolFeeAmount)),
+		sdk.NewDecCoinFromDec(soldToken.Denom, protocolFeeAmount)
+}
+
+// SendProtocolFee sends the protocol fee skimmed from a swap by the sender to the community pool or the protocol
+// fee module account
+func (k Keeper) SendProtocolFee(ctx sdk.Context, protocolFee sdk.SysCoin, sender sdk.AccAddress) error {
+	if !protocolFee.IsPositive() {
+		return nil
+	}
+	if k.GetProtocolFee(ctx).ToCommunityPool && k.distrKeeper != nil {
+		return k.distrKeeper.FundCommunityPool(ctx, sdk.SysCoins{protocolFee}, sender)
+	}
+	return k.supplyKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ProtocolFeeAccount, sdk.SysCoins{protocolFee})
+}
@@ -17,6 +17,8 @@ import (
 type Keeper struct {
 	supplyKeeper types.SupplyKeeper
 	tokenKeeper  types.TokenKeeper
+	govKeeper    types.GovKeeper
+	distrKeeper  types.DistrKeeper
 
 	storeKey       sdk.StoreKey
 	cdc            *codec.Codec
@@ -36,6 +38,16 @@ func NewKeeper(supplyKeeper types.SupplyKeeper, tokenKeeper types.TokenKeeper, c
 	return keeper
 }
 
+// SetGovKeeper sets keeper of gov
+func (k *Keeper) SetGovKeeper(gk types.GovKeeper) {
+	k.govKeeper = gk
+}
+
+// SetDistrKeeper sets keeper of distribution, which receives the protocol fees sent to the community pool
+func (k *Keeper) SetDistrKeeper(dk types.DistrKeeper) {
+	k.distrKeeper = dk
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
@@ -58,11 +70,13 @@ func (k Keeper) GetSwapTokenPair(ctx sdk.Context, tokenPairName string) (types.S
 	return item, nil
 }
 
-// SetSwapTokenPair sets the entire SwapTokenPair data struct for a quote token name
+// SetSwapTokenPair sets the entire SwapTokenPair data struct for a quote token name, and updates the price
+// observation of the pair as its reserves may change
 func (k Keeper) SetSwapTokenPair(ctx sdk.Context, tokenPairName string, swapTokenPair types.SwapTokenPair) {
 	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshalBinaryLengthPrefixed(swapTokenPair)
 	store.Set(types.GetTokenPairKey(tokenPairName), bz)
+	k.updatePriceObservation(ctx, tokenPairName, swapTokenPair)
 }
 
 // DeleteSwapTokenPair deletes the entire SwapTokenPair data struct for a quote token name
@@ -174,8 +188,8 @@ func (k Keeper) GetRedeemableAssets(ctx sdk.Context, baseAmountName, quoteAmount
 	return baseAmount, quoteAmount, nil
 }
 
-//CalculateTokenToBuy calculates the amount to buy
-func CalculateTokenToBuy(swapTokenPair types.SwapTokenPair, sellToken sdk.SysCoin, buyTokenDenom string, params types.Params) sdk.SysCoin {
+//CalculateTokenToBuy calculates the amount to buy, the fee rate is the one of the swap token pair
+func CalculateTokenToBuy(swapTokenPair types.SwapTokenPair, sellToken sdk.SysCoin, buyTokenDenom string, feeRate sdk.Dec) sdk.SysCoin {
 	var inputReserve, outputReserve sdk.Dec
 	if buyTokenDenom < sellToken.Denom {
 		inputReserve = swapTokenPair.QuotePooledCoin.Amount
@@ -184,7 +198,7 @@ func CalculateTokenToBuy(swapTokenPair types.SwapTokenPair, sellToken sdk.SysCoi
 		inputReserve = swapTokenPair.BasePooledCoin.Amount
 		outputReserve = swapTokenPair.QuotePooledCoin.Amount
 	}
-	tokenBuyAmt := GetInputPrice(sellToken.Amount, inputReserve, outputReserve, params.FeeRate)
+	tokenBuyAmt := GetInputPrice(sellToken.Amount, inputReserve, outputReserve, feeRate)
 	tokenBuy := sdk.NewDecCoinFromDec(buyTokenDenom, tokenBuyAmt)
 
 	return tokenBuy
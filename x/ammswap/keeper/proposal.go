@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/ammswap/types"
+	sdkGov "github.com/okex/exchain/x/gov"
+	govKeeper "github.com/okex/exchain/x/gov/keeper"
+	govTypes "github.com/okex/exchain/x/gov/types"
+)
+
+var _ govKeeper.ProposalHandler = (*Keeper)(nil)
+
+// GetMinDeposit returns min deposit
+func (k Keeper) GetMinDeposit(ctx sdk.Context, content sdkGov.Content) (minDeposit sdk.SysCoins) {
+	switch content.(type) {
+	case types.ManageSwapPairFeeRateProposal, types.ManageProtocolFeeProposal:
+		minDeposit = k.govKeeper.GetDepositParams(ctx).MinDeposit
+	}
+
+	return
+}
+
+// GetMaxDepositPeriod returns max deposit period
+func (k Keeper) GetMaxDepositPeriod(ctx sdk.Context, content sdkGov.Content) (maxDepositPeriod time.Duration) {
+	switch content.(type) {
+	case types.ManageSwapPairFeeRateProposal, types.ManageProtocolFeeProposal:
+		maxDepositPeriod = k.govKeeper.GetDepositParams(ctx).MaxDepositPeriod
+	}
+
+	return
+}
+
+// GetVotingPeriod returns voting period
+func (k Keeper) GetVotingPeriod(ctx sdk.Context, content sdkGov.Content) (votingPeriod time.Duration) {
+	switch content.(type) {
+	case types.ManageSwapPairFeeRateProposal, types.ManageProtocolFeeProposal:
+		votingPeriod = k.govKeeper.GetVotingParams(ctx).VotingPeriod
+	}
+
+	return
+}
+
+// CheckMsgSubmitProposal validates MsgSubmitProposal
+func (k Keeper) CheckMsgSubmitProposal(ctx sdk.Context, msg govTypes.MsgSubmitProposal) sdk.Error {
+	switch content := msg.Content.(type) {
+	case types.ManageSwapPairFeeRateProposal:
+		return k.CheckMsgManageSwapPairFeeRateProposal(ctx, content)
+	case types.ManageProtocolFeeProposal:
+		return nil
+	default:
+		return sdk.ErrUnknownRequest(fmt.Sprintf("unrecognized ammswap proposal content type: %T", content))
+	}
+}
+
+// nolint
+func (k Keeper) AfterSubmitProposalHandler(_ sdk.Context, _ govTypes.Proposal) {}
+func (k Keeper) AfterDepositPeriodPassed(_ sdk.Context, _ govTypes.Proposal)   {}
+func (k Keeper) RejectedHandler(_ sdk.Context, _ govTypes.Content)             {}
+func (k Keeper) VoteHandler(_ sdk.Context, _ govTypes.Proposal, _ govTypes.Vote) (string, sdk.Error) {
+	return "", nil
+}
+
+// CheckMsgManageSwapPairFeeRateProposal checks msg manage swap pair fee rate proposal
+func (k Keeper) CheckMsgManageSwapPairFeeRateProposal(ctx sdk.Context,
+	proposal types.ManageSwapPairFeeRateProposal) sdk.Error {
+	if proposal.IsAdded {
+		// the fee rate can only be set for an existing swap token pair
+		if _, err := k.GetSwapTokenPair(ctx, proposal.TokenPairName); err != nil {
+			return err
+		}
+		return nil
+	}
+
+	// the fee rate to delete must have been set
+	if _, found := k.GetPairFeeRate(ctx, proposal.TokenPairName); !found {
+		return types.ErrNonExistSwapPairFeeRate(proposal.TokenPairName)
+	}
+	return nil
+}
@@ -28,6 +28,12 @@ func NewQuerier(k Keeper) sdk.Querier {
 			res, err = querySwapQuoteInfo(ctx, req, k)
 		case types.QuerySwapAddLiquidityQuote:
 			res, err = querySwapAddLiquidityQuote(ctx, req, k)
+		case types.QuerySwapBestPath:
+			res, err = querySwapBestPath(ctx, req, k)
+		case types.QuerySwapPairFees:
+			res, err = querySwapPairFees(ctx, path[1:], req, k)
+		case types.QueryTWAP:
+			res, err = queryTWAP(ctx, req, k)
 
 		default:
 			return nil, types.ErrSwapUnknownQueryType()
@@ -84,7 +90,6 @@ func queryBuyAmount(
 	if errToken != nil {
 		return nil, errToken
 	}
-	params := keeper.GetParams(ctx)
 	var buyAmount sdk.Dec
 	swapTokenPair := types.GetSwapTokenPairName(queryParams.SoldToken.Denom, queryParams.TokenToBuy)
 	tokenPair, errTokenPair := keeper.GetSwapTokenPair(ctx, swapTokenPair)
@@ -92,7 +97,8 @@ func queryBuyAmount(
 		if tokenPair.BasePooledCoin.IsZero() || tokenPair.QuotePooledCoin.IsZero() {
 			return nil, types.ErrIsZeroValue("base pooled coin or quote pooled coin")
 		}
-		buyAmount = CalculateTokenToBuy(tokenPair, queryParams.SoldToken, queryParams.TokenToBuy, params).Amount
+		buyAmount = CalculateTokenToBuy(tokenPair, queryParams.SoldToken, queryParams.TokenToBuy,
+			keeper.GetSwapTokenPairFeeRate(ctx, swapTokenPair)).Amount
 	} else {
 		tokenPairName1 := types.GetSwapTokenPairName(queryParams.SoldToken.Denom, sdk.DefaultBondDenom)
 		tokenPair1, err := keeper.GetSwapTokenPair(ctx, tokenPairName1)
@@ -110,8 +116,10 @@ func queryBuyAmount(
 		if tokenPair2.BasePooledCoin.IsZero() || tokenPair2.QuotePooledCoin.IsZero() {
 			return nil, types.ErrIsZeroValue("base pooled coin or quote pooled coin")
 		}
-		nativeToken := CalculateTokenToBuy(tokenPair1, queryParams.SoldToken, sdk.DefaultBondDenom, params)
-		buyAmount = CalculateTokenToBuy(tokenPair2, nativeToken, queryParams.TokenToBuy, params).Amount
+		nativeToken := CalculateTokenToBuy(tokenPair1, queryParams.SoldToken, sdk.DefaultBondDenom,
+			keeper.GetSwapTokenPairFeeRate(ctx, tokenPairName1))
+		buyAmount = CalculateTokenToBuy(tokenPair2, nativeToken, queryParams.TokenToBuy,
+			keeper.GetSwapTokenPairFeeRate(ctx, tokenPairName2)).Amount
 	}
 
 	bz := keeper.cdc.MustMarshalJSON(buyAmount)
@@ -177,14 +185,14 @@ func querySwapQuoteInfo(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (
 	buyAmount := sdk.ZeroDec()
 	marketPrice := sdk.ZeroDec()
 
-	swapParams := keeper.GetParams(ctx)
 	tokenPairName := types.GetSwapTokenPairName(sellAmount.Denom, queryParams.BuyToken)
 	tokenPair, err := keeper.GetSwapTokenPair(ctx, tokenPairName)
 	if err == nil {
 		if tokenPair.BasePooledCoin.Amount.IsZero() || tokenPair.QuotePooledCoin.IsZero() {
 			return nil, types.ErrIsZeroValue("base pooled coin or quote pooled coin")
 		}
-		buyAmount = CalculateTokenToBuy(tokenPair, sellAmount, queryParams.BuyToken, swapParams).Amount
+		feeRate := keeper.GetSwapTokenPairFeeRate(ctx, tokenPairName)
+		buyAmount = CalculateTokenToBuy(tokenPair, sellAmount, queryParams.BuyToken, feeRate).Amount
 		// calculate market price
 		if tokenPair.BasePooledCoin.Denom == sellAmount.Denom {
 			marketPrice = tokenPair.QuotePooledCoin.Amount.Quo(tokenPair.BasePooledCoin.Amount)
@@ -192,7 +200,7 @@ func querySwapQuoteInfo(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (
 			marketPrice = tokenPair.BasePooledCoin.Amount.Quo(tokenPair.QuotePooledCoin.Amount)
 		}
 		// calculate fee
-		fee = sdk.NewDecCoinFromDec(sellAmount.Denom, sellAmount.Amount.Mul(swapParams.FeeRate))
+		fee = sdk.NewDecCoinFromDec(sellAmount.Denom, sellAmount.Amount.Mul(feeRate))
 	} else {
 		tokenPairName1 := types.GetSwapTokenPairName(sellAmount.Denom, common.NativeToken)
 		tokenPair1, err := keeper.GetSwapTokenPair(ctx, tokenPairName1)
@@ -210,8 +218,10 @@ func querySwapQuoteInfo(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (
 		if tokenPair2.BasePooledCoin.Amount.IsZero() || tokenPair2.QuotePooledCoin.IsZero() {
 			return nil, types.ErrIsZeroValue("base pooled coin or quote pooled coin")
 		}
-		nativeToken := CalculateTokenToBuy(tokenPair1, sellAmount, common.NativeToken, swapParams)
-		buyAmount = CalculateTokenToBuy(tokenPair2, nativeToken, queryParams.BuyToken, swapParams).Amount
+		feeRate1 := keeper.GetSwapTokenPairFeeRate(ctx, tokenPairName1)
+		feeRate2 := keeper.GetSwapTokenPairFeeRate(ctx, tokenPairName2)
+		nativeToken := CalculateTokenToBuy(tokenPair1, sellAmount, common.NativeToken, feeRate1)
+		buyAmount = CalculateTokenToBuy(tokenPair2, nativeToken, queryParams.BuyToken, feeRate2).Amount
 
 		// calculate market price
 		var sellTokenMarketPrice sdk.Dec
@@ -231,9 +241,9 @@ func querySwapQuoteInfo(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (
 		}
 
 		// calculate fee
-		fee1 := sdk.NewDecCoinFromDec(sellAmount.Denom, sellAmount.Amount.Mul(swapParams.FeeRate))
-		routeTokenFee := sdk.NewDecCoinFromDec(common.NativeToken, nativeToken.Amount.Mul(swapParams.FeeRate))
-		fee2 := CalculateTokenToBuy(tokenPair1, routeTokenFee, sellAmount.Denom, swapParams)
+		fee1 := sdk.NewDecCoinFromDec(sellAmount.Denom, sellAmount.Amount.Mul(feeRate1))
+		routeTokenFee := sdk.NewDecCoinFromDec(common.NativeToken, nativeToken.Amount.Mul(feeRate2))
+		fee2 := CalculateTokenToBuy(tokenPair1, routeTokenFee, sellAmount.Denom, feeRate1)
 		fee = fee1.Add(fee2)
 
 		// swap by route
@@ -273,6 +283,84 @@ func querySwapQuoteInfo(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (
 
 }
 
+// querySwapBestPath returns the path which buys the most of the token to buy, and the quoted output of it
+func querySwapBestPath(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var queryParams types.QuerySwapBestPathParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &queryParams)
+	if err != nil {
+		return nil, common.ErrUnMarshalJSONFailed(err.Error())
+	}
+	if queryParams.SellTokenAmount == "" || queryParams.BuyToken == "" {
+		return nil, types.ErrSellAmountOrBuyTokenIsEmpty()
+	}
+
+	sellAmount, err := sdk.ParseDecCoin(queryParams.SellTokenAmount)
+	if err != nil {
+		return nil, types.ErrConvertSellTokenAmount(queryParams.SellTokenAmount, err)
+	}
+	if sellAmount.Denom == queryParams.BuyToken {
+		return nil, types.ErrSellAmountEqualBuyToken()
+	}
+
+	path, buyAmount, err := keeper.GetBestSwapPath(ctx, sellAmount, queryParams.BuyToken)
+	if err != nil {
+		return nil, err
+	}
+	response := common.GetBaseResponse(types.SwapPathInfo{
+		Path:      path,
+		BuyAmount: buyAmount.Amount,
+	})
+	bz, err := json.Marshal(response)
+	if err != nil {
+		return nil, common.ErrMarshalJSONFailed(err.Error())
+	}
+	return bz, nil
+}
+
+// querySwapPairFees returns the fee rates and the realized fees of the swap token pair in the path,
+// or of all the swap token pairs without it
+func querySwapPairFees(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var response *common.BaseResponse
+	if len(path) > 0 && path[0] != "" {
+		if _, err := keeper.GetSwapTokenPair(ctx, path[0]); err != nil {
+			return nil, err
+		}
+		response = common.GetBaseResponse(keeper.GetSwapPairFeeInfo(ctx, path[0]))
+	} else {
+		feeInfos := []types.SwapPairFeeInfo{}
+		for _, swapTokenPair := range keeper.GetSwapTokenPairs(ctx) {
+			feeInfos = append(feeInfos, keeper.GetSwapPairFeeInfo(ctx, swapTokenPair.TokenPairName()))
+		}
+		response = common.GetBaseResponse(feeInfos)
+	}
+
+	bz, err := json.Marshal(response)
+	if err != nil {
+		return nil, common.ErrMarshalJSONFailed(err.Error())
+	}
+	return bz, nil
+}
+
+// queryTWAP returns the time weighted average prices of the swap token pair between two heights
+func queryTWAP(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var queryParams types.QueryTWAPParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &queryParams)
+	if err != nil {
+		return nil, common.ErrUnMarshalJSONFailed(err.Error())
+	}
+
+	twap, err := keeper.GetTWAP(ctx, queryParams.TokenPairName, queryParams.StartHeight, queryParams.EndHeight)
+	if err != nil {
+		return nil, err
+	}
+	response := common.GetBaseResponse(twap)
+	bz, err := json.Marshal(response)
+	if err != nil {
+		return nil, common.ErrMarshalJSONFailed(err.Error())
+	}
+	return bz, nil
+}
+
 // querySwapAddLiquidityQuote returns swap information of adding liquidity
 func querySwapAddLiquidityQuote(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
 	var queryParams types.QuerySwapAddInfoParams
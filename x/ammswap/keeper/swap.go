@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"sort"
+
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 	"github.com/okex/exchain/x/ammswap/types"
 )
@@ -19,3 +21,112 @@ func (k Keeper) IsTokenExist(ctx sdk.Context, token string) error {
 	return nil
 
 }
+
+// CalculateTokenToBuyByPath calculates the token bought at each hop of the path with CalculateTokenToBuy,
+// it returns the swap token pairs along the path and the amounts bought from them
+func (k Keeper) CalculateTokenToBuyByPath(ctx sdk.Context, sellToken sdk.SysCoin,
+	path []string) ([]types.SwapTokenPair, []sdk.SysCoin, error) {
+	swapTokenPairs := make([]types.SwapTokenPair, 0, len(path)-1)
+	tokensBuy := make([]sdk.SysCoin, 0, len(path)-1)
+	for i := 1; i < len(path); i++ {
+		tokenPairName := types.GetSwapTokenPairName(path[i-1], path[i])
+		swapTokenPair, err := k.GetSwapTokenPair(ctx, tokenPairName)
+		if err != nil {
+			return nil, nil, err
+		}
+		if swapTokenPair.BasePooledCoin.IsZero() || swapTokenPair.QuotePooledCoin.IsZero() {
+			return nil, nil, types.ErrIsZeroValue("base pooled coin or quote pooled coin")
+		}
+		tokenBuy := CalculateTokenToBuy(swapTokenPair, sellToken, path[i], k.GetSwapTokenPairFeeRate(ctx, tokenPairName))
+		if tokenBuy.IsZero() {
+			return nil, nil, types.ErrIsZeroValue("token buy")
+		}
+		swapTokenPairs = append(swapTokenPairs, swapTokenPair)
+		tokensBuy = append(tokensBuy, tokenBuy)
+		sellToken = tokenBuy
+	}
+	return swapTokenPairs, tokensBuy, nil
+}
+
+// swapPathHop is the best path found to a token within a number of hops
+type swapPathHop struct {
+	path     []string
+	tokenBuy sdk.SysCoin
+}
+
+// GetBestSwapPath searches the swap token pairs for the path which buys the most of the token to buy,
+// the shorter one wins a tie. Paths pass through at most MaxSwapPathLength tokens. The search goes hop by
+// hop and only extends the path buying the most of each token at each hop, so it takes
+// O(MaxSwapPathLength * number of swap token pairs) calculations.
+func (k Keeper) GetBestSwapPath(ctx sdk.Context, sellToken sdk.SysCoin, buyToken string) ([]string, sdk.SysCoin, error) {
+	tokenPairs := make(map[string][]types.SwapTokenPair)
+	feeRates := make(map[string]sdk.Dec)
+	for _, swapTokenPair := range k.GetSwapTokenPairs(ctx) {
+		if swapTokenPair.BasePooledCoin.IsZero() || swapTokenPair.QuotePooledCoin.IsZero() {
+			continue
+		}
+		feeRates[swapTokenPair.TokenPairName()] = k.GetSwapTokenPairFeeRate(ctx, swapTokenPair.TokenPairName())
+		base, quote := swapTokenPair.BasePooledCoin.Denom, swapTokenPair.QuotePooledCoin.Denom
+		tokenPairs[base] = append(tokenPairs[base], swapTokenPair)
+		tokenPairs[quote] = append(tokenPairs[quote], swapTokenPair)
+	}
+
+	var bestPath []string
+	bestTokenBuy := sdk.NewDecCoinFromDec(buyToken, sdk.ZeroDec())
+	hops := map[string]swapPathHop{sellToken.Denom: {path: []string{sellToken.Denom}, tokenBuy: sellToken}}
+	for pathLength := 2; pathLength <= types.MaxSwapPathLength && len(hops) > 0; pathLength++ {
+		// the tokens are extended in order so that the same path wins a tie every time
+		tokens := make([]string, 0, len(hops))
+		for token := range hops {
+			tokens = append(tokens, token)
+		}
+		sort.Strings(tokens)
+
+		nextHops := make(map[string]swapPathHop)
+		for _, token := range tokens {
+			hop := hops[token]
+			for _, swapTokenPair := range tokenPairs[token] {
+				nextToken := swapTokenPair.BasePooledCoin.Denom
+				if nextToken == token {
+					nextToken = swapTokenPair.QuotePooledCoin.Denom
+				}
+				if containsToken(hop.path, nextToken) {
+					continue
+				}
+				tokenBuy := CalculateTokenToBuy(swapTokenPair, hop.tokenBuy, nextToken, feeRates[swapTokenPair.TokenPairName()])
+				if !tokenBuy.IsPositive() {
+					continue
+				}
+				if nextHop, found := nextHops[nextToken]; found && !tokenBuy.Amount.GT(nextHop.tokenBuy.Amount) {
+					continue
+				}
+				path := make([]string, len(hop.path), len(hop.path)+1)
+				copy(path, hop.path)
+				nextHops[nextToken] = swapPathHop{path: append(path, nextToken), tokenBuy: tokenBuy}
+			}
+		}
+
+		// the paths reaching the token to buy end there, a longer one must buy more to win
+		if hop, found := nextHops[buyToken]; found {
+			if hop.tokenBuy.Amount.GT(bestTokenBuy.Amount) {
+				bestPath, bestTokenBuy = hop.path, hop.tokenBuy
+			}
+			delete(nextHops, buyToken)
+		}
+		hops = nextHops
+	}
+
+	if bestPath == nil {
+		return nil, bestTokenBuy, types.ErrNoSwapPath(sellToken.Denom, buyToken)
+	}
+	return bestPath, bestTokenBuy, nil
+}
+
+func containsToken(path []string, token string) bool {
+	for _, t := range path {
+		if t == token {
+			return true
+		}
+	}
+	return false
+}
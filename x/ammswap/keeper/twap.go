@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/ammswap/types"
+)
+
+// updatePriceObservation accumulates the prices of the swap token pair up to the current height, and records the
+// prices after its reserves changed
+func (k Keeper) updatePriceObservation(ctx sdk.Context, tokenPairName string, swapTokenPair types.SwapTokenPair) {
+	height := ctx.BlockHeight()
+	prev := k.GetPriceObservation(ctx, tokenPairName, height)
+	observation := types.NewPriceObservation(swapTokenPair, height, prev)
+	k.SetPriceObservation(ctx, tokenPairName, observation)
+	k.prunePriceObservations(ctx, tokenPairName, height-types.MaxTWAPBlocks)
+}
+
+// prunePriceObservations deletes the price observations of the swap token pair which no twap can look back to from
+// the height. The latest one at or before the height is kept, since the cumulative prices at the height are based on it.
+func (k Keeper) prunePriceObservations(ctx sdk.Context, tokenPairName string, height int64) {
+	if height < 0 {
+		return
+	}
+	latest := k.GetPriceObservation(ctx, tokenPairName, height)
+	if latest == nil {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.GetPriceObservationsKey(tokenPairName),
+		types.GetPriceObservationKey(tokenPairName, latest.Height))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// SetPriceObservation sets the price observation of the swap token pair at its height
+func (k Keeper) SetPriceObservation(ctx sdk.Context, tokenPairName string, observation types.PriceObservation) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPriceObservationKey(tokenPairName, observation.Height),
+		k.cdc.MustMarshalBinaryLengthPrefixed(observation))
+}
+
+// GetPriceObservations gets the price observations of all the swap token pairs
+func (k Keeper) GetPriceObservations(ctx sdk.Context) []types.SwapPairPriceObservation {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PriceObservationPrefixKey)
+	defer iterator.Close()
+
+	var observations []types.SwapPairPriceObservation
+	for ; iterator.Valid(); iterator.Next() {
+		var observation types.PriceObservation
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &observation)
+		observations = append(observations, types.SwapPairPriceObservation{
+			TokenPairName:    types.SplitPriceObservationKey(iterator.Key()),
+			PriceObservation: observation,
+		})
+	}
+	return observations
+}
+
+// GetPriceObservation gets the latest price observation of the swap token pair at or before the height
+func (k Keeper) GetPriceObservation(ctx sdk.Context, tokenPairName string, height int64) *types.PriceObservation {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.ReverseIterator(types.GetPriceObservationsKey(tokenPairName),
+		types.GetPriceObservationKey(tokenPairName, height+1))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return nil
+	}
+	var observation types.PriceObservation
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &observation)
+	return &observation
+}
+
+// GetCumulativePrices gets the cumulative prices of the swap token pair at the height
+func (k Keeper) GetCumulativePrices(ctx sdk.Context, tokenPairName string,
+	height int64) (baseCumulativePrice, quoteCumulativePrice sdk.Dec, err error) {
+	observation := k.GetPriceObservation(ctx, tokenPairName, height)
+	if observation == nil {
+		return baseCumulativePrice, quoteCumulativePrice, types.ErrNoPriceObservation(tokenPairName, height)
+	}
+	baseCumulativePrice, quoteCumulativePrice = observation.CumulativePricesAt(height)
+	return baseCumulativePrice, quoteCumulativePrice, nil
+}
+
+// GetTWAP gets the time weighted average prices of the swap token pair at the end of the blocks in
+// [startHeight, endHeight), the end height can't be later than the current height
+func (k Keeper) GetTWAP(ctx sdk.Context, tokenPairName string, startHeight, endHeight int64) (types.TWAPInfo, error) {
+	if startHeight <= 0 || startHeight >= endHeight || endHeight > ctx.BlockHeight() ||
+		startHeight < ctx.BlockHeight()-types.MaxTWAPBlocks {
+		return types.TWAPInfo{}, types.ErrInvalidTWAPHeights(startHeight, endHeight, ctx.BlockHeight())
+	}
+	if _, err := k.GetSwapTokenPair(ctx, tokenPairName); err != nil {
+		return types.TWAPInfo{}, err
+	}
+
+	startBaseCumulativePrice, startQuoteCumulativePrice, err := k.GetCumulativePrices(ctx, tokenPairName, startHeight)
+	if err != nil {
+		return types.TWAPInfo{}, err
+	}
+	endBaseCumulativePrice, endQuoteCumulativePrice, err := k.GetCumulativePrices(ctx, tokenPairName, endHeight)
+	if err != nil {
+		return types.TWAPInfo{}, err
+	}
+
+	blocks := sdk.NewDec(endHeight - startHeight)
+	return types.TWAPInfo{
+		TokenPairName: tokenPairName,
+		StartHeight:   startHeight,
+		EndHeight:     endHeight,
+		BasePrice:     endBaseCumulativePrice.Sub(startBaseCumulativePrice).Quo(blocks),
+		QuotePrice:    endQuoteCumulativePrice.Sub(startQuoteCumulativePrice).Quo(blocks),
+	}, nil
+}
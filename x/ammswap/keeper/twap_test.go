@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	"github.com/okex/exchain/x/ammswap/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeeper_GetTWAP(t *testing.T) {
+	mapp, _ := GetTestInput(t, 1)
+	keeper := mapp.swapKeeper
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 2}})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{}).WithBlockHeight(10)
+
+	// the base price is 1 from height 10, 4 from height 20 and 2 from height 25
+	swapTokenPair := types.GetTestSwapTokenPair()
+	setReserves := func(height int64, baseAmount, quoteAmount int64) {
+		swapTokenPair.BasePooledCoin.Amount = sdk.NewDec(baseAmount)
+		swapTokenPair.QuotePooledCoin.Amount = sdk.NewDec(quoteAmount)
+		keeper.SetSwapTokenPair(ctx.WithBlockHeight(height), types.TestSwapTokenPairName, swapTokenPair)
+	}
+	setReserves(10, 100, 100)
+	// the reserves changing more than once in a block only leave the last price
+	setReserves(20, 100, 200)
+	setReserves(20, 100, 400)
+	setReserves(25, 100, 200)
+
+	observation := keeper.GetPriceObservation(ctx, types.TestSwapTokenPairName, 24)
+	require.Equal(t, int64(20), observation.Height)
+	require.Equal(t, sdk.NewDec(10), observation.BaseCumulativePrice)
+	require.Equal(t, sdk.NewDec(4), observation.BasePrice)
+	require.Equal(t, sdk.NewDecWithPrec(25, 2), observation.QuotePrice)
+	require.Nil(t, keeper.GetPriceObservation(ctx, types.TestSwapTokenPairName, 9))
+
+	ctx = ctx.WithBlockHeight(30)
+	tests := []struct {
+		startHeight, endHeight int64
+		basePrice              sdk.Dec
+		quotePrice             sdk.Dec
+	}{
+		{10, 20, sdk.NewDec(1), sdk.NewDec(1)},
+		{15, 25, sdk.NewDecWithPrec(25, 1), sdk.NewDecWithPrec(625, 3)},
+		{20, 30, sdk.NewDec(3), sdk.NewDecWithPrec(375, 3)},
+		{26, 27, sdk.NewDec(2), sdk.NewDecWithPrec(5, 1)},
+	}
+	for _, test := range tests {
+		twap, err := keeper.GetTWAP(ctx, types.TestSwapTokenPairName, test.startHeight, test.endHeight)
+		require.Nil(t, err)
+		require.Equal(t, test.basePrice, twap.BasePrice, "%d-%d", test.startHeight, test.endHeight)
+		require.Equal(t, test.quotePrice, twap.QuotePrice, "%d-%d", test.startHeight, test.endHeight)
+	}
+
+	// invalid heights
+	_, err := keeper.GetTWAP(ctx, types.TestSwapTokenPairName, 20, 20)
+	require.NotNil(t, err)
+	_, err = keeper.GetTWAP(ctx, types.TestSwapTokenPairName, 20, 31)
+	require.NotNil(t, err)
+	// no price observation before the swap token pair is created
+	_, err = keeper.GetTWAP(ctx, types.TestSwapTokenPairName, 5, 20)
+	require.NotNil(t, err)
+	_, err = keeper.GetTWAP(ctx, "eeb_okt", 10, 20)
+	require.NotNil(t, err)
+
+	querier := NewQuerier(keeper)
+	bz, err := types.ModuleCdc.MarshalJSON(types.NewQueryTWAPParams(types.TestSwapTokenPairName, 20, 30))
+	require.Nil(t, err)
+	res, err := querier(ctx, []string{types.QueryTWAP}, abci.RequestQuery{Data: bz})
+	require.Nil(t, err)
+	require.Contains(t, string(res), `"base_price":"3.000000000000000000"`)
+}
+
+func TestKeeper_PrunePriceObservations(t *testing.T) {
+	mapp, _ := GetTestInput(t, 1)
+	keeper := mapp.swapKeeper
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 2}})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{}).WithBlockHeight(10)
+
+	swapTokenPair := types.GetTestSwapTokenPair()
+	for _, height := range []int64{10, 20, 30 + types.MaxTWAPBlocks} {
+		keeper.SetSwapTokenPair(ctx.WithBlockHeight(height), types.TestSwapTokenPairName, swapTokenPair)
+	}
+
+	// the observations before the latest one which the twap can look back to are pruned
+	require.Nil(t, keeper.GetPriceObservation(ctx, types.TestSwapTokenPairName, 15))
+	observation := keeper.GetPriceObservation(ctx, types.TestSwapTokenPairName, 30)
+	require.NotNil(t, observation)
+	require.Equal(t, int64(20), observation.Height)
+	require.Equal(t, 2, len(keeper.GetPriceObservations(ctx)))
+
+	// the twap can't look back further than the max blocks
+	ctx = ctx.WithBlockHeight(40 + types.MaxTWAPBlocks)
+	_, err := keeper.GetTWAP(ctx, types.TestSwapTokenPairName, 39, 50)
+	require.NotNil(t, err)
+	_, err = keeper.GetTWAP(ctx, types.TestSwapTokenPairName, 40, 50)
+	require.Nil(t, err)
+}
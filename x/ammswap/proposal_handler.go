@@ -0,0 +1,53 @@
+package ammswap
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/ammswap/types"
+	"github.com/okex/exchain/x/common"
+	govTypes "github.com/okex/exchain/x/gov/types"
+)
+
+// NewSwapFeeProposalHandler handles "gov" type message in "ammswap"
+func NewSwapFeeProposalHandler(k *Keeper) govTypes.Handler {
+	return func(ctx sdk.Context, proposal *govTypes.Proposal) (err sdk.Error) {
+		switch content := proposal.Content.(type) {
+		case types.ManageSwapPairFeeRateProposal:
+			return handleManageSwapPairFeeRateProposal(ctx, k, proposal)
+		case types.ManageProtocolFeeProposal:
+			return handleManageProtocolFeeProposal(ctx, k, proposal)
+		default:
+			return common.ErrUnknownProposalType(types.DefaultCodespace, content.ProposalType())
+		}
+	}
+}
+
+func handleManageSwapPairFeeRateProposal(ctx sdk.Context, k *Keeper, proposal *govTypes.Proposal) sdk.Error {
+	// check
+	manageSwapPairFeeRateProposal, ok := proposal.Content.(types.ManageSwapPairFeeRateProposal)
+	if !ok {
+		return types.ErrUnexpectedProposalType(proposal.Content.ProposalType())
+	}
+	if sdkErr := k.CheckMsgManageSwapPairFeeRateProposal(ctx, manageSwapPairFeeRateProposal); sdkErr != nil {
+		return sdkErr
+	}
+
+	if manageSwapPairFeeRateProposal.IsAdded {
+		// set the fee rate of the swap token pair
+		k.SetPairFeeRate(ctx, manageSwapPairFeeRateProposal.TokenPairName, manageSwapPairFeeRateProposal.FeeRate)
+		return nil
+	}
+
+	// the swap token pair falls back to the global fee rate
+	k.DeletePairFeeRate(ctx, manageSwapPairFeeRateProposal.TokenPairName)
+	return nil
+}
+
+func handleManageProtocolFeeProposal(ctx sdk.Context, k *Keeper, proposal *govTypes.Proposal) sdk.Error {
+	manageProtocolFeeProposal, ok := proposal.Content.(types.ManageProtocolFeeProposal)
+	if !ok {
+		return types.ErrUnexpectedProposalType(proposal.Content.ProposalType())
+	}
+
+	k.SetProtocolFee(ctx, manageProtocolFeeProposal.GetProtocolFee())
+	return nil
+}
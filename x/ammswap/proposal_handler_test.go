@@ -0,0 +1,106 @@
+package ammswap
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/supply"
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	"github.com/okex/exchain/x/ammswap/types"
+	govtypes "github.com/okex/exchain/x/gov/types"
+	"github.com/okex/exchain/x/token"
+	"github.com/stretchr/testify/require"
+)
+
+type mockDistrKeeper struct {
+	communityPool sdk.SysCoins
+}
+
+func (dk *mockDistrKeeper) FundCommunityPool(_ sdk.Context, amount sdk.SysCoins, _ sdk.AccAddress) error {
+	dk.communityPool = dk.communityPool.Add(amount...)
+	return nil
+}
+
+func TestSwapFeeProposalHandler(t *testing.T) {
+	mapp, addrKeysSlice := getMockAppWithBalance(t, 1, 100000)
+	keeper := mapp.swapKeeper
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 2}})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{}).WithBlockHeight(10).WithBlockTime(time.Now())
+	mapp.swapKeeper.SetParams(ctx, types.DefaultParams())
+	mapp.supplyKeeper.SetSupply(ctx, supply.NewSupply(mapp.TotalCoinsSupply))
+	mapp.tokenKeeper.NewToken(ctx, token.InitTestToken(types.TestBasePooledToken))
+	mapp.tokenKeeper.NewToken(ctx, token.InitTestToken(types.TestQuotePooledToken))
+	NewTestSwapTokenPairWithInitLiquidity(t, ctx, keeper, sdk.NewDecCoin(types.TestBasePooledToken, sdk.NewInt(100)),
+		sdk.NewDecCoin(types.TestQuotePooledToken, sdk.NewInt(100)), []sdk.AccAddress{addrKeysSlice[0].Address})
+	tokenPairName := types.GetSwapTokenPairName(types.TestBasePooledToken, types.TestQuotePooledToken)
+	hdlr := NewSwapFeeProposalHandler(&keeper)
+
+	proposal := govtypes.Proposal{Content: govtypes.NewTextProposal("Test", "description")}
+	require.NotNil(t, hdlr(ctx, &proposal))
+
+	// the fee rate of a swap token pair which does not exist can't be set
+	feeRate := sdk.NewDecWithPrec(1, 2)
+	proposal = govtypes.Proposal{Content: types.NewManageSwapPairFeeRateProposal("Test", "description",
+		types.GetSwapTokenPairName(types.TestBasePooledToken2, types.TestQuotePooledToken), feeRate, true)}
+	require.NotNil(t, hdlr(ctx, &proposal))
+
+	proposal = govtypes.Proposal{Content: types.NewManageSwapPairFeeRateProposal("Test", "description",
+		tokenPairName, feeRate, true)}
+	require.Nil(t, hdlr(ctx, &proposal))
+	require.Equal(t, feeRate, keeper.GetSwapTokenPairFeeRate(ctx, tokenPairName))
+	require.Equal(t, []types.SwapPairFeeRate{{TokenPairName: tokenPairName, FeeRate: feeRate}},
+		keeper.GetPairFeeRates(ctx))
+
+	proposal = govtypes.Proposal{Content: types.NewManageProtocolFeeProposal("Test", "description",
+		sdk.NewDecWithPrec(5, 1), false)}
+	require.Nil(t, hdlr(ctx, &proposal))
+	require.Equal(t, types.NewProtocolFee(sdk.NewDecWithPrec(5, 1), false), keeper.GetProtocolFee(ctx))
+
+	// half of the fee is skimmed into the protocol fee module account
+	swapTokenPair, err := keeper.GetSwapTokenPair(ctx, tokenPairName)
+	require.Nil(t, err)
+	basePooledAmount := swapTokenPair.BasePooledCoin.Amount
+	handler := NewHandler(keeper)
+	addr := addrKeysSlice[0].Address
+	soldTokenAmount := sdk.NewDecCoinFromDec(types.TestBasePooledToken, sdk.NewDec(10))
+	msg := types.NewMsgTokenToToken(soldTokenAmount, sdk.NewDecCoinFromDec(types.TestQuotePooledToken, sdk.ZeroDec()),
+		time.Now().Unix(), addr, addr)
+	_, err = handler(ctx, msg)
+	require.Nil(t, err)
+
+	swapTokenPair, err = keeper.GetSwapTokenPair(ctx, tokenPairName)
+	require.Nil(t, err)
+	protocolFee := sdk.NewDecCoinFromDec(types.TestBasePooledToken, sdk.NewDecWithPrec(5, 2))
+	require.Equal(t, basePooledAmount.Add(soldTokenAmount.Amount).Sub(protocolFee.Amount),
+		swapTokenPair.BasePooledCoin.Amount)
+	protocolFeeAccount := mapp.supplyKeeper.GetModuleAccount(ctx, types.ProtocolFeeAccount)
+	require.Equal(t, sdk.SysCoins{protocolFee}, protocolFeeAccount.GetCoins())
+	fees := keeper.GetSwapPairFees(ctx, tokenPairName)
+	require.Equal(t, sdk.SysCoins{protocolFee}, fees.LiquidityFees)
+	require.Equal(t, sdk.SysCoins{protocolFee}, fees.ProtocolFees)
+
+	querier := NewQuerier(keeper)
+	res, err := querier(ctx, []string{types.QuerySwapPairFees, tokenPairName}, abci.RequestQuery{})
+	require.Nil(t, err)
+	require.Contains(t, string(res), `"fee_rate":"0.010000000000000000"`)
+	_, err = querier(ctx, []string{types.QuerySwapPairFees, "eeb_okt"}, abci.RequestQuery{})
+	require.NotNil(t, err)
+
+	// the protocol fee goes to the community pool
+	distrKeeper := &mockDistrKeeper{}
+	keeper.SetDistrKeeper(distrKeeper)
+	proposal = govtypes.Proposal{Content: types.NewManageProtocolFeeProposal("Test", "description",
+		sdk.NewDecWithPrec(5, 1), true)}
+	require.Nil(t, hdlr(ctx, &proposal))
+	_, err = NewHandler(keeper)(ctx, msg)
+	require.Nil(t, err)
+	require.Equal(t, sdk.SysCoins{protocolFee}, distrKeeper.communityPool)
+
+	// the swap token pair falls back to the global fee rate
+	proposal = govtypes.Proposal{Content: types.NewManageSwapPairFeeRateProposal("Test", "description",
+		tokenPairName, sdk.ZeroDec(), false)}
+	require.Nil(t, hdlr(ctx, &proposal))
+	require.Equal(t, types.DefaultParams().FeeRate, keeper.GetSwapTokenPairFeeRate(ctx, tokenPairName))
+	require.NotNil(t, hdlr(ctx, &proposal))
+}
@@ -56,6 +56,12 @@ const (
 	CodeIsSwapTokenPairExist                 uint32 = 65043
 	CodeIsPoolTokenPairExist                 uint32 = 65044
 	CodeInternalError                        uint32 = 65045
+	CodeInvalidSwapPath                      uint32 = 65046
+	CodeNoSwapPath                           uint32 = 65047
+	CodeUnexpectedProposalType               uint32 = 65048
+	CodeNonExistSwapPairFeeRate              uint32 = 65049
+	CodeInvalidTWAPHeights                   uint32 = 65050
+	CodeNoPriceObservation                   uint32 = 65051
 )
 
 func ErrNonExistSwapTokenPair(tokenPairName string) sdk.EnvelopedErr {
@@ -239,3 +245,29 @@ func ErrSwapTokenPairExist() sdk.EnvelopedErr {
 func ErrPoolTokenPairExist() sdk.EnvelopedErr {
 	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeIsPoolTokenPairExist, "the pool token pair already exists")}
 }
+
+func ErrInvalidSwapPath(reason string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInvalidSwapPath, fmt.Sprintf("invalid swap path: %s", reason))}
+}
+
+func ErrNoSwapPath(soldToken, boughtToken string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeNoSwapPath, fmt.Sprintf("no swap path from %s to %s", soldToken, boughtToken))}
+}
+
+// ErrUnexpectedProposalType returns an error when the proposal type is not supported in ammswap module
+func ErrUnexpectedProposalType(proposalType string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeUnexpectedProposalType,
+		fmt.Sprintf("failed. the proposal type %s is not supported in ammswap module", proposalType))}
+}
+
+func ErrNonExistSwapPairFeeRate(tokenPairName string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeNonExistSwapPairFeeRate, fmt.Sprintf("swap token pair %s has no fee rate of its own", tokenPairName))}
+}
+
+func ErrInvalidTWAPHeights(startHeight, endHeight, height int64) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInvalidTWAPHeights, fmt.Sprintf("invalid heights of twap: start height %d, end height %d, current height %d", startHeight, endHeight, height))}
+}
+
+func ErrNoPriceObservation(tokenPairName string, height int64) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeNoPriceObservation, fmt.Sprintf("swap token pair %s has no price observation at or before height %d", tokenPairName, height))}
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	govtypes "github.com/okex/exchain/x/gov/types"
 	"github.com/okex/exchain/x/params"
 	token "github.com/okex/exchain/x/token/types"
 )
@@ -41,6 +42,16 @@ type TokenKeeper interface {
 	GetTokensInfo(ctx sdk.Context) (tokens []token.Token)
 }
 
+// GovKeeper defines the expected gov interface
+type GovKeeper interface {
+	GetDepositParams(ctx sdk.Context) govtypes.DepositParams
+	GetVotingParams(ctx sdk.Context) govtypes.VotingParams
+}
+
+// DistrKeeper defines the expected distribution interface
+type DistrKeeper interface {
+	FundCommunityPool(ctx sdk.Context, amount sdk.SysCoins, sender sdk.AccAddress) error
+}
 
 type BackendKeeper interface {
 	OnSwapToken(ctx sdk.Context, address sdk.AccAddress, swapTokenPair SwapTokenPair, sellAmount sdk.SysCoin, buyAmount sdk.SysCoin)
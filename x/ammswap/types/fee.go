@@ -0,0 +1,84 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+// ProtocolFeeAccount is the module account which receives the protocol fees not sent to the community pool
+const ProtocolFeeAccount = "ammswap_protocol_fee"
+
+// ProtocolFee is the share of the swap fees skimmed from the pools as protocol fees
+type ProtocolFee struct {
+	FeeShare        sdk.Dec `json:"fee_share"`
+	ToCommunityPool bool    `json:"to_community_pool"`
+}
+
+// NewProtocolFee creates a new ProtocolFee object
+func NewProtocolFee(feeShare sdk.Dec, toCommunityPool bool) ProtocolFee {
+	return ProtocolFee{
+		FeeShare:        feeShare,
+		ToCommunityPool: toCommunityPool,
+	}
+}
+
+// DefaultProtocolFee keeps all the swap fees in the pools
+func DefaultProtocolFee() ProtocolFee {
+	return NewProtocolFee(sdk.ZeroDec(), false)
+}
+
+// String implements the stringer interface for ProtocolFee
+func (p ProtocolFee) String() string {
+	return fmt.Sprintf(`Protocol Fee:
+  FeeShare:        %s
+  ToCommunityPool: %t`, p.FeeShare, p.ToCommunityPool)
+}
+
+// Validate checks the fee share of the protocol fee
+func (p ProtocolFee) Validate() error {
+	if p.FeeShare.IsNil() || p.FeeShare.IsNegative() || p.FeeShare.GT(sdk.OneDec()) {
+		return fmt.Errorf("invalid protocol fee share: %s", p.FeeShare)
+	}
+	return nil
+}
+
+// SwapPairFeeRate is the fee rate of a swap token pair which overrides the global one
+type SwapPairFeeRate struct {
+	TokenPairName string  `json:"token_pair_name"`
+	FeeRate       sdk.Dec `json:"fee_rate"`
+}
+
+// SwapPairFees records the fees realized by a swap token pair, the liquidity fees stay in the pool while the
+// protocol fees are skimmed from it
+type SwapPairFees struct {
+	TokenPairName string       `json:"token_pair_name"`
+	LiquidityFees sdk.SysCoins `json:"liquidity_fees"`
+	ProtocolFees  sdk.SysCoins `json:"protocol_fees"`
+}
+
+// NewSwapPairFees creates a new SwapPairFees object without any fees
+func NewSwapPairFees(tokenPairName string) SwapPairFees {
+	return SwapPairFees{
+		TokenPairName: tokenPairName,
+		LiquidityFees: sdk.SysCoins{},
+		ProtocolFees:  sdk.SysCoins{},
+	}
+}
+
+// SwapPairFeeInfo is the fee rates and the realized fees of a swap token pair
+type SwapPairFeeInfo struct {
+	TokenPairName    string       `json:"token_pair_name"`
+	FeeRate          sdk.Dec      `json:"fee_rate"`
+	ProtocolFeeShare sdk.Dec      `json:"protocol_fee_share"`
+	LiquidityFees    sdk.SysCoins `json:"liquidity_fees"`
+	ProtocolFees     sdk.SysCoins `json:"protocol_fees"`
+}
+
+// ValidateFeeRate checks that the fee rate is in [0, 1)
+func ValidateFeeRate(feeRate sdk.Dec) error {
+	if feeRate.IsNil() || feeRate.IsNegative() || feeRate.GTE(sdk.OneDec()) {
+		return fmt.Errorf("invalid fee rate: %s", feeRate)
+	}
+	return nil
+}
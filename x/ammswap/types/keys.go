@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName is the name of the module
 	ModuleName = "ammswap"
@@ -21,14 +25,51 @@ const (
 	QueryBuyAmount             = "buy"
 	QuerySwapQuoteInfo         = "swapQuoteInfo"
 	QuerySwapAddLiquidityQuote = "swapAddLiquidityQuote"
+	QuerySwapBestPath          = "swapBestPath"
+	QuerySwapPairFees          = "swapPairFees"
+	QueryTWAP                  = "twap"
 )
 
 var (
 	// TokenPairPrefixKey to be used for KVStore
 	TokenPairPrefixKey = []byte{0x01}
+	// PairFeeRatePrefixKey to be used for KVStore, stores the fee rates overriding the global one
+	PairFeeRatePrefixKey = []byte{0x02}
+	// ProtocolFeeKey to be used for KVStore, stores the protocol fee share
+	ProtocolFeeKey = []byte{0x03}
+	// PairFeesPrefixKey to be used for KVStore, stores the fees realized by swap token pairs
+	PairFeesPrefixKey = []byte{0x04}
+	// PriceObservationPrefixKey to be used for KVStore, stores the cumulative prices of swap token pairs by height
+	PriceObservationPrefixKey = []byte{0x05}
 )
 
 // nolint
 func GetTokenPairKey(key string) []byte {
 	return append(TokenPairPrefixKey, []byte(key)...)
 }
+
+// nolint
+func GetPairFeeRateKey(key string) []byte {
+	return append(PairFeeRatePrefixKey, []byte(key)...)
+}
+
+// nolint
+func GetPriceObservationsKey(tokenPairName string) []byte {
+	return append(append(PriceObservationPrefixKey, []byte(tokenPairName)...), 0x00)
+}
+
+// nolint
+func GetPriceObservationKey(tokenPairName string, height int64) []byte {
+	return append(GetPriceObservationsKey(tokenPairName), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// SplitPriceObservationKey splits the token pair name from the key of a price observation
+func SplitPriceObservationKey(key []byte) (tokenPairName string) {
+	// the name is followed by a 0x00 separator and the 8-byte height
+	return string(key[len(PriceObservationPrefixKey) : len(key)-9])
+}
+
+// nolint
+func GetPairFeesKey(key string) []byte {
+	return append(PairFeesPrefixKey, []byte(key)...)
+}
@@ -264,3 +264,38 @@ func TestMsgTokenToTokenInvalid(t *testing.T) {
 		testCode(t, err, testCase.exceptResultCode)
 	}
 }
+
+func TestMsgTokenToTokenByPath(t *testing.T) {
+	addr, err := hex.DecodeString(addrStr)
+	require.Nil(t, err)
+	minBoughtTokenAmount := sdk.NewDecCoinFromDec(TestBasePooledToken2, sdk.NewDec(1))
+	deadLine := time.Now().Unix()
+	soldTokenAmount := sdk.NewDecCoinFromDec(TestBasePooledToken, sdk.NewDec(2))
+	path := []string{TestBasePooledToken, TestQuotePooledToken, TestBasePooledToken2}
+
+	msg := NewMsgTokenToTokenByPath(soldTokenAmount, minBoughtTokenAmount, path, deadLine, addr, addr)
+	require.Nil(t, msg.ValidateBasic())
+	require.Equal(t, RouterKey, msg.Route())
+	require.Equal(t, TypeMsgPathSwap, msg.Type())
+	require.EqualValues(t, addr, msg.GetSigners()[0])
+
+	tests := []struct {
+		testCase         string
+		path             []string
+		exceptResultCode uint32
+	}{
+		{"success(direct)", []string{TestBasePooledToken, TestBasePooledToken2}, sdk.CodeOK},
+		{"too short", []string{TestBasePooledToken}, CodeInvalidSwapPath},
+		{"too long", []string{TestBasePooledToken, "a1", "a2", "a3", "a4", TestBasePooledToken2}, CodeInvalidSwapPath},
+		{"not start with the sold token", []string{TestQuotePooledToken, TestBasePooledToken2}, CodeInvalidSwapPath},
+		{"not end with the bought token", []string{TestBasePooledToken, TestQuotePooledToken}, CodeInvalidSwapPath},
+		{"duplicated token", []string{TestBasePooledToken, TestQuotePooledToken, TestBasePooledToken,
+			TestBasePooledToken2}, CodeInvalidSwapPath},
+		{"invalid token", []string{TestBasePooledToken, "1aaa", TestBasePooledToken2}, CodeValidateDenom},
+	}
+	for _, testCase := range tests {
+		msg := NewMsgTokenToTokenByPath(soldTokenAmount, minBoughtTokenAmount, testCase.path, deadLine, addr, addr)
+		err := msg.ValidateBasic()
+		testCode(t, err, testCase.exceptResultCode)
+	}
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 )
 
@@ -8,6 +10,10 @@ import (
 const (
 	TypeMsgAddLiquidity = "add_liquidity"
 	TypeMsgTokenSwap    = "token_swap"
+	TypeMsgPathSwap     = "token_swap_by_path"
+
+	// MaxSwapPathLength is the max number of tokens in the path of a multi-hop swap
+	MaxSwapPathLength = 5
 )
 
 // MsgAddLiquidity Deposit quote_amount and base_amount at current ratio to mint pool tokens.
@@ -265,3 +271,89 @@ func (msg MsgTokenToToken) GetSigners() []sdk.AccAddress {
 func (msg MsgTokenToToken) GetSwapTokenPairName() string {
 	return GetSwapTokenPairName(msg.MinBoughtTokenAmount.Denom, msg.SoldTokenAmount.Denom)
 }
+
+// MsgTokenToTokenByPath define the message for swap across the swap token pairs along a path of tokens
+type MsgTokenToTokenByPath struct {
+	SoldTokenAmount      sdk.SysCoin    `json:"sold_token_amount"`       // Amount of Tokens sold.
+	MinBoughtTokenAmount sdk.SysCoin    `json:"min_bought_token_amount"` // Minimum token purchased at the end of the path.
+	Path                 []string       `json:"path"`                    // Tokens to swap through, from the sold token to the bought token.
+	Deadline             int64          `json:"deadline"`                // Time after which this transaction can no longer be executed.
+	Recipient            sdk.AccAddress `json:"recipient"`               // Recipient address,transfer Tokens to recipient.default recipient is sender.
+	Sender               sdk.AccAddress `json:"sender"`                  // Sender
+}
+
+// NewMsgTokenToTokenByPath is a constructor function for MsgTokenToTokenByPath
+func NewMsgTokenToTokenByPath(
+	soldTokenAmount, minBoughtTokenAmount sdk.SysCoin, path []string, deadline int64, recipient, sender sdk.AccAddress,
+) MsgTokenToTokenByPath {
+	return MsgTokenToTokenByPath{
+		SoldTokenAmount:      soldTokenAmount,
+		MinBoughtTokenAmount: minBoughtTokenAmount,
+		Path:                 path,
+		Deadline:             deadline,
+		Recipient:            recipient,
+		Sender:               sender,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgTokenToTokenByPath) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgTokenToTokenByPath) Type() string { return TypeMsgPathSwap }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgTokenToTokenByPath) ValidateBasic() sdk.Error {
+	if msg.Sender.Empty() {
+		return ErrAddressIsRequire("sender")
+	}
+
+	if msg.Recipient.Empty() {
+		return ErrAddressIsRequire("recipient")
+	}
+
+	if !(msg.SoldTokenAmount.IsPositive()) {
+		return ErrSoldTokenAmountIsNegative()
+	}
+	if !msg.SoldTokenAmount.IsValid() {
+		return ErrSoldTokenAmount()
+	}
+
+	if !msg.MinBoughtTokenAmount.IsValid() {
+		return ErrMinBoughtTokenAmount()
+	}
+
+	return ValidateSwapPath(msg.Path, msg.SoldTokenAmount.Denom, msg.MinBoughtTokenAmount.Denom)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgTokenToTokenByPath) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgTokenToTokenByPath) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// ValidateSwapPath checks that the path starts with the sold token, ends with the bought token, and passes
+// through every token at most once
+func ValidateSwapPath(path []string, soldToken, boughtToken string) sdk.Error {
+	if len(path) < 2 || len(path) > MaxSwapPathLength {
+		return ErrInvalidSwapPath(fmt.Sprintf("the length of path should be between 2 and %d", MaxSwapPathLength))
+	}
+	if path[0] != soldToken || path[len(path)-1] != boughtToken {
+		return ErrInvalidSwapPath("path should start with the sold token and end with the bought token")
+	}
+	tokens := make(map[string]bool, len(path))
+	for _, token := range path {
+		if err := ValidateSwapAmountName(token); err != nil {
+			return err
+		}
+		if tokens[token] {
+			return ErrInvalidSwapPath(fmt.Sprintf("token %s appears in path more than once", token))
+		}
+		tokens[token] = true
+	}
+	return nil
+}
@@ -0,0 +1,197 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	govtypes "github.com/okex/exchain/x/gov/types"
+)
+
+const (
+	// proposalTypeManageSwapPairFeeRate defines the type for a ManageSwapPairFeeRateProposal
+	proposalTypeManageSwapPairFeeRate = "ManageSwapPairFeeRate"
+	// proposalTypeManageProtocolFee defines the type for a ManageProtocolFeeProposal
+	proposalTypeManageProtocolFee = "ManageProtocolFee"
+)
+
+func init() {
+	govtypes.RegisterProposalType(proposalTypeManageSwapPairFeeRate)
+	govtypes.RegisterProposalType(proposalTypeManageProtocolFee)
+	govtypes.RegisterProposalTypeCodec(ManageSwapPairFeeRateProposal{}, "okexchain/ammswap/ManageSwapPairFeeRateProposal")
+	govtypes.RegisterProposalTypeCodec(ManageProtocolFeeProposal{}, "okexchain/ammswap/ManageProtocolFeeProposal")
+}
+
+var (
+	_ govtypes.Content = (*ManageSwapPairFeeRateProposal)(nil)
+	_ govtypes.Content = (*ManageProtocolFeeProposal)(nil)
+)
+
+// ManageSwapPairFeeRateProposal - structure for the proposal to set or delete the fee rate of a swap token pair,
+// the pair falls back to the global fee rate once its own one is deleted
+type ManageSwapPairFeeRateProposal struct {
+	Title         string  `json:"title" yaml:"title"`
+	Description   string  `json:"description" yaml:"description"`
+	TokenPairName string  `json:"token_pair_name" yaml:"token_pair_name"`
+	FeeRate       sdk.Dec `json:"fee_rate" yaml:"fee_rate"`
+	IsAdded       bool    `json:"is_added" yaml:"is_added"`
+}
+
+// NewManageSwapPairFeeRateProposal creates a new instance of ManageSwapPairFeeRateProposal
+func NewManageSwapPairFeeRateProposal(title, description, tokenPairName string, feeRate sdk.Dec,
+	isAdded bool) ManageSwapPairFeeRateProposal {
+	return ManageSwapPairFeeRateProposal{
+		Title:         title,
+		Description:   description,
+		TokenPairName: tokenPairName,
+		FeeRate:       feeRate,
+		IsAdded:       isAdded,
+	}
+}
+
+// GetTitle returns title of a manage swap pair fee rate proposal object
+func (mp ManageSwapPairFeeRateProposal) GetTitle() string {
+	return mp.Title
+}
+
+// GetDescription returns description of a manage swap pair fee rate proposal object
+func (mp ManageSwapPairFeeRateProposal) GetDescription() string {
+	return mp.Description
+}
+
+// ProposalRoute returns route key of a manage swap pair fee rate proposal object
+func (mp ManageSwapPairFeeRateProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+// ProposalType returns type of a manage swap pair fee rate proposal object
+func (mp ManageSwapPairFeeRateProposal) ProposalType() string {
+	return proposalTypeManageSwapPairFeeRate
+}
+
+// ValidateBasic validates a manage swap pair fee rate proposal
+func (mp ManageSwapPairFeeRateProposal) ValidateBasic() sdk.Error {
+	if err := validateProposalContent(mp.Title, mp.Description); err != nil {
+		return err
+	}
+
+	if mp.ProposalType() != proposalTypeManageSwapPairFeeRate {
+		return govtypes.ErrInvalidProposalType(mp.ProposalType())
+	}
+
+	if len(mp.TokenPairName) == 0 {
+		return govtypes.ErrInvalidProposalContent("token pair name is required")
+	}
+
+	if mp.IsAdded {
+		if err := ValidateFeeRate(mp.FeeRate); err != nil {
+			return govtypes.ErrInvalidProposalContent(err.Error())
+		}
+	}
+
+	return nil
+}
+
+// String returns a human readable string representation of a ManageSwapPairFeeRateProposal
+func (mp ManageSwapPairFeeRateProposal) String() string {
+	return fmt.Sprintf(`ManageSwapPairFeeRateProposal:
+ Title:					%s
+ Description:        	%s
+ Type:                	%s
+ TokenPairName:			%s
+ FeeRate:				%s
+ IsAdded:				%t`,
+		mp.Title, mp.Description, mp.ProposalType(), mp.TokenPairName, mp.FeeRate, mp.IsAdded)
+}
+
+// ManageProtocolFeeProposal - structure for the proposal to set the share of the swap fees skimmed from the pools
+// as protocol fees, and whether they go to the community pool or the protocol fee module account
+type ManageProtocolFeeProposal struct {
+	Title           string  `json:"title" yaml:"title"`
+	Description     string  `json:"description" yaml:"description"`
+	FeeShare        sdk.Dec `json:"fee_share" yaml:"fee_share"`
+	ToCommunityPool bool    `json:"to_community_pool" yaml:"to_community_pool"`
+}
+
+// NewManageProtocolFeeProposal creates a new instance of ManageProtocolFeeProposal
+func NewManageProtocolFeeProposal(title, description string, feeShare sdk.Dec,
+	toCommunityPool bool) ManageProtocolFeeProposal {
+	return ManageProtocolFeeProposal{
+		Title:           title,
+		Description:     description,
+		FeeShare:        feeShare,
+		ToCommunityPool: toCommunityPool,
+	}
+}
+
+// GetTitle returns title of a manage protocol fee proposal object
+func (mp ManageProtocolFeeProposal) GetTitle() string {
+	return mp.Title
+}
+
+// GetDescription returns description of a manage protocol fee proposal object
+func (mp ManageProtocolFeeProposal) GetDescription() string {
+	return mp.Description
+}
+
+// ProposalRoute returns route key of a manage protocol fee proposal object
+func (mp ManageProtocolFeeProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+// ProposalType returns type of a manage protocol fee proposal object
+func (mp ManageProtocolFeeProposal) ProposalType() string {
+	return proposalTypeManageProtocolFee
+}
+
+// ValidateBasic validates a manage protocol fee proposal
+func (mp ManageProtocolFeeProposal) ValidateBasic() sdk.Error {
+	if err := validateProposalContent(mp.Title, mp.Description); err != nil {
+		return err
+	}
+
+	if mp.ProposalType() != proposalTypeManageProtocolFee {
+		return govtypes.ErrInvalidProposalType(mp.ProposalType())
+	}
+
+	if err := mp.GetProtocolFee().Validate(); err != nil {
+		return govtypes.ErrInvalidProposalContent(err.Error())
+	}
+
+	return nil
+}
+
+// GetProtocolFee returns the protocol fee the proposal sets
+func (mp ManageProtocolFeeProposal) GetProtocolFee() ProtocolFee {
+	return NewProtocolFee(mp.FeeShare, mp.ToCommunityPool)
+}
+
+// String returns a human readable string representation of a ManageProtocolFeeProposal
+func (mp ManageProtocolFeeProposal) String() string {
+	return fmt.Sprintf(`ManageProtocolFeeProposal:
+ Title:					%s
+ Description:        	%s
+ Type:                	%s
+ FeeShare:				%s
+ ToCommunityPool:		%t`,
+		mp.Title, mp.Description, mp.ProposalType(), mp.FeeShare, mp.ToCommunityPool)
+}
+
+func validateProposalContent(title, description string) sdk.Error {
+	if len(strings.TrimSpace(title)) == 0 {
+		return govtypes.ErrInvalidProposalContent("title is required")
+	}
+	if len(title) > govtypes.MaxTitleLength {
+		return govtypes.ErrInvalidProposalContent("title length is longer than the maximum title length")
+	}
+
+	if len(description) == 0 {
+		return govtypes.ErrInvalidProposalContent("description is required")
+	}
+
+	if len(description) > govtypes.MaxDescriptionLength {
+		return govtypes.ErrInvalidProposalContent("description length is longer than the maximum description length")
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	govtypes "github.com/okex/exchain/x/gov/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManageSwapPairFeeRateProposal(t *testing.T) {
+	proposal := NewManageSwapPairFeeRateProposal("Test", "description", "aab_okt", sdk.NewDecWithPrec(1, 2), true)
+	require.Equal(t, "Test", proposal.GetTitle())
+	require.Equal(t, "description", proposal.GetDescription())
+	require.Equal(t, RouterKey, proposal.ProposalRoute())
+	require.Equal(t, proposalTypeManageSwapPairFeeRate, proposal.ProposalType())
+	require.NotPanics(t, func() {
+		_ = proposal.String()
+	})
+	require.Nil(t, proposal.ValidateBasic())
+
+	proposal.Title = ""
+	require.NotNil(t, proposal.ValidateBasic())
+	proposal.Title = strings.Repeat("a", govtypes.MaxTitleLength+1)
+	require.NotNil(t, proposal.ValidateBasic())
+	proposal.Title = "Test"
+	proposal.Description = ""
+	require.NotNil(t, proposal.ValidateBasic())
+	proposal.Description = strings.Repeat("a", govtypes.MaxDescriptionLength+1)
+	require.NotNil(t, proposal.ValidateBasic())
+	proposal.Description = "description"
+
+	proposal.TokenPairName = ""
+	require.NotNil(t, proposal.ValidateBasic())
+	proposal.TokenPairName = "aab_okt"
+
+	proposal.FeeRate = sdk.NewDec(-1)
+	require.NotNil(t, proposal.ValidateBasic())
+	proposal.FeeRate = sdk.OneDec()
+	require.NotNil(t, proposal.ValidateBasic())
+
+	// the fee rate is ignored when it is deleted
+	proposal.IsAdded = false
+	require.Nil(t, proposal.ValidateBasic())
+}
+
+func TestManageProtocolFeeProposal(t *testing.T) {
+	proposal := NewManageProtocolFeeProposal("Test", "description", sdk.NewDecWithPrec(5, 1), true)
+	require.Equal(t, "Test", proposal.GetTitle())
+	require.Equal(t, "description", proposal.GetDescription())
+	require.Equal(t, RouterKey, proposal.ProposalRoute())
+	require.Equal(t, proposalTypeManageProtocolFee, proposal.ProposalType())
+	require.Equal(t, NewProtocolFee(sdk.NewDecWithPrec(5, 1), true), proposal.GetProtocolFee())
+	require.NotPanics(t, func() {
+		_ = proposal.String()
+	})
+	require.Nil(t, proposal.ValidateBasic())
+
+	proposal.Title = ""
+	require.NotNil(t, proposal.ValidateBasic())
+	proposal.Title = "Test"
+
+	proposal.FeeShare = sdk.OneDec()
+	require.Nil(t, proposal.ValidateBasic())
+	proposal.FeeShare = sdk.NewDecWithPrec(11, 1)
+	require.NotNil(t, proposal.ValidateBasic())
+	proposal.FeeShare = sdk.NewDec(-1)
+	require.NotNil(t, proposal.ValidateBasic())
+	proposal.FeeShare = sdk.Dec{}
+	require.NotNil(t, proposal.ValidateBasic())
+}
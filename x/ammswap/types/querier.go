@@ -50,3 +50,23 @@ type QueryBuyAmountParams struct {
 	SoldToken  sdk.SysCoin
 	TokenToBuy string
 }
+
+// nolint
+type QuerySwapBestPathParams struct {
+	SellTokenAmount string `json:"sell_token_amount"`
+	BuyToken        string `json:"buy_token"`
+}
+
+// NewQuerySwapBestPathParams creates a new instance of QuerySwapBestPathParams
+func NewQuerySwapBestPathParams(sellTokenAmount string, buyToken string) QuerySwapBestPathParams {
+	return QuerySwapBestPathParams{
+		SellTokenAmount: sellTokenAmount,
+		BuyToken:        buyToken,
+	}
+}
+
+// SwapPathInfo is the best path to swap the sold token to the token to buy, and the quoted output of it
+type SwapPathInfo struct {
+	Path      []string `json:"path"`
+	BuyAmount sdk.Dec  `json:"buy_amount"`
+}
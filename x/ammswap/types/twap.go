@@ -0,0 +1,96 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+// MaxTWAPBlocks is the max number of blocks a time weighted average price can look back from the current height,
+// the price observations older than it are pruned. It's about a week with 3-second blocks.
+const MaxTWAPBlocks int64 = 201600
+
+// PriceObservation is the state of the cumulative prices of a swap token pair after its reserves changed at a height.
+// The cumulative prices sum up the price at the end of every block before the height, so the time weighted average
+// price between two heights is weighted by blocks.
+type PriceObservation struct {
+	Height               int64   `json:"height"`
+	BaseCumulativePrice  sdk.Dec `json:"base_cumulative_price"`
+	QuoteCumulativePrice sdk.Dec `json:"quote_cumulative_price"`
+	BasePrice            sdk.Dec `json:"base_price"`  // price of the base token in the quote token after the change
+	QuotePrice           sdk.Dec `json:"quote_price"` // price of the quote token in the base token after the change
+}
+
+// NewPriceObservation creates the observation of the swap token pair at the height from the previous observation
+func NewPriceObservation(swapTokenPair SwapTokenPair, height int64, prev *PriceObservation) PriceObservation {
+	observation := PriceObservation{
+		Height:               height,
+		BaseCumulativePrice:  sdk.ZeroDec(),
+		QuoteCumulativePrice: sdk.ZeroDec(),
+		BasePrice:            sdk.ZeroDec(),
+		QuotePrice:           sdk.ZeroDec(),
+	}
+	if prev != nil {
+		observation.BaseCumulativePrice, observation.QuoteCumulativePrice = prev.CumulativePricesAt(height)
+	}
+	if swapTokenPair.BasePooledCoin.IsPositive() && swapTokenPair.QuotePooledCoin.IsPositive() {
+		observation.BasePrice = swapTokenPair.QuotePooledCoin.Amount.Quo(swapTokenPair.BasePooledCoin.Amount)
+		observation.QuotePrice = swapTokenPair.BasePooledCoin.Amount.Quo(swapTokenPair.QuotePooledCoin.Amount)
+	}
+	return observation
+}
+
+// CumulativePricesAt extrapolates the cumulative prices to a height not before the observation, as the prices stay
+// the same until the next observation
+func (o PriceObservation) CumulativePricesAt(height int64) (baseCumulativePrice, quoteCumulativePrice sdk.Dec) {
+	blocks := sdk.NewDec(height - o.Height)
+	return o.BaseCumulativePrice.Add(o.BasePrice.Mul(blocks)), o.QuoteCumulativePrice.Add(o.QuotePrice.Mul(blocks))
+}
+
+// SwapPairPriceObservation is a price observation of a swap token pair, used in genesis
+type SwapPairPriceObservation struct {
+	TokenPairName    string           `json:"token_pair_name"`
+	PriceObservation PriceObservation `json:"price_observation"`
+}
+
+// Validate checks that the heights and the prices of the observation are valid
+func (o SwapPairPriceObservation) Validate() error {
+	if o.TokenPairName == "" {
+		return fmt.Errorf("empty token pair name of price observation")
+	}
+	ob := o.PriceObservation
+	if ob.Height < 0 {
+		return fmt.Errorf("negative height of price observation: %d", ob.Height)
+	}
+	for _, price := range []sdk.Dec{ob.BaseCumulativePrice, ob.QuoteCumulativePrice, ob.BasePrice, ob.QuotePrice} {
+		if price.IsNil() || price.IsNegative() {
+			return fmt.Errorf("invalid price of price observation at height %d: %s", ob.Height, price)
+		}
+	}
+	return nil
+}
+
+// QueryTWAPParams defines the params of the query of the time weighted average price of a swap token pair
+type QueryTWAPParams struct {
+	TokenPairName string `json:"token_pair_name"`
+	StartHeight   int64  `json:"start_height"`
+	EndHeight     int64  `json:"end_height"`
+}
+
+// NewQueryTWAPParams creates a new instance of QueryTWAPParams
+func NewQueryTWAPParams(tokenPairName string, startHeight, endHeight int64) QueryTWAPParams {
+	return QueryTWAPParams{
+		TokenPairName: tokenPairName,
+		StartHeight:   startHeight,
+		EndHeight:     endHeight,
+	}
+}
+
+// TWAPInfo is the time weighted average price of a swap token pair between two heights
+type TWAPInfo struct {
+	TokenPairName string  `json:"token_pair_name"`
+	StartHeight   int64   `json:"start_height"`
+	EndHeight     int64   `json:"end_height"`
+	BasePrice     sdk.Dec `json:"base_price"`
+	QuotePrice    sdk.Dec `json:"quote_price"`
+}
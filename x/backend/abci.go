@@ -13,9 +13,12 @@ import (
 
 // EndBlocker called every block, check expired orders
 func EndBlocker(ctx sdk.Context, keeper Keeper) {
-	if keeper.Config.EnableBackend && keeper.Config.EnableMktCompute {
-		keeper.Logger.Debug(fmt.Sprintf("begin backend endblocker: block---%d", ctx.BlockHeight()))
+	if !keeper.Config.EnableBackend {
+		return
+	}
+	keeper.Logger.Debug(fmt.Sprintf("begin backend endblocker: block---%d", ctx.BlockHeight()))
 
+	if keeper.Config.EnableMktCompute {
 		// store data to db
 		storeNewOrders(ctx, keeper)
 		updateOrders(ctx, keeper)
@@ -25,10 +28,12 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) {
 		storeSwapInfos(keeper)
 		storeClaimInfos(keeper)
 		keeper.EmitAllWsItems(ctx)
-		// refresh cache
-		keeper.Flush()
-		keeper.Logger.Debug(fmt.Sprintf("end backend endblocker: block---%d", ctx.BlockHeight()))
 	}
+	// evm txs are indexed without the market computation
+	storeEvmInfos(keeper)
+	// refresh cache
+	keeper.Flush()
+	keeper.Logger.Debug(fmt.Sprintf("end backend endblocker: block---%d", ctx.BlockHeight()))
 }
 
 func storeSwapInfos(keeper Keeper) {
@@ -166,6 +171,12 @@ func GetNewDealsAndMatchResultsAtEndBlock(ctx sdk.Context, orderKeeper types.Ord
 
 		for _, record := range matchResult.Deals {
 			order := orderKeeper.GetOrder(ctx, record.OrderID)
+			dealPrice := price
+			if !record.Price.IsNil() {
+				if dealPrice, err = strconv.ParseFloat(record.Price.String(), 64); err != nil {
+					return deals, results, err
+				}
+			}
 			if quantity, err := strconv.ParseFloat(record.Quantity.String(), 64); err == nil {
 
 				deal := &types.Deal{
@@ -174,7 +185,7 @@ func GetNewDealsAndMatchResultsAtEndBlock(ctx sdk.Context, orderKeeper types.Ord
 					Side:        record.Side,
 					Sender:      order.Sender.String(),
 					Product:     product,
-					Price:       price,
+					Price:       dealPrice,
 					Quantity:    quantity,
 					Fee:         record.Fee,
 					Timestamp:   ctx.BlockHeader().Time.Unix(),
@@ -210,6 +221,7 @@ func GetNewOrdersAtEndBlock(ctx sdk.Context, orderKeeper types.OrderKeeper) ([]*
 				FilledAvgPrice: order.FilledAvgPrice.String(),
 				RemainQuantity: order.RemainQuantity.String(),
 				Timestamp:      order.Timestamp,
+				Type:           order.GetType(),
 			}
 			orders = append(orders, orderDb)
 		} else {
@@ -238,6 +250,7 @@ func GetUpdatedOrdersAtEndBlock(ctx sdk.Context, orderKeeper types.OrderKeeper)
 				FilledAvgPrice: order.FilledAvgPrice.String(),
 				RemainQuantity: order.RemainQuantity.String(),
 				Timestamp:      order.Timestamp,
+				Type:           order.GetType(),
 			}
 			orders = append(orders, orderDb)
 		}
@@ -256,3 +269,15 @@ func storeClaimInfos(keeper Keeper) {
 		keeper.Logger.Debug(fmt.Sprintf("[backend] Expect to insert %d claimInfos, inserted Count %d", total, count))
 	}
 }
+
+func storeEvmInfos(keeper Keeper) {
+	defer types.PrintStackIfPanic()
+	evmInfos := keeper.Cache.GetEvmInfos()
+	total := len(evmInfos)
+	count, err := keeper.Orm.AddEvmInfos(evmInfos)
+	if err != nil {
+		keeper.Logger.Error(fmt.Sprintf("[backend] Expect to insert %d evmInfos, inserted Count %d, err: %+v", total, count, err))
+	} else {
+		keeper.Logger.Debug(fmt.Sprintf("[backend] Expect to insert %d evmInfos, inserted Count %d", total, count))
+	}
+}
@@ -13,6 +13,9 @@ type Cache struct {
 	// swap infos, flush at EndBlocker
 	swapInfos  []*types.SwapInfo
 	claimInfos []*types.ClaimInfo
+
+	// evm infos, flush at EndBlocker
+	evmInfos []*types.EvmInfo
 }
 
 // NewCache return  cache pointer address, called at NewKeeper
@@ -22,6 +25,7 @@ func NewCache() *Cache {
 		LatestTicker: make(map[string]*types.Ticker),
 		swapInfos:    make([]*types.SwapInfo, 0, 2000),
 		claimInfos:   make([]*types.ClaimInfo, 0, 2000),
+		evmInfos:     make([]*types.EvmInfo, 0, 2000),
 	}
 }
 
@@ -30,6 +34,7 @@ func (c *Cache) Flush() {
 	c.Transactions = make([]*types.Transaction, 0, 2000)
 	c.swapInfos = make([]*types.SwapInfo, 0, 2000)
 	c.claimInfos = make([]*types.ClaimInfo, 0, 2000)
+	c.evmInfos = make([]*types.EvmInfo, 0, 2000)
 }
 
 // AddTransaction append transaction to cache Transactions
@@ -61,3 +66,13 @@ func (c *Cache) AddClaimInfo(claimInfo *types.ClaimInfo) {
 func (c *Cache) GetClaimInfos() []*types.ClaimInfo {
 	return c.claimInfos
 }
+
+// AddEvmInfo appends evmInfo to cache EvmInfos
+func (c *Cache) AddEvmInfo(evmInfo *types.EvmInfo) {
+	c.evmInfos = append(c.evmInfos, evmInfo)
+}
+
+// nolint
+func (c *Cache) GetEvmInfos() []*types.EvmInfo {
+	return c.evmInfos
+}
@@ -33,6 +33,9 @@ func RegisterRoutesV2(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/fees", feesHandlerV2(cliCtx)).Methods("GET")
 	r.HandleFunc("/deals", dealsHandlerV2(cliCtx)).Methods("GET")
 	r.HandleFunc("/transactions", txListHandlerV2(cliCtx)).Methods("GET")
+	r.HandleFunc("/evm/transactions", evmTxListHandlerV2(cliCtx)).Methods("GET")
+	r.HandleFunc("/evm/token_transfers", tokenTransferListHandlerV2(cliCtx)).Methods("GET")
+	r.HandleFunc("/evm/contracts", contractCreationListHandlerV2(cliCtx)).Methods("GET")
 }
 
 func txListHandlerV2(cliCtx context.CLIContext) http.HandlerFunc {
@@ -434,3 +437,153 @@ func orderHandlerV2(cliCtx context.CLIContext) http.HandlerFunc {
 		common.HandleSuccessResponseV2(w, res)
 	}
 }
+
+// validCursors checks the optional after/before cursors of the evm endpoints
+func validCursors(after, before string) bool {
+	for _, cursor := range []string{after, before} {
+		if cursor == "" {
+			continue
+		}
+		if _, err := strconv.ParseInt(cursor, 10, 64); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+func evmTxListHandlerV2(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		address := r.URL.Query().Get("address")
+		after := r.URL.Query().Get("after")
+		before := r.URL.Query().Get("before")
+		limit := r.URL.Query().Get("limit")
+
+		// validate request
+		if address == "" {
+			common.HandleErrorResponseV2(w, http.StatusBadRequest, common.ErrorMissingRequiredParam)
+			return
+		}
+		if _, err := types.ParseEvmAddress(address); err != nil {
+			common.HandleErrorResponseV2(w, http.StatusBadRequest, common.ErrorInvalidAddress)
+			return
+		}
+		if !validCursors(after, before) {
+			common.HandleErrorResponseV2(w, http.StatusBadRequest, common.ErrorInvalidParam)
+			return
+		}
+		if limit == "" {
+			limit = defaultLimit
+		}
+		limitInt, err := strconv.Atoi(limit)
+		if err != nil {
+			common.HandleErrorResponseV2(w, http.StatusBadRequest, common.ErrorInvalidParam)
+			return
+		}
+
+		params := types.QueryEvmTxListParamsV2{
+			Address: address,
+			After:   after,
+			Before:  before,
+			Limit:   limitInt,
+		}
+		req := cliCtx.Codec.MustMarshalJSON(params)
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/backend/%s", types.QueryEvmTxListV2), req)
+		common.HandleResponseV2(w, res, err)
+	}
+}
+
+func tokenTransferListHandlerV2(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		address := r.URL.Query().Get("address")
+		contract := r.URL.Query().Get("contract")
+		standard := strings.ToLower(r.URL.Query().Get("standard"))
+		after := r.URL.Query().Get("after")
+		before := r.URL.Query().Get("before")
+		limit := r.URL.Query().Get("limit")
+
+		// validate request
+		if address == "" {
+			common.HandleErrorResponseV2(w, http.StatusBadRequest, common.ErrorMissingRequiredParam)
+			return
+		}
+		if _, err := types.ParseEvmAddress(address); err != nil {
+			common.HandleErrorResponseV2(w, http.StatusBadRequest, common.ErrorInvalidAddress)
+			return
+		}
+		if contract != "" {
+			if _, err := types.ParseEvmAddress(contract); err != nil {
+				common.HandleErrorResponseV2(w, http.StatusBadRequest, common.ErrorInvalidAddress)
+				return
+			}
+		}
+		if standard != "" && standard != types.TokenStandardERC20 && standard != types.TokenStandardERC721 {
+			common.HandleErrorResponseV2(w, http.StatusBadRequest, common.ErrorInvalidParam)
+			return
+		}
+		if !validCursors(after, before) {
+			common.HandleErrorResponseV2(w, http.StatusBadRequest, common.ErrorInvalidParam)
+			return
+		}
+		if limit == "" {
+			limit = defaultLimit
+		}
+		limitInt, err := strconv.Atoi(limit)
+		if err != nil {
+			common.HandleErrorResponseV2(w, http.StatusBadRequest, common.ErrorInvalidParam)
+			return
+		}
+
+		params := types.QueryTokenTransferListParamsV2{
+			Address:  address,
+			Contract: contract,
+			Standard: standard,
+			After:    after,
+			Before:   before,
+			Limit:    limitInt,
+		}
+		req := cliCtx.Codec.MustMarshalJSON(params)
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/backend/%s", types.QueryTokenTransferListV2), req)
+		common.HandleResponseV2(w, res, err)
+	}
+}
+
+func contractCreationListHandlerV2(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		address := r.URL.Query().Get("address")
+		after := r.URL.Query().Get("after")
+		before := r.URL.Query().Get("before")
+		limit := r.URL.Query().Get("limit")
+
+		// validate request
+		if address == "" {
+			common.HandleErrorResponseV2(w, http.StatusBadRequest, common.ErrorMissingRequiredParam)
+			return
+		}
+		if _, err := types.ParseEvmAddress(address); err != nil {
+			common.HandleErrorResponseV2(w, http.StatusBadRequest, common.ErrorInvalidAddress)
+			return
+		}
+		if !validCursors(after, before) {
+			common.HandleErrorResponseV2(w, http.StatusBadRequest, common.ErrorInvalidParam)
+			return
+		}
+		if limit == "" {
+			limit = defaultLimit
+		}
+		limitInt, err := strconv.Atoi(limit)
+		if err != nil {
+			common.HandleErrorResponseV2(w, http.StatusBadRequest, common.ErrorInvalidParam)
+			return
+		}
+
+		params := types.QueryContractCreationListParamsV2{
+			Address: address,
+			After:   after,
+			Before:  before,
+			Limit:   limitInt,
+		}
+		req := cliCtx.Codec.MustMarshalJSON(params)
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/backend/%s", types.QueryContractCreationListV2), req)
+		common.HandleResponseV2(w, res, err)
+	}
+}
@@ -187,17 +187,21 @@ func queryFarmDashboard(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (
 		if whitelistMap[poolName] {
 			hasWhiteList = true
 		}
-		// calculate staked in dollars and pool ratio
+		// calculate staked in dollars and pool ratio, which is the share of the locked weight boosted by lock terms
 		poolRatio := sdk.ZeroDec()
 		userStaked := sdk.ZeroDec()
 		userStakedDollars := sdk.ZeroDec()
+		rewardMultiplier := sdk.OneDec()
 		totalStakedDollars := keeper.farmKeeper.GetPoolLockedValue(ctx, farmPool)
 		if lockInfo, found := keeper.farmKeeper.GetLockInfo(ctx, address, poolName); found {
 			if !farmPool.TotalValueLocked.Amount.IsZero() {
-				poolRatio = lockInfo.Amount.Amount.Quo(farmPool.TotalValueLocked.Amount)
 				userStaked = lockInfo.Amount.Amount
-				userStakedDollars = poolRatio.Mul(totalStakedDollars)
+				userStakedDollars = common.MulAndQuo(userStaked, totalStakedDollars, farmPool.TotalValueLocked.Amount)
 			}
+			if totalLockedWeight := farmPool.GetTotalLockedWeight(); !totalLockedWeight.IsZero() {
+				poolRatio = lockInfo.GetWeight().Quo(totalLockedWeight)
+			}
+			rewardMultiplier = lockInfo.GetRewardMultiplier()
 		}
 
 		// calculate start at and finish at
@@ -206,7 +210,7 @@ func queryFarmDashboard(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (
 		// calculate pool rate and farm apy
 		yieldedInDay := farmPool.YieldedTokenInfos[0].AmountYieldedPerBlock.MulInt64(int64(types.BlocksPerDay))
 		poolRate := sdk.NewDecCoinsFromDec(farmPool.YieldedTokenInfos[0].RemainingAmount.Denom, yieldedInDay)
-		apy := calculateFarmApy(ctx, keeper, farmPool, totalStakedDollars)
+		apy := calculateFarmApy(ctx, keeper, farmPool, totalStakedDollars).Mul(rewardMultiplier)
 		farmApy := sdk.NewDecCoinsFromDec(farmPool.YieldedTokenInfos[0].RemainingAmount.Denom, apy)
 
 		// calculate total farmed and claim infos
@@ -374,14 +378,16 @@ func queryFarmStakedInfo(ctx sdk.Context, req abci.RequestQuery, keeper Keeper)
 
 	// locked info
 	accountStaked := sdk.ZeroDec()
+	accountWeight := sdk.ZeroDec()
 	if lockedInfo, found := keeper.farmKeeper.GetLockInfo(ctx, address, farmPool.Name); found {
 		accountStaked = lockedInfo.Amount.Amount
+		accountWeight = lockedInfo.GetWeight()
 	}
 
-	// pool ratio
+	// pool ratio, in proportion to the locked weight boosted by lock terms
 	poolRatio := sdk.ZeroDec()
-	if !farmPool.TotalValueLocked.IsZero() {
-		poolRatio = accountStaked.Quo(farmPool.TotalValueLocked.Amount)
+	if totalLockedWeight := farmPool.GetTotalLockedWeight(); !totalLockedWeight.IsZero() {
+		poolRatio = accountWeight.Quo(totalLockedWeight)
 	}
 
 	// min lock amount
@@ -521,16 +527,22 @@ func getFarmPoolStatus(startAt int64, finishAt int64, farmPool farm.FarmPool) ty
 	return types.FarmPoolFinished
 }
 
+// calculateFarmApy returns the apy of a lock without any lock term. The yields are shared in proportion to the
+// locked weights, so the apy of a lock under a term is boosted by its reward multiplier.
 func calculateFarmApy(ctx sdk.Context, keeper Keeper, farmPool farm.FarmPool, totalStakedDollars sdk.Dec) sdk.Dec {
-	if farmPool.YieldedTokenInfos[0].AmountYieldedPerBlock.IsZero() || farmPool.TotalValueLocked.Amount.IsZero() {
+	totalLockedWeight := farmPool.GetTotalLockedWeight()
+	if farmPool.YieldedTokenInfos[0].AmountYieldedPerBlock.IsZero() || farmPool.TotalValueLocked.Amount.IsZero() ||
+		totalLockedWeight.IsZero() {
 		return sdk.ZeroDec()
 	}
 
 	yieldedInDay := farmPool.YieldedTokenInfos[0].AmountYieldedPerBlock.MulInt64(int64(types.BlocksPerDay))
 	yieldedDollarsInDay := calculateAmountToDollars(ctx, keeper,
 		sdk.NewDecCoinFromDec(farmPool.YieldedTokenInfos[0].RemainingAmount.Denom, yieldedInDay))
-	if !totalStakedDollars.IsZero() && !yieldedDollarsInDay.IsZero() {
-		return yieldedDollarsInDay.Quo(totalStakedDollars).MulInt64(types.DaysInYear)
+	// the dollars of the total locked weight, as if every lock was boosted by its lock term
+	totalWeightDollars := common.MulAndQuo(totalStakedDollars, totalLockedWeight, farmPool.TotalValueLocked.Amount)
+	if !totalWeightDollars.IsZero() && !yieldedDollarsInDay.IsZero() {
+		return yieldedDollarsInDay.Quo(totalWeightDollars).MulInt64(types.DaysInYear)
 	}
 
 	apy := sdk.ZeroDec()
@@ -540,10 +552,10 @@ func calculateFarmApy(ctx sdk.Context, keeper Keeper, farmPool farm.FarmPool, to
 		if swapTokenPair.QuotePooledCoin.Denom == farmPool.TotalValueLocked.Denom && swapTokenPair.BasePooledCoin.Amount.IsPositive() {
 			yieldedInDay.Mul(swapTokenPair.QuotePooledCoin.Amount.Quo(swapTokenPair.BasePooledCoin.Amount))
 			apy = common.MulAndQuo(yieldedInDay, swapTokenPair.QuotePooledCoin.Amount,
-				swapTokenPair.BasePooledCoin.Amount).Quo(farmPool.TotalValueLocked.Amount).MulInt64(types.DaysInYear)
+				swapTokenPair.BasePooledCoin.Amount).Quo(totalLockedWeight).MulInt64(types.DaysInYear)
 		} else if swapTokenPair.QuotePooledCoin.Amount.IsPositive() {
 			apy = common.MulAndQuo(yieldedInDay, swapTokenPair.BasePooledCoin.Amount,
-				swapTokenPair.QuotePooledCoin.Amount).Quo(farmPool.TotalValueLocked.Amount).MulInt64(types.DaysInYear)
+				swapTokenPair.QuotePooledCoin.Amount).Quo(totalLockedWeight).MulInt64(types.DaysInYear)
 		}
 	}
 
@@ -594,13 +606,15 @@ func queryFarmFirstPool(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (
 		firstPool.Balance = accountCoins.AmountOf(farmPool.MinLockAmount.Denom)
 
 		// locked info
+		accountWeight := sdk.ZeroDec()
 		if lockedInfo, found := keeper.farmKeeper.GetLockInfo(ctx, address, farmPool.Name); found {
 			firstPool.AccountStaked = lockedInfo.Amount.Amount
+			accountWeight = lockedInfo.GetWeight()
 		}
 
-		// estimated farm
-		if !farmPool.TotalValueLocked.IsZero() {
-			firstPool.EstimatedFarm = farmAmount.Mul(firstPool.AccountStaked.Quo(farmPool.TotalValueLocked.Amount))
+		// estimated farm, in proportion to the locked weight boosted by lock terms
+		if totalLockedWeight := farmPool.GetTotalLockedWeight(); !totalLockedWeight.IsZero() {
+			firstPool.EstimatedFarm = farmAmount.Mul(accountWeight.Quo(totalLockedWeight))
 		}
 
 		if firstPool.EstimatedFarm.IsZero() {
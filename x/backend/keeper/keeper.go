@@ -6,7 +6,9 @@ import (
 	"reflect"
 	"time"
 
+	ethcmn "github.com/ethereum/go-ethereum/common"
 	"github.com/okex/exchain/x/ammswap"
+	evmtypes "github.com/okex/exchain/x/evm/types"
 
 	"github.com/okex/exchain/libs/cosmos-sdk/x/auth"
 
@@ -416,6 +418,18 @@ func (k Keeper) getTransactionListV2(ctx sdk.Context, addr string, txType int, a
 	return k.Orm.GetTransactionListV2(addr, txType, after, before, limit)
 }
 
+func (k Keeper) getEvmTxListV2(ctx sdk.Context, addr string, after string, before string, limit int) []types.EvmTransaction {
+	return k.Orm.GetEvmTxListV2(addr, after, before, limit)
+}
+
+func (k Keeper) getTokenTransferListV2(ctx sdk.Context, addr, contract, standard string, after string, before string, limit int) []types.TokenTransfer {
+	return k.Orm.GetTokenTransferListV2(addr, contract, standard, after, before, limit)
+}
+
+func (k Keeper) getContractCreationListV2(ctx sdk.Context, addr string, after string, before string, limit int) []types.ContractCreation {
+	return k.Orm.GetContractCreationListV2(addr, after, before, limit)
+}
+
 func (k Keeper) getAllTickers() []types.Ticker {
 	var tickers []types.Ticker
 	for _, ticker := range k.Cache.LatestTicker {
@@ -500,3 +514,11 @@ func (k Keeper) OnFarmClaim(ctx sdk.Context, address sdk.AccAddress, poolName st
 	}
 	k.Cache.AddClaimInfo(claimInfo)
 }
+
+func (k Keeper) OnEvmTx(ctx sdk.Context, msg evmtypes.MsgEthereumTx, from ethcmn.Address, txHash ethcmn.Hash, txIndex uint64,
+	status uint64, result *evmtypes.ResultData, gasUsed uint64) {
+	if !k.Config.EnableBackend {
+		return
+	}
+	k.Cache.AddEvmInfo(types.GenerateEvmInfo(ctx, msg, from, txHash, txIndex, status, result, gasUsed))
+}
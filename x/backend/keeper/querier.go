@@ -71,6 +71,8 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			}
 		case types.QueryDexFeesList:
 			res, err = queryDexFees(ctx, path[1:], req, keeper)
+		case types.QueryDexFeeSchedules:
+			res, err = queryDexFeeSchedules(ctx, req, keeper)
 
 		case types.QuerySwapWatchlist:
 			res, err = querySwapWatchlist(ctx, req, keeper)
@@ -122,6 +124,12 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			res, err = queryDealsV2(ctx, path[1:], req, keeper)
 		case types.QueryTxListV2:
 			res, err = queryTxListV2(ctx, path[1:], req, keeper)
+		case types.QueryEvmTxListV2:
+			res, err = queryEvmTxListV2(ctx, path[1:], req, keeper)
+		case types.QueryTokenTransferListV2:
+			res, err = queryTokenTransferListV2(ctx, path[1:], req, keeper)
+		case types.QueryContractCreationListV2:
+			res, err = queryContractCreationListV2(ctx, path[1:], req, keeper)
 		default:
 			res, err = nil, types.ErrBackendModuleUnknownQueryType()
 		}
@@ -579,3 +587,58 @@ func queryDexFees(ctx sdk.Context, path []string, req abci.RequestQuery, keeper
 	}
 	return bz, nil
 }
+
+func queryDexFeeSchedules(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryDexFeeSchedulesParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, common.ErrUnMarshalJSONFailed(err.Error())
+	}
+	if params.Page < 0 || params.PerPage < 0 {
+		return nil, common.ErrInvalidPaginateParam(params.Page, params.PerPage)
+	}
+	offset, limit := common.GetPage(params.Page, params.PerPage)
+
+	var products []string
+	if params.Product != "" {
+		if keeper.dexKeeper.GetTokenPair(ctx, params.Product) != nil {
+			products = []string{params.Product}
+		}
+	} else {
+		products = keeper.getAllProducts(ctx)
+	}
+	total := len(products)
+	switch {
+	case offset >= total:
+		products = nil
+	case offset+limit > total:
+		products = products[offset:]
+	default:
+		products = products[offset : offset+limit]
+	}
+
+	tradeFeeRate := keeper.OrderKeeper.GetParams(ctx).TradeFeeRate
+	feeSchedules := make([]types.DexFeeSchedule, 0, len(products))
+	for _, product := range products {
+		feeSchedule := types.DexFeeSchedule{Product: product, MakerFeeRate: tradeFeeRate, TakerFeeRate: tradeFeeRate}
+		if operatorFeeSchedule, found := keeper.dexKeeper.GetProductFeeSchedule(ctx, product); found {
+			feeSchedule.MakerFeeRate = operatorFeeSchedule.MakerFeeRate
+			feeSchedule.TakerFeeRate = operatorFeeSchedule.TakerFeeRate
+			feeSchedule.Tiers = operatorFeeSchedule.Tiers
+			feeSchedule.OperatorDefined = true
+		}
+		feeSchedules = append(feeSchedules, feeSchedule)
+	}
+
+	var response *common.ListResponse
+	if len(feeSchedules) > 0 {
+		response = common.GetListResponse(total, params.Page, params.PerPage, feeSchedules)
+	} else {
+		response = common.GetEmptyListResponse(total, params.Page, params.PerPage)
+	}
+	bz, err := json.Marshal(response)
+	if err != nil {
+		return nil, common.ErrMarshalJSONFailed(err.Error())
+	}
+	return bz, nil
+}
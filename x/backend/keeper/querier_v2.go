@@ -357,3 +357,81 @@ func queryTxListV2(ctx sdk.Context, path []string, req abci.RequestQuery, keeper
 
 	return res, nil
 }
+
+func queryEvmTxListV2(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryEvmTxListParamsV2
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+	address, err := types.ParseEvmAddress(params.Address)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("invalid address", err.Error()))
+	}
+
+	txs := keeper.getEvmTxListV2(ctx, address, params.After, params.Before, params.Limit)
+	if len(txs) == 0 {
+		return nil, nil
+	}
+
+	res, err := common.JSONMarshalV2(txs)
+	if err != nil {
+		return nil, sdk.ErrInternal(err.Error())
+	}
+
+	return res, nil
+}
+
+func queryTokenTransferListV2(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryTokenTransferListParamsV2
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+	address, err := types.ParseEvmAddress(params.Address)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("invalid address", err.Error()))
+	}
+	var contract string
+	if params.Contract != "" {
+		if contract, err = types.ParseEvmAddress(params.Contract); err != nil {
+			return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("invalid contract", err.Error()))
+		}
+	}
+	if params.Standard != "" && params.Standard != types.TokenStandardERC20 && params.Standard != types.TokenStandardERC721 {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("invalid token standard %s", params.Standard))
+	}
+
+	transfers := keeper.getTokenTransferListV2(ctx, address, contract, params.Standard, params.After, params.Before, params.Limit)
+	if len(transfers) == 0 {
+		return nil, nil
+	}
+
+	res, err := common.JSONMarshalV2(transfers)
+	if err != nil {
+		return nil, sdk.ErrInternal(err.Error())
+	}
+
+	return res, nil
+}
+
+func queryContractCreationListV2(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryContractCreationListParamsV2
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+	address, err := types.ParseEvmAddress(params.Address)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("invalid address", err.Error()))
+	}
+
+	creations := keeper.getContractCreationListV2(ctx, address, params.After, params.Before, params.Limit)
+	if len(creations) == 0 {
+		return nil, nil
+	}
+
+	res, err := common.JSONMarshalV2(creations)
+	if err != nil {
+		return nil, sdk.ErrInternal(err.Error())
+	}
+
+	return res, nil
+}
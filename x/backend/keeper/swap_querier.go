@@ -94,7 +94,6 @@ func querySwapWatchlist(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (
 
 	// total watchlist
 	var totalWatchlist []types.SwapWatchlist
-	swapParams := keeper.swapKeeper.GetParams(ctx)
 	for _, swapTokenPair := range swapTokenPairs {
 		tokenPairName := swapTokenPair.TokenPairName()
 		// check if in whitelist
@@ -118,10 +117,12 @@ func querySwapWatchlist(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (
 			price24h = volumePriceInfo.Price24h
 		}
 
-		// calculate fee apy
+		// calculate fee apy, the protocol fees skimmed from the pool are not earned by the liquidity providers
+		feeInfo := keeper.swapKeeper.GetSwapPairFeeInfo(ctx, tokenPairName)
+		liquidityFeeRate := feeInfo.FeeRate.Mul(sdk.OneDec().Sub(feeInfo.ProtocolFeeShare))
 		feeApy := sdk.ZeroDec()
 		if liquidity.IsPositive() && liquidity.IsPositive() {
-			feeApy = volume24h.Mul(swapParams.FeeRate).Quo(liquidity).Mul(sdk.NewDec(365))
+			feeApy = volume24h.Mul(liquidityFeeRate).Quo(liquidity).Mul(sdk.NewDec(365))
 		}
 
 		// calculate price change
@@ -131,12 +132,15 @@ func querySwapWatchlist(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (
 		}
 
 		totalWatchlist = append(totalWatchlist, types.SwapWatchlist{
-			SwapPair:  tokenPairName,
-			Liquidity: liquidity,
-			Volume24h: volume24h,
-			FeeApy:    feeApy,
-			LastPrice: lastPrice,
-			Change24h: change24h,
+			SwapPair:      tokenPairName,
+			Liquidity:     liquidity,
+			Volume24h:     volume24h,
+			FeeApy:        feeApy,
+			LastPrice:     lastPrice,
+			Change24h:     change24h,
+			FeeRate:       feeInfo.FeeRate,
+			LiquidityFees: feeInfo.LiquidityFees,
+			ProtocolFees:  feeInfo.ProtocolFees,
 		})
 	}
 
@@ -0,0 +1,89 @@
+package orm
+
+import (
+	"github.com/okex/exchain/x/backend/types"
+)
+
+// AddEvmInfos insert evm txs, token transfers and contract creations into db
+func (orm *ORM) AddEvmInfos(evmInfos []*types.EvmInfo) (addedCnt int, err error) {
+	orm.singleEntryLock.Lock()
+	defer orm.singleEntryLock.Unlock()
+
+	tx := orm.db.Begin()
+	defer orm.deferRollbackTx(tx, err)
+	cnt := 0
+
+	for _, evmInfo := range evmInfos {
+		if evmInfo == nil || evmInfo.Tx == nil {
+			continue
+		}
+		if ret := tx.Create(evmInfo.Tx); ret.Error != nil {
+			return cnt, ret.Error
+		}
+		for _, transfer := range evmInfo.Transfers {
+			if ret := tx.Create(transfer); ret.Error != nil {
+				return cnt, ret.Error
+			}
+		}
+		if evmInfo.Creation != nil {
+			if ret := tx.Create(evmInfo.Creation); ret.Error != nil {
+				return cnt, ret.Error
+			}
+		}
+		cnt++
+	}
+
+	tx.Commit()
+	return cnt, nil
+}
+
+// GetEvmTxListV2 returns the evm txs sent or received by address
+func (orm *ORM) GetEvmTxListV2(address string, after string, before string, limit int) []types.EvmTransaction {
+	var txs []types.EvmTransaction
+	query := orm.db.Model(types.EvmTransaction{}).Where("from_address = ? OR to_address = ?", address, address)
+	if after != "" {
+		query = query.Where("cursor_id > ?", after)
+	}
+	if before != "" {
+		query = query.Where("cursor_id < ?", before)
+	}
+
+	query.Order("cursor_id desc").Limit(limit).Find(&txs)
+	return txs
+}
+
+// GetTokenTransferListV2 returns the token transfers sent or received by address
+func (orm *ORM) GetTokenTransferListV2(address, contract, standard string, after string, before string, limit int) []types.TokenTransfer {
+	var transfers []types.TokenTransfer
+	query := orm.db.Model(types.TokenTransfer{}).Where("from_address = ? OR to_address = ?", address, address)
+	if contract != "" {
+		query = query.Where("contract = ?", contract)
+	}
+	if standard != "" {
+		query = query.Where("standard = ?", standard)
+	}
+	if after != "" {
+		query = query.Where("cursor_id > ?", after)
+	}
+	if before != "" {
+		query = query.Where("cursor_id < ?", before)
+	}
+
+	query.Order("cursor_id desc").Limit(limit).Find(&transfers)
+	return transfers
+}
+
+// GetContractCreationListV2 returns the contracts deployed by address
+func (orm *ORM) GetContractCreationListV2(address string, after string, before string, limit int) []types.ContractCreation {
+	var creations []types.ContractCreation
+	query := orm.db.Model(types.ContractCreation{}).Where("creator = ?", address)
+	if after != "" {
+		query = query.Where("cursor_id > ?", after)
+	}
+	if before != "" {
+		query = query.Where("cursor_id < ?", before)
+	}
+
+	query.Order("cursor_id desc").Limit(limit).Find(&creations)
+	return creations
+}
@@ -0,0 +1,64 @@
+package orm
+
+import (
+	"testing"
+
+	"github.com/okex/exchain/x/backend/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestORM_EvmInfos(t *testing.T) {
+	orm, err := NewSqlite3ORM(false, "/tmp/", "test_evm.db", nil)
+	require.NoError(t, err)
+	defer DeleteDB("/tmp/test_evm.db")
+
+	alice, bob, token := "0x00000000000000000000000000000000000000a1",
+		"0x00000000000000000000000000000000000000b2", "0x00000000000000000000000000000000000000c3"
+
+	var infos []*types.EvmInfo
+	for i := int64(1); i <= 5; i++ {
+		cursor := i * types.CursorBlockFactor
+		infos = append(infos, &types.EvmInfo{
+			Tx: &types.EvmTransaction{Cursor: cursor, BlockHeight: i, From: alice, To: token, Status: 1},
+			Transfers: []*types.TokenTransfer{
+				{Cursor: cursor, BlockHeight: i, Contract: token, Standard: types.TokenStandardERC20, From: alice, To: bob, Value: "1"},
+			},
+		})
+	}
+	infos = append(infos, &types.EvmInfo{
+		Tx:       &types.EvmTransaction{Cursor: 6 * types.CursorBlockFactor, BlockHeight: 6, From: bob, ContractAddress: token, Status: 1},
+		Creation: &types.ContractCreation{Cursor: 6 * types.CursorBlockFactor, BlockHeight: 6, Contract: token, Creator: bob},
+	})
+	cnt, err := orm.AddEvmInfos(infos)
+	require.NoError(t, err)
+	require.Equal(t, 6, cnt)
+
+	// txs are matched by sender and recipient, the latest first
+	txs := orm.GetEvmTxListV2(alice, "", "", 100)
+	require.Len(t, txs, 5)
+	require.Equal(t, int64(5), txs[0].BlockHeight)
+	require.Len(t, orm.GetEvmTxListV2(token, "", "", 100), 5)
+	require.Len(t, orm.GetEvmTxListV2(bob, "", "", 100), 1)
+
+	// paging with cursors
+	txs = orm.GetEvmTxListV2(alice, "", "", 2)
+	require.Len(t, txs, 2)
+	next := orm.GetEvmTxListV2(alice, "", "3000000", 2)
+	require.Len(t, next, 2)
+	require.Equal(t, int64(2), next[0].BlockHeight)
+	require.Equal(t, int64(1), next[1].BlockHeight)
+	require.Len(t, orm.GetEvmTxListV2(alice, "3000000", "", 100), 2)
+
+	// token transfers
+	require.Len(t, orm.GetTokenTransferListV2(bob, "", "", "", "", 100), 5)
+	require.Len(t, orm.GetTokenTransferListV2(bob, token, types.TokenStandardERC20, "", "", 100), 5)
+	require.Len(t, orm.GetTokenTransferListV2(bob, token, types.TokenStandardERC721, "", "", 100), 0)
+	require.Len(t, orm.GetTokenTransferListV2(bob, alice, "", "", "", 100), 0)
+	require.Len(t, orm.GetTokenTransferListV2(bob, "", "", "1000000", "5000000", 100), 3)
+
+	// contract creations
+	creations := orm.GetContractCreationListV2(bob, "", "", 100)
+	require.Len(t, creations, 1)
+	require.Equal(t, token, creations[0].Contract)
+	require.Len(t, orm.GetContractCreationListV2(alice, "", "", 100), 0)
+}
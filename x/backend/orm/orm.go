@@ -103,6 +103,9 @@ func New(enableLog bool, engineInfo *OrmEngineInfo, logger *log.Logger) (m *ORM,
 	orm.db.AutoMigrate(&types.SwapInfo{})
 	orm.db.AutoMigrate(&types.SwapWhitelist{})
 	orm.db.AutoMigrate(&types.ClaimInfo{})
+	orm.db.AutoMigrate(&types.EvmTransaction{})
+	orm.db.AutoMigrate(&types.TokenTransfer{})
+	orm.db.AutoMigrate(&types.ContractCreation{})
 
 	allKlinesMap := types.GetAllKlineMap()
 	for _, v := range allKlinesMap {
@@ -1151,7 +1154,7 @@ func (orm *ORM) GetOrderList(address, product, side string, open bool, offset, l
 		query = query.Where("status = 0")
 	} else {
 		if hideNoFill {
-			query = query.Where("status in (1, 4, 5)")
+			query = query.Where("status in (1, 4, 5, 8)")
 		} else {
 			query = query.Where("status > 0")
 		}
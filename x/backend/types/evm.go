@@ -0,0 +1,199 @@
+package types
+
+import (
+	"strings"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	evmtypes "github.com/okex/exchain/x/evm/types"
+)
+
+const (
+	// query key
+	QueryEvmTxListV2            = "evmTxsV2"
+	QueryTokenTransferListV2    = "tokenTransfersV2"
+	QueryContractCreationListV2 = "contractCreationsV2"
+
+	// token standard of a Transfer log
+	TokenStandardERC20  = "erc20"
+	TokenStandardERC721 = "erc721"
+
+	// CursorBlockFactor spaces the cursors of two consecutive blocks, the cursor of an evm item
+	// is its block height * CursorBlockFactor + its tx index (or log index) in the block
+	CursorBlockFactor = 1000000
+)
+
+// transferEventID is the topic of Transfer(address,address,uint256), shared by ERC20 and ERC721
+var transferEventID = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+
+// EvmTransaction is an executed evm tx
+type EvmTransaction struct {
+	Cursor          int64  `gorm:"column:cursor_id;index;" json:"cursor"`
+	TxHash          string `gorm:"index;type:varchar(66)" json:"tx_hash"`
+	BlockHeight     int64  `gorm:"index;" json:"block_height"`
+	TxIndex         uint64 `json:"tx_index"`
+	From            string `gorm:"column:from_address;index;type:varchar(42)" json:"from"`
+	To              string `gorm:"column:to_address;index;type:varchar(42)" json:"to"`
+	ContractAddress string `gorm:"type:varchar(42)" json:"contract_address"`
+	Value           string `gorm:"type:varchar(80)" json:"value"`
+	GasPrice        string `gorm:"type:varchar(80)" json:"gas_price"`
+	GasLimit        uint64 `json:"gas_limit"`
+	GasUsed         uint64 `json:"gas_used"`
+	Status          uint64 `json:"status"`
+	Timestamp       int64  `gorm:"index;" json:"timestamp"`
+}
+
+// TokenTransfer is an ERC20 or ERC721 Transfer log emitted by a successful evm tx
+type TokenTransfer struct {
+	Cursor      int64  `gorm:"column:cursor_id;index;" json:"cursor"`
+	TxHash      string `gorm:"index;type:varchar(66)" json:"tx_hash"`
+	BlockHeight int64  `gorm:"index;" json:"block_height"`
+	LogIndex    uint64 `json:"log_index"`
+	Contract    string `gorm:"index;type:varchar(42)" json:"contract"`
+	Standard    string `gorm:"type:varchar(16)" json:"standard"`
+	From        string `gorm:"column:from_address;index;type:varchar(42)" json:"from"`
+	To          string `gorm:"column:to_address;index;type:varchar(42)" json:"to"`
+	// Value is the amount of an ERC20 transfer or the token id of an ERC721 transfer
+	Value     string `gorm:"type:varchar(80)" json:"value"`
+	Timestamp int64  `gorm:"index;" json:"timestamp"`
+}
+
+// ContractCreation is a contract deployed by an evm tx without recipient
+type ContractCreation struct {
+	Cursor      int64  `gorm:"column:cursor_id;index;" json:"cursor"`
+	TxHash      string `gorm:"index;type:varchar(66)" json:"tx_hash"`
+	BlockHeight int64  `gorm:"index;" json:"block_height"`
+	Contract    string `gorm:"index;type:varchar(42)" json:"contract"`
+	Creator     string `gorm:"index;type:varchar(42)" json:"creator"`
+	Timestamp   int64  `gorm:"index;" json:"timestamp"`
+}
+
+// EvmInfo is everything indexed from one evm tx
+type EvmInfo struct {
+	Tx        *EvmTransaction
+	Transfers []*TokenTransfer
+	Creation  *ContractCreation
+}
+
+type QueryEvmTxListParamsV2 struct {
+	Address string
+	After   string
+	Before  string
+	Limit   int
+}
+
+type QueryTokenTransferListParamsV2 struct {
+	Address  string
+	Contract string
+	Standard string
+	After    string
+	Before   string
+	Limit    int
+}
+
+type QueryContractCreationListParamsV2 struct {
+	Address string
+	After   string
+	Before  string
+	Limit   int
+}
+
+// GenerateEvmInfo builds the rows indexed from an executed evm tx, called at DeliverTx
+func GenerateEvmInfo(ctx sdk.Context, msg evmtypes.MsgEthereumTx, from ethcmn.Address, txHash ethcmn.Hash, txIndex uint64,
+	status uint64, result *evmtypes.ResultData, gasUsed uint64) *EvmInfo {
+	height := ctx.BlockHeight()
+	timestamp := ctx.BlockTime().Unix()
+	info := &EvmInfo{}
+
+	tx := &EvmTransaction{
+		Cursor:      height*CursorBlockFactor + int64(txIndex),
+		TxHash:      txHash.Hex(),
+		BlockHeight: height,
+		TxIndex:     txIndex,
+		From:        FormatEvmAddress(from),
+		GasLimit:    msg.Data.GasLimit,
+		GasUsed:     gasUsed,
+		Status:      status,
+		Timestamp:   timestamp,
+	}
+	if msg.Data.Recipient != nil {
+		tx.To = FormatEvmAddress(*msg.Data.Recipient)
+	}
+	if msg.Data.Amount != nil {
+		tx.Value = msg.Data.Amount.String()
+	}
+	if msg.Data.Price != nil {
+		tx.GasPrice = msg.Data.EffectiveGasPrice(nil).String()
+	}
+	info.Tx = tx
+
+	if status != ethtypes.ReceiptStatusSuccessful || result == nil {
+		return info
+	}
+
+	if msg.Data.Recipient == nil {
+		tx.ContractAddress = FormatEvmAddress(result.ContractAddress)
+		info.Creation = &ContractCreation{
+			Cursor:      tx.Cursor,
+			TxHash:      tx.TxHash,
+			BlockHeight: height,
+			Contract:    tx.ContractAddress,
+			Creator:     tx.From,
+			Timestamp:   timestamp,
+		}
+	}
+
+	for _, log := range result.Logs {
+		if transfer := parseTransferLog(log); transfer != nil {
+			transfer.Cursor = height*CursorBlockFactor + int64(log.Index)
+			transfer.TxHash = tx.TxHash
+			transfer.BlockHeight = height
+			transfer.LogIndex = uint64(log.Index)
+			transfer.Timestamp = timestamp
+			info.Transfers = append(info.Transfers, transfer)
+		}
+	}
+	return info
+}
+
+// parseTransferLog decodes a Transfer log, ERC20 indexes from and to with the amount in data,
+// ERC721 indexes the token id as well
+func parseTransferLog(log *ethtypes.Log) *TokenTransfer {
+	if log == nil || len(log.Topics) == 0 || log.Topics[0] != transferEventID {
+		return nil
+	}
+
+	transfer := &TokenTransfer{Contract: FormatEvmAddress(log.Address)}
+	switch {
+	case len(log.Topics) == 3 && len(log.Data) == 32:
+		transfer.Standard = TokenStandardERC20
+		transfer.Value = ethcmn.BytesToHash(log.Data).Big().String()
+	case len(log.Topics) == 4 && len(log.Data) == 0:
+		transfer.Standard = TokenStandardERC721
+		transfer.Value = log.Topics[3].Big().String()
+	default:
+		return nil
+	}
+	transfer.From = FormatEvmAddress(ethcmn.BytesToAddress(log.Topics[1].Bytes()))
+	transfer.To = FormatEvmAddress(ethcmn.BytesToAddress(log.Topics[2].Bytes()))
+	return transfer
+}
+
+// FormatEvmAddress returns the lower case hex address stored in the evm tables
+func FormatEvmAddress(addr ethcmn.Address) string {
+	return strings.ToLower(addr.Hex())
+}
+
+// ParseEvmAddress accepts a hex or a bech32 address and returns it in the format of FormatEvmAddress
+func ParseEvmAddress(addr string) (string, error) {
+	if ethcmn.IsHexAddress(addr) {
+		return FormatEvmAddress(ethcmn.HexToAddress(addr)), nil
+	}
+	accAddr, err := sdk.AccAddressFromBech32(addr)
+	if err != nil {
+		return "", err
+	}
+	return FormatEvmAddress(ethcmn.BytesToAddress(accAddr.Bytes())), nil
+}
@@ -0,0 +1,94 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	"github.com/okex/exchain/libs/tendermint/libs/log"
+	evmtypes "github.com/okex/exchain/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateEvmInfo(t *testing.T) {
+	ctx := sdk.NewContext(nil, abci.Header{Height: 10, Time: time.Unix(1000, 0)}, false, log.NewNopLogger())
+	from := ethcmn.HexToAddress("0x00000000000000000000000000000000000000a1")
+	to := ethcmn.HexToAddress("0x00000000000000000000000000000000000000b2")
+	token := ethcmn.HexToAddress("0x00000000000000000000000000000000000000c3")
+	txHash := ethcmn.HexToHash("0x01")
+
+	erc20Log := &ethtypes.Log{
+		Address: token,
+		Topics:  []ethcmn.Hash{transferEventID, from.Hash(), to.Hash()},
+		Data:    ethcmn.BigToHash(big.NewInt(500)).Bytes(),
+		Index:   7,
+	}
+	erc721Log := &ethtypes.Log{
+		Address: token,
+		Topics:  []ethcmn.Hash{transferEventID, from.Hash(), to.Hash(), ethcmn.BigToHash(big.NewInt(42))},
+		Index:   8,
+	}
+	otherLog := &ethtypes.Log{
+		Address: token,
+		Topics:  []ethcmn.Hash{ethcmn.HexToHash("0xff"), from.Hash()},
+		Index:   9,
+	}
+	result := &evmtypes.ResultData{Logs: []*ethtypes.Log{erc20Log, erc721Log, otherLog}}
+
+	// call
+	msg := evmtypes.NewMsgEthereumTx(0, &token, big.NewInt(3), 100000, big.NewInt(1000000000), nil)
+	info := GenerateEvmInfo(ctx, msg, from, txHash, 2, ethtypes.ReceiptStatusSuccessful, result, 50000)
+	require.Equal(t, int64(10*CursorBlockFactor+2), info.Tx.Cursor)
+	require.Equal(t, FormatEvmAddress(from), info.Tx.From)
+	require.Equal(t, FormatEvmAddress(token), info.Tx.To)
+	require.Equal(t, "3", info.Tx.Value)
+	require.Equal(t, "1000000000", info.Tx.GasPrice)
+	require.Equal(t, uint64(50000), info.Tx.GasUsed)
+	require.Equal(t, int64(1000), info.Tx.Timestamp)
+	require.Nil(t, info.Creation)
+	require.Len(t, info.Transfers, 2)
+
+	require.Equal(t, TokenStandardERC20, info.Transfers[0].Standard)
+	require.Equal(t, "500", info.Transfers[0].Value)
+	require.Equal(t, int64(10*CursorBlockFactor+7), info.Transfers[0].Cursor)
+	require.Equal(t, FormatEvmAddress(from), info.Transfers[0].From)
+	require.Equal(t, FormatEvmAddress(to), info.Transfers[0].To)
+	require.Equal(t, FormatEvmAddress(token), info.Transfers[0].Contract)
+	require.Equal(t, TokenStandardERC721, info.Transfers[1].Standard)
+	require.Equal(t, "42", info.Transfers[1].Value)
+
+	// failed txs index no logs
+	info = GenerateEvmInfo(ctx, msg, from, txHash, 2, ethtypes.ReceiptStatusFailed, result, 50000)
+	require.Equal(t, ethtypes.ReceiptStatusFailed, info.Tx.Status)
+	require.Empty(t, info.Transfers)
+
+	// contract creation
+	contract := ethcmn.HexToAddress("0x00000000000000000000000000000000000000d4")
+	msg = evmtypes.NewMsgEthereumTxContract(0, nil, 100000, big.NewInt(1), []byte{0x60})
+	info = GenerateEvmInfo(ctx, msg, from, txHash, 0, ethtypes.ReceiptStatusSuccessful,
+		&evmtypes.ResultData{ContractAddress: contract}, 50000)
+	require.Equal(t, "", info.Tx.To)
+	require.Equal(t, FormatEvmAddress(contract), info.Tx.ContractAddress)
+	require.NotNil(t, info.Creation)
+	require.Equal(t, FormatEvmAddress(contract), info.Creation.Contract)
+	require.Equal(t, FormatEvmAddress(from), info.Creation.Creator)
+}
+
+func TestParseEvmAddress(t *testing.T) {
+	hexAddr := "0x00000000000000000000000000000000000000A1"
+	addr, err := ParseEvmAddress(hexAddr)
+	require.NoError(t, err)
+	require.Equal(t, "0x00000000000000000000000000000000000000a1", addr)
+
+	bech32Addr := sdk.AccAddress(ethcmn.HexToAddress(hexAddr).Bytes()).String()
+	addr, err = ParseEvmAddress(bech32Addr)
+	require.NoError(t, err)
+	require.Equal(t, "0x00000000000000000000000000000000000000a1", addr)
+
+	_, err = ParseEvmAddress("invalid")
+	require.Error(t, err)
+}
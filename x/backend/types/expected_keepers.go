@@ -24,6 +24,7 @@ type OrderKeeper interface {
 	GetBlockMatchResult() *ordertypes.BlockMatchResult
 	GetLastPrice(ctx sdk.Context, product string) sdk.Dec
 	GetBestBidAndAsk(ctx sdk.Context, product string) (sdk.Dec, sdk.Dec)
+	GetParams(ctx sdk.Context) *ordertypes.Params
 }
 
 // TokenKeeper expected token keeper
@@ -39,6 +40,7 @@ type DexKeeper interface {
 	GetTokenPairs(ctx sdk.Context) []*dextypes.TokenPair
 	GetTokenPair(ctx sdk.Context, product string) *dextypes.TokenPair
 	SetObserverKeeper(keeper dex.StreamKeeper)
+	GetProductFeeSchedule(ctx sdk.Context, product string) (feeSchedule dextypes.FeeSchedule, found bool)
 }
 
 // MarketKeeper expected market keeper which would get data from pulsar & redis
@@ -54,6 +56,7 @@ type SwapKeeper interface {
 	GetParams(ctx sdk.Context) (params ammswap.Params)
 	GetPoolTokenAmount(ctx sdk.Context, poolTokenName string) sdk.Dec
 	SetObserverKeeper(k ammswaptypes.BackendKeeper)
+	GetSwapPairFeeInfo(ctx sdk.Context, tokenPairName string) ammswaptypes.SwapPairFeeInfo
 }
 
 // FarmKeeper expected farm keeper
@@ -21,6 +21,9 @@ const (
 	QueryTickerList    = "tickers"
 	QueryDexFeesList   = "dexFees"
 
+	// fee schedules of dex operators
+	QueryDexFeeSchedules = "dexFeeSchedules"
+
 	// v2
 	QueryTickerListV2   = "tickerListV2"
 	QueryTickerV2       = "tickerV2"
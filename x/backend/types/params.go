@@ -210,3 +210,23 @@ func NewQueryDexFeesParams(dexHandlingAddr, baseAsset, quoteAsset string, page,
 		PerPage:         perPage,
 	}
 }
+
+// QueryDexFeeSchedulesParams defines query params of the fee schedules of products
+type QueryDexFeeSchedulesParams struct {
+	Product string
+	Page    int
+	PerPage int
+}
+
+// NewQueryDexFeeSchedulesParams creates a new instance of QueryDexFeeSchedulesParams
+func NewQueryDexFeeSchedulesParams(product string, page, perPage int) QueryDexFeeSchedulesParams {
+	if page == 0 && perPage == 0 {
+		page = DefaultPage
+		perPage = DefaultPerPage
+	}
+	return QueryDexFeeSchedulesParams{
+		Product: product,
+		Page:    page,
+		PerPage: perPage,
+	}
+}
@@ -56,12 +56,15 @@ type SwapVolumePriceInfo struct {
 }
 
 type SwapWatchlist struct {
-	SwapPair  string  `json:"swap_pair"`
-	Liquidity sdk.Dec `json:"liquidity"`
-	Volume24h sdk.Dec `json:"volume24h"`
-	FeeApy    sdk.Dec `json:"fee_apy"`
-	LastPrice sdk.Dec `json:"last_price"`
-	Change24h sdk.Dec `json:"change24h"`
+	SwapPair      string       `json:"swap_pair"`
+	Liquidity     sdk.Dec      `json:"liquidity"`
+	Volume24h     sdk.Dec      `json:"volume24h"`
+	FeeApy        sdk.Dec      `json:"fee_apy"`
+	LastPrice     sdk.Dec      `json:"last_price"`
+	Change24h     sdk.Dec      `json:"change24h"`
+	FeeRate       sdk.Dec      `json:"fee_rate"`
+	LiquidityFees sdk.SysCoins `json:"liquidity_fees"`
+	ProtocolFees  sdk.SysCoins `json:"protocol_fees"`
 }
 
 type SwapWatchlistSorter struct {
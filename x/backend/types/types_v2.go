@@ -173,3 +173,13 @@ type DexFees struct {
 	Fee             string `json:"fee"`
 	HandlingFeeAddr string `json:"handling_fee_addr"`
 }
+
+// DexFeeSchedule is the fee schedule charged on the deals of a product. Without a fee schedule set by the dex
+// operator, both the maker and the taker fee rates are the trade fee rate of the order params
+type DexFeeSchedule struct {
+	Product         string        `json:"product"`
+	MakerFeeRate    sdk.Dec       `json:"maker_fee_rate"`
+	TakerFeeRate    sdk.Dec       `json:"taker_fee_rate"`
+	Tiers           []dex.FeeTier `json:"tiers"`
+	OperatorDefined bool          `json:"operator_defined"`
+}
@@ -52,6 +52,8 @@ type (
 	WithdrawInfos = types.WithdrawInfos
 	DEXOperator   = types.DEXOperator
 	DEXOperators  = types.DEXOperators
+	FeeSchedule   = types.FeeSchedule
+	FeeTier       = types.FeeTier
 )
 
 var (
@@ -67,6 +69,8 @@ var (
 	NewMsgList     = types.NewMsgList
 	NewMsgDeposit  = types.NewMsgDeposit
 	NewMsgWithdraw = types.NewMsgWithdraw
+	NewFeeSchedule = types.NewFeeSchedule
+	NewFeeTier     = types.NewFeeTier
 
 	ErrTokenPairNotFound   = types.ErrTokenPairNotFound
 )
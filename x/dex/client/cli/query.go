@@ -29,6 +29,7 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdQueryProductsUnderDelisting(queryRoute, cdc),
 		GetCmdQueryOperator(queryRoute, cdc),
 		GetCmdQueryOperators(queryRoute, cdc),
+		GetCmdQueryFeeSchedule(queryRoute, cdc),
 	)...)
 
 	return queryCmd
@@ -233,6 +234,33 @@ func GetCmdQueryOperators(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryFeeSchedule queries the fee schedule of a product
+func GetCmdQueryFeeSchedule(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-schedule [product]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the fee schedule charged on the deals of a product by its operator",
+		RunE: func(_ *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, err := cliCtx.Codec.MarshalJSON(types.NewQueryDexFeeScheduleParams(args[0]))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryFeeSchedule), bz)
+			if err != nil {
+				return err
+			}
+			var feeSchedule types.ProductFeeSchedule
+			cdc.MustUnmarshalJSON(res, &feeSchedule)
+			return cliCtx.PrintOutput(feeSchedule)
+		},
+	}
+
+	return cmd
+}
+
 // Strings is just for the object of []string could be inputted into cliCtx.PrintOutput(...)
 type Strings []string
 
@@ -28,11 +28,16 @@ const (
 	FlagBaseAsset          = "base-asset"
 	FlagQuoteAsset         = "quote-asset"
 	FlagInitPrice          = "init-price"
+	FlagContinuousAuction  = "continuous-auction"
 	FlagProduct            = "product"
 	FlagFrom               = "from"
 	FlagTo                 = "to"
 	FlagWebsite            = "website"
 	FlagHandlingFeeAddress = "handling-fee-address"
+	FlagMakerFeeRate       = "maker-fee-rate"
+	FlagTakerFeeRate       = "taker-fee-rate"
+	FlagFeeTiers           = "fee-tiers"
+	FlagClearFeeSchedule   = "clear-fee-schedule"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -91,8 +96,13 @@ $ exchaincli tx dex list --base-asset mytoken --quote-asset okt --from mykey
 				return err
 			}
 			initPrice := sdk.MustNewDecFromStr(strInitPrice)
+			continuousAuction, err := flags.GetBool(FlagContinuousAuction)
+			if err != nil {
+				return err
+			}
 			owner := cliCtx.GetFromAddress()
 			listMsg := types.NewMsgList(owner, baseAsset, quoteAsset, initPrice)
+			listMsg.ContinuousAuction = continuousAuction
 			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{listMsg})
 		},
 	}
@@ -100,6 +110,7 @@ $ exchaincli tx dex list --base-asset mytoken --quote-asset okt --from mykey
 	cmd.Flags().StringP(FlagBaseAsset, "", "", FlagBaseAsset+" should be issued before listed to opendex")
 	cmd.Flags().StringP(FlagQuoteAsset, "", common.NativeToken, FlagQuoteAsset+" should be issued before listed to opendex")
 	cmd.Flags().StringP(FlagInitPrice, "", "0.01", FlagInitPrice+" should be valid price")
+	cmd.Flags().Bool(FlagContinuousAuction, false, "match the orders of the trading pair continuously instead of in periodic auctions")
 
 	return cmd
 }
@@ -299,6 +310,11 @@ func getCmdRegisterOperator(cdc *codec.Codec) *cobra.Command {
 		Long: strings.TrimSpace(`Register a dex operator:
 
 $ exchaincli tx dex register-operator --website http://xxx/operator.json --handling-fee-address addr --from mykey
+
+Set the maker and taker fee rates charged on the deals of the products of the operator, with optional volume tiers:
+
+$ exchaincli tx dex register-operator --handling-fee-address addr --maker-fee-rate 0.001 --taker-fee-rate 0.002 \
+	--fee-tiers 10000:0.0008:0.0015,100000:0.0005:0.001 --from mykey
 `),
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
@@ -322,14 +338,19 @@ $ exchaincli tx dex register-operator --website http://xxx/operator.json --handl
 			if err != nil {
 				return sdk.ErrInvalidAddress(fmt.Sprintf("invalid address：%s", feeAddrStr))
 			}
+			feeSchedule, err := getFeeSchedule(cmd)
+			if err != nil {
+				return err
+			}
 			owner := cliCtx.GetFromAddress()
-			operatorMsg := types.NewMsgCreateOperator(website, owner, feeAddr)
+			operatorMsg := types.NewMsgCreateOperator(website, owner, feeAddr, feeSchedule)
 			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{operatorMsg})
 		},
 	}
 
 	cmd.Flags().String(FlagWebsite, "", `A valid http link to describe DEXOperator which ends with "operator.json" defined in OIP-{xxx}，and its length should be less than 1024`)
 	cmd.Flags().String(FlagHandlingFeeAddress, "", "An address to receive fees of tokenpair's matched order")
+	addFeeScheduleFlags(cmd)
 
 	return cmd
 }
@@ -342,6 +363,9 @@ func getCmdEditOperator(cdc *codec.Codec) *cobra.Command {
 		Long: strings.TrimSpace(`Edit a dex operator:
 
 $ exchaincli tx dex edit-operator --website http://xxx/operator.json --handling-fee-address addr --from mykey
+
+The fee schedule is replaced as a whole, editing without fee rates keeps the current one, which is removed by
+--clear-fee-schedule to fall back to the trade fee rate of the order params.
 `),
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
@@ -365,14 +389,48 @@ $ exchaincli tx dex edit-operator --website http://xxx/operator.json --handling-
 			if err != nil {
 				return sdk.ErrInvalidAddress(fmt.Sprintf("invalid address：%s", feeAddrStr))
 			}
+			feeSchedule, err := getFeeSchedule(cmd)
+			if err != nil {
+				return err
+			}
+			clearFeeSchedule, err := flags.GetBool(FlagClearFeeSchedule)
+			if err != nil {
+				return err
+			}
 			owner := cliCtx.GetFromAddress()
-			operatorMsg := types.NewMsgUpdateOperator(website, owner, feeAddr)
+			operatorMsg := types.NewMsgUpdateOperator(website, owner, feeAddr, feeSchedule)
+			operatorMsg.ClearFeeSchedule = clearFeeSchedule
 			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{operatorMsg})
 		},
 	}
 
 	cmd.Flags().String(FlagWebsite, "", `A valid http link to describe DEXOperator which ends with "operator.json" defined in OIP-{xxx}，and its length should be less than 1024`)
 	cmd.Flags().String(FlagHandlingFeeAddress, "", "An address to receive fees of tokenpair's matched order")
+	addFeeScheduleFlags(cmd)
+	cmd.Flags().Bool(FlagClearFeeSchedule, false, "Remove the fee schedule, so that the trade fee rate of the order params applies")
 
 	return cmd
 }
+
+func addFeeScheduleFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagMakerFeeRate, "", "The fee rate charged on the deals of maker orders, the trade fee rate of the order params applies if no fee rates are set")
+	cmd.Flags().String(FlagTakerFeeRate, "", "The fee rate charged on the deals of taker orders")
+	cmd.Flags().String(FlagFeeTiers, "", "Comma separated volume tiers of min-volume:maker-fee-rate:taker-fee-rate, the volume is denominated in the quote asset")
+}
+
+func getFeeSchedule(cmd *cobra.Command) (*types.FeeSchedule, error) {
+	flags := cmd.Flags()
+	makerFeeRate, err := flags.GetString(FlagMakerFeeRate)
+	if err != nil {
+		return nil, err
+	}
+	takerFeeRate, err := flags.GetString(FlagTakerFeeRate)
+	if err != nil {
+		return nil, err
+	}
+	tiers, err := flags.GetString(FlagFeeTiers)
+	if err != nil {
+		return nil, err
+	}
+	return dexUtils.ParseFeeSchedule(makerFeeRate, takerFeeRate, tiers)
+}
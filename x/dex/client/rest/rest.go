@@ -24,6 +24,7 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/dex/product_rank", matchOrderHandler(cliCtx)).Methods("GET")
 	r.HandleFunc("/dexoperator/{address}", operatorHandler(cliCtx)).Methods("GET")
 	r.HandleFunc("/dexoperators", operatorsHandler(cliCtx)).Methods("GET")
+	r.HandleFunc("/dex/fee_schedule/{product}", feeScheduleHandler(cliCtx)).Methods("GET")
 }
 
 func productsHandler(cliContext context.CLIContext) func(http.ResponseWriter, *http.Request) {
@@ -184,6 +185,28 @@ func operatorsHandler(cliContext context.CLIContext) func(http.ResponseWriter, *
 	}
 }
 
+func feeScheduleHandler(cliContext context.CLIContext) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		params := types.NewQueryDexFeeScheduleParams(mux.Vars(r)["product"])
+		bz := cliContext.Codec.MustMarshalJSON(&params)
+		res, _, err := cliContext.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryFeeSchedule), bz)
+		if err != nil {
+			sdkErr := common.ParseSDKError(err.Error())
+			common.HandleErrorMsg(w, cliContext, sdkErr.Code, sdkErr.Message)
+			return
+		}
+
+		result := common.GetBaseResponse("hello")
+		result2, err2 := json.Marshal(result)
+		if err2 != nil {
+			common.HandleErrorMsg(w, cliContext, common.CodeMarshalJSONFailed, err2.Error())
+			return
+		}
+		result2 = []byte(strings.Replace(string(result2), "\"hello\"", string(res), 1))
+		rest.PostProcessResponse(w, cliContext, result2)
+	}
+}
+
 // DelistProposalRESTHandler defines dex proposal handler
 func DelistProposalRESTHandler(context.CLIContext) govRest.ProposalRESTHandler {
 	return govRest.ProposalRESTHandler{}
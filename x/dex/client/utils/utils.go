@@ -1,10 +1,13 @@
 package utils
 
 import (
+	"fmt"
 	"io/ioutil"
+	"strings"
 
 	"github.com/okex/exchain/libs/cosmos-sdk/codec"
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/dex/types"
 )
 
 // DelistProposalJSON defines a DelistProposal with a deposit used
@@ -30,3 +33,41 @@ func ParseDelistProposalJSON(cdc *codec.Codec, proposalFilePath string) (proposa
 
 	return proposal, nil
 }
+
+// ParseFeeSchedule parses the fee schedule of a dex operator from the maker and taker fee rates and the tiers
+// like "10000:0.0008:0.001,100000:0.0005:0.0008" of min volume, maker fee rate and taker fee rate. No fee schedule
+// is returned if all of them are empty.
+func ParseFeeSchedule(makerFeeRateStr, takerFeeRateStr, tiersStr string) (*types.FeeSchedule, error) {
+	if makerFeeRateStr == "" && takerFeeRateStr == "" && tiersStr == "" {
+		return nil, nil
+	}
+
+	makerFeeRate, err := sdk.NewDecFromStr(makerFeeRateStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid maker fee rate %s: %s", makerFeeRateStr, err)
+	}
+	takerFeeRate, err := sdk.NewDecFromStr(takerFeeRateStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid taker fee rate %s: %s", takerFeeRateStr, err)
+	}
+
+	var tiers []types.FeeTier
+	if tiersStr = strings.TrimSpace(tiersStr); tiersStr != "" {
+		for _, tierStr := range strings.Split(tiersStr, ",") {
+			fields := strings.Split(strings.TrimSpace(tierStr), ":")
+			if len(fields) != 3 {
+				return nil, fmt.Errorf("invalid fee tier %s, expected like 10000:0.0008:0.001", tierStr)
+			}
+			decs := make([]sdk.Dec, len(fields))
+			for i, field := range fields {
+				if decs[i], err = sdk.NewDecFromStr(field); err != nil {
+					return nil, fmt.Errorf("invalid fee tier %s: %s", tierStr, err)
+				}
+			}
+			tiers = append(tiers, types.NewFeeTier(decs[0], decs[1], decs[2]))
+		}
+	}
+
+	feeSchedule := types.NewFeeSchedule(makerFeeRate, takerFeeRate, tiers)
+	return &feeSchedule, nil
+}
@@ -85,16 +85,17 @@ func handleMsgList(ctx sdk.Context, keeper IKeeper, msg MsgList, logger log.Logg
 	}
 
 	tokenPair := &TokenPair{
-		BaseAssetSymbol:  msg.ListAsset,
-		QuoteAssetSymbol: msg.QuoteAsset,
-		InitPrice:        msg.InitPrice,
-		MaxPriceDigit:    int64(DefaultMaxPriceDigitSize),
-		MaxQuantityDigit: int64(DefaultMaxQuantityDigitSize),
-		MinQuantity:      sdk.MustNewDecFromStr("0.00000001"),
-		Owner:            msg.Owner,
-		Delisting:        false,
-		Deposits:         DefaultTokenPairDeposit,
-		BlockHeight:      ctx.BlockHeight(),
+		BaseAssetSymbol:   msg.ListAsset,
+		QuoteAssetSymbol:  msg.QuoteAsset,
+		InitPrice:         msg.InitPrice,
+		MaxPriceDigit:     int64(DefaultMaxPriceDigitSize),
+		MaxQuantityDigit:  int64(DefaultMaxQuantityDigitSize),
+		MinQuantity:       sdk.MustNewDecFromStr("0.00000001"),
+		Owner:             msg.Owner,
+		Delisting:         false,
+		Deposits:          DefaultTokenPairDeposit,
+		BlockHeight:       ctx.BlockHeight(),
+		ContinuousAuction: msg.ContinuousAuction,
 	}
 
 	// check whether a specific token pair exists with the symbols of base asset and quote asset
@@ -276,12 +277,16 @@ func handleMsgCreateOperator(ctx sdk.Context, keeper IKeeper, msg MsgCreateOpera
 	if _, isExist := keeper.GetOperator(ctx, msg.Owner); isExist {
 		return types.ErrExistOperator(msg.Owner).Result()
 	}
+	if err := checkFeeScheduleLimits(ctx, keeper, msg.FeeSchedule); err != nil {
+		return nil, err
+	}
 	operator := types.DEXOperator{
 		Address:            msg.Owner,
 		HandlingFeeAddress: msg.HandlingFeeAddress,
 		Website:            msg.Website,
 		InitHeight:         ctx.BlockHeight(),
 		TxHash:             fmt.Sprintf("%X", tmhash.Sum(ctx.TxBytes())),
+		FeeSchedule:        msg.FeeSchedule,
 	}
 	keeper.SetOperator(ctx, operator)
 
@@ -314,9 +319,19 @@ func handleMsgUpdateOperator(ctx sdk.Context, keeper IKeeper, msg MsgUpdateOpera
 	if !operator.Address.Equals(msg.Owner) {
 		return types.ErrUnauthorizedOperator(operator.Address.String(), msg.Owner.String()).Result()
 	}
+	if err := checkFeeScheduleLimits(ctx, keeper, msg.FeeSchedule); err != nil {
+		return nil, err
+	}
 
 	operator.HandlingFeeAddress = msg.HandlingFeeAddress
 	operator.Website = msg.Website
+	// an update without fee schedule keeps the current one, which is only removed explicitly to fall back to the
+	// trade fee rate of the order params
+	if msg.ClearFeeSchedule {
+		operator.FeeSchedule = nil
+	} else if msg.FeeSchedule != nil {
+		operator.FeeSchedule = msg.FeeSchedule
+	}
 
 	keeper.SetOperator(ctx, operator)
 
@@ -329,3 +344,12 @@ func handleMsgUpdateOperator(ctx sdk.Context, keeper IKeeper, msg MsgUpdateOpera
 
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
+
+// checkFeeScheduleLimits checks the fee schedule of a dex operator against the max fee rates of the params
+func checkFeeScheduleLimits(ctx sdk.Context, keeper IKeeper, feeSchedule *types.FeeSchedule) sdk.Error {
+	if feeSchedule == nil {
+		return nil
+	}
+	params := keeper.GetParams(ctx)
+	return feeSchedule.CheckLimits(params.MaxMakerFeeRate, params.MaxTakerFeeRate)
+}
@@ -161,3 +161,51 @@ func TestHandler_handleMsgTransferOwnership(t *testing.T) {
 	spKeeper.behaveEvil = false
 	handlerFunctor(ctx, msgFailedConfirmOwnership)
 }
+
+func TestHandler_handleMsgOperatorFeeSchedule(t *testing.T) {
+	mApp, _, spKeeper, _, ctx := getMockTestCaseEvn(t)
+	spKeeper.behaveEvil = false
+	address := mApp.GenesisAccounts[0].GetAddress()
+	params := mApp.dexKeeper.GetParams(ctx)
+
+	// fail case : failed to create operator because the taker fee rate exceeds the max one
+	overLimit := types.NewFeeSchedule(params.MaxMakerFeeRate, params.MaxTakerFeeRate.Add(sdk.NewDecWithPrec(1, 4)), nil)
+	msgCreate := types.NewMsgCreateOperator("", address, address, &overLimit)
+	_, err := handleMsgCreateOperator(ctx, mApp.dexKeeper, msgCreate, ctx.Logger())
+	require.NotNil(t, err)
+	_, found := mApp.dexKeeper.GetOperator(ctx, address)
+	require.False(t, found)
+
+	// successful case
+	feeSchedule := types.NewFeeSchedule(sdk.ZeroDec(), params.MaxTakerFeeRate, nil)
+	msgCreate = types.NewMsgCreateOperator("", address, address, &feeSchedule)
+	_, err = handleMsgCreateOperator(ctx, mApp.dexKeeper, msgCreate, ctx.Logger())
+	require.Nil(t, err)
+	operator, found := mApp.dexKeeper.GetOperator(ctx, address)
+	require.True(t, found)
+	require.Equal(t, &feeSchedule, operator.FeeSchedule)
+
+	// fail case : failed to update operator because the maker fee rate exceeds the max one
+	overLimit = types.NewFeeSchedule(params.MaxMakerFeeRate.Add(sdk.NewDecWithPrec(1, 4)), sdk.ZeroDec(), nil)
+	msgUpdate := types.NewMsgUpdateOperator("", address, address, &overLimit)
+	_, err = handleMsgUpdateOperator(ctx, mApp.dexKeeper, msgUpdate, ctx.Logger())
+	require.NotNil(t, err)
+
+	// successful case : the fee schedule is kept by an update without it
+	msgUpdate = types.NewMsgUpdateOperator("http://xxx/operator.json", address, address, nil)
+	_, err = handleMsgUpdateOperator(ctx, mApp.dexKeeper, msgUpdate, ctx.Logger())
+	require.Nil(t, err)
+	operator, found = mApp.dexKeeper.GetOperator(ctx, address)
+	require.True(t, found)
+	require.Equal(t, "http://xxx/operator.json", operator.Website)
+	require.Equal(t, &feeSchedule, operator.FeeSchedule)
+
+	// successful case : the fee schedule is removed explicitly
+	msgUpdate = types.NewMsgUpdateOperator("", address, address, nil)
+	msgUpdate.ClearFeeSchedule = true
+	_, err = handleMsgUpdateOperator(ctx, mApp.dexKeeper, msgUpdate, ctx.Logger())
+	require.Nil(t, err)
+	operator, found = mApp.dexKeeper.GetOperator(ctx, address)
+	require.True(t, found)
+	require.Nil(t, operator.FeeSchedule)
+}
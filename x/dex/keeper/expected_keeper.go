@@ -54,6 +54,7 @@ type IKeeper interface {
 	SetOperator(ctx sdk.Context, operator types.DEXOperator)
 	GetOperator(ctx sdk.Context, addr sdk.AccAddress) (operator types.DEXOperator, isExist bool)
 	IterateOperators(ctx sdk.Context, cb func(operator types.DEXOperator) (stop bool))
+	GetProductFeeSchedule(ctx sdk.Context, product string) (feeSchedule types.FeeSchedule, found bool)
 	GetMaxTokenPairID(ctx sdk.Context) (tokenPairMaxID uint64)
 	SetMaxTokenPairID(ctx sdk.Context, tokenPairMaxID uint64)
 	GetConfirmOwnership(ctx sdk.Context, product string) (confirmOwnership *types.ConfirmOwnership, exist bool)
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
 	"sort"
 	"time"
@@ -310,7 +311,17 @@ func (k Keeper) SortProducts(ctx sdk.Context, products []string) {
 
 // GetParams gets inflation params from the global param store
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.GetParamSubspace().GetParamSet(ctx, &params)
+	// the max fee rates are added after genesis, so the default ones are used on a chain started before
+	// until they are set by a param change proposal
+	defaultParams := types.DefaultParams()
+	params.MaxMakerFeeRate, params.MaxTakerFeeRate = defaultParams.MaxMakerFeeRate, defaultParams.MaxTakerFeeRate
+	for _, pair := range params.ParamSetPairs() {
+		if bytes.Equal(pair.Key, types.KeyMaxMakerFeeRate) || bytes.Equal(pair.Key, types.KeyMaxTakerFeeRate) {
+			k.GetParamSubspace().GetIfExists(ctx, pair.Key, pair.Value)
+			continue
+		}
+		k.GetParamSubspace().Get(ctx, pair.Key, pair.Value)
+	}
 	return params
 }
 
@@ -459,6 +470,22 @@ func (k Keeper) SetOperator(ctx sdk.Context, operator types.DEXOperator) {
 	store.Set(key, bytes)
 }
 
+// GetProductFeeSchedule gets the fee schedule charged on the deals of a product by the DEXOperator owning it.
+// The rates returned never exceed the max ones of the current params.
+func (k Keeper) GetProductFeeSchedule(ctx sdk.Context, product string) (feeSchedule types.FeeSchedule, found bool) {
+	tokenPair := k.GetTokenPair(ctx, product)
+	if tokenPair == nil {
+		return feeSchedule, false
+	}
+	operator, isExist := k.GetOperator(ctx, tokenPair.Owner)
+	if !isExist || operator.FeeSchedule == nil {
+		return feeSchedule, false
+	}
+
+	params := k.GetParams(ctx)
+	return operator.FeeSchedule.CapRates(params.MaxMakerFeeRate, params.MaxTakerFeeRate), true
+}
+
 // SetMaxTokenPairID sets the max ID of token pair
 func (k Keeper) SetMaxTokenPairID(ctx sdk.Context, MaxtokenPairID uint64) {
 	store := ctx.KVStore(k.tokenPairStoreKey)
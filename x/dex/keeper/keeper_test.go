@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"testing"
 	"time"
 
@@ -407,3 +408,29 @@ func TestKeeper_CheckTokenPairUnderDexDelist(t *testing.T) {
 	require.Equal(t, isDelisting, tokenPair.Delisting)
 
 }
+
+func TestGetParamsWithoutMaxFeeRates(t *testing.T) {
+	testInput := createTestInput(t)
+	ctx := testInput.Ctx
+	keeper := testInput.DexKeeper
+
+	// set the params of a chain started before the max fee rates are added
+	defaultParams := types.DefaultParams()
+	for _, pair := range defaultParams.ParamSetPairs() {
+		if bytes.Equal(pair.Key, types.KeyMaxMakerFeeRate) || bytes.Equal(pair.Key, types.KeyMaxTakerFeeRate) {
+			continue
+		}
+		keeper.GetParamSubspace().Set(ctx, pair.Key, pair.Value)
+	}
+
+	var params types.Params
+	require.NotPanics(t, func() { params = keeper.GetParams(ctx) })
+	require.Equal(t, defaultParams.ListFee, params.ListFee)
+	require.Equal(t, defaultParams.MaxMakerFeeRate, params.MaxMakerFeeRate)
+	require.Equal(t, defaultParams.MaxTakerFeeRate, params.MaxTakerFeeRate)
+
+	// the max fee rates set later are read as usual
+	params.MaxMakerFeeRate = sdk.MustNewDecFromStr("0.002")
+	keeper.SetParams(ctx, params)
+	require.Equal(t, params.MaxMakerFeeRate, keeper.GetParams(ctx).MaxMakerFeeRate)
+}
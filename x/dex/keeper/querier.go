@@ -32,6 +32,8 @@ func NewQuerier(keeper IKeeper) sdk.Querier {
 			return queryOperator(ctx, req, keeper)
 		case types.QueryOperators:
 			return queryOperators(ctx, keeper)
+		case types.QueryFeeSchedule:
+			return queryFeeSchedule(ctx, req, keeper)
 		default:
 			return nil, types.ErrDexUnknownQueryType()
 		}
@@ -275,3 +277,25 @@ func queryOperators(ctx sdk.Context, keeper IKeeper) ([]byte, sdk.Error) {
 	}
 	return bz, nil
 }
+
+func queryFeeSchedule(ctx sdk.Context, req abci.RequestQuery, keeper IKeeper) ([]byte, sdk.Error) {
+	var params types.QueryDexFeeScheduleParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, common.ErrUnMarshalJSONFailed(err.Error())
+	}
+
+	if keeper.GetTokenPair(ctx, params.Product) == nil {
+		return nil, types.ErrTokenPairNotFound(params.Product)
+	}
+	productFeeSchedule := types.ProductFeeSchedule{Product: params.Product}
+	if feeSchedule, found := keeper.GetProductFeeSchedule(ctx, params.Product); found {
+		productFeeSchedule.FeeSchedule = &feeSchedule
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, productFeeSchedule)
+	if err != nil {
+		return nil, common.ErrMarshalJSONFailed(err.Error())
+	}
+	return bz, nil
+}
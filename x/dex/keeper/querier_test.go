@@ -206,3 +206,51 @@ func TestQueryParam(t *testing.T) {
 		})
 	}
 }
+
+func TestQuerier_QueryFeeSchedule(t *testing.T) {
+	testInput := createTestInputWithBalance(t, 1, 10000)
+	ctx := testInput.Ctx
+	testInput.DexKeeper.SetParams(ctx, *types.DefaultParams())
+	querier := NewQuerier(testInput.DexKeeper)
+
+	tokenPair := GetBuiltInTokenPair()
+	require.Nil(t, testInput.DexKeeper.SaveTokenPair(ctx, tokenPair))
+	operator := types.DEXOperator{
+		Address:            tokenPair.Owner,
+		HandlingFeeAddress: tokenPair.Owner,
+	}
+	testInput.DexKeeper.SetOperator(ctx, operator)
+
+	query := func(product string) (types.ProductFeeSchedule, sdk.Error) {
+		bz, err := amino.MarshalJSON(types.NewQueryDexFeeScheduleParams(product))
+		require.Nil(t, err)
+		var productFeeSchedule types.ProductFeeSchedule
+		res, sdkErr := querier(ctx, []string{types.QueryFeeSchedule}, abci.RequestQuery{Data: bz})
+		if sdkErr == nil {
+			require.Nil(t, types.ModuleCdc.UnmarshalJSON(res, &productFeeSchedule))
+		}
+		return productFeeSchedule, sdkErr
+	}
+
+	// unknown product
+	_, err := query("unknown_" + common.NativeToken)
+	require.NotNil(t, err)
+
+	// no fee schedule set by the operator
+	productFeeSchedule, err := query(tokenPair.Name())
+	require.Nil(t, err)
+	require.Nil(t, productFeeSchedule.FeeSchedule)
+
+	// the rates over the limits lowered by governance are capped
+	feeSchedule := types.NewFeeSchedule(sdk.MustNewDecFromStr("0.001"), sdk.MustNewDecFromStr("0.005"), nil)
+	operator.FeeSchedule = &feeSchedule
+	testInput.DexKeeper.SetOperator(ctx, operator)
+	params := types.DefaultParams()
+	params.MaxTakerFeeRate = sdk.MustNewDecFromStr("0.003")
+	testInput.DexKeeper.SetParams(ctx, *params)
+	productFeeSchedule, err = query(tokenPair.Name())
+	require.Nil(t, err)
+	require.NotNil(t, productFeeSchedule.FeeSchedule)
+	require.Equal(t, sdk.MustNewDecFromStr("0.001"), productFeeSchedule.FeeSchedule.MakerFeeRate)
+	require.Equal(t, sdk.MustNewDecFromStr("0.003"), productFeeSchedule.FeeSchedule.TakerFeeRate)
+}
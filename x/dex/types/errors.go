@@ -43,6 +43,8 @@ const (
 	CodeIsTransferringOwner         uint32 = 64031
 	CodeTransferOwnerExpired        uint32 = 64032
 	CodeUnauthorizedOperator        uint32 = 64033
+	CodeInvalidFeeSchedule          uint32 = 64034
+	CodeFeeRateExceedsLimit         uint32 = 64035
 )
 
 // Addr and Product All Required
@@ -186,3 +188,11 @@ func ErrIsTransferOwnerExpired(time string) sdk.EnvelopedErr {
 func ErrUnauthorizedOperator(operator, owner string) sdk.EnvelopedErr {
 	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeUnauthorizedOperator, fmt.Sprintf("%s is not the owner of operator(%s)", owner, operator))}
 }
+
+func ErrInvalidFeeSchedule(msg string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInvalidFeeSchedule, fmt.Sprintf("invalid fee schedule: %s", msg))}
+}
+
+func ErrFeeRateExceedsLimit(rateType string, rate, limit sdk.Dec) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeFeeRateExceedsLimit, fmt.Sprintf("%s fee rate %s exceeds the max %s fee rate %s", rateType, rate, rateType, limit))}
+}
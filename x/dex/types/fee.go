@@ -1,12 +1,156 @@
 package types
 
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
 const (
 	defaultFeeList              = "20000"
 	defaultFeeTransferOwnership = "10"
 	defaultDelistMinDeposit     = "100"
+	defaultMaxMakerFeeRate      = "0.01"
+	defaultMaxTakerFeeRate      = "0.01"
 
 	// DefaultMaxPriceDigitSize defines default max price digit size
 	DefaultMaxPriceDigitSize = 4
 	// DefaultMaxQuantityDigitSize defines default max quantity digit size
 	DefaultMaxQuantityDigitSize = 4
+
+	// MaxFeeTiers defines the max number of volume tiers of a fee schedule
+	MaxFeeTiers = 10
 )
+
+// FeeTier defines the fee rates applied to the deals of an address once its trading volume on a product,
+// denominated in the quote asset, reaches MinVolume
+type FeeTier struct {
+	MinVolume    sdk.Dec `json:"min_volume"`
+	MakerFeeRate sdk.Dec `json:"maker_fee_rate"`
+	TakerFeeRate sdk.Dec `json:"taker_fee_rate"`
+}
+
+// NewFeeTier creates a new instance of FeeTier
+func NewFeeTier(minVolume, makerFeeRate, takerFeeRate sdk.Dec) FeeTier {
+	return FeeTier{
+		MinVolume:    minVolume,
+		MakerFeeRate: makerFeeRate,
+		TakerFeeRate: takerFeeRate,
+	}
+}
+
+// String returns a human readable string representation of FeeTier
+func (ft FeeTier) String() string {
+	return fmt.Sprintf("volume>=%s maker:%s taker:%s", ft.MinVolume, ft.MakerFeeRate, ft.TakerFeeRate)
+}
+
+// FeeSchedule defines the maker and taker fee rates charged on the deals of the products of a DEXOperator,
+// which replace the trade fee rate of the order params. The rates of the highest tier reached by the trading
+// volume of an address take the place of the base ones.
+type FeeSchedule struct {
+	MakerFeeRate sdk.Dec   `json:"maker_fee_rate"`
+	TakerFeeRate sdk.Dec   `json:"taker_fee_rate"`
+	Tiers        []FeeTier `json:"tiers"`
+}
+
+// NewFeeSchedule creates a new instance of FeeSchedule
+func NewFeeSchedule(makerFeeRate, takerFeeRate sdk.Dec, tiers []FeeTier) FeeSchedule {
+	return FeeSchedule{
+		MakerFeeRate: makerFeeRate,
+		TakerFeeRate: takerFeeRate,
+		Tiers:        tiers,
+	}
+}
+
+// String returns a human readable string representation of FeeSchedule
+func (fs FeeSchedule) String() string {
+	tiers := make([]string, len(fs.Tiers))
+	for i, tier := range fs.Tiers {
+		tiers[i] = tier.String()
+	}
+	return fmt.Sprintf("maker:%s taker:%s tiers:[%s]", fs.MakerFeeRate, fs.TakerFeeRate, strings.Join(tiers, ", "))
+}
+
+// ValidateBasic checks that all the rates are in [0, 1] and the tiers are sorted by strictly increasing
+// positive volumes
+func (fs FeeSchedule) ValidateBasic() sdk.Error {
+	if err := validateFeeRate("maker fee rate", fs.MakerFeeRate); err != nil {
+		return err
+	}
+	if err := validateFeeRate("taker fee rate", fs.TakerFeeRate); err != nil {
+		return err
+	}
+	if len(fs.Tiers) > MaxFeeTiers {
+		return ErrInvalidFeeSchedule(fmt.Sprintf("%d tiers exceed the max number %d", len(fs.Tiers), MaxFeeTiers))
+	}
+
+	lastVolume := sdk.ZeroDec()
+	for _, tier := range fs.Tiers {
+		if tier.MinVolume.IsNil() || tier.MinVolume.LTE(lastVolume) {
+			return ErrInvalidFeeSchedule("min volumes of tiers must be positive and strictly increasing")
+		}
+		if err := validateFeeRate("maker fee rate of tier", tier.MakerFeeRate); err != nil {
+			return err
+		}
+		if err := validateFeeRate("taker fee rate of tier", tier.TakerFeeRate); err != nil {
+			return err
+		}
+		lastVolume = tier.MinVolume
+	}
+	return nil
+}
+
+func validateFeeRate(rateType string, rate sdk.Dec) sdk.Error {
+	if rate.IsNil() || rate.IsNegative() || rate.GT(sdk.OneDec()) {
+		return ErrInvalidFeeSchedule(fmt.Sprintf("%s should be in [0, 1]: %s", rateType, rate))
+	}
+	return nil
+}
+
+// CheckLimits checks that no rate of the fee schedule exceeds the max ones allowed by governance
+func (fs FeeSchedule) CheckLimits(maxMakerFeeRate, maxTakerFeeRate sdk.Dec) sdk.Error {
+	if fs.MakerFeeRate.GT(maxMakerFeeRate) {
+		return ErrFeeRateExceedsLimit("maker", fs.MakerFeeRate, maxMakerFeeRate)
+	}
+	if fs.TakerFeeRate.GT(maxTakerFeeRate) {
+		return ErrFeeRateExceedsLimit("taker", fs.TakerFeeRate, maxTakerFeeRate)
+	}
+	for _, tier := range fs.Tiers {
+		if tier.MakerFeeRate.GT(maxMakerFeeRate) {
+			return ErrFeeRateExceedsLimit("maker", tier.MakerFeeRate, maxMakerFeeRate)
+		}
+		if tier.TakerFeeRate.GT(maxTakerFeeRate) {
+			return ErrFeeRateExceedsLimit("taker", tier.TakerFeeRate, maxTakerFeeRate)
+		}
+	}
+	return nil
+}
+
+// CapRates returns a copy of the fee schedule whose rates are lowered to the max ones if exceeding them.
+// The limits may be lowered by governance after an operator sets its schedule.
+func (fs FeeSchedule) CapRates(maxMakerFeeRate, maxTakerFeeRate sdk.Dec) FeeSchedule {
+	capped := NewFeeSchedule(sdk.MinDec(fs.MakerFeeRate, maxMakerFeeRate), sdk.MinDec(fs.TakerFeeRate, maxTakerFeeRate),
+		make([]FeeTier, len(fs.Tiers)))
+	for i, tier := range fs.Tiers {
+		capped.Tiers[i] = NewFeeTier(tier.MinVolume, sdk.MinDec(tier.MakerFeeRate, maxMakerFeeRate),
+			sdk.MinDec(tier.TakerFeeRate, maxTakerFeeRate))
+	}
+	return capped
+}
+
+// GetFeeRate returns the maker or taker fee rate applied to an address with the trading volume
+func (fs FeeSchedule) GetFeeRate(isMaker bool, volume sdk.Dec) sdk.Dec {
+	makerFeeRate, takerFeeRate := fs.MakerFeeRate, fs.TakerFeeRate
+	for _, tier := range fs.Tiers {
+		if volume.LT(tier.MinVolume) {
+			break
+		}
+		makerFeeRate, takerFeeRate = tier.MakerFeeRate, tier.TakerFeeRate
+	}
+
+	if isMaker {
+		return makerFeeRate
+	}
+	return takerFeeRate
+}
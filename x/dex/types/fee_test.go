@@ -0,0 +1,91 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeeSchedule_ValidateBasic(t *testing.T) {
+	rate := sdk.MustNewDecFromStr("0.001")
+	tests := []struct {
+		name        string
+		feeSchedule FeeSchedule
+		result      bool
+	}{
+		{"no-tiers", NewFeeSchedule(rate, rate, nil), true},
+		{"zero-rates", NewFeeSchedule(sdk.ZeroDec(), sdk.ZeroDec(), nil), true},
+		{"with-tiers", NewFeeSchedule(rate, rate, []FeeTier{
+			NewFeeTier(sdk.NewDec(100), rate, rate),
+			NewFeeTier(sdk.NewDec(1000), sdk.ZeroDec(), rate),
+		}), true},
+		{"nil-rate", FeeSchedule{MakerFeeRate: rate}, false},
+		{"negative-rate", NewFeeSchedule(rate.Neg(), rate, nil), false},
+		{"rate-greater-than-one", NewFeeSchedule(rate, sdk.NewDec(2), nil), false},
+		{"zero-volume-tier", NewFeeSchedule(rate, rate, []FeeTier{NewFeeTier(sdk.ZeroDec(), rate, rate)}), false},
+		{"unsorted-tiers", NewFeeSchedule(rate, rate, []FeeTier{
+			NewFeeTier(sdk.NewDec(1000), rate, rate),
+			NewFeeTier(sdk.NewDec(100), rate, rate),
+		}), false},
+		{"negative-tier-rate", NewFeeSchedule(rate, rate, []FeeTier{NewFeeTier(sdk.NewDec(100), rate.Neg(), rate)}),
+			false},
+		{"too-many-tiers", NewFeeSchedule(rate, rate, make([]FeeTier, MaxFeeTiers+1)), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.result, tt.feeSchedule.ValidateBasic() == nil)
+		})
+	}
+}
+
+func TestFeeSchedule_Limits(t *testing.T) {
+	maxRate := sdk.MustNewDecFromStr("0.002")
+	feeSchedule := NewFeeSchedule(sdk.MustNewDecFromStr("0.001"), sdk.MustNewDecFromStr("0.002"), []FeeTier{
+		NewFeeTier(sdk.NewDec(100), sdk.MustNewDecFromStr("0.003"), sdk.MustNewDecFromStr("0.001")),
+	})
+	require.Nil(t, NewFeeSchedule(maxRate, maxRate, nil).CheckLimits(maxRate, maxRate))
+	require.NotNil(t, feeSchedule.CheckLimits(maxRate, maxRate))
+	require.NotNil(t, feeSchedule.CheckLimits(sdk.MustNewDecFromStr("0.0005"), maxRate))
+
+	capped := feeSchedule.CapRates(maxRate, sdk.MustNewDecFromStr("0.0015"))
+	require.Equal(t, sdk.MustNewDecFromStr("0.001"), capped.MakerFeeRate)
+	require.Equal(t, sdk.MustNewDecFromStr("0.0015"), capped.TakerFeeRate)
+	require.Equal(t, maxRate, capped.Tiers[0].MakerFeeRate)
+	require.Equal(t, sdk.MustNewDecFromStr("0.001"), capped.Tiers[0].TakerFeeRate)
+	// the original schedule is left untouched
+	require.Equal(t, sdk.MustNewDecFromStr("0.003"), feeSchedule.Tiers[0].MakerFeeRate)
+}
+
+func TestFeeSchedule_GetFeeRate(t *testing.T) {
+	feeSchedule := NewFeeSchedule(sdk.MustNewDecFromStr("0.002"), sdk.MustNewDecFromStr("0.003"), []FeeTier{
+		NewFeeTier(sdk.NewDec(100), sdk.MustNewDecFromStr("0.001"), sdk.MustNewDecFromStr("0.002")),
+		NewFeeTier(sdk.NewDec(1000), sdk.ZeroDec(), sdk.MustNewDecFromStr("0.001")),
+	})
+	tests := []struct {
+		volume int64
+		maker  string
+		taker  string
+	}{
+		{0, "0.002", "0.003"},
+		{99, "0.002", "0.003"},
+		{100, "0.001", "0.002"},
+		{999, "0.001", "0.002"},
+		{1000, "0", "0.001"},
+		{100000, "0", "0.001"},
+	}
+	for _, tt := range tests {
+		require.Equal(t, sdk.MustNewDecFromStr(tt.maker), feeSchedule.GetFeeRate(true, sdk.NewDec(tt.volume)))
+		require.Equal(t, sdk.MustNewDecFromStr(tt.taker), feeSchedule.GetFeeRate(false, sdk.NewDec(tt.volume)))
+	}
+}
+
+func TestParams_ValidateMaxFeeRate(t *testing.T) {
+	validateFn := validateMaxFeeRate("max maker fee rate")
+	require.NoError(t, validateFn(sdk.ZeroDec()))
+	require.NoError(t, validateFn(sdk.OneDec()))
+	require.Error(t, validateFn(sdk.NewDec(-1)))
+	require.Error(t, validateFn(sdk.MustNewDecFromStr("1.01")))
+	require.Error(t, validateFn(sdk.Dec{}))
+	require.Error(t, validateFn("0.01"))
+}
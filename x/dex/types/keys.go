@@ -37,6 +37,8 @@ const (
 	QueryOperator = "operator"
 	// QueryOperators defines operators query route path
 	QueryOperators = "operators"
+	// QueryFeeSchedule defines fee schedule query route path
+	QueryFeeSchedule = "fee_schedule"
 )
 
 var (
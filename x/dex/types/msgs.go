@@ -22,6 +22,8 @@ type MsgList struct {
 	ListAsset  string         `json:"list_asset"`  //  Symbol of asset listed on Dex.
 	QuoteAsset string         `json:"quote_asset"` //  Symbol of asset quoted by asset listed on Dex.
 	InitPrice  sdk.Dec        `json:"init_price"`
+	// ContinuousAuction is omitted from the sign bytes when unset to stay compatible with old clients
+	ContinuousAuction bool `json:"continuous_auction,omitempty"`
 }
 
 // NewMsgList creates a new MsgList
@@ -243,14 +245,17 @@ type MsgCreateOperator struct {
 	Owner              sdk.AccAddress `json:"owner"`
 	Website            string         `json:"website"`
 	HandlingFeeAddress sdk.AccAddress `json:"handling_fee_address"`
+	// FeeSchedule is omitted from the sign bytes when unset to stay compatible with old clients
+	FeeSchedule *FeeSchedule `json:"fee_schedule,omitempty"`
 }
 
 // NewMsgCreateOperator creates a new MsgCreateOperator
-func NewMsgCreateOperator(website string, owner, handlingFeeAddress sdk.AccAddress) MsgCreateOperator {
+func NewMsgCreateOperator(website string, owner, handlingFeeAddress sdk.AccAddress,
+	feeSchedule *FeeSchedule) MsgCreateOperator {
 	if handlingFeeAddress.Empty() {
 		handlingFeeAddress = owner
 	}
-	return MsgCreateOperator{owner, strings.TrimSpace(website), handlingFeeAddress}
+	return MsgCreateOperator{owner, strings.TrimSpace(website), handlingFeeAddress, feeSchedule}
 }
 
 // Route Implements Msg
@@ -267,6 +272,11 @@ func (msg MsgCreateOperator) ValidateBasic() sdk.Error {
 	if msg.HandlingFeeAddress.Empty() {
 		return ErrAddressIsRequired("handling fee")
 	}
+	if msg.FeeSchedule != nil {
+		if err := msg.FeeSchedule.ValidateBasic(); err != nil {
+			return err
+		}
+	}
 	return checkWebsite(msg.Website)
 }
 
@@ -284,19 +294,30 @@ func (msg MsgCreateOperator) GetSigners() []sdk.AccAddress {
 // MsgUpdateOperator register a new DEXOperator or update it
 // Addr represent an DEXOperator
 // if DEXOperator not exist, register a new DEXOperator
-// else update Website or HandlingFeeAddress
+// else update Website, HandlingFeeAddress or FeeSchedule
 type MsgUpdateOperator struct {
 	Owner              sdk.AccAddress `json:"owner"`
 	Website            string         `json:"website"`
 	HandlingFeeAddress sdk.AccAddress `json:"handling_fee_address"`
+	// FeeSchedule is omitted from the sign bytes when unset to stay compatible with old clients, and the current
+	// fee schedule is kept then
+	FeeSchedule *FeeSchedule `json:"fee_schedule,omitempty"`
+	// ClearFeeSchedule removes the current fee schedule, so that the trade fee rate of the order params applies
+	ClearFeeSchedule bool `json:"clear_fee_schedule,omitempty"`
 }
 
 // NewMsgUpdateOperator creates a new MsgUpdateOperator
-func NewMsgUpdateOperator(website string, owner, handlingFeeAddress sdk.AccAddress) MsgUpdateOperator {
+func NewMsgUpdateOperator(website string, owner, handlingFeeAddress sdk.AccAddress,
+	feeSchedule *FeeSchedule) MsgUpdateOperator {
 	if handlingFeeAddress.Empty() {
 		handlingFeeAddress = owner
 	}
-	return MsgUpdateOperator{owner, strings.TrimSpace(website), handlingFeeAddress}
+	return MsgUpdateOperator{
+		Owner:              owner,
+		Website:            strings.TrimSpace(website),
+		HandlingFeeAddress: handlingFeeAddress,
+		FeeSchedule:        feeSchedule,
+	}
 }
 
 // Route Implements Msg
@@ -310,6 +331,14 @@ func (msg MsgUpdateOperator) ValidateBasic() sdk.Error {
 	if msg.HandlingFeeAddress.Empty() {
 		return ErrAddressIsRequired("handling fee")
 	}
+	if msg.FeeSchedule != nil {
+		if msg.ClearFeeSchedule {
+			return ErrInvalidFeeSchedule("a fee schedule can't be set and cleared at the same time")
+		}
+		if err := msg.FeeSchedule.ValidateBasic(); err != nil {
+			return err
+		}
+	}
 	return checkWebsite(msg.Website)
 }
 
@@ -14,6 +14,9 @@ type DEXOperator struct {
 	Website            string         `json:"website"`
 	InitHeight         int64          `json:"init_height"`
 	TxHash             string         `json:"tx_hash"`
+	// FeeSchedule replaces the trade fee rate of the order params on the deals of the products of the operator
+	// when set. It's appended last to keep the amino encoding of stored operators.
+	FeeSchedule *FeeSchedule `json:"fee_schedule,omitempty"`
 }
 
 // nolint
@@ -23,12 +26,20 @@ func (o DEXOperator) String() string {
   Handling Fee Address: %s
   Website:              %s
   Init Height:          %d
-  TxHash:               %s`,
+  TxHash:               %s
+  Fee Schedule:         %s`,
 		o.Address, o.HandlingFeeAddress, o.Website,
-		o.InitHeight, o.TxHash,
+		o.InitHeight, o.TxHash, o.feeScheduleString(),
 	)
 }
 
+func (o DEXOperator) feeScheduleString() string {
+	if o.FeeSchedule == nil {
+		return "default"
+	}
+	return o.FeeSchedule.String()
+}
+
 type DEXOperators []DEXOperator
 
 func (o DEXOperators) String() string {
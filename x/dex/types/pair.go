@@ -23,6 +23,9 @@ type TokenPair struct {
 	Owner            sdk.AccAddress `json:"owner"`
 	Deposits         sdk.SysCoin    `json:"deposits"`
 	BlockHeight      int64          `json:"block_height"`
+	// ContinuousAuction makes the order module match the pair with the continuous auction engine
+	// instead of the periodic one. It's appended last to keep the amino encoding of stored pairs.
+	ContinuousAuction bool `json:"continuous_auction"`
 }
 
 // Name returns name of token pair
@@ -7,6 +7,7 @@ import (
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 	"github.com/okex/exchain/x/common"
 	"github.com/okex/exchain/x/params"
+	"github.com/okex/exchain/x/params/subspace"
 )
 
 var (
@@ -18,6 +19,8 @@ var (
 	keyDelistVotingPeriod     = []byte("DelistVotingPeriod")
 	keyWithdrawPeriod         = []byte("WithdrawPeriod")
 	keyOwnershipConfirmWindow = []byte("OwnershipConfirmWindow")
+	KeyMaxMakerFeeRate        = []byte("MaxMakerFeeRate")
+	KeyMaxTakerFeeRate        = []byte("MaxTakerFeeRate")
 )
 
 // Params defines param object
@@ -35,6 +38,10 @@ type Params struct {
 
 	WithdrawPeriod         time.Duration `json:"withdraw_period"`
 	OwnershipConfirmWindow time.Duration `json:"ownership_confirm_window"`
+
+	//  max maker and taker fee rates allowed in the fee schedules of dex operators
+	MaxMakerFeeRate sdk.Dec `json:"max_maker_fee_rate"`
+	MaxTakerFeeRate sdk.Dec `json:"max_taker_fee_rate"`
 }
 
 // ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
@@ -48,6 +55,21 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		{Key: keyDelistVotingPeriod, Value: &p.DelistVotingPeriod, ValidatorFn: common.ValidateDurationPositive("delist voting period")},
 		{Key: keyWithdrawPeriod, Value: &p.WithdrawPeriod, ValidatorFn: common.ValidateDurationPositive("withdraw period")},
 		{Key: keyOwnershipConfirmWindow, Value: &p.OwnershipConfirmWindow, ValidatorFn: common.ValidateDurationPositive("ownership confirm window")},
+		{Key: KeyMaxMakerFeeRate, Value: &p.MaxMakerFeeRate, ValidatorFn: validateMaxFeeRate("max maker fee rate")},
+		{Key: KeyMaxTakerFeeRate, Value: &p.MaxTakerFeeRate, ValidatorFn: validateMaxFeeRate("max taker fee rate")},
+	}
+}
+
+func validateMaxFeeRate(rateType string) subspace.ValueValidatorFn {
+	return func(value interface{}) error {
+		v, ok := value.(sdk.Dec)
+		if !ok {
+			return fmt.Errorf("invalid parameter type of %s: %T", rateType, value)
+		}
+		if err := validateFeeRate(rateType, v); err != nil {
+			return err
+		}
+		return nil
 	}
 }
 
@@ -70,12 +92,16 @@ func DefaultParams() *Params {
 		DelistVotingPeriod:     time.Hour * 72,
 		WithdrawPeriod:         DefaultWithdrawPeriod,
 		OwnershipConfirmWindow: DefaultOwnershipConfirmWindow,
+		MaxMakerFeeRate:        sdk.MustNewDecFromStr(defaultMaxMakerFeeRate),
+		MaxTakerFeeRate:        sdk.MustNewDecFromStr(defaultMaxTakerFeeRate),
 	}
 }
 
 // String implements the stringer interface.
 func (p Params) String() string {
 	return fmt.Sprintf("Params: \nDexListFee:%s\nTransferOwnershipFee:%s\nRegisterOperatorFee:%s\nDelistMaxDepositPeriod:%s\n"+
-		"DelistMinDeposit:%s\nDelistVotingPeriod:%s\nWithdrawPeriod:%d\nOwnershipConfirmWindow: %s\n",
-		p.ListFee, p.TransferOwnershipFee, p.RegisterOperatorFee, p.DelistMaxDepositPeriod, p.DelistMinDeposit, p.DelistVotingPeriod, p.WithdrawPeriod, p.OwnershipConfirmWindow)
+		"DelistMinDeposit:%s\nDelistVotingPeriod:%s\nWithdrawPeriod:%d\nOwnershipConfirmWindow: %s\n"+
+		"MaxMakerFeeRate:%s\nMaxTakerFeeRate:%s\n",
+		p.ListFee, p.TransferOwnershipFee, p.RegisterOperatorFee, p.DelistMaxDepositPeriod, p.DelistMinDeposit, p.DelistVotingPeriod, p.WithdrawPeriod, p.OwnershipConfirmWindow,
+		p.MaxMakerFeeRate, p.MaxTakerFeeRate)
 }
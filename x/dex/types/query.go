@@ -42,6 +42,25 @@ func NewQueryDexOperatorParams(addr sdk.AccAddress) QueryDexOperatorParams {
 	}
 }
 
+// QueryDexFeeScheduleParams defines query params of the fee schedule of a product
+type QueryDexFeeScheduleParams struct {
+	Product string
+}
+
+// NewQueryDexFeeScheduleParams creates a new instance of QueryDexFeeScheduleParams
+func NewQueryDexFeeScheduleParams(product string) QueryDexFeeScheduleParams {
+	return QueryDexFeeScheduleParams{
+		Product: product,
+	}
+}
+
+// ProductFeeSchedule is the fee schedule charged on the deals of a product by its DEXOperator,
+// the trade fee rate of the order params applies when FeeSchedule is nil
+type ProductFeeSchedule struct {
+	Product     string       `json:"product"`
+	FeeSchedule *FeeSchedule `json:"fee_schedule"`
+}
+
 // nolint
 type QueryDepositParams struct {
 	Address    string
@@ -6,6 +6,7 @@ import (
 
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 	"github.com/okex/exchain/x/distribution/keeper"
+	"github.com/okex/exchain/x/distribution/types"
 )
 
 // BeginBlocker set the proposer for determining distribution during endblock
@@ -26,6 +27,12 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper)
 		k.AllocateTokens(ctx, previousTotalPower, previousProposer, req.LastCommitInfo.GetVotes())
 	}
 
+	// restake the rewards of the delegators who opt in from the end of every epoch, a bounded number of them per
+	// block, and an epoch ending during the restaking doesn't restart it
+	if k.IsEndOfEpoch(ctx) || k.IsRestaking(ctx) {
+		k.RestakeDelegatorRewards(ctx, types.MaxRestakeDelegatorsPerBlock)
+	}
+
 	// record the proposer for when we payout on the next block
 	consAddr := sdk.ConsAddress(req.Header.ProposerAddress)
 	k.SetPreviousProposerConsAddr(ctx, consAddr)
@@ -13,15 +13,18 @@ import (
 )
 
 const (
-	ModuleName                  = types.ModuleName
-	StoreKey                    = types.StoreKey
-	RouterKey                   = types.RouterKey
-	QuerierRoute                = types.QuerierRoute
-	QueryParams                 = types.QueryParams
-	QueryValidatorCommission    = types.QueryValidatorCommission
-	QueryWithdrawAddr           = types.QueryWithdrawAddr
-	ParamWithdrawAddrEnabled    = types.ParamWithdrawAddrEnabled
-	DefaultParamspace           = types.DefaultParamspace
+	ModuleName                       = types.ModuleName
+	StoreKey                         = types.StoreKey
+	RouterKey                        = types.RouterKey
+	QuerierRoute                     = types.QuerierRoute
+	QueryParams                      = types.QueryParams
+	QueryValidatorCommission         = types.QueryValidatorCommission
+	QueryValidatorOutstandingRewards = types.QueryValidatorOutstandingRewards
+	QueryDelegationRewards           = types.QueryDelegationRewards
+	QueryDelegatorTotalRewards       = types.QueryDelegatorTotalRewards
+	QueryWithdrawAddr                = types.QueryWithdrawAddr
+	ParamWithdrawAddrEnabled         = types.ParamWithdrawAddrEnabled
+	DefaultParamspace                = types.DefaultParamspace
 )
 
 var (
@@ -43,6 +46,9 @@ var (
 	ValidateGenesis                          = types.ValidateGenesis
 	NewMsgSetWithdrawAddress                 = types.NewMsgSetWithdrawAddress
 	NewMsgWithdrawValidatorCommission        = types.NewMsgWithdrawValidatorCommission
+	NewMsgWithdrawDelegatorReward            = types.NewMsgWithdrawDelegatorReward
+	NewQueryDelegationRewardsParams          = types.NewQueryDelegationRewardsParams
+	NewQueryDelegatorParams                  = types.NewQueryDelegatorParams
 	NewQueryValidatorCommissionParams        = types.NewQueryValidatorCommissionParams
 	NewQueryDelegatorWithdrawAddrParams      = types.NewQueryDelegatorWithdrawAddrParams
 	InitialValidatorAccumulatedCommission    = types.InitialValidatorAccumulatedCommission
@@ -56,6 +62,8 @@ var (
 	EventTypeSetWithdrawAddress          = types.EventTypeSetWithdrawAddress
 	EventTypeCommission                  = types.EventTypeCommission
 	EventTypeWithdrawCommission          = types.EventTypeWithdrawCommission
+	EventTypeRewards                     = types.EventTypeRewards
+	EventTypeWithdrawRewards             = types.EventTypeWithdrawRewards
 	EventTypeProposerReward              = types.EventTypeProposerReward
 	AttributeKeyWithdrawAddress          = types.AttributeKeyWithdrawAddress
 	AttributeKeyValidator                = types.AttributeKeyValidator
@@ -71,6 +79,9 @@ type (
 	GenesisState                         = types.GenesisState
 	MsgSetWithdrawAddress                = types.MsgSetWithdrawAddress
 	MsgWithdrawValidatorCommission       = types.MsgWithdrawValidatorCommission
+	MsgWithdrawDelegatorReward           = types.MsgWithdrawDelegatorReward
+	DelegatorStartingInfo                = types.DelegatorStartingInfo
+	DelegationDelegatorReward            = types.DelegationDelegatorReward
 	QueryValidatorCommissionParams       = types.QueryValidatorCommissionParams
 	QueryDelegatorWithdrawAddrParams     = types.QueryDelegatorWithdrawAddrParams
 	ValidatorAccumulatedCommission       = types.ValidatorAccumulatedCommission
@@ -30,7 +30,9 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 
 	distQueryCmd.AddCommand(flags.GetCommands(
 		GetCmdQueryParams(queryRoute, cdc),
+		GetCmdQueryValidatorOutstandingRewards(queryRoute, cdc),
 		GetCmdQueryValidatorCommission(queryRoute, cdc),
+		GetCmdQueryDelegatorRewards(queryRoute, cdc),
 		GetCmdQueryCommunityPool(queryRoute, cdc),
 	)...)
 
@@ -91,6 +93,101 @@ $ %s query distr commission exvaloper1alq9na49n9yycysh889rl90g9nhe58lcqkfpfg
 	}
 }
 
+// GetCmdQueryValidatorOutstandingRewards implements the query validator outstanding rewards command.
+func GetCmdQueryValidatorOutstandingRewards(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "outstanding-rewards [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query distribution outstanding (un-withdrawn) delegator rewards for a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the rewards not withdrawn yet by the delegators who added shares to a validator.
+
+Example:
+$ %s query distr outstanding-rewards exvaloper1alq9na49n9yycysh889rl90g9nhe58lcqkfpfg
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			validatorAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := common.QueryValidatorOutstandingRewards(cliCtx, queryRoute, validatorAddr)
+			if err != nil {
+				return err
+			}
+
+			var outstandingRewards types.ValidatorOutstandingRewards
+			if err := cdc.UnmarshalJSON(res, &outstandingRewards); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(outstandingRewards)
+		},
+	}
+}
+
+// GetCmdQueryDelegatorRewards implements the query delegator rewards command.
+func GetCmdQueryDelegatorRewards(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "rewards [delegator-addr] [validator-addr]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Query all distribution delegator rewards or rewards from a particular validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all rewards earned by a delegator on the shares it added, optionally restrict to rewards
+from a single validator. The rewards of a delegator bound to a proxy are its part of the rewards on the shares of
+the proxy.
+
+Example:
+$ %s query distr rewards ex1cftp8q8g4aa65nw9s5trwexe77d9t6cr8ndu02
+$ %s query distr rewards ex1cftp8q8g4aa65nw9s5trwexe77d9t6cr8ndu02 exvaloper1alq9na49n9yycysh889rl90g9nhe58lcqkfpfg
+`,
+				version.ClientName, version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			delAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			if len(args) == 2 {
+				valAddr, err := sdk.ValAddressFromBech32(args[1])
+				if err != nil {
+					return err
+				}
+
+				res, _, err := common.QueryDelegationRewards(cliCtx, queryRoute, delAddr, valAddr)
+				if err != nil {
+					return err
+				}
+
+				var result sdk.SysCoins
+				if err := cdc.UnmarshalJSON(res, &result); err != nil {
+					return fmt.Errorf("failed to unmarshal response: %w", err)
+				}
+				return cliCtx.PrintOutput(result)
+			}
+
+			res, _, err := common.QueryDelegatorTotalRewards(cliCtx, queryRoute, delAddr)
+			if err != nil {
+				return err
+			}
+
+			var result types.QueryDelegatorTotalRewardsResponse
+			if err := cdc.UnmarshalJSON(res, &result); err != nil {
+				return fmt.Errorf("failed to unmarshal response: %w", err)
+			}
+			return cliCtx.PrintOutput(result)
+		},
+	}
+}
+
 // GetCmdQueryCommunityPool returns the command for fetching community pool info
 func GetCmdQueryCommunityPool(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
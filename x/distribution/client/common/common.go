@@ -40,6 +40,34 @@ func QueryValidatorCommission(cliCtx context.CLIContext, queryRoute string, vali
 	return res, err
 }
 
+// QueryValidatorOutstandingRewards returns the outstanding delegator rewards of a validator.
+func QueryValidatorOutstandingRewards(cliCtx context.CLIContext, queryRoute string, validatorAddr sdk.ValAddress) (
+	[]byte, error) {
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryValidatorOutstandingRewards),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryValidatorOutstandingRewardsParams(validatorAddr)),
+	)
+	return res, err
+}
+
+// QueryDelegationRewards returns the rewards of a delegator on a validator.
+func QueryDelegationRewards(cliCtx context.CLIContext, queryRoute string, delegatorAddr sdk.AccAddress,
+	validatorAddr sdk.ValAddress) ([]byte, int64, error) {
+	return cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryDelegationRewards),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryDelegationRewardsParams(delegatorAddr, validatorAddr)),
+	)
+}
+
+// QueryDelegatorTotalRewards returns the rewards of a delegator on all the validators its shares are added to.
+func QueryDelegatorTotalRewards(cliCtx context.CLIContext, queryRoute string, delegatorAddr sdk.AccAddress) (
+	[]byte, int64, error) {
+	return cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryDelegatorTotalRewards),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryDelegatorParams(delegatorAddr)),
+	)
+}
+
 // WithdrawValidatorRewardsAndCommission builds a two-message message slice to be
 // used to withdraw both validation's commission and self-delegation reward.
 func WithdrawValidatorRewardsAndCommission(validatorAddr sdk.ValAddress) ([]sdk.Msg, error) {
@@ -0,0 +1,221 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+
+	"github.com/okex/exchain/x/distribution/types"
+	"github.com/okex/exchain/x/staking/exported"
+)
+
+// delegatorRewards pairs a delegator with its part of the rewards accumulated on the shares of a delegation
+type delegatorRewards struct {
+	delAddr sdk.AccAddress
+	rewards sdk.SysCoins
+}
+
+// initialize starting info for the shares added by a delegator to a validator
+func (k Keeper) initializeDelegation(ctx sdk.Context, valAddr sdk.ValAddress, delAddr sdk.AccAddress) {
+	shares, found := k.stakingKeeper.GetShares(ctx, delAddr, valAddr)
+	if !found {
+		return
+	}
+
+	// period has already been incremented - we want to store the period ended by this delegation action
+	previousPeriod := k.GetValidatorCurrentRewards(ctx, valAddr).Period - 1
+
+	// increment reference count for the period we're going to track
+	k.incrementReferenceCount(ctx, valAddr, previousPeriod)
+
+	k.SetDelegatorStartingInfo(ctx, valAddr, delAddr,
+		types.NewDelegatorStartingInfo(previousPeriod, shares, uint64(ctx.BlockHeight())))
+}
+
+// calculate the rewards accrued by the shares between two periods
+func (k Keeper) calculateDelegationRewardsBetween(ctx sdk.Context, valAddr sdk.ValAddress,
+	startingPeriod, endingPeriod uint64, stake sdk.Dec) (rewards sdk.SysCoins) {
+	// sanity check
+	if startingPeriod > endingPeriod {
+		panic("startingPeriod cannot be greater than endingPeriod")
+	}
+
+	// sanity check
+	if stake.IsNegative() {
+		panic("stake should not be negative")
+	}
+
+	// return staking * (ending - starting)
+	starting := k.GetValidatorHistoricalRewards(ctx, valAddr, startingPeriod)
+	ending := k.GetValidatorHistoricalRewards(ctx, valAddr, endingPeriod)
+	difference := ending.CumulativeRewardRatio.Sub(starting.CumulativeRewardRatio)
+	return difference.MulDecTruncate(stake)
+}
+
+// calculate the total rewards accrued by the shares of a delegation until the ending period
+func (k Keeper) calculateDelegationRewards(ctx sdk.Context, valAddr sdk.ValAddress, delAddr sdk.AccAddress,
+	endingPeriod uint64) sdk.SysCoins {
+	startingInfo := k.GetDelegatorStartingInfo(ctx, valAddr, delAddr)
+	return k.calculateDelegationRewardsBetween(ctx, valAddr, startingInfo.PreviousPeriod, endingPeriod,
+		startingInfo.Stake)
+}
+
+// splitDelegationRewards splits the rewards accumulated on the shares of a delegator between itself and the delegators
+// bound to it by their tokens, since a proxy adds shares with the tokens of its bound delegators as well
+func (k Keeper) splitDelegationRewards(ctx sdk.Context, owner sdk.AccAddress, rewards sdk.SysCoins) []delegatorRewards {
+	boundDelAddrs := k.stakingKeeper.GetDelegatorsByProxy(ctx, owner)
+	if len(boundDelAddrs) == 0 {
+		return []delegatorRewards{{owner, rewards}}
+	}
+
+	totalTokens := delegatorTokens(k.stakingKeeper.Delegator(ctx, owner))
+	boundTokens := make([]sdk.Dec, len(boundDelAddrs))
+	for i, delAddr := range boundDelAddrs {
+		boundTokens[i] = delegatorTokens(k.stakingKeeper.Delegator(ctx, delAddr))
+		totalTokens = totalTokens.Add(boundTokens[i])
+	}
+	if !totalTokens.IsPositive() {
+		return []delegatorRewards{{owner, rewards}}
+	}
+
+	split := make([]delegatorRewards, 0, len(boundDelAddrs)+1)
+	remaining := rewards
+	for i, delAddr := range boundDelAddrs {
+		part := rewards.MulDecTruncate(boundTokens[i]).QuoDecTruncate(totalTokens)
+		remaining = remaining.Sub(part)
+		split = append(split, delegatorRewards{delAddr, part})
+	}
+
+	// the owner takes the rest, including the truncated dust
+	return append(split, delegatorRewards{owner, remaining})
+}
+
+func delegatorTokens(delegator exported.DelegatorI) sdk.Dec {
+	if delegator == nil {
+		return sdk.ZeroDec()
+	}
+	return delegator.GetTokens()
+}
+
+// payDelegatorRewards sends the rewards to the withdraw address of the delegator
+func (k Keeper) payDelegatorRewards(ctx sdk.Context, valAddr sdk.ValAddress, delAddr sdk.AccAddress,
+	rewards sdk.SysCoins) {
+	if !rewards.IsZero() {
+		withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, delAddr)
+		err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, rewards)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawRewards,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, rewards.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+		),
+	)
+}
+
+// withdrawDelegationRewards withdraws the rewards accumulated on the shares of the owner and pays them to the owner and
+// its bound delegators, the starting info of the delegation is removed
+func (k Keeper) withdrawDelegationRewards(ctx sdk.Context, val exported.ValidatorI, owner sdk.AccAddress) []delegatorRewards {
+	valAddr := val.GetOperator()
+	// check existence of delegator starting info
+	if !k.HasDelegatorStartingInfo(ctx, valAddr, owner) {
+		panic(fmt.Sprintf("no starting info of delegator %s on validator %s", owner, valAddr))
+	}
+
+	// end current period and calculate rewards
+	endingPeriod := k.incrementValidatorPeriod(ctx, val)
+	rewardsRaw := k.calculateDelegationRewards(ctx, valAddr, owner, endingPeriod)
+	outstanding := k.GetValidatorOutstandingRewards(ctx, valAddr)
+
+	// defensive edge case may happen on the very final digits
+	// of the decCoins due to operation order of the distribution mechanism.
+	rewards := rewardsRaw.Intersect(outstanding)
+	if !rewards.IsEqual(rewardsRaw) {
+		k.Logger(ctx).Info(fmt.Sprintf("missing rewards rounding error, delegator %v withdrawing rewards %v from "+
+			"validator %v, should have received %v, got %v", owner, rewardsRaw, valAddr, rewardsRaw, rewards))
+	}
+
+	// update the outstanding rewards
+	k.SetValidatorOutstandingRewards(ctx, valAddr, outstanding.Sub(rewards))
+
+	// decrement reference count of starting period
+	startingInfo := k.GetDelegatorStartingInfo(ctx, valAddr, owner)
+	k.decrementReferenceCount(ctx, valAddr, startingInfo.PreviousPeriod)
+
+	// remove delegator starting info
+	k.DeleteDelegatorStartingInfo(ctx, valAddr, owner)
+
+	split := k.splitDelegationRewards(ctx, owner, rewards)
+	for _, part := range split {
+		k.payDelegatorRewards(ctx, valAddr, part.delAddr, part.rewards)
+	}
+
+	return split
+}
+
+// getRewardsOwner returns the address whose shares accumulate the rewards of a delegator, which is the proxy if the
+// delegator has bound one
+func (k Keeper) getRewardsOwner(ctx sdk.Context, delAddr sdk.AccAddress) sdk.AccAddress {
+	delegator := k.stakingKeeper.Delegator(ctx, delAddr)
+	if delegator != nil && delegator.GetProxyAddress() != nil {
+		return delegator.GetProxyAddress()
+	}
+	return delAddr
+}
+
+// WithdrawDelegationRewards withdraws the rewards of a delegator from a validator. For a delegator bound to a proxy,
+// the rewards on the shares of the proxy are withdrawn and split among the proxy and all of its bound delegators
+func (k Keeper) WithdrawDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (
+	sdk.Coins, error) {
+	val := k.stakingKeeper.Validator(ctx, valAddr)
+	if val == nil || !k.HasValidatorCurrentRewards(ctx, valAddr) {
+		return nil, types.ErrEmptyValidatorDistInfo()
+	}
+
+	owner := k.getRewardsOwner(ctx, delAddr)
+	if !k.HasDelegatorStartingInfo(ctx, valAddr, owner) {
+		return nil, types.ErrEmptyDelegationDistInfo()
+	}
+
+	// withdraw rewards
+	split := k.withdrawDelegationRewards(ctx, val, owner)
+
+	// reinitialize the delegation
+	k.initializeDelegation(ctx, valAddr, owner)
+
+	for _, part := range split {
+		if part.delAddr.Equals(delAddr) {
+			return part.rewards, nil
+		}
+	}
+	return sdk.Coins{}, nil
+}
+
+// calculateDelegatorRewards calculates the rewards of a delegator on a validator without withdrawing them, the context
+// passed in is expected to be a cached one since the period of the validator is incremented
+func (k Keeper) calculateDelegatorRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (
+	sdk.SysCoins, error) {
+	val := k.stakingKeeper.Validator(ctx, valAddr)
+	if val == nil || !k.HasValidatorCurrentRewards(ctx, valAddr) {
+		return nil, types.ErrEmptyValidatorDistInfo()
+	}
+
+	owner := k.getRewardsOwner(ctx, delAddr)
+	if !k.HasDelegatorStartingInfo(ctx, valAddr, owner) {
+		return nil, types.ErrEmptyDelegationDistInfo()
+	}
+
+	endingPeriod := k.incrementValidatorPeriod(ctx, val)
+	rewards := k.calculateDelegationRewards(ctx, valAddr, owner, endingPeriod)
+	for _, part := range k.splitDelegationRewards(ctx, owner, rewards) {
+		if part.delAddr.Equals(delAddr) {
+			return part.rewards, nil
+		}
+	}
+	return sdk.SysCoins{}, nil
+}
@@ -0,0 +1,226 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+
+	"github.com/okex/exchain/x/distribution/types"
+	"github.com/okex/exchain/x/staking"
+	stakingtypes "github.com/okex/exchain/x/staking/types"
+)
+
+func TestWithdrawDelegationRewards(t *testing.T) {
+	ctx, ak, k, sk, supplyKeeper := CreateTestInputDefault(t, false, 1000)
+	ctx = ctx.WithBlockTime(time.Now())
+	h := staking.NewHandler(sk)
+
+	// lower the commission rate of the validator, so that half of the rewards go to the delegators
+	_, err := h(ctx, staking.NewMsgEditValidatorCommissionRate(valOpAddr1, sdk.NewDecWithPrec(5, 1)))
+	require.NoError(t, err)
+
+	// delAddr1 adds shares by itself, delAddr2 adds shares as a proxy of delAddr3 with equal tokens
+	for _, delAddr := range []sdk.AccAddress{delAddr1, delAddr2, delAddr3} {
+		_, err = h(ctx, staking.NewMsgDeposit(delAddr, NewTestSysCoin(100, 0)))
+		require.NoError(t, err)
+	}
+	_, err = h(ctx, stakingtypes.NewMsgRegProxy(delAddr2, true))
+	require.NoError(t, err)
+	_, err = h(ctx, stakingtypes.NewMsgBindProxy(delAddr3, delAddr2))
+	require.NoError(t, err)
+	for _, delAddr := range []sdk.AccAddress{delAddr1, delAddr2} {
+		_, err = h(ctx, staking.NewMsgAddShares(delAddr, []sdk.ValAddress{valOpAddr1}))
+		require.NoError(t, err)
+		require.True(t, k.HasDelegatorStartingInfo(ctx, valOpAddr1, delAddr))
+	}
+	require.False(t, k.HasDelegatorStartingInfo(ctx, valOpAddr1, delAddr3))
+
+	// allocate rewards held by the distribution module account
+	acc := supplyKeeper.GetModuleAccount(ctx, types.ModuleName)
+	require.NoError(t, acc.SetCoins(NewTestSysCoins(10, 0)))
+	ak.SetAccount(ctx, acc)
+	val := sk.Validator(ctx, valOpAddr1)
+	k.AllocateTokensToValidator(ctx, val, NewTestSysCoins(10, 0))
+	require.Equal(t, NewTestSysCoins(5, 0), k.GetValidatorAccumulatedCommission(ctx, valOpAddr1))
+	require.Equal(t, NewTestSysCoins(5, 0), k.GetValidatorOutstandingRewards(ctx, valOpAddr1))
+
+	// the proxy takes twice the rewards of delAddr1 and shares them with its bound delegator
+	cacheCtx, _ := ctx.CacheContext()
+	rewards1, err := k.calculateDelegatorRewards(cacheCtx, delAddr1, valOpAddr1)
+	require.NoError(t, err)
+	cacheCtx, _ = ctx.CacheContext()
+	rewards2, err := k.calculateDelegatorRewards(cacheCtx, delAddr2, valOpAddr1)
+	require.NoError(t, err)
+	cacheCtx, _ = ctx.CacheContext()
+	rewards3, err := k.calculateDelegatorRewards(cacheCtx, delAddr3, valOpAddr1)
+	require.NoError(t, err)
+	require.True(t, rewards1.IsAllPositive())
+	require.True(t, rewards1.AmountOf(sdk.DefaultBondDenom).Sub(
+		rewards3.AmountOf(sdk.DefaultBondDenom)).Abs().LTE(sdk.NewDecWithPrec(1, 12)))
+	require.True(t, rewards2.AmountOf(sdk.DefaultBondDenom).Sub(
+		rewards3.AmountOf(sdk.DefaultBondDenom)).Abs().LTE(sdk.NewDecWithPrec(1, 12)))
+
+	// withdrawing by the bound delegator pays the proxy as well
+	balance2, balance3 := ak.GetAccount(ctx, delAddr2).GetCoins(), ak.GetAccount(ctx, delAddr3).GetCoins()
+	withdrawn, err := k.WithdrawDelegationRewards(ctx, delAddr3, valOpAddr1)
+	require.NoError(t, err)
+	require.Equal(t, rewards3, withdrawn)
+	require.Equal(t, balance2.Add(rewards2...), ak.GetAccount(ctx, delAddr2).GetCoins())
+	require.Equal(t, balance3.Add(rewards3...), ak.GetAccount(ctx, delAddr3).GetCoins())
+	require.True(t, k.HasDelegatorStartingInfo(ctx, valOpAddr1, delAddr2))
+
+	// nothing left to withdraw on the proxy until the next allocation
+	cacheCtx, _ = ctx.CacheContext()
+	left, err := k.calculateDelegatorRewards(cacheCtx, delAddr2, valOpAddr1)
+	require.NoError(t, err)
+	require.True(t, left.IsZero())
+
+	// modifying the shares withdraws the rewards automatically
+	balance1 := ak.GetAccount(ctx, delAddr1).GetCoins()
+	_, err = h(ctx, staking.NewMsgDeposit(delAddr1, NewTestSysCoin(100, 0)))
+	require.NoError(t, err)
+	require.Equal(t, balance1.Add(rewards1...).Sub(NewTestSysCoins(100, 0)), ak.GetAccount(ctx, delAddr1).GetCoins())
+
+	// the delegators take all the rewards but the truncated dust, the shares of the min self delegation earn none
+	withdrawnAll := rewards1.Add(rewards2...).Add(rewards3...)
+	require.Equal(t, NewTestSysCoins(5, 0).Sub(withdrawnAll), k.GetValidatorOutstandingRewards(ctx, valOpAddr1))
+	_, broken := ModuleAccountInvariant(k)(ctx)
+	require.False(t, broken)
+
+	// no rewards without shares
+	_, err = k.WithdrawDelegationRewards(ctx, delAddr4, valOpAddr1)
+	require.Error(t, err)
+}
+
+func TestMinSelfDelegationEarnsNoRewards(t *testing.T) {
+	ctx, ak, k, sk, supplyKeeper := CreateTestInputDefault(t, false, 1000)
+	ctx = ctx.WithBlockTime(time.Now())
+	h := staking.NewHandler(sk)
+
+	_, err := h(ctx, staking.NewMsgEditValidatorCommissionRate(valOpAddr1, sdk.NewDecWithPrec(5, 1)))
+	require.NoError(t, err)
+	val := sk.Validator(ctx, valOpAddr1)
+	require.True(t, val.GetDelegatorShares().IsPositive())
+	require.True(t, val.GetAddedShares().IsZero())
+
+	// the rewards of a validator without added shares go to the community pool instead of the min self delegation
+	acc := supplyKeeper.GetModuleAccount(ctx, types.ModuleName)
+	require.NoError(t, acc.SetCoins(NewTestSysCoins(10, 0)))
+	ak.SetAccount(ctx, acc)
+	k.AllocateTokensToValidator(ctx, val, NewTestSysCoins(10, 0))
+	communityPool := k.GetFeePool(ctx).CommunityPool
+	k.incrementValidatorPeriod(ctx, val)
+	require.True(t, k.GetValidatorOutstandingRewards(ctx, valOpAddr1).IsZero())
+	require.Equal(t, communityPool.Add(NewTestSysCoins(5, 0)...), k.GetFeePool(ctx).CommunityPool)
+	_, broken := ModuleAccountInvariant(k)(ctx)
+	require.False(t, broken)
+}
+
+func TestRestakeDelegatorRewards(t *testing.T) {
+	ctx, ak, k, sk, supplyKeeper := CreateTestInputDefault(t, false, 1000)
+	ctx = ctx.WithBlockTime(time.Now())
+	h := staking.NewHandler(sk)
+
+	_, err := h(ctx, staking.NewMsgEditValidatorCommissionRate(valOpAddr1, sdk.NewDecWithPrec(5, 1)))
+	require.NoError(t, err)
+	for _, delAddr := range []sdk.AccAddress{delAddr1, delAddr2} {
+		_, err = h(ctx, staking.NewMsgDeposit(delAddr, NewTestSysCoin(100, 0)))
+		require.NoError(t, err)
+		_, err = h(ctx, staking.NewMsgAddShares(delAddr, []sdk.ValAddress{valOpAddr1}))
+		require.NoError(t, err)
+	}
+
+	// only delAddr1 opts in to auto-restake
+	_, err = h(ctx, staking.NewMsgSetAutoRestake(delAddr1, true))
+	require.NoError(t, err)
+
+	acc := supplyKeeper.GetModuleAccount(ctx, types.ModuleName)
+	require.NoError(t, acc.SetCoins(NewTestSysCoins(10, 0)))
+	ak.SetAccount(ctx, acc)
+	k.AllocateTokensToValidator(ctx, sk.Validator(ctx, valOpAddr1), NewTestSysCoins(10, 0))
+
+	cacheCtx, _ := ctx.CacheContext()
+	rewards1, err := k.calculateDelegatorRewards(cacheCtx, delAddr1, valOpAddr1)
+	require.NoError(t, err)
+	require.True(t, rewards1.IsAllPositive())
+
+	balance1, balance2 := ak.GetAccount(ctx, delAddr1).GetCoins(), ak.GetAccount(ctx, delAddr2).GetCoins()
+	k.RestakeDelegatorRewards(ctx, types.MaxRestakeDelegatorsPerBlock)
+
+	// the rewards of delAddr1 are deposited without passing through its balance
+	delegator1, found := sk.GetDelegator(ctx, delAddr1)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(100).Add(rewards1.AmountOf(sdk.DefaultBondDenom)), delegator1.Tokens)
+	require.Equal(t, balance1, ak.GetAccount(ctx, delAddr1).GetCoins())
+
+	// the rewards of delAddr2 are left on the validator
+	delegator2, found := sk.GetDelegator(ctx, delAddr2)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(100), delegator2.Tokens)
+	require.Equal(t, balance2, ak.GetAccount(ctx, delAddr2).GetCoins())
+	cacheCtx, _ = ctx.CacheContext()
+	rewards2, err := k.calculateDelegatorRewards(cacheCtx, delAddr2, valOpAddr1)
+	require.NoError(t, err)
+	require.True(t, rewards2.IsAllPositive())
+
+	// no rewards are restaked when they are paid to another withdraw address
+	require.NoError(t, k.SetWithdrawAddr(ctx, delAddr1, delAddr3))
+	acc = supplyKeeper.GetModuleAccount(ctx, types.ModuleName)
+	require.NoError(t, acc.SetCoins(acc.GetCoins().Add(NewTestSysCoins(10, 0)...)))
+	ak.SetAccount(ctx, acc)
+	k.AllocateTokensToValidator(ctx, sk.Validator(ctx, valOpAddr1), NewTestSysCoins(10, 0))
+	k.RestakeDelegatorRewards(ctx, types.MaxRestakeDelegatorsPerBlock)
+	delegator1, _ = sk.GetDelegator(ctx, delAddr1)
+	require.Equal(t, sdk.NewDec(100).Add(rewards1.AmountOf(sdk.DefaultBondDenom)), delegator1.Tokens)
+	require.True(t, k.HasDelegatorStartingInfo(ctx, valOpAddr1, delAddr1))
+	cacheCtx, _ = ctx.CacheContext()
+	rewards1, err = k.calculateDelegatorRewards(cacheCtx, delAddr1, valOpAddr1)
+	require.NoError(t, err)
+	require.True(t, rewards1.IsAllPositive())
+}
+
+func TestRestakeDelegatorRewardsInBatches(t *testing.T) {
+	ctx, ak, k, sk, supplyKeeper := CreateTestInputDefault(t, false, 1000)
+	ctx = ctx.WithBlockTime(time.Now())
+	h := staking.NewHandler(sk)
+
+	_, err := h(ctx, staking.NewMsgEditValidatorCommissionRate(valOpAddr1, sdk.NewDecWithPrec(5, 1)))
+	require.NoError(t, err)
+	delAddrs := []sdk.AccAddress{delAddr1, delAddr2, delAddr3}
+	for _, delAddr := range delAddrs {
+		_, err = h(ctx, staking.NewMsgDeposit(delAddr, NewTestSysCoin(100, 0)))
+		require.NoError(t, err)
+		_, err = h(ctx, staking.NewMsgAddShares(delAddr, []sdk.ValAddress{valOpAddr1}))
+		require.NoError(t, err)
+		_, err = h(ctx, staking.NewMsgSetAutoRestake(delAddr, true))
+		require.NoError(t, err)
+	}
+
+	acc := supplyKeeper.GetModuleAccount(ctx, types.ModuleName)
+	require.NoError(t, acc.SetCoins(NewTestSysCoins(10, 0)))
+	ak.SetAccount(ctx, acc)
+	k.AllocateTokensToValidator(ctx, sk.Validator(ctx, valOpAddr1), NewTestSysCoins(10, 0))
+
+	restaked := func() (n int) {
+		for _, delAddr := range delAddrs {
+			delegator, found := sk.GetDelegator(ctx, delAddr)
+			require.True(t, found)
+			if delegator.Tokens.GT(sdk.NewDec(100)) {
+				n++
+			}
+		}
+		return n
+	}
+
+	// two delegators are restaked in the first block, and the last one in the next block
+	require.False(t, k.IsRestaking(ctx))
+	k.RestakeDelegatorRewards(ctx, 2)
+	require.Equal(t, 2, restaked())
+	require.True(t, k.IsRestaking(ctx))
+	k.RestakeDelegatorRewards(ctx, 2)
+	require.Equal(t, 3, restaked())
+	require.False(t, k.IsRestaking(ctx))
+}
@@ -49,11 +49,54 @@ func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, _ sdk.ConsAddress, valAddr
 
 	// remove commission record
 	h.k.deleteValidatorAccumulatedCommission(ctx, valAddr)
+
+	// the delegator rewards left over on the validator go to the community pool
+	outstanding := h.k.GetValidatorOutstandingRewards(ctx, valAddr)
+	if !outstanding.IsZero() {
+		feePool := h.k.GetFeePool(ctx)
+		feePool.CommunityPool = feePool.CommunityPool.Add(outstanding...)
+		h.k.SetFeePool(ctx, feePool)
+	}
+
+	// remove delegator rewards records
+	h.k.deleteValidatorOutstandingRewards(ctx, valAddr)
+	h.k.DeleteValidatorHistoricalRewards(ctx, valAddr)
+	h.k.DeleteValidatorCurrentRewards(ctx, valAddr)
+	h.k.deleteValidatorDelegatorStartingInfos(ctx, valAddr)
 }
 
-// AfterValidatorDestroyed nothing to do
+// AfterValidatorDestroyed ends the current period of the validator before the shares of its min self delegation are
+// removed
 func (h Hooks) AfterValidatorDestroyed(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
+	if !h.k.HasValidatorCurrentRewards(ctx, valAddr) {
+		return
+	}
+	val := h.k.stakingKeeper.Validator(ctx, valAddr)
+	h.k.incrementValidatorPeriod(ctx, val)
+}
 
+// BeforeDelegationSharesModified withdraws the rewards accumulated on the shares before they are modified, or ends the
+// current period of the validator before new shares are added
+func (h Hooks) BeforeDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	if !h.k.HasValidatorCurrentRewards(ctx, valAddr) {
+		return
+	}
+	val := h.k.stakingKeeper.Validator(ctx, valAddr)
+	if h.k.HasDelegatorStartingInfo(ctx, valAddr, delAddr) {
+		h.k.withdrawDelegationRewards(ctx, val, delAddr)
+		return
+	}
+	h.k.incrementValidatorPeriod(ctx, val)
+}
+
+// AfterDelegationModified initializes the starting info of the shares after they are modified
+func (h Hooks) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	if !h.k.HasValidatorCurrentRewards(ctx, valAddr) {
+		// the starting info of the shares is initialized along with the validator
+		h.k.ensureValidatorRewards(ctx, valAddr)
+		return
+	}
+	h.k.initializeDelegation(ctx, valAddr, delAddr)
 }
 
 // nolint - unused hooks
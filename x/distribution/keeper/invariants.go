@@ -12,6 +12,7 @@ import (
 // RegisterInvariants registers all distribution invariants
 func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 	ir.RegisterRoute(types.ModuleName, "nonnegative-commission", NonNegativeCommissionsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "nonnegative-outstanding", NonNegativeOutstandingInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "can-withdraw", CanWithdrawInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "module-account", ModuleAccountInvariant(k))
 }
@@ -39,6 +40,27 @@ func NonNegativeCommissionsInvariant(k Keeper) sdk.Invariant {
 	}
 }
 
+// NonNegativeOutstandingInvariant checks that outstanding unwithdrawn delegator rewards are never negative
+func NonNegativeOutstandingInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var count int
+
+		k.IterateValidatorOutstandingRewards(ctx,
+			func(addr sdk.ValAddress, outstanding types.ValidatorOutstandingRewards) (stop bool) {
+				if outstanding.IsAnyNegative() {
+					count++
+					msg += fmt.Sprintf("\t%v has negative outstanding coins: %v\n", addr, outstanding)
+				}
+				return false
+			})
+		broken := count != 0
+
+		return sdk.FormatInvariant(types.ModuleName, "nonnegative outstanding",
+			fmt.Sprintf("found %d validators with negative outstanding rewards\n%s", count, msg)), broken
+	}
+}
+
 // CanWithdrawInvariant checks that current commission can be completely withdrawn
 func CanWithdrawInvariant(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
@@ -69,7 +91,7 @@ func CanWithdrawInvariant(k Keeper) sdk.Invariant {
 }
 
 // ModuleAccountInvariant checks that the coins held by the distr ModuleAccount
-// is consistent with the sum of accumulated commissions
+// is consistent with the sum of accumulated commissions and outstanding delegator rewards
 func ModuleAccountInvariant(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
 		var accumulatedCommission sdk.SysCoins
@@ -78,12 +100,19 @@ func ModuleAccountInvariant(k Keeper) sdk.Invariant {
 				accumulatedCommission = accumulatedCommission.Add(commission...)
 				return false
 			})
+		var outstanding sdk.SysCoins
+		k.IterateValidatorOutstandingRewards(ctx,
+			func(_ sdk.ValAddress, rewards types.ValidatorOutstandingRewards) (stop bool) {
+				outstanding = outstanding.Add(rewards...)
+				return false
+			})
 		communityPool := k.GetFeePoolCommunityCoins(ctx)
+		expectedCoins := communityPool.Add(accumulatedCommission...).Add(outstanding...)
 		macc := k.GetDistributionAccount(ctx)
-		broken := !macc.GetCoins().IsEqual(communityPool.Add(accumulatedCommission...))
+		broken := !macc.GetCoins().IsEqual(expectedCoins)
 		return sdk.FormatInvariant(types.ModuleName, "ModuleAccount coins",
 			fmt.Sprintf("\texpected distribution ModuleAccount coins:     %s\n"+
 				"\tacutal distribution ModuleAccount coins: %s\n",
-				accumulatedCommission, macc.GetCoins())), broken
+				expectedCoins, macc.GetCoins())), broken
 	}
 }
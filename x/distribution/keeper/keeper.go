@@ -107,3 +107,16 @@ func (k Keeper) WithdrawValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddr
 
 	return commission, nil
 }
+
+// FundCommunityPool allows an account to directly fund the community pool.
+// The amount is first sent to the distribution module account and then added to the pool.
+func (k Keeper) FundCommunityPool(ctx sdk.Context, amount sdk.SysCoins, sender sdk.AccAddress) error {
+	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, amount); err != nil {
+		return err
+	}
+
+	feePool := k.GetFeePool(ctx)
+	feePool.CommunityPool = feePool.CommunityPool.Add(amount...)
+	k.SetFeePool(ctx, feePool)
+	return nil
+}
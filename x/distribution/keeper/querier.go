@@ -16,9 +16,18 @@ func NewQuerier(k Keeper) sdk.Querier {
 		case types.QueryParams:
 			return queryParams(ctx, path[1:], req, k)
 
+		case types.QueryValidatorOutstandingRewards:
+			return queryValidatorOutstandingRewards(ctx, path[1:], req, k)
+
 		case types.QueryValidatorCommission:
 			return queryValidatorCommission(ctx, path[1:], req, k)
 
+		case types.QueryDelegationRewards:
+			return queryDelegationRewards(ctx, path[1:], req, k)
+
+		case types.QueryDelegatorTotalRewards:
+			return queryDelegatorTotalRewards(ctx, path[1:], req, k)
+
 		case types.QueryWithdrawAddr:
 			return queryDelegatorWithdrawAddress(ctx, path[1:], req, k)
 
@@ -71,6 +80,87 @@ func queryValidatorCommission(ctx sdk.Context, _ []string, req abci.RequestQuery
 	return bz, nil
 }
 
+func queryValidatorOutstandingRewards(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryValidatorOutstandingRewardsParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, comm.ErrUnMarshalJSONFailed(err.Error())
+	}
+
+	rewards := k.GetValidatorOutstandingRewards(ctx, params.ValidatorAddress)
+	if rewards == nil {
+		rewards = types.ValidatorOutstandingRewards{}
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, rewards)
+	if err != nil {
+		return nil, comm.ErrMarshalJSONFailed(err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryDelegationRewards(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryDelegationRewardsParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, comm.ErrUnMarshalJSONFailed(err.Error())
+	}
+
+	// cache-wrap context as to not persist state changes during querying
+	ctx, _ = ctx.CacheContext()
+	rewards, err := k.calculateDelegatorRewards(ctx, params.DelegatorAddress, params.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	if rewards == nil {
+		rewards = sdk.SysCoins{}
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, rewards)
+	if err != nil {
+		return nil, comm.ErrMarshalJSONFailed(err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryDelegatorTotalRewards(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryDelegatorParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, comm.ErrUnMarshalJSONFailed(err.Error())
+	}
+
+	// cache-wrap context as to not persist state changes during querying
+	ctx, _ = ctx.CacheContext()
+
+	// the rewards of a delegator bound to a proxy are on the validators the proxy added shares to
+	owner := k.stakingKeeper.Delegator(ctx, k.getRewardsOwner(ctx, params.DelegatorAddress))
+	if owner == nil {
+		return nil, types.ErrEmptyDelegationDistInfo()
+	}
+
+	total := sdk.SysCoins{}
+	var delRewards []types.DelegationDelegatorReward
+	for _, valAddr := range owner.GetShareAddedValidatorAddresses() {
+		rewards, err := k.calculateDelegatorRewards(ctx, params.DelegatorAddress, valAddr)
+		if err != nil {
+			// the validator has no delegator rewards recorded yet
+			continue
+		}
+		delRewards = append(delRewards, types.NewDelegationDelegatorReward(valAddr, rewards))
+		total = total.Add(rewards...)
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, types.NewQueryDelegatorTotalRewardsResponse(delRewards, total))
+	if err != nil {
+		return nil, comm.ErrMarshalJSONFailed(err.Error())
+	}
+
+	return bz, nil
+}
+
 func queryDelegatorWithdrawAddress(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
 	var params types.QueryDelegatorWithdrawAddrParams
 	err := k.cdc.UnmarshalJSON(req.Data, &params)
@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+
+	"github.com/okex/exchain/x/distribution/types"
+)
+
+// RestakeDelegatorRewards withdraws the rewards of the delegators who opt in to auto-restake and deposits the bond
+// denom part of them back to their delegations. At most limit delegators are restaked per call, starting from the
+// restake cursor left by the last call, so it's expected to be called at the end of every epoch and then in the
+// following blocks until the cursor is cleared
+func (k Keeper) RestakeDelegatorRewards(ctx sdk.Context, limit int) {
+	// collect the delegators first since restaking writes to the staking store
+	var delAddrs []sdk.AccAddress
+	var next sdk.AccAddress
+	k.stakingKeeper.IterateAutoRestakeDelegatorsFrom(ctx, k.GetRestakeCursor(ctx),
+		func(_ int64, delAddr sdk.AccAddress) (stop bool) {
+			if len(delAddrs) == limit {
+				next = delAddr
+				return true
+			}
+			delAddrs = append(delAddrs, delAddr)
+			return false
+		})
+	k.SetRestakeCursor(ctx, next)
+
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	for _, delAddr := range delAddrs {
+		k.restakeDelegatorRewards(ctx, delAddr, bondDenom)
+	}
+}
+
+// IsRestaking tells whether the restaking of the delegator rewards is in progress
+func (k Keeper) IsRestaking(ctx sdk.Context) bool {
+	return k.GetRestakeCursor(ctx) != nil
+}
+
+// IsEndOfEpoch tells whether the current block ends an epoch of the staking module
+func (k Keeper) IsEndOfEpoch(ctx sdk.Context) bool {
+	return k.stakingKeeper.IsEndOfEpoch(ctx)
+}
+
+// restakeDelegatorRewards restakes the rewards of a delegator, nothing is withdrawn if they can't be restaked
+func (k Keeper) restakeDelegatorRewards(ctx sdk.Context, delAddr sdk.AccAddress, bondDenom string) {
+	// the rewards paid elsewhere aren't in the account of the delegator to deposit
+	if !k.GetDelegatorWithdrawAddr(ctx, delAddr).Equals(delAddr) {
+		return
+	}
+
+	owner := k.stakingKeeper.Delegator(ctx, k.getRewardsOwner(ctx, delAddr))
+	if owner == nil {
+		return
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	amount := sdk.ZeroDec()
+	for _, valAddr := range owner.GetShareAddedValidatorAddresses() {
+		if !k.HasDelegatorStartingInfo(cacheCtx, valAddr, owner.GetDelegatorAddress()) {
+			continue
+		}
+		rewards, err := k.WithdrawDelegationRewards(cacheCtx, delAddr, valAddr)
+		if err != nil {
+			continue
+		}
+		amount = amount.Add(rewards.AmountOf(bondDenom))
+	}
+	if !amount.IsPositive() {
+		return
+	}
+
+	token := sdk.NewDecCoinFromDec(bondDenom, amount)
+	if err := k.stakingKeeper.Delegate(cacheCtx, delAddr, token); err != nil {
+		k.Logger(ctx).Debug(fmt.Sprintf("skip restaking rewards %s of delegator %s: %s", token, delAddr, err))
+		return
+	}
+
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRestakeRewards,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, token.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+		),
+	)
+}
@@ -78,6 +78,21 @@ func (k Keeper) SetPreviousProposerConsAddr(ctx sdk.Context, consAddr sdk.ConsAd
 	store.Set(types.ProposerKey, b)
 }
 
+// GetRestakeCursor returns the next delegator to restake the rewards of, which is nil if no restaking is in progress
+func (k Keeper) GetRestakeCursor(ctx sdk.Context) sdk.AccAddress {
+	return ctx.KVStore(k.storeKey).Get(types.RestakeCursorKey)
+}
+
+// SetRestakeCursor sets the next delegator to restake the rewards of, the cursor is deleted if delAddr is empty
+func (k Keeper) SetRestakeCursor(ctx sdk.Context, delAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	if delAddr.Empty() {
+		store.Delete(types.RestakeCursorKey)
+		return
+	}
+	store.Set(types.RestakeCursorKey, delAddr)
+}
+
 // GetValidatorAccumulatedCommission returns accumulated commission for a validator
 func (k Keeper) GetValidatorAccumulatedCommission(ctx sdk.Context, val sdk.ValAddress) (
 	commission types.ValidatorAccumulatedCommission) {
@@ -127,3 +142,195 @@ func (k Keeper) IterateValidatorAccumulatedCommissions(ctx sdk.Context,
 		}
 	}
 }
+
+// GetValidatorOutstandingRewards returns the outstanding delegator rewards of a validator
+func (k Keeper) GetValidatorOutstandingRewards(ctx sdk.Context, val sdk.ValAddress) (
+	rewards types.ValidatorOutstandingRewards) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetValidatorOutstandingRewardsKey(val))
+	if b == nil {
+		return types.ValidatorOutstandingRewards{}
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(b, &rewards)
+	return rewards
+}
+
+// SetValidatorOutstandingRewards sets the outstanding delegator rewards of a validator
+func (k Keeper) SetValidatorOutstandingRewards(ctx sdk.Context, val sdk.ValAddress,
+	rewards types.ValidatorOutstandingRewards) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinaryLengthPrefixed(rewards)
+	store.Set(types.GetValidatorOutstandingRewardsKey(val), b)
+}
+
+// deleteValidatorOutstandingRewards deletes the outstanding delegator rewards of a validator
+func (k Keeper) deleteValidatorOutstandingRewards(ctx sdk.Context, val sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetValidatorOutstandingRewardsKey(val))
+}
+
+// IterateValidatorOutstandingRewards iterates over the outstanding delegator rewards of the validators
+func (k Keeper) IterateValidatorOutstandingRewards(ctx sdk.Context,
+	handler func(val sdk.ValAddress, rewards types.ValidatorOutstandingRewards) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorOutstandingRewardsPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var rewards types.ValidatorOutstandingRewards
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &rewards)
+		addr := types.GetValidatorOutstandingRewardsAddress(iter.Key())
+		if handler(addr, rewards) {
+			break
+		}
+	}
+}
+
+// GetDelegatorStartingInfo returns the starting info of the shares added by a delegator to a validator
+func (k Keeper) GetDelegatorStartingInfo(ctx sdk.Context, val sdk.ValAddress, del sdk.AccAddress) (
+	period types.DelegatorStartingInfo) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetDelegatorStartingInfoKey(val, del))
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(b, &period)
+	return
+}
+
+// SetDelegatorStartingInfo sets the starting info of the shares added by a delegator to a validator
+func (k Keeper) SetDelegatorStartingInfo(ctx sdk.Context, val sdk.ValAddress, del sdk.AccAddress,
+	period types.DelegatorStartingInfo) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinaryLengthPrefixed(period)
+	store.Set(types.GetDelegatorStartingInfoKey(val, del), b)
+}
+
+// HasDelegatorStartingInfo checks the existence of the starting info of a delegator on a validator
+func (k Keeper) HasDelegatorStartingInfo(ctx sdk.Context, val sdk.ValAddress, del sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetDelegatorStartingInfoKey(val, del))
+}
+
+// DeleteDelegatorStartingInfo deletes the starting info of a delegator on a validator
+func (k Keeper) DeleteDelegatorStartingInfo(ctx sdk.Context, val sdk.ValAddress, del sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetDelegatorStartingInfoKey(val, del))
+}
+
+// IterateDelegatorStartingInfos iterates over the delegator starting infos
+func (k Keeper) IterateDelegatorStartingInfos(ctx sdk.Context,
+	handler func(val sdk.ValAddress, del sdk.AccAddress, info types.DelegatorStartingInfo) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.DelegatorStartingInfoPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var info types.DelegatorStartingInfo
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &info)
+		val, del := types.GetDelegatorStartingInfoAddresses(iter.Key())
+		if handler(val, del, info) {
+			break
+		}
+	}
+}
+
+// deleteValidatorDelegatorStartingInfos deletes the starting infos of all the delegators of a validator
+func (k Keeper) deleteValidatorDelegatorStartingInfos(ctx sdk.Context, val sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetDelegatorStartingInfosKey(val))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		store.Delete(iter.Key())
+	}
+}
+
+// GetValidatorHistoricalRewards returns the historical rewards of a validator for a period
+func (k Keeper) GetValidatorHistoricalRewards(ctx sdk.Context, val sdk.ValAddress, period uint64) (
+	rewards types.ValidatorHistoricalRewards) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetValidatorHistoricalRewardsKey(val, period))
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(b, &rewards)
+	return
+}
+
+// SetValidatorHistoricalRewards sets the historical rewards of a validator for a period
+func (k Keeper) SetValidatorHistoricalRewards(ctx sdk.Context, val sdk.ValAddress, period uint64,
+	rewards types.ValidatorHistoricalRewards) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinaryLengthPrefixed(rewards)
+	store.Set(types.GetValidatorHistoricalRewardsKey(val, period), b)
+}
+
+// IterateValidatorHistoricalRewards iterates over the historical rewards of the validators
+func (k Keeper) IterateValidatorHistoricalRewards(ctx sdk.Context,
+	handler func(val sdk.ValAddress, period uint64, rewards types.ValidatorHistoricalRewards) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorHistoricalRewardsPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var rewards types.ValidatorHistoricalRewards
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &rewards)
+		addr, period := types.GetValidatorHistoricalRewardsAddressPeriod(iter.Key())
+		if handler(addr, period, rewards) {
+			break
+		}
+	}
+}
+
+// DeleteValidatorHistoricalReward deletes the historical rewards of a validator for a period
+func (k Keeper) DeleteValidatorHistoricalReward(ctx sdk.Context, val sdk.ValAddress, period uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetValidatorHistoricalRewardsKey(val, period))
+}
+
+// DeleteValidatorHistoricalRewards deletes the historical rewards of a validator for all the periods
+func (k Keeper) DeleteValidatorHistoricalRewards(ctx sdk.Context, val sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetValidatorHistoricalRewardsPrefix(val))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		store.Delete(iter.Key())
+	}
+}
+
+// GetValidatorCurrentRewards returns the current rewards of a validator
+func (k Keeper) GetValidatorCurrentRewards(ctx sdk.Context, val sdk.ValAddress) (
+	rewards types.ValidatorCurrentRewards) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetValidatorCurrentRewardsKey(val))
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(b, &rewards)
+	return
+}
+
+// SetValidatorCurrentRewards sets the current rewards of a validator
+func (k Keeper) SetValidatorCurrentRewards(ctx sdk.Context, val sdk.ValAddress,
+	rewards types.ValidatorCurrentRewards) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinaryLengthPrefixed(rewards)
+	store.Set(types.GetValidatorCurrentRewardsKey(val), b)
+}
+
+// HasValidatorCurrentRewards checks the existence of the current rewards of a validator, it doesn't exist for the
+// validators created before the delegator rewards were introduced until they are initialized
+func (k Keeper) HasValidatorCurrentRewards(ctx sdk.Context, val sdk.ValAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetValidatorCurrentRewardsKey(val))
+}
+
+// DeleteValidatorCurrentRewards deletes the current rewards of a validator
+func (k Keeper) DeleteValidatorCurrentRewards(ctx sdk.Context, val sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetValidatorCurrentRewardsKey(val))
+}
+
+// IterateValidatorCurrentRewards iterates over the current rewards of the validators
+func (k Keeper) IterateValidatorCurrentRewards(ctx sdk.Context,
+	handler func(val sdk.ValAddress, rewards types.ValidatorCurrentRewards) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorCurrentRewardsPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var rewards types.ValidatorCurrentRewards
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &rewards)
+		addr := types.GetValidatorCurrentRewardsAddress(iter.Key())
+		if handler(addr, rewards) {
+			break
+		}
+	}
+}
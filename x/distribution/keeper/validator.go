@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 
 	"github.com/okex/exchain/x/distribution/types"
@@ -11,4 +13,90 @@ import (
 func (k Keeper) initializeValidator(ctx sdk.Context, val exported.ValidatorI) {
 	// set accumulated commissions
 	k.SetValidatorAccumulatedCommission(ctx, val.GetOperator(), types.InitialValidatorAccumulatedCommission())
+	// set the delegator rewards records
+	k.initializeValidatorRewards(ctx, val.GetOperator())
+}
+
+// initializeValidatorRewards initializes the historical, current and outstanding rewards of a validator
+func (k Keeper) initializeValidatorRewards(ctx sdk.Context, valAddr sdk.ValAddress) {
+	// set initial historical rewards (period 0) with reference count of 1
+	k.SetValidatorHistoricalRewards(ctx, valAddr, 0, types.NewValidatorHistoricalRewards(sdk.SysCoins{}, 1))
+	// set current rewards (starting at period 1)
+	k.SetValidatorCurrentRewards(ctx, valAddr, types.NewValidatorCurrentRewards(sdk.SysCoins{}, 1))
+	// set outstanding rewards
+	k.SetValidatorOutstandingRewards(ctx, valAddr, types.ValidatorOutstandingRewards{})
+}
+
+// ensureValidatorRewards initializes the delegator rewards records of a validator created before they were introduced,
+// together with the starting infos of all the shares already added to it
+func (k Keeper) ensureValidatorRewards(ctx sdk.Context, valAddr sdk.ValAddress) {
+	if k.HasValidatorCurrentRewards(ctx, valAddr) {
+		return
+	}
+
+	k.initializeValidatorRewards(ctx, valAddr)
+	for _, sharesResp := range k.stakingKeeper.GetValidatorAllShares(ctx, valAddr) {
+		k.initializeDelegation(ctx, valAddr, sharesResp.DelAddr)
+	}
+}
+
+// incrementValidatorPeriod increments the period of a validator, returning the period just ended
+func (k Keeper) incrementValidatorPeriod(ctx sdk.Context, val exported.ValidatorI) uint64 {
+	// fetch current rewards
+	rewards := k.GetValidatorCurrentRewards(ctx, val.GetOperator())
+
+	// calculate current ratio, over the shares added by the delegators only since the shares of the min self
+	// delegation have no starting info to earn rewards with
+	var current sdk.SysCoins
+	if !val.GetAddedShares().IsPositive() {
+		// can't calculate ratio for zero-share validators
+		// ergo we instead add to the community pool
+		feePool := k.GetFeePool(ctx)
+		outstanding := k.GetValidatorOutstandingRewards(ctx, val.GetOperator())
+		feePool.CommunityPool = feePool.CommunityPool.Add(rewards.Rewards...)
+		outstanding = outstanding.Sub(rewards.Rewards)
+		k.SetFeePool(ctx, feePool)
+		k.SetValidatorOutstandingRewards(ctx, val.GetOperator(), outstanding)
+
+		current = sdk.SysCoins{}
+	} else {
+		// note: necessary to truncate so we don't allow withdrawing more rewards than owed
+		current = rewards.Rewards.QuoDecTruncate(val.GetAddedShares())
+	}
+
+	// fetch historical rewards for last period
+	historical := k.GetValidatorHistoricalRewards(ctx, val.GetOperator(), rewards.Period-1).CumulativeRewardRatio
+
+	// decrement reference count
+	k.decrementReferenceCount(ctx, val.GetOperator(), rewards.Period-1)
+
+	// set new historical rewards with reference count of 1
+	k.SetValidatorHistoricalRewards(ctx, val.GetOperator(), rewards.Period,
+		types.NewValidatorHistoricalRewards(historical.Add(current...), 1))
+
+	// set current rewards, incrementing period by 1
+	k.SetValidatorCurrentRewards(ctx, val.GetOperator(), types.NewValidatorCurrentRewards(sdk.SysCoins{}, rewards.Period+1))
+
+	return rewards.Period
+}
+
+// incrementReferenceCount increments the reference count for a historical rewards value
+func (k Keeper) incrementReferenceCount(ctx sdk.Context, valAddr sdk.ValAddress, period uint64) {
+	historical := k.GetValidatorHistoricalRewards(ctx, valAddr, period)
+	historical.ReferenceCount++
+	k.SetValidatorHistoricalRewards(ctx, valAddr, period, historical)
+}
+
+// decrementReferenceCount decrements the reference count for a historical rewards value, and deletes it if zero
+func (k Keeper) decrementReferenceCount(ctx sdk.Context, valAddr sdk.ValAddress, period uint64) {
+	historical := k.GetValidatorHistoricalRewards(ctx, valAddr, period)
+	if historical.ReferenceCount == 0 {
+		panic(fmt.Sprintf("cannot set negative reference count of validator %s period %d", valAddr, period))
+	}
+	historical.ReferenceCount--
+	if historical.ReferenceCount == 0 {
+		k.DeleteValidatorHistoricalReward(ctx, valAddr, period)
+	} else {
+		k.SetValidatorHistoricalRewards(ctx, valAddr, period, historical)
+	}
 }
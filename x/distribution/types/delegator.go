@@ -0,0 +1,33 @@
+package types
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+// DelegatorStartingInfo is the starting info for the rewards of the shares added by a delegator to a validator,
+// the rewards are calculated by the shares and the cumulative reward ratios of the validator since the previous period
+type DelegatorStartingInfo struct {
+	PreviousPeriod uint64  `json:"previous_period" yaml:"previous_period"` // period at which the shares were added
+	Stake          sdk.Dec `json:"stake" yaml:"stake"`                     // amount of the shares added
+	Height         uint64  `json:"creation_height" yaml:"creation_height"` // height at which the shares were added
+}
+
+// NewDelegatorStartingInfo creates a new DelegatorStartingInfo
+func NewDelegatorStartingInfo(previousPeriod uint64, stake sdk.Dec, height uint64) DelegatorStartingInfo {
+	return DelegatorStartingInfo{
+		PreviousPeriod: previousPeriod,
+		Stake:          stake,
+		Height:         height,
+	}
+}
+
+// DelegationDelegatorReward is the rewards of a delegator on a validator
+type DelegationDelegatorReward struct {
+	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+	Reward           sdk.SysCoins   `json:"reward" yaml:"reward"`
+}
+
+// NewDelegationDelegatorReward creates a new DelegationDelegatorReward
+func NewDelegationDelegatorReward(valAddr sdk.ValAddress, reward sdk.SysCoins) DelegationDelegatorReward {
+	return DelegationDelegatorReward{ValidatorAddress: valAddr, Reward: reward}
+}
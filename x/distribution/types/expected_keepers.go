@@ -5,6 +5,7 @@ import (
 	supplyexported "github.com/okex/exchain/libs/cosmos-sdk/x/supply/exported"
 
 	stakingexported "github.com/okex/exchain/x/staking/exported"
+	stakingtypes "github.com/okex/exchain/x/staking/types"
 )
 
 // StakingKeeper expected staking keeper (noalias)
@@ -35,6 +36,23 @@ type StakingKeeper interface {
 
 	GetLastTotalPower(ctx sdk.Context) sdk.Int
 	GetLastValidatorPower(ctx sdk.Context, valAddr sdk.ValAddress) int64
+
+	// get a particular delegator by address
+	Delegator(ctx sdk.Context, delAddr sdk.AccAddress) stakingexported.DelegatorI
+	// get the delegators bound to a proxy
+	GetDelegatorsByProxy(ctx sdk.Context, proxyAddr sdk.AccAddress) []sdk.AccAddress
+	// get the shares added by a delegator to a validator
+	GetShares(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (stakingtypes.Shares, bool)
+	// get all the shares added to a validator
+	GetValidatorAllShares(ctx sdk.Context, valAddr sdk.ValAddress) stakingtypes.SharesResponses
+
+	// iterate through the delegators who opt in to restake their rewards automatically, starting from start
+	IterateAutoRestakeDelegatorsFrom(ctx sdk.Context, start sdk.AccAddress,
+		fn func(index int64, delAddr sdk.AccAddress) (stop bool))
+	// deposit tokens of a delegator and update its shares
+	Delegate(ctx sdk.Context, delAddr sdk.AccAddress, token sdk.SysCoin) error
+	IsEndOfEpoch(ctx sdk.Context) bool
+	BondDenom(ctx sdk.Context) string
 }
 
 // StakingHooks event hooks for staking validator object (noalias)
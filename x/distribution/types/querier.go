@@ -1,13 +1,21 @@
 package types
 
-import sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
 
 // querier keys
 const (
-	QueryParams              = "params"
-	QueryValidatorCommission = "validator_commission"
-	QueryWithdrawAddr        = "withdraw_addr"
-	QueryCommunityPool       = "community_pool"
+	QueryParams                      = "params"
+	QueryValidatorOutstandingRewards = "validator_outstanding_rewards"
+	QueryValidatorCommission         = "validator_commission"
+	QueryDelegationRewards           = "delegation_rewards"
+	QueryDelegatorTotalRewards       = "delegator_total_rewards"
+	QueryWithdrawAddr                = "withdraw_addr"
+	QueryCommunityPool               = "community_pool"
 
 	ParamCommunityTax        = "community_tax"
 	ParamWithdrawAddrEnabled = "withdraw_addr_enabled"
@@ -34,3 +42,67 @@ type QueryDelegatorWithdrawAddrParams struct {
 func NewQueryDelegatorWithdrawAddrParams(delegatorAddr sdk.AccAddress) QueryDelegatorWithdrawAddrParams {
 	return QueryDelegatorWithdrawAddrParams{DelegatorAddress: delegatorAddr}
 }
+
+// QueryValidatorOutstandingRewardsParams is the struct of params for query 'custom/distr/validator_outstanding_rewards'
+type QueryValidatorOutstandingRewardsParams struct {
+	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+}
+
+// NewQueryValidatorOutstandingRewardsParams creates a new instance of QueryValidatorOutstandingRewardsParams
+func NewQueryValidatorOutstandingRewardsParams(validatorAddr sdk.ValAddress) QueryValidatorOutstandingRewardsParams {
+	return QueryValidatorOutstandingRewardsParams{
+		ValidatorAddress: validatorAddr,
+	}
+}
+
+// QueryDelegationRewardsParams is the struct of params for query 'custom/distr/delegation_rewards'
+type QueryDelegationRewardsParams struct {
+	DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
+	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+}
+
+// NewQueryDelegationRewardsParams creates a new instance of QueryDelegationRewardsParams
+func NewQueryDelegationRewardsParams(delegatorAddr sdk.AccAddress,
+	validatorAddr sdk.ValAddress) QueryDelegationRewardsParams {
+	return QueryDelegationRewardsParams{
+		DelegatorAddress: delegatorAddr,
+		ValidatorAddress: validatorAddr,
+	}
+}
+
+// QueryDelegatorParams is the struct of params for query 'custom/distr/delegator_total_rewards'
+type QueryDelegatorParams struct {
+	DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
+}
+
+// NewQueryDelegatorParams creates a new instance of QueryDelegatorParams
+func NewQueryDelegatorParams(delegatorAddr sdk.AccAddress) QueryDelegatorParams {
+	return QueryDelegatorParams{
+		DelegatorAddress: delegatorAddr,
+	}
+}
+
+// QueryDelegatorTotalRewardsResponse defines the properties of the response of query 'custom/distr/delegator_total_rewards'
+type QueryDelegatorTotalRewardsResponse struct {
+	Rewards []DelegationDelegatorReward `json:"rewards" yaml:"rewards"`
+	Total   sdk.SysCoins                `json:"total" yaml:"total"`
+}
+
+// NewQueryDelegatorTotalRewardsResponse creates a new instance of QueryDelegatorTotalRewardsResponse
+func NewQueryDelegatorTotalRewardsResponse(rewards []DelegationDelegatorReward,
+	total sdk.SysCoins) QueryDelegatorTotalRewardsResponse {
+	return QueryDelegatorTotalRewardsResponse{Rewards: rewards, Total: total}
+}
+
+// String returns a human readable string representation of QueryDelegatorTotalRewardsResponse
+func (res QueryDelegatorTotalRewardsResponse) String() string {
+	out := "Delegator Total Rewards:\n"
+	out += "  Rewards:"
+	for _, reward := range res.Rewards {
+		out += fmt.Sprintf(`
+    ValidatorAddress: %s
+    Reward: %s`, reward.ValidatorAddress, reward.Reward)
+	}
+	out += fmt.Sprintf("\n  Total: %s\n", res.Total)
+	return strings.TrimSpace(out)
+}
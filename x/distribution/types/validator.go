@@ -12,3 +12,39 @@ type ValidatorAccumulatedCommission = sdk.SysCoins
 func InitialValidatorAccumulatedCommission() ValidatorAccumulatedCommission {
 	return ValidatorAccumulatedCommission{}
 }
+
+// ValidatorHistoricalRewards is the historical rewards for a validator, the cumulative reward ratio is the sum from
+// the zeroth period until this period of the rewards / shares of the validator.
+// The reference count indicates the number of objects which might need to reference this historical entry at any
+// point, which is the number of the delegations starting right after the associated period, plus one for the current
+// rewards of the validator if it's the latest period.
+type ValidatorHistoricalRewards struct {
+	CumulativeRewardRatio sdk.SysCoins `json:"cumulative_reward_ratio" yaml:"cumulative_reward_ratio"`
+	ReferenceCount        uint32       `json:"reference_count" yaml:"reference_count"`
+}
+
+// NewValidatorHistoricalRewards creates a new historical rewards
+func NewValidatorHistoricalRewards(cumulativeRewardRatio sdk.SysCoins, referenceCount uint32) ValidatorHistoricalRewards {
+	return ValidatorHistoricalRewards{
+		CumulativeRewardRatio: cumulativeRewardRatio,
+		ReferenceCount:        referenceCount,
+	}
+}
+
+// ValidatorCurrentRewards is the current rewards and current period for a validator, kept as a running counter and
+// incremented each block as long as the validator's shares remain constant
+type ValidatorCurrentRewards struct {
+	Rewards sdk.SysCoins `json:"rewards" yaml:"rewards"` // current rewards
+	Period  uint64       `json:"period" yaml:"period"`   // current period
+}
+
+// NewValidatorCurrentRewards creates a new current rewards
+func NewValidatorCurrentRewards(rewards sdk.SysCoins, period uint64) ValidatorCurrentRewards {
+	return ValidatorCurrentRewards{
+		Rewards: rewards,
+		Period:  period,
+	}
+}
+
+// ValidatorOutstandingRewards is the rewards allocated to the delegators of a validator but not withdrawn yet
+type ValidatorOutstandingRewards = sdk.SysCoins
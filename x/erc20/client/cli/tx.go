@@ -0,0 +1,147 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/okex/exchain/libs/cosmos-sdk/client/context"
+	client "github.com/okex/exchain/libs/cosmos-sdk/client/flags"
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/version"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/auth"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/auth/client/utils"
+	erc20utils "github.com/okex/exchain/x/erc20/client/utils"
+	"github.com/okex/exchain/x/erc20/types"
+	"github.com/okex/exchain/x/gov"
+	"github.com/spf13/cobra"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	erc20TxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		SuggestionsMinimumDistance: 2,
+	}
+
+	erc20TxCmd.AddCommand(client.PostCommands(
+		GetCmdConvertCoin(cdc),
+		GetCmdConvertERC20(cdc),
+	)...)
+	return erc20TxCmd
+}
+
+func GetCmdConvertCoin(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "convert-coin [amount]",
+		Short: "convert native coins to erc20 tokens",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Convert native coins to the tokens of the erc20 contract mapped to them, the tokens are
+minted to the same address.
+
+Example:
+$ %s tx erc20 convert-coin 10xxb --from mykey
+`, version.ClientName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			amount, err := sdk.ParseDecCoin(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgConvertCoin(cliCtx.GetFromAddress(), amount)
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdConvertERC20(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "convert-erc20 [contract] [amount]",
+		Short: "convert erc20 tokens to native coins",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Convert the tokens of an erc20 contract deployed by the module to the native coins mapped to
+it, the coins are sent to the same address. The amount has 18 decimals as the native coins.
+
+Example:
+$ %s tx erc20 convert-erc20 0x4b1Ed27A4f1CdCa4dC1fbc5F3C3E1A4e8e4a0f18 10.5 --from mykey
+`, version.ClientName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			if !ethcmn.IsHexAddress(args[0]) {
+				return fmt.Errorf("invalid contract address: %s", args[0])
+			}
+			amount, err := sdk.NewDecFromStr(args[1])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgConvertERC20(cliCtx.GetFromAddress(), ethcmn.HexToAddress(args[0]), amount)
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdTokenMappingProposal implements a command handler for submitting a token mapping proposal transaction
+func GetCmdTokenMappingProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "token-mapping [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to map a native token to an erc20 contract",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a token mapping proposal along with an initial deposit.
+Once the proposal passes, the module deploys an erc20 contract for the native token, and the token can be
+converted between the native coins and the erc20 tokens in both directions.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal token-mapping <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "map xxb to erc20",
+  "description": "deploy the erc20 contract of xxb",
+  "denom": "xxb",
+  "deposit": [
+    {
+      "denom": "%s",
+      "amount": "100.000000000000000000"
+    }
+  ]
+}
+`, version.ClientName, sdk.DefaultBondDenom,
+			)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := erc20utils.ParseTokenMappingProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			content := types.NewTokenMappingProposal(proposal.Title, proposal.Description, proposal.Denom)
+			if err := content.ValidateBasic(); err != nil {
+				return err
+			}
+
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, cliCtx.GetFromAddress())
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
@@ -0,0 +1,85 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/okex/exchain/libs/cosmos-sdk/client/context"
+	"github.com/okex/exchain/libs/cosmos-sdk/types/rest"
+	"github.com/okex/exchain/x/common"
+	"github.com/okex/exchain/x/erc20/types"
+	govRest "github.com/okex/exchain/x/gov/client/rest"
+)
+
+// RegisterRoutes registers erc20-related REST handlers to a router
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	// get the token mapping of a native token or an erc20 contract
+	r.HandleFunc(
+		"/erc20/token_mapping/{denomOrContract}",
+		queryTokenMappingHandlerFn(cliCtx),
+	).Methods("GET")
+
+	// get all the token mappings
+	r.HandleFunc(
+		"/erc20/token_mappings",
+		queryHandlerFn(cliCtx, types.QueryTokenMappings),
+	).Methods("GET")
+
+	// get the current erc20 parameter values
+	r.HandleFunc(
+		"/erc20/parameters",
+		queryHandlerFn(cliCtx, types.QueryParameters),
+	).Methods("GET")
+}
+
+// TokenMappingProposalRESTHandler defines erc20 proposal handler
+func TokenMappingProposalRESTHandler(context.CLIContext) govRest.ProposalRESTHandler {
+	return govRest.ProposalRESTHandler{}
+}
+
+func queryTokenMappingHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		bz, err := cliCtx.Codec.MarshalJSON(types.NewQueryTokenMappingParams(mux.Vars(r)["denomOrContract"]))
+		if err != nil {
+			common.HandleErrorMsg(w, cliCtx, common.CodeMarshalJSONFailed, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryTokenMapping)
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			sdkErr := common.ParseSDKError(err.Error())
+			common.HandleErrorMsg(w, cliCtx, sdkErr.Code, sdkErr.Message)
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func queryHandlerFn(cliCtx context.CLIContext, path string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, path)
+		res, height, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			sdkErr := common.ParseSDKError(err.Error())
+			common.HandleErrorMsg(w, cliCtx, sdkErr.Code, sdkErr.Message)
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
@@ -0,0 +1,32 @@
+package erc20
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/erc20/keeper"
+	"github.com/okex/exchain/x/erc20/types"
+)
+
+// InitGenesis initializes the params and the token mappings, the contracts missing in the evm state are deployed
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	k.SetParams(ctx, data.Params)
+	if len(data.TokenMappings) != 0 && !sdk.IsErc20Enabled(ctx.BlockHeight()) {
+		panic(types.ErrModuleDisabled(ctx.BlockHeight()))
+	}
+
+	for _, tm := range data.TokenMappings {
+		contract := tm.ContractAddress()
+		if !k.IsContractDeployed(ctx, contract) {
+			deployed, err := k.DeployContract(ctx, tm.Denom)
+			if err != nil {
+				panic(err)
+			}
+			contract = deployed
+		}
+		k.SetTokenMapping(ctx, tm.Denom, contract)
+	}
+}
+
+// ExportGenesis writes the current store values to a genesis file, which can be imported again with InitGenesis
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
+	return types.NewGenesisState(k.GetParams(ctx), k.GetTokenMappings(ctx))
+}
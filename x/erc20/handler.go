@@ -0,0 +1,87 @@
+package erc20
+
+import (
+	"fmt"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/common"
+	"github.com/okex/exchain/x/common/perf"
+	"github.com/okex/exchain/x/erc20/keeper"
+	"github.com/okex/exchain/x/erc20/types"
+)
+
+// NewHandler creates an sdk.Handler for all the erc20 type messages
+func NewHandler(k keeper.Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		var handlerFun func() (*sdk.Result, error)
+		var name string
+		switch msg := msg.(type) {
+		case types.MsgConvertCoin:
+			name = "handleMsgConvertCoin"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgConvertCoin(ctx, k, msg)
+			}
+		case types.MsgConvertERC20:
+			name = "handleMsgConvertERC20"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgConvertERC20(ctx, k, msg)
+			}
+		default:
+			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
+			return types.ErrUnknownMsgType(errMsg).Result()
+		}
+
+		seq := perf.GetPerf().OnDeliverTxEnter(ctx, types.ModuleName, name)
+		defer perf.GetPerf().OnDeliverTxExit(ctx, types.ModuleName, name, seq)
+
+		res, err := handlerFun()
+		common.SanityCheckHandler(res, err)
+		return res, err
+	}
+}
+
+func handleMsgConvertCoin(ctx sdk.Context, k keeper.Keeper, msg types.MsgConvertCoin) (*sdk.Result, error) {
+	contract, err := k.ConvertCoin(ctx, msg.Sender, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeConvertCoin,
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Sender.String()),
+			sdk.NewAttribute(types.AttributeKeyContract, contract.Hex()),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgConvertERC20(ctx sdk.Context, k keeper.Keeper, msg types.MsgConvertERC20) (*sdk.Result, error) {
+	denom, err := k.ConvertERC20(ctx, msg.Sender, ethcmn.HexToAddress(msg.Contract), msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeConvertERC20,
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Sender.String()),
+			sdk.NewAttribute(types.AttributeKeyContract, msg.Contract),
+			sdk.NewAttribute(types.AttributeKeyAmount, sdk.NewDecCoinFromDec(denom, msg.Amount).String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"bytes"
+	"math/big"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/erc20/types"
+	evmtypes "github.com/okex/exchain/x/evm/types"
+)
+
+// DeployContract deploys the erc20 contract of denom at types.ContractAddress(denom)
+func (k Keeper) DeployContract(ctx sdk.Context, denom string) (ethcmn.Address, error) {
+	contract := types.ContractAddress(denom)
+
+	csdb := k.newStateDB(ctx)
+	// an account may have been created at the address by a transfer, but it can't have been used
+	if csdb.GetNonce(contract) != 0 || csdb.GetCodeSize(contract) != 0 {
+		return ethcmn.Address{}, types.ErrContractExist(contract.Hex())
+	}
+	csdb.SetNonce(contract, 1)
+	csdb.SetCode(contract, types.ContractCode)
+	if err := commitStateDB(csdb); err != nil {
+		return ethcmn.Address{}, err
+	}
+	return contract, nil
+}
+
+// IsContractDeployed returns whether the code of the erc20 contracts is deployed at contract
+func (k Keeper) IsContractDeployed(ctx sdk.Context, contract ethcmn.Address) bool {
+	return bytes.Equal(k.newStateDB(ctx).GetCode(contract), types.ContractCode)
+}
+
+func (k Keeper) newStateDB(ctx sdk.Context) *evmtypes.CommitStateDB {
+	return evmtypes.CreateEmptyCommitStateDB(k.evmKeeper.GenerateCSDBParams(), ctx)
+}
+
+// commitStateDB writes the changes of csdb made out of the evm to the store
+func commitStateDB(csdb *evmtypes.CommitStateDB) error {
+	if err := csdb.Finalise(true); err != nil {
+		return err
+	}
+	_, err := csdb.Commit(true)
+	return err
+}
+
+// erc20State reads and writes the storage of an erc20 contract in a state db, the changes can be reverted as the
+// ones made by the evm
+type erc20State struct {
+	csdb     *evmtypes.CommitStateDB
+	contract ethcmn.Address
+}
+
+func (s erc20State) get(slot ethcmn.Hash) *big.Int {
+	return s.csdb.GetState(s.contract, slot).Big()
+}
+
+func (s erc20State) set(slot ethcmn.Hash, value *big.Int) {
+	s.csdb.SetState(s.contract, slot, ethcmn.BigToHash(value))
+}
+
+func (s erc20State) totalSupply() *big.Int {
+	return s.get(types.TotalSupplySlot)
+}
+
+func (s erc20State) balanceOf(account ethcmn.Address) *big.Int {
+	return s.get(types.BalanceSlot(account))
+}
+
+func (s erc20State) allowance(owner, spender ethcmn.Address) *big.Int {
+	return s.get(types.AllowanceSlot(owner, spender))
+}
+
+func (s erc20State) approve(owner, spender ethcmn.Address, amount *big.Int) {
+	s.set(types.AllowanceSlot(owner, spender), amount)
+}
+
+func (s erc20State) transfer(from, to ethcmn.Address, amount *big.Int) error {
+	fromBalance := s.balanceOf(from)
+	if fromBalance.Cmp(amount) < 0 {
+		return types.ErrInsufficientBalance(decFromWei(fromBalance), decFromWei(amount))
+	}
+	s.set(types.BalanceSlot(from), new(big.Int).Sub(fromBalance, amount))
+	s.set(types.BalanceSlot(to), new(big.Int).Add(s.balanceOf(to), amount))
+	return nil
+}
+
+// mint creates amount tokens for the account, they must be backed by the native coins held by the contract
+func (s erc20State) mint(account ethcmn.Address, amount *big.Int) {
+	s.set(types.BalanceSlot(account), new(big.Int).Add(s.balanceOf(account), amount))
+	s.set(types.TotalSupplySlot, new(big.Int).Add(s.totalSupply(), amount))
+}
+
+// burn destroys amount tokens of the account, the native coins backing them must be released by the contract
+func (s erc20State) burn(account ethcmn.Address, amount *big.Int) error {
+	balance := s.balanceOf(account)
+	if balance.Cmp(amount) < 0 {
+		return types.ErrInsufficientBalance(decFromWei(balance), decFromWei(amount))
+	}
+	s.set(types.BalanceSlot(account), new(big.Int).Sub(balance, amount))
+	s.set(types.TotalSupplySlot, new(big.Int).Sub(s.totalSupply(), amount))
+	return nil
+}
+
+// decFromWei converts an erc20 amount with 18 decimals to a native amount
+func decFromWei(amount *big.Int) sdk.Dec {
+	return sdk.NewDecFromBigIntWithPrec(amount, sdk.Precision)
+}
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/erc20/types"
+)
+
+// ConvertCoin locks the native coins of the sender in the erc20 contract and mints the same amount of erc20 tokens
+// to the sender
+func (k Keeper) ConvertCoin(ctx sdk.Context, sender sdk.AccAddress, amount sdk.SysCoin) (ethcmn.Address, error) {
+	if !k.GetParams(ctx).EnableConversion {
+		return ethcmn.Address{}, types.ErrConversionDisabled()
+	}
+	contract, found := k.GetContractByDenom(ctx, amount.Denom)
+	if !found {
+		return ethcmn.Address{}, types.ErrTokenMappingNotExist(amount.Denom)
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, sender, sdk.AccAddress(contract.Bytes()), sdk.NewCoins(amount)); err != nil {
+		return ethcmn.Address{}, err
+	}
+
+	// the state db is created after the coins are sent, so that it doesn't overwrite the account of the contract
+	csdb := k.newStateDB(ctx)
+	erc20State{csdb, contract}.mint(ethcmn.BytesToAddress(sender), amount.Amount.BigInt())
+	return contract, commitStateDB(csdb)
+}
+
+// ConvertERC20 burns the erc20 tokens of the sender and releases the same amount of native coins locked in the erc20
+// contract to the sender
+func (k Keeper) ConvertERC20(ctx sdk.Context, sender sdk.AccAddress, contract ethcmn.Address, amount sdk.Dec) (string, error) {
+	if !k.GetParams(ctx).EnableConversion {
+		return "", types.ErrConversionDisabled()
+	}
+	denom, found := k.GetDenomByContract(ctx, contract)
+	if !found {
+		return "", types.ErrTokenMappingNotExist(contract.Hex())
+	}
+
+	csdb := k.newStateDB(ctx)
+	if err := (erc20State{csdb, contract}).burn(ethcmn.BytesToAddress(sender), amount.BigInt()); err != nil {
+		return "", err
+	}
+	if err := commitStateDB(csdb); err != nil {
+		return "", err
+	}
+
+	coins := sdk.NewCoins(sdk.NewDecCoinFromDec(denom, amount))
+	return denom, k.bankKeeper.SendCoins(ctx, sdk.AccAddress(contract.Bytes()), sender, coins)
+}
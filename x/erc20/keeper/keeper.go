@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/tendermint/libs/log"
+	"github.com/okex/exchain/x/erc20/types"
+)
+
+// Keeper of the erc20 store
+type Keeper struct {
+	storeKey      sdk.StoreKey
+	cdc           *codec.Codec
+	paramSubspace types.ParamSubspace
+	bankKeeper    types.BankKeeper
+	tokenKeeper   types.TokenKeeper
+	evmKeeper     types.EvmKeeper
+	govKeeper     GovKeeper
+}
+
+// NewKeeper creates an erc20 keeper
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramSubspace types.ParamSubspace,
+	bankKeeper types.BankKeeper, tokenKeeper types.TokenKeeper, evmKeeper types.EvmKeeper) Keeper {
+	return Keeper{
+		storeKey:      key,
+		cdc:           cdc,
+		paramSubspace: paramSubspace.WithKeyTable(types.ParamKeyTable()),
+		bankKeeper:    bankKeeper,
+		tokenKeeper:   tokenKeeper,
+		evmKeeper:     evmKeeper,
+	}
+}
+
+// Logger returns a module-specific logger
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", types.ModuleName)
+}
+
+// SetGovKeeper sets keeper of gov
+func (k *Keeper) SetGovKeeper(gk GovKeeper) {
+	k.govKeeper = gk
+}
+
+// SetTokenMapping maps denom to contract in both directions
+func (k Keeper) SetTokenMapping(ctx sdk.Context, denom string, contract ethcmn.Address) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetDenomToContractKey(denom), contract.Bytes())
+	store.Set(types.GetContractToDenomKey(contract), []byte(denom))
+}
+
+// GetContractByDenom returns the erc20 contract mapped to denom
+func (k Keeper) GetContractByDenom(ctx sdk.Context, denom string) (ethcmn.Address, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetDenomToContractKey(denom))
+	if bz == nil {
+		return ethcmn.Address{}, false
+	}
+	return ethcmn.BytesToAddress(bz), true
+}
+
+// GetDenomByContract returns the denom mapped to the erc20 contract
+func (k Keeper) GetDenomByContract(ctx sdk.Context, contract ethcmn.Address) (string, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetContractToDenomKey(contract))
+	if bz == nil {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// IterateTokenMappings iterates over the token mappings ordered by denom and stops when cb returns true
+func (k Keeper) IterateTokenMappings(ctx sdk.Context, cb func(tm types.TokenMapping) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.DenomToContractPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		denom := string(iterator.Key()[len(types.DenomToContractPrefix):])
+		if cb(types.NewTokenMapping(denom, ethcmn.BytesToAddress(iterator.Value()))) {
+			break
+		}
+	}
+}
+
+// GetTokenMappings returns all the token mappings
+func (k Keeper) GetTokenMappings(ctx sdk.Context) []types.TokenMapping {
+	tokenMappings := []types.TokenMapping{}
+	k.IterateTokenMappings(ctx, func(tm types.TokenMapping) bool {
+		tokenMappings = append(tokenMappings, tm)
+		return false
+	})
+	return tokenMappings
+}
@@ -0,0 +1,184 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/okex/exchain/app"
+	ethermint "github.com/okex/exchain/app/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/store/prefix"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/auth"
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	"github.com/okex/exchain/x/erc20/types"
+	evmtypes "github.com/okex/exchain/x/evm/types"
+	"github.com/okex/exchain/x/params"
+	"github.com/stretchr/testify/suite"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app      *app.OKExChainApp
+	ctx      sdk.Context
+	sender   ethcmn.Address
+	to       ethcmn.Address
+	contract ethcmn.Address
+	nonce    uint64
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.app = app.Setup(false)
+	suite.ctx = suite.app.BaseApp.NewContext(false, abci.Header{Height: 1, ChainID: "ethermint-1"})
+	suite.nonce = 0
+
+	suite.sender = ethcmn.BytesToAddress([]byte("sender"))
+	suite.to = ethcmn.BytesToAddress([]byte("to"))
+	coins := sdk.NewCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDec(100)), sdk.NewDecCoinFromDec("xxb", sdk.NewDec(50)))
+	suite.app.AccountKeeper.SetAccount(suite.ctx, &ethermint.EthAccount{
+		BaseAccount: auth.NewBaseAccount(sdk.AccAddress(suite.sender.Bytes()), coins, nil, 0, 0),
+		CodeHash:    ethcrypto.Keccak256(nil),
+	})
+
+	params := evmtypes.DefaultParams()
+	params.EnableCall = true
+	suite.app.EvmKeeper.SetParams(suite.ctx, params)
+	suite.app.Erc20Keeper.SetParams(suite.ctx, types.DefaultParams())
+
+	var err error
+	suite.contract, err = suite.app.Erc20Keeper.DeployContract(suite.ctx, "xxb")
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.ContractAddress("xxb"), suite.contract)
+	suite.app.Erc20Keeper.SetTokenMapping(suite.ctx, "xxb", suite.contract)
+}
+
+func (suite *KeeperTestSuite) call(to ethcmn.Address, method string, args ...interface{}) ([]interface{}, error) {
+	payload, err := types.ERC20.Pack(method, args...)
+	suite.Require().NoError(err)
+
+	st := evmtypes.StateTransition{
+		AccountNonce: suite.nonce,
+		Price:        big.NewInt(1),
+		GasLimit:     1000000,
+		Recipient:    &to,
+		Amount:       big.NewInt(0),
+		Payload:      payload,
+		ChainID:      big.NewInt(1),
+		Csdb:         evmtypes.CreateEmptyCommitStateDB(suite.app.EvmKeeper.GenerateCSDBParams(), suite.ctx),
+		TxHash:       &ethcmn.Hash{},
+		Sender:       suite.sender,
+	}
+	config := evmtypes.DefaultChainConfig()
+	config.PrecompileBlock = sdk.ZeroInt()
+	_, res, err, _, _ := st.TransitionDb(suite.ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	suite.nonce++
+	return types.ERC20.Unpack(method, res.Ret)
+}
+
+func (suite *KeeperTestSuite) balance(addr ethcmn.Address, denom string) sdk.Dec {
+	acc := suite.app.AccountKeeper.GetAccount(suite.ctx, sdk.AccAddress(addr.Bytes()))
+	if acc == nil {
+		return sdk.ZeroDec()
+	}
+	return acc.GetCoins().AmountOf(denom)
+}
+
+func (suite *KeeperTestSuite) erc20Balance(addr ethcmn.Address) *big.Int {
+	out, err := suite.call(suite.contract, "balanceOf", addr)
+	suite.Require().NoError(err)
+	return out[0].(*big.Int)
+}
+
+func (suite *KeeperTestSuite) TestDeployContract() {
+	suite.Require().True(suite.app.Erc20Keeper.IsContractDeployed(suite.ctx, suite.contract))
+	_, err := suite.app.Erc20Keeper.DeployContract(suite.ctx, "xxb")
+	suite.Require().Error(err)
+
+	denom, found := suite.app.Erc20Keeper.GetDenomByContract(suite.ctx, suite.contract)
+	suite.Require().True(found)
+	suite.Require().Equal("xxb", denom)
+	suite.Require().Len(suite.app.Erc20Keeper.GetTokenMappings(suite.ctx), 1)
+}
+
+func (suite *KeeperTestSuite) TestConvert() {
+	sender := sdk.AccAddress(suite.sender.Bytes())
+	_, err := suite.app.Erc20Keeper.ConvertCoin(suite.ctx, sender, sdk.NewDecCoinFromDec("xxb", sdk.NewDec(20)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(30), suite.balance(suite.sender, "xxb"))
+	suite.Require().Equal(sdk.NewDec(20), suite.balance(suite.contract, "xxb"))
+	suite.Require().Equal(sdk.NewDec(20).BigInt(), suite.erc20Balance(suite.sender))
+
+	_, err = suite.app.Erc20Keeper.ConvertERC20(suite.ctx, sender, suite.contract, sdk.NewDec(5))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(35), suite.balance(suite.sender, "xxb"))
+	suite.Require().Equal(sdk.NewDec(15), suite.balance(suite.contract, "xxb"))
+	suite.Require().Equal(sdk.NewDec(15).BigInt(), suite.erc20Balance(suite.sender))
+
+	// insufficient erc20 balance
+	_, err = suite.app.Erc20Keeper.ConvertERC20(suite.ctx, sender, suite.contract, sdk.NewDec(16))
+	suite.Require().Error(err)
+
+	// unmapped denom
+	_, err = suite.app.Erc20Keeper.ConvertCoin(suite.ctx, sender, sdk.NewDecCoinFromDec("yyb", sdk.NewDec(1)))
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestContract() {
+	out, err := suite.call(suite.contract, "convertFromNative", sdk.NewDec(10).BigInt())
+	suite.Require().NoError(err)
+	suite.Require().True(out[0].(bool))
+	suite.Require().Equal(sdk.NewDec(40), suite.balance(suite.sender, "xxb"))
+	suite.Require().Equal(sdk.NewDec(10), suite.balance(suite.contract, "xxb"))
+
+	_, err = suite.call(suite.contract, "transfer", suite.to, sdk.NewDec(4).BigInt())
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(6).BigInt(), suite.erc20Balance(suite.sender))
+	suite.Require().Equal(sdk.NewDec(4).BigInt(), suite.erc20Balance(suite.to))
+
+	out, err = suite.call(suite.contract, "totalSupply")
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(10).BigInt(), out[0].(*big.Int))
+
+	out, err = suite.call(suite.contract, "symbol")
+	suite.Require().NoError(err)
+	suite.Require().Equal("xxb", out[0].(string))
+
+	// insufficient balance reverts
+	_, err = suite.call(suite.contract, "transfer", suite.to, sdk.NewDec(7).BigInt())
+	suite.Require().Error(err)
+	suite.Require().Equal(sdk.NewDec(6).BigInt(), suite.erc20Balance(suite.sender))
+
+	_, err = suite.call(suite.contract, "convertToNative", sdk.NewDec(6).BigInt())
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(46), suite.balance(suite.sender, "xxb"))
+	suite.Require().Equal(sdk.NewDec(4), suite.balance(suite.contract, "xxb"))
+	suite.Require().Equal(0, suite.erc20Balance(suite.sender).Sign())
+}
+
+func (suite *KeeperTestSuite) TestPrecompileCalledDirectly() {
+	_, err := suite.call(types.PrecompileAddress, "convertToNative", sdk.NewDec(1).BigInt())
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestGetParamsBeforeSet() {
+	// the params of a chain started before the erc20 module is added
+	store := prefix.NewStore(suite.ctx.KVStore(suite.app.GetKey(params.StoreKey)), []byte(types.DefaultParamspace+"/"))
+	store.Delete(types.KeyEnableConversion)
+
+	var erc20Params types.Params
+	suite.Require().NotPanics(func() { erc20Params = suite.app.Erc20Keeper.GetParams(suite.ctx) })
+	suite.Require().Equal(types.DefaultParams(), erc20Params)
+
+	erc20Params.EnableConversion = false
+	suite.app.Erc20Keeper.SetParams(suite.ctx, erc20Params)
+	suite.Require().False(suite.app.Erc20Keeper.GetParams(suite.ctx).EnableConversion)
+}
@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/erc20/types"
+)
+
+// SetParams sets the erc20 parameters to the param space.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSubspace.SetParamSet(ctx, &params)
+}
+
+// GetParams returns the total set of erc20 parameters. The module is added after genesis, so the default
+// ones are used on a chain started before until they are set by a param change proposal.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	params = types.DefaultParams()
+	for _, pair := range params.ParamSetPairs() {
+		k.paramSubspace.GetIfExists(ctx, pair.Key, pair.Value)
+	}
+	return
+}
@@ -0,0 +1,225 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/okex/exchain/x/erc20/types"
+	evmtypes "github.com/okex/exchain/x/evm/types"
+)
+
+var _ evmtypes.Precompile = Precompile{}
+
+// gas of the erc20 methods, not including the call to the contract
+var precompileGas = map[string]uint64{
+	"name":              2000,
+	"symbol":            2000,
+	"decimals":          1000,
+	"denom":             2000,
+	"totalSupply":       2000,
+	"balanceOf":         2000,
+	"allowance":         2000,
+	"transfer":          30000,
+	"approve":           25000,
+	"transferFrom":      40000,
+	"convertFromNative": 40000,
+	"convertToNative":   40000,
+}
+
+// revertSelector is the selector of Error(string), the revert reason of solidity
+var revertSelector = []byte{0x08, 0xc3, 0x79, 0xa0}
+
+// Precompile implements the erc20 contracts deployed by the module, it can only be called by them. The input is
+// the calldata of the contract followed by its caller, see types.ContractCode for the output.
+type Precompile struct {
+	k Keeper
+}
+
+// NewPrecompile creates the precompile of the erc20 contracts
+func NewPrecompile(k Keeper) Precompile {
+	return Precompile{k: k}
+}
+
+func (p Precompile) Address() ethcmn.Address {
+	return types.PrecompileAddress
+}
+
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	if len(input) < 4 {
+		return 0
+	}
+	method, err := types.ERC20.MethodById(input[:4])
+	if err != nil {
+		return 0
+	}
+	return precompileGas[method.Name]
+}
+
+func (p Precompile) Run(pctx *evmtypes.PrecompileContext, input []byte) ([]byte, error) {
+	contract := pctx.Caller
+	denom, found := p.k.GetDenomByContract(pctx.Ctx, contract)
+	if !found {
+		return revert("erc20 precompile can only be called by the erc20 contracts")
+	}
+	if len(input) < 4+ethcmn.HashLength {
+		return revert("input is too short to contain a method id")
+	}
+	caller := ethcmn.BytesToAddress(input[len(input)-ethcmn.HashLength:])
+	input = input[:len(input)-ethcmn.HashLength]
+
+	method, err := types.ERC20.MethodById(input[:4])
+	if err != nil {
+		return revert(err.Error())
+	}
+	args, err := method.Inputs.Unpack(input[4:])
+	if err != nil {
+		return revert(err.Error())
+	}
+	if !method.IsConstant() && pctx.ReadOnly {
+		return revert("state change is not allowed in a static call")
+	}
+
+	state := erc20State{pctx.StateDB, contract}
+	switch method.Name {
+	case "name":
+		name := p.k.tokenKeeper.GetTokenInfo(pctx.Ctx, denom).WholeName
+		if name == "" {
+			name = denom
+		}
+		return viewOutput(method, name)
+	case "symbol", "denom":
+		return viewOutput(method, denom)
+	case "decimals":
+		return viewOutput(method, uint8(types.Decimals))
+	case "totalSupply":
+		return viewOutput(method, state.totalSupply())
+	case "balanceOf":
+		return viewOutput(method, state.balanceOf(args[0].(ethcmn.Address)))
+	case "allowance":
+		return viewOutput(method, state.allowance(args[0].(ethcmn.Address), args[1].(ethcmn.Address)))
+	case "transfer":
+		to, amount := args[0].(ethcmn.Address), args[1].(*big.Int)
+		if err := state.transfer(caller, to, amount); err != nil {
+			return revert(err.Error())
+		}
+		return logOutput(method, "Transfer", caller, to, amount)
+	case "approve":
+		spender, amount := args[0].(ethcmn.Address), args[1].(*big.Int)
+		state.approve(caller, spender, amount)
+		return logOutput(method, "Approval", caller, spender, amount)
+	case "transferFrom":
+		from, to, amount := args[0].(ethcmn.Address), args[1].(ethcmn.Address), args[2].(*big.Int)
+		allowance := state.allowance(from, caller)
+		if allowance.Cmp(amount) < 0 {
+			return revert(fmt.Sprintf("insufficient allowance: %s < %s", allowance, amount))
+		}
+		// the max allowance is never decreased, as the openzeppelin erc20
+		if allowance.Cmp(abi.MaxUint256) != 0 {
+			state.approve(from, caller, new(big.Int).Sub(allowance, amount))
+		}
+		if err := state.transfer(from, to, amount); err != nil {
+			return revert(err.Error())
+		}
+		return logOutput(method, "Transfer", from, to, amount)
+	case "convertFromNative":
+		amount := args[0].(*big.Int)
+		if err := p.convertFromNative(pctx, state, denom, caller, amount); err != nil {
+			return revert(err.Error())
+		}
+		return logOutput(method, "Transfer", ethcmn.Address{}, caller, amount)
+	case "convertToNative":
+		amount := args[0].(*big.Int)
+		if err := p.convertToNative(pctx, state, denom, caller, amount); err != nil {
+			return revert(err.Error())
+		}
+		return logOutput(method, "Transfer", caller, ethcmn.Address{}, amount)
+	default:
+		return revert(fmt.Sprintf("unknown method %s", method.Name))
+	}
+}
+
+// convertFromNative locks the native coins of the caller in the contract and mints the erc20 tokens, the coins are
+// moved through the state db so that they are reverted with the evm call
+func (p Precompile) convertFromNative(pctx *evmtypes.PrecompileContext, state erc20State, denom string,
+	caller ethcmn.Address, amount *big.Int) error {
+	if !p.k.GetParams(pctx.Ctx).EnableConversion {
+		return types.ErrConversionDisabled()
+	}
+	if amount.Sign() <= 0 {
+		return errors.New("amount must be positive")
+	}
+
+	amt := decFromWei(amount)
+	csdb := pctx.StateDB
+	balance := csdb.GetCoinBalance(caller, denom)
+	if balance.LT(amt) {
+		return fmt.Errorf("insufficient %s balance: %s < %s", denom, balance, amt)
+	}
+	csdb.SetCoinBalance(caller, denom, balance.Sub(amt))
+	csdb.SetCoinBalance(state.contract, denom, csdb.GetCoinBalance(state.contract, denom).Add(amt))
+	state.mint(caller, amount)
+	return nil
+}
+
+// convertToNative burns the erc20 tokens of the caller and releases the native coins locked in the contract
+func (p Precompile) convertToNative(pctx *evmtypes.PrecompileContext, state erc20State, denom string,
+	caller ethcmn.Address, amount *big.Int) error {
+	if !p.k.GetParams(pctx.Ctx).EnableConversion {
+		return types.ErrConversionDisabled()
+	}
+	if amount.Sign() <= 0 {
+		return errors.New("amount must be positive")
+	}
+	if err := state.burn(caller, amount); err != nil {
+		return err
+	}
+
+	amt := decFromWei(amount)
+	csdb := pctx.StateDB
+	locked := csdb.GetCoinBalance(state.contract, denom)
+	if locked.LT(amt) {
+		// never happens as long as the erc20 tokens are only minted for the locked coins
+		return fmt.Errorf("insufficient locked %s: %s < %s", denom, locked, amt)
+	}
+	csdb.SetCoinBalance(state.contract, denom, locked.Sub(amt))
+	csdb.SetCoinBalance(caller, denom, csdb.GetCoinBalance(caller, denom).Add(amt))
+	return nil
+}
+
+// viewOutput returns the output of a method not changing the state
+func viewOutput(method *abi.Method, values ...interface{}) ([]byte, error) {
+	ret, err := method.Outputs.Pack(values...)
+	if err != nil {
+		return nil, err
+	}
+	return append(make([]byte, ethcmn.HashLength), ret...), nil
+}
+
+// logOutput returns the output of a method changing the state, with the event logged by the contract
+func logOutput(method *abi.Method, event string, from, to ethcmn.Address, value *big.Int) ([]byte, error) {
+	ret, err := method.Outputs.Pack(true)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]byte, 0, 5*ethcmn.HashLength+len(ret))
+	out = append(out, ethcmn.BigToHash(ethcmn.Big1).Bytes()...)
+	out = append(out, types.ERC20.Events[event].ID.Bytes()...)
+	out = append(out, from.Hash().Bytes()...)
+	out = append(out, to.Hash().Bytes()...)
+	out = append(out, ethcmn.BigToHash(value).Bytes()...)
+	return append(out, ret...), nil
+}
+
+// revert fails the call with reason, which is returned to the caller as a solidity revert reason
+func revert(reason string) ([]byte, error) {
+	stringType, _ := abi.NewType("string", "", nil)
+	data, err := abi.Arguments{{Type: stringType}}.Pack(reason)
+	if err != nil {
+		return nil, err
+	}
+	return append(append([]byte{}, revertSelector...), data...), vm.ErrExecutionReverted
+}
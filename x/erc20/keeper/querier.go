@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	"github.com/okex/exchain/x/common"
+	"github.com/okex/exchain/x/erc20/types"
+)
+
+// NewQuerier creates a new querier for erc20 clients.
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case types.QueryParameters:
+			return queryParams(ctx, k)
+		case types.QueryTokenMapping:
+			return queryTokenMapping(ctx, req, k)
+		case types.QueryTokenMappings:
+			return queryTokenMappings(ctx, k)
+		default:
+			return nil, types.ErrUnknownQueryType(path[0])
+		}
+	}
+}
+
+func queryParams(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, k.GetParams(ctx))
+	if err != nil {
+		return nil, common.ErrMarshalJSONFailed(err.Error())
+	}
+	return res, nil
+}
+
+func queryTokenMapping(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryTokenMappingParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, common.ErrUnMarshalJSONFailed(err.Error())
+	}
+
+	var tokenMapping types.TokenMapping
+	if ethcmn.IsHexAddress(params.DenomOrContract) {
+		contract := ethcmn.HexToAddress(params.DenomOrContract)
+		denom, found := k.GetDenomByContract(ctx, contract)
+		if !found {
+			return nil, types.ErrTokenMappingNotExist(params.DenomOrContract)
+		}
+		tokenMapping = types.NewTokenMapping(denom, contract)
+	} else {
+		contract, found := k.GetContractByDenom(ctx, params.DenomOrContract)
+		if !found {
+			return nil, types.ErrTokenMappingNotExist(params.DenomOrContract)
+		}
+		tokenMapping = types.NewTokenMapping(params.DenomOrContract, contract)
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, tokenMapping)
+	if err != nil {
+		return nil, common.ErrMarshalJSONFailed(err.Error())
+	}
+	return res, nil
+}
+
+func queryTokenMappings(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, k.GetTokenMappings(ctx))
+	if err != nil {
+		return nil, common.ErrMarshalJSONFailed(err.Error())
+	}
+	return res, nil
+}
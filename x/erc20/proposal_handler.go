@@ -0,0 +1,45 @@
+package erc20
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/common"
+	"github.com/okex/exchain/x/erc20/types"
+	govTypes "github.com/okex/exchain/x/gov/types"
+)
+
+// NewTokenMappingProposalHandler handles "gov" type message in "erc20"
+func NewTokenMappingProposalHandler(k *Keeper) govTypes.Handler {
+	return func(ctx sdk.Context, proposal *govTypes.Proposal) (err sdk.Error) {
+		switch content := proposal.Content.(type) {
+		case types.TokenMappingProposal:
+			return handleTokenMappingProposal(ctx, k, proposal)
+		default:
+			return common.ErrUnknownProposalType(DefaultCodespace, content.ProposalType())
+		}
+	}
+}
+
+func handleTokenMappingProposal(ctx sdk.Context, k *Keeper, proposal *govTypes.Proposal) sdk.Error {
+	// check
+	tokenMappingProposal, ok := proposal.Content.(types.TokenMappingProposal)
+	if !ok {
+		return types.ErrUnexpectedProposalType(proposal.Content.ProposalType())
+	}
+	// the token may have been mapped by another proposal during the voting period
+	if sdkErr := k.CheckTokenMappingProposal(ctx, tokenMappingProposal); sdkErr != nil {
+		return sdkErr
+	}
+
+	contract, err := k.DeployContract(ctx, tokenMappingProposal.Denom)
+	if err != nil {
+		return sdk.ErrInternal(err.Error())
+	}
+	k.SetTokenMapping(ctx, tokenMappingProposal.Denom, contract)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeTokenMapping,
+		sdk.NewAttribute(types.AttributeKeyDenom, tokenMappingProposal.Denom),
+		sdk.NewAttribute(types.AttributeKeyContract, contract.Hex()),
+	))
+	return nil
+}
@@ -0,0 +1,22 @@
+package types
+
+import (
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+)
+
+// RegisterCodec registers concrete types on codec
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgConvertCoin{}, "okexchain/erc20/MsgConvertCoin", nil)
+	cdc.RegisterConcrete(MsgConvertERC20{}, "okexchain/erc20/MsgConvertERC20", nil)
+	cdc.RegisterConcrete(TokenMappingProposal{}, "okexchain/erc20/TokenMappingProposal", nil)
+}
+
+// ModuleCdc defines the module codec
+var ModuleCdc *codec.Codec
+
+func init() {
+	ModuleCdc = codec.New()
+	RegisterCodec(ModuleCdc)
+	codec.RegisterCrypto(ModuleCdc)
+	ModuleCdc.Seal()
+}
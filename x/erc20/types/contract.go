@@ -0,0 +1,110 @@
+package types
+
+import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/supply"
+)
+
+// Decimals of the erc20 tokens, the same as the precision of the native coins so that the conversions are lossless
+const Decimals = 18
+
+// ERC20ABI is the abi of the erc20 contracts deployed by the module. Besides the standard erc20 methods, the
+// tokens are converted from and to the native coins of the caller by convertFromNative and convertToNative.
+const ERC20ABI = `[
+	{"type":"function","name":"name","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"string"}]},
+	{"type":"function","name":"symbol","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"string"}]},
+	{"type":"function","name":"decimals","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint8"}]},
+	{"type":"function","name":"denom","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"string"}]},
+	{"type":"function","name":"totalSupply","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"balanceOf","stateMutability":"view",
+	 "inputs":[{"name":"account","type":"address"}],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"allowance","stateMutability":"view",
+	 "inputs":[{"name":"owner","type":"address"},{"name":"spender","type":"address"}],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"transfer","stateMutability":"nonpayable",
+	 "inputs":[{"name":"recipient","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"approve","stateMutability":"nonpayable",
+	 "inputs":[{"name":"spender","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"transferFrom","stateMutability":"nonpayable",
+	 "inputs":[{"name":"sender","type":"address"},{"name":"recipient","type":"address"},{"name":"amount","type":"uint256"}],
+	 "outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"convertFromNative","stateMutability":"nonpayable",
+	 "inputs":[{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"convertToNative","stateMutability":"nonpayable",
+	 "inputs":[{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+	{"type":"event","name":"Transfer","anonymous":false,
+	 "inputs":[{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":true},
+	           {"name":"value","type":"uint256","indexed":false}]},
+	{"type":"event","name":"Approval","anonymous":false,
+	 "inputs":[{"name":"owner","type":"address","indexed":true},{"name":"spender","type":"address","indexed":true},
+	           {"name":"value","type":"uint256","indexed":false}]}
+]`
+
+var (
+	// PrecompileAddress is the address of the precompile implementing the erc20 contracts
+	PrecompileAddress = ethcmn.HexToAddress("0x0000000000000000000000000000000000000103")
+
+	// ModuleAddress is the deployer of the erc20 contracts
+	ModuleAddress = ethcmn.BytesToAddress(supply.NewModuleAddress(ModuleName))
+
+	// ERC20 is the parsed ERC20ABI
+	ERC20 abi.ABI
+
+	// ContractCode is the runtime code of the erc20 contracts. It rejects the value, calls the precompile with
+	// the calldata followed by the caller, and returns what the precompile returns. The precompile returns a
+	// flag word first, if it is set, the next 3 words are the topics of the event and the 4th one is its data,
+	// which are logged by the contract itself. The log makes the calls changing the state fail in a static
+	// context as if the contract was written in solidity.
+	ContractCode = []byte{
+		byte(vm.CALLVALUE), byte(vm.PUSH1), 0x4b, byte(vm.JUMPI), // 0x00: revert if value != 0
+		byte(vm.CALLDATASIZE), byte(vm.PUSH1), 0x00, byte(vm.PUSH1), 0x00, byte(vm.CALLDATACOPY), // 0x04: mem[0:] = calldata
+		byte(vm.CALLER), byte(vm.CALLDATASIZE), byte(vm.MSTORE), // 0x0a: mem[calldatasize:] = caller
+		byte(vm.PUSH1), 0x00, byte(vm.PUSH1), 0x00, // 0x0d: retSize, retOffset
+		byte(vm.PUSH1), 0x20, byte(vm.CALLDATASIZE), byte(vm.ADD), byte(vm.PUSH1), 0x00, // 0x11: argsSize, argsOffset
+		byte(vm.PUSH1), 0x00, byte(vm.PUSH2), 0x01, 0x03, byte(vm.GAS), byte(vm.CALL), // 0x17: call the precompile
+		byte(vm.RETURNDATASIZE), byte(vm.PUSH1), 0x00, byte(vm.PUSH1), 0x00, byte(vm.RETURNDATACOPY), // 0x1e: mem[0:] = returndata
+		byte(vm.ISZERO), byte(vm.PUSH1), 0x4b, byte(vm.JUMPI), // 0x24: revert if the call failed
+		byte(vm.PUSH1), 0x00, byte(vm.MLOAD), byte(vm.PUSH1), 0x35, byte(vm.JUMPI), // 0x28: jump to log if flag != 0
+		byte(vm.PUSH1), 0x20, byte(vm.RETURNDATASIZE), byte(vm.SUB), byte(vm.PUSH1), 0x20, byte(vm.RETURN), // 0x2e: return mem[0x20:]
+		// 0x35: log
+		byte(vm.JUMPDEST),
+		byte(vm.PUSH1), 0x60, byte(vm.MLOAD), byte(vm.PUSH1), 0x40, byte(vm.MLOAD), byte(vm.PUSH1), 0x20, byte(vm.MLOAD), // 0x36: topics
+		byte(vm.PUSH1), 0x20, byte(vm.PUSH1), 0x80, byte(vm.LOG3), // 0x3f: log mem[0x80:0xa0]
+		byte(vm.PUSH1), 0xa0, byte(vm.RETURNDATASIZE), byte(vm.SUB), byte(vm.PUSH1), 0xa0, byte(vm.RETURN), // 0x44: return mem[0xa0:]
+		// 0x4b: revert with returndata
+		byte(vm.JUMPDEST),
+		byte(vm.RETURNDATASIZE), byte(vm.PUSH1), 0x00, byte(vm.REVERT),
+	}
+
+	// storage slots of the erc20 contracts, laid out as the solidity contract
+	// mapping(address => uint256) balances; mapping(address => mapping(address => uint256)) allowances; uint256 totalSupply;
+	balancesSlot    = ethcmn.BigToHash(ethcmn.Big0)
+	allowancesSlot  = ethcmn.BigToHash(ethcmn.Big1)
+	TotalSupplySlot = ethcmn.BigToHash(ethcmn.Big2)
+)
+
+func init() {
+	var err error
+	if ERC20, err = abi.JSON(strings.NewReader(ERC20ABI)); err != nil {
+		panic(err)
+	}
+}
+
+// ContractAddress returns the address of the erc20 contract of denom, which is created as CREATE2 by the module
+func ContractAddress(denom string) ethcmn.Address {
+	return ethcrypto.CreateAddress2(ModuleAddress, ethcrypto.Keccak256Hash([]byte(denom)), ethcrypto.Keccak256(ContractCode))
+}
+
+// BalanceSlot returns the storage slot of the balance of account
+func BalanceSlot(account ethcmn.Address) ethcmn.Hash {
+	return ethcrypto.Keccak256Hash(account.Hash().Bytes(), balancesSlot.Bytes())
+}
+
+// AllowanceSlot returns the storage slot of the allowance of spender given by owner
+func AllowanceSlot(owner, spender ethcmn.Address) ethcmn.Hash {
+	return ethcrypto.Keccak256Hash(spender.Hash().Bytes(), ethcrypto.Keccak256(owner.Hash().Bytes(), allowancesSlot.Bytes()))
+}
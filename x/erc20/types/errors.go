@@ -0,0 +1,79 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
+)
+
+const (
+	DefaultCodespace string = ModuleName
+
+	CodeInvalidInput           uint32 = 68300
+	CodeTokenNotExist          uint32 = 68301
+	CodeTokenMappingExist      uint32 = 68302
+	CodeTokenMappingNotExist   uint32 = 68303
+	CodeConversionDisabled     uint32 = 68304
+	CodeInsufficientBalance    uint32 = 68305
+	CodeUnexpectedProposalType uint32 = 68306
+	CodeUnknownMsgType         uint32 = 68307
+	CodeUnknownQueryType       uint32 = 68308
+	CodeContractExist          uint32 = 68309
+	CodeModuleDisabled         uint32 = 68310
+)
+
+// ErrInvalidInput returns an error when an input parameter is invalid
+func ErrInvalidInput(msg string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInvalidInput, fmt.Sprintf("failed. invalid input: %s", msg))}
+}
+
+// ErrModuleDisabled returns an error when the erc20 module is not enabled at the block height yet
+func ErrModuleDisabled(height int64) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeModuleDisabled, fmt.Sprintf("failed. the erc20 module is not enabled at height %d", height))}
+}
+
+// ErrTokenNotExist returns an error when a token doesn't exist
+func ErrTokenNotExist(denom string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeTokenNotExist, fmt.Sprintf("failed. token %s does not exist", denom))}
+}
+
+// ErrTokenMappingExist returns an error when a denom is mapped already
+func ErrTokenMappingExist(denom string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeTokenMappingExist, fmt.Sprintf("failed. token %s is mapped to an erc20 contract already", denom))}
+}
+
+// ErrTokenMappingNotExist returns an error when a denom or a contract is not mapped
+func ErrTokenMappingNotExist(denomOrContract string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeTokenMappingNotExist, fmt.Sprintf("failed. token mapping of %s does not exist", denomOrContract))}
+}
+
+// ErrConversionDisabled returns an error when the conversions are disabled by the params
+func ErrConversionDisabled() sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeConversionDisabled, "failed. the conversions between native tokens and erc20 tokens are disabled")}
+}
+
+// ErrInsufficientBalance returns an error when the erc20 balance is not enough
+func ErrInsufficientBalance(balance, amount sdk.Dec) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInsufficientBalance, fmt.Sprintf("failed. insufficient erc20 balance: %s < %s", balance, amount))}
+}
+
+// ErrUnexpectedProposalType returns an error when the proposal type is not supported in erc20 module
+func ErrUnexpectedProposalType(proposalType string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeUnexpectedProposalType, fmt.Sprintf("failed. unsupported erc20 proposal type: %s", proposalType))}
+}
+
+// ErrUnknownMsgType returns an error when the msg type is unknown
+func ErrUnknownMsgType(msg string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeUnknownMsgType, msg)}
+}
+
+// ErrUnknownQueryType returns an error when the query path is unknown
+func ErrUnknownQueryType(path string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeUnknownQueryType, fmt.Sprintf("failed. unknown erc20 query endpoint: %s", path))}
+}
+
+// ErrContractExist returns an error when an account exists at the address of the contract to deploy
+func ErrContractExist(contract string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeContractExist, fmt.Sprintf("failed. account %s exists already", contract))}
+}
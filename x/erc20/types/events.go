@@ -0,0 +1,15 @@
+package types
+
+// erc20 module event types
+const (
+	EventTypeTokenMapping = "token-mapping"
+	EventTypeConvertCoin  = "convert-coin"
+	EventTypeConvertERC20 = "convert-erc20"
+
+	AttributeKeyAddress  = "address"
+	AttributeKeyDenom    = "denom"
+	AttributeKeyContract = "contract"
+	AttributeKeyAmount   = "amount"
+
+	AttributeValueCategory = ModuleName
+)
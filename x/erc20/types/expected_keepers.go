@@ -0,0 +1,33 @@
+package types
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	evmtypes "github.com/okex/exchain/x/evm/types"
+	"github.com/okex/exchain/x/params"
+	tokentypes "github.com/okex/exchain/x/token/types"
+)
+
+// ParamSubspace defines the expected Subspace interfacace
+type ParamSubspace interface {
+	WithKeyTable(table params.KeyTable) params.Subspace
+	Get(ctx sdk.Context, key []byte, ptr interface{})
+	GetIfExists(ctx sdk.Context, key []byte, ptr interface{})
+	GetParamSet(ctx sdk.Context, ps params.ParamSet)
+	SetParamSet(ctx sdk.Context, ps params.ParamSet)
+}
+
+// BankKeeper defines the expected bank keeper
+type BankKeeper interface {
+	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// TokenKeeper defines the expected token keeper
+type TokenKeeper interface {
+	TokenExist(ctx sdk.Context, symbol string) bool
+	GetTokenInfo(ctx sdk.Context, symbol string) tokentypes.Token
+}
+
+// EvmKeeper defines the expected evm keeper
+type EvmKeeper interface {
+	GenerateCSDBParams() evmtypes.CommitStateDBParams
+}
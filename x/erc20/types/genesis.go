@@ -0,0 +1,51 @@
+package types
+
+import (
+	"fmt"
+)
+
+// GenesisState - all erc20 state that must be provided at genesis
+type GenesisState struct {
+	Params        Params         `json:"params" yaml:"params"`
+	TokenMappings []TokenMapping `json:"token_mappings" yaml:"token_mappings"`
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, tokenMappings []TokenMapping) GenesisState {
+	return GenesisState{
+		Params:        params,
+		TokenMappings: tokenMappings,
+	}
+}
+
+// DefaultGenesisState - default GenesisState used by Cosmos Hub
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		Params:        DefaultParams(),
+		TokenMappings: []TokenMapping{},
+	}
+}
+
+// ValidateGenesis validates the erc20 genesis parameters
+func ValidateGenesis(data GenesisState) error {
+	denoms := make(map[string]struct{}, len(data.TokenMappings))
+	contracts := make(map[string]struct{}, len(data.TokenMappings))
+	for _, tm := range data.TokenMappings {
+		if err := tm.Validate(); err != nil {
+			return err
+		}
+		contract := tm.ContractAddress().Hex()
+		if contract != ContractAddress(tm.Denom).Hex() {
+			return fmt.Errorf("contract %s is not the one deployed for denom %s", contract, tm.Denom)
+		}
+		if _, found := denoms[tm.Denom]; found {
+			return fmt.Errorf("duplicated token mapping of denom %s", tm.Denom)
+		}
+		if _, found := contracts[contract]; found {
+			return fmt.Errorf("duplicated token mapping of contract %s", contract)
+		}
+		denoms[tm.Denom] = struct{}{}
+		contracts[contract] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	require.NoError(t, ValidateGenesis(DefaultGenesisState()))
+
+	xxb := NewTokenMapping("xxb", ContractAddress("xxb"))
+	genesis := NewGenesisState(DefaultParams(), []TokenMapping{xxb})
+	require.NoError(t, ValidateGenesis(genesis))
+
+	// duplicated mapping
+	genesis.TokenMappings = []TokenMapping{xxb, xxb}
+	require.Error(t, ValidateGenesis(genesis))
+
+	// contract not deployed by the module
+	genesis.TokenMappings = []TokenMapping{NewTokenMapping("xxb", ContractAddress("yyb"))}
+	require.Error(t, ValidateGenesis(genesis))
+
+	// the native coin can't be mapped
+	genesis.TokenMappings = []TokenMapping{NewTokenMapping("okt", ContractAddress("okt"))}
+	require.Error(t, ValidateGenesis(genesis))
+}
+
+func TestContractCode(t *testing.T) {
+	// the jump destinations of the contract code
+	require.Equal(t, byte(0x5b), ContractCode[0x35])
+	require.Equal(t, byte(0x5b), ContractCode[0x4b])
+	require.NotEqual(t, ContractAddress("xxb"), ContractAddress("yyb"))
+}
@@ -0,0 +1,34 @@
+package types
+
+import (
+	ethcmn "github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "erc20"
+
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+
+	// RouterKey to be used for routing msgs
+	RouterKey = ModuleName
+
+	// QuerierRoute to be used for querier msgs
+	QuerierRoute = ModuleName
+)
+
+var (
+	DenomToContractPrefix = []byte{0x01}
+	ContractToDenomPrefix = []byte{0x02}
+)
+
+// GetDenomToContractKey returns the key of the contract mapped to denom
+func GetDenomToContractKey(denom string) []byte {
+	return append(DenomToContractPrefix, []byte(denom)...)
+}
+
+// GetContractToDenomKey returns the key of the denom mapped to contract
+func GetContractToDenomKey(contract ethcmn.Address) []byte {
+	return append(ContractToDenomPrefix, contract.Bytes()...)
+}
@@ -0,0 +1,105 @@
+package types
+
+import (
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+const (
+	convertCoinMsgType  = "convert_coin"
+	convertERC20MsgType = "convert_erc20"
+)
+
+// MsgConvertCoin converts the native coins of the sender to the erc20 tokens of the same address
+type MsgConvertCoin struct {
+	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
+	Amount sdk.SysCoin    `json:"amount" yaml:"amount"`
+}
+
+var _ sdk.Msg = MsgConvertCoin{}
+
+func NewMsgConvertCoin(sender sdk.AccAddress, amount sdk.SysCoin) MsgConvertCoin {
+	return MsgConvertCoin{
+		Sender: sender,
+		Amount: amount,
+	}
+}
+
+func (m MsgConvertCoin) Route() string {
+	return RouterKey
+}
+
+func (m MsgConvertCoin) Type() string {
+	return convertCoinMsgType
+}
+
+func (m MsgConvertCoin) ValidateBasic() sdk.Error {
+	if m.Sender.Empty() {
+		return ErrInvalidInput("sender address is empty")
+	}
+	if !m.Amount.IsValid() || !m.Amount.IsPositive() {
+		return ErrInvalidInput("amount must be positive: " + m.Amount.String())
+	}
+	if m.Amount.Denom == sdk.DefaultBondDenom {
+		return ErrInvalidInput(sdk.DefaultBondDenom + " can't be converted")
+	}
+	return nil
+}
+
+func (m MsgConvertCoin) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m MsgConvertCoin) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
+
+// MsgConvertERC20 converts the erc20 tokens of the sender to the native coins of the same address. The erc20
+// tokens have 18 decimals as the native coins, so the amount is the same on both sides.
+type MsgConvertERC20 struct {
+	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
+	// Contract is the hex address of the erc20 contract
+	Contract string  `json:"contract" yaml:"contract"`
+	Amount   sdk.Dec `json:"amount" yaml:"amount"`
+}
+
+var _ sdk.Msg = MsgConvertERC20{}
+
+func NewMsgConvertERC20(sender sdk.AccAddress, contract ethcmn.Address, amount sdk.Dec) MsgConvertERC20 {
+	return MsgConvertERC20{
+		Sender:   sender,
+		Contract: contract.Hex(),
+		Amount:   amount,
+	}
+}
+
+func (m MsgConvertERC20) Route() string {
+	return RouterKey
+}
+
+func (m MsgConvertERC20) Type() string {
+	return convertERC20MsgType
+}
+
+func (m MsgConvertERC20) ValidateBasic() sdk.Error {
+	if m.Sender.Empty() {
+		return ErrInvalidInput("sender address is empty")
+	}
+	if !ethcmn.IsHexAddress(m.Contract) {
+		return ErrInvalidInput("invalid contract address: " + m.Contract)
+	}
+	if m.Amount.IsNil() || !m.Amount.IsPositive() {
+		return ErrInvalidInput("amount must be positive")
+	}
+	return nil
+}
+
+func (m MsgConvertERC20) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m MsgConvertERC20) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/okex/exchain/x/common"
+	"github.com/okex/exchain/x/params"
+)
+
+// Default parameter namespace
+const (
+	DefaultParamspace = ModuleName
+)
+
+// Parameter store keys
+var (
+	KeyEnableConversion = []byte("EnableConversion")
+)
+
+// ParamKeyTable for erc20 module
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// Params - used for initializing default parameter for erc20 at genesis
+type Params struct {
+	// EnableConversion enables the conversions between the native tokens and the erc20 tokens, both by the msgs
+	// and by the contract calls
+	EnableConversion bool `json:"enable_conversion" yaml:"enable_conversion"`
+}
+
+// String implements the stringer interface for Params
+func (p Params) String() string {
+	return fmt.Sprintf(`Params:
+  Enable Conversion:	%v`, p.EnableConversion)
+}
+
+// ParamSetPairs - Implements params.ParamSet
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		{Key: KeyEnableConversion, Value: &p.EnableConversion, ValidatorFn: common.ValidateBool("enable conversion")},
+	}
+}
+
+// DefaultParams defines the parameters for this module
+func DefaultParams() Params {
+	return Params{
+		EnableConversion: true,
+	}
+}
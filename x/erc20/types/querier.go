@@ -0,0 +1,21 @@
+package types
+
+const (
+	QueryParameters    = "parameters"
+	QueryTokenMapping  = "token-mapping"
+	QueryTokenMappings = "token-mappings"
+)
+
+// QueryTokenMappingParams defines the params for the following queries:
+// - 'custom/erc20/token-mapping'
+// The mapping is found by the denom or by the hex address of the contract.
+type QueryTokenMappingParams struct {
+	DenomOrContract string
+}
+
+// NewQueryTokenMappingParams creates a new instance of QueryTokenMappingParams
+func NewQueryTokenMappingParams(denomOrContract string) QueryTokenMappingParams {
+	return QueryTokenMappingParams{
+		DenomOrContract: denomOrContract,
+	}
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"fmt"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+// TokenMapping is a native token mapped to the erc20 contract deployed by the module
+type TokenMapping struct {
+	Denom string `json:"denom" yaml:"denom"`
+	// Contract is the hex address of the erc20 contract
+	Contract string `json:"contract" yaml:"contract"`
+}
+
+// NewTokenMapping creates a new instance of TokenMapping
+func NewTokenMapping(denom string, contract ethcmn.Address) TokenMapping {
+	return TokenMapping{
+		Denom:    denom,
+		Contract: contract.Hex(),
+	}
+}
+
+// ContractAddress returns the address of the erc20 contract
+func (tm TokenMapping) ContractAddress() ethcmn.Address {
+	return ethcmn.HexToAddress(tm.Contract)
+}
+
+// Validate performs a basic validation of the token mapping
+func (tm TokenMapping) Validate() error {
+	if err := sdk.ValidateDenom(tm.Denom); err != nil {
+		return err
+	}
+	if tm.Denom == sdk.DefaultBondDenom {
+		return fmt.Errorf("%s can't be mapped to an erc20 contract", sdk.DefaultBondDenom)
+	}
+	if !ethcmn.IsHexAddress(tm.Contract) {
+		return fmt.Errorf("invalid contract address %s", tm.Contract)
+	}
+	return nil
+}
+
+// String returns a human readable string representation of a TokenMapping
+func (tm TokenMapping) String() string {
+	return fmt.Sprintf(`TokenMapping:
+  Denom:	%s
+  Contract:	%s`, tm.Denom, tm.Contract)
+}
@@ -223,3 +223,66 @@ Where proposal.json contains:
 		},
 	}
 }
+
+// GetCmdManageChainConfigForkProposal implements a command handler for submitting a manage chain config fork
+// proposal transaction
+func GetCmdManageChainConfigForkProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "update-chain-config-fork [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit an update chain config fork proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit an update chain config fork proposal along with an initial deposit.
+The proposal schedules the Berlin and London forks of the evm and the precompiles backed by the native modules,
+a negative block leaves the fork disabled.
+The forks already activated can't be changed and the new activation blocks must be above the current block.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal update-chain-config-fork <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "activate the berlin and london forks",
+  "description": "activate the berlin and london forks at block 5000000",
+  "berlin_block": "5000000",
+  "london_block": "5000000",
+  "precompile_block": "-1",
+  "deposit": [
+    {
+      "denom": "%s",
+      "amount": "100.000000000000000000"
+    }
+  ]
+}
+`, version.ClientName, sdk.DefaultBondDenom,
+			)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := evmutils.ParseManageChainConfigForkProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			content := types.NewManageChainConfigForkProposal(
+				proposal.Title,
+				proposal.Description,
+				proposal.BerlinBlock,
+				proposal.LondonBlock,
+				proposal.PrecompileBlock,
+			)
+
+			err = content.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, cliCtx.GetFromAddress())
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
@@ -22,4 +22,10 @@ var (
 		cli.GetCmdManageContractMethodBlockedListProposal,
 		rest.ManageContractMethodBlockedListProposalRESTHandler,
 	)
+
+	// ManageChainConfigForkProposalHandler alias gov NewProposalHandler
+	ManageChainConfigForkProposalHandler = govcli.NewProposalHandler(
+		cli.GetCmdManageChainConfigForkProposal,
+		rest.ManageChainConfigForkProposalRESTHandler,
+	)
 )
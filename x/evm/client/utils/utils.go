@@ -36,6 +36,16 @@ type (
 		IsAdded      bool                      `json:"is_added" yaml:"is_added"`
 		Deposit      sdk.SysCoins              `json:"deposit" yaml:"deposit"`
 	}
+	// ManageChainConfigForkProposalJSON defines a ManageChainConfigForkProposal with a deposit used to parse
+	// manage chain config fork proposals from a JSON file.
+	ManageChainConfigForkProposalJSON struct {
+		Title           string       `json:"title" yaml:"title"`
+		Description     string       `json:"description" yaml:"description"`
+		BerlinBlock     sdk.Int      `json:"berlin_block" yaml:"berlin_block"`
+		LondonBlock     sdk.Int      `json:"london_block" yaml:"london_block"`
+		PrecompileBlock sdk.Int      `json:"precompile_block" yaml:"precompile_block"`
+		Deposit         sdk.SysCoins `json:"deposit" yaml:"deposit"`
+	}
 
 	ResponseBlockContract struct {
 		Address      string                `json:"address" yaml:"address"`
@@ -79,3 +89,15 @@ func ParseManageContractMethodBlockedListProposalJSON(cdc *codec.Codec, proposal
 	cdc.MustUnmarshalJSON(contents, &proposal)
 	return
 }
+
+// ParseManageChainConfigForkProposalJSON parses json from proposal file to ManageChainConfigForkProposalJSON struct
+func ParseManageChainConfigForkProposalJSON(cdc *codec.Codec, proposalFilePath string) (
+	proposal ManageChainConfigForkProposalJSON, err error) {
+	contents, err := ioutil.ReadFile(proposalFilePath)
+	if err != nil {
+		return
+	}
+
+	cdc.MustUnmarshalJSON(contents, &proposal)
+	return
+}
@@ -2,6 +2,7 @@ package evm
 
 import (
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/okex/exchain/app/refund"
 	ethermint "github.com/okex/exchain/app/types"
 	bam "github.com/okex/exchain/libs/cosmos-sdk/baseapp"
@@ -138,11 +139,12 @@ func handleMsgEthereumTx(ctx sdk.Context, k *Keeper, msg types.MsgEthereumTx) (*
 	StartTxLog(bam.SaveTx)
 	st := types.StateTransition{
 		AccountNonce: msg.Data.AccountNonce,
-		Price:        msg.Data.Price,
+		Price:        msg.Data.EffectiveGasPrice(nil),
 		GasLimit:     msg.Data.GasLimit,
 		Recipient:    msg.Data.Recipient,
 		Amount:       msg.Data.Amount,
 		Payload:      msg.Data.Payload,
+		AccessList:   msg.AccessList(),
 		Csdb:         types.CreateEmptyCommitStateDB(k.GenerateCSDBParams(), ctx),
 		ChainID:      chainIDEpoch,
 		TxHash:       &ethHash,
@@ -178,7 +180,7 @@ func handleMsgEthereumTx(ctx sdk.Context, k *Keeper, msg types.MsgEthereumTx) (*
 			sendAcc := pm.AccountKeeper.GetAccount(infCtx, sender.Bytes())
 			//fix sender's balance in watcher with refund fees
 			gasConsumed := ctx.GasMeter().GasConsumed()
-			fixedFees := refund.CaculateRefundFees(ctx, gasConsumed, msg.GetFee(), msg.Data.Price)
+			fixedFees := refund.CaculateRefundFees(ctx, gasConsumed, msg.GetFee(), msg.Data.EffectiveGasPrice(nil))
 			coins := sendAcc.GetCoins().Add2(fixedFees)
 			_ = sendAcc.SetCoins(coins)
 			if sendAcc != nil {
@@ -203,15 +205,30 @@ func handleMsgEthereumTx(ctx sdk.Context, k *Keeper, msg types.MsgEthereumTx) (*
 	StartTxLog(bam.TransitionDb)
 	executionResult, resultData, err, innerTxs, erc20s := st.TransitionDb(ctx, config)
 	if ctx.IsAsync() {
+		// the tx indexes of parallel txs are only fixed after the block is executed, so the observers are
+		// notified by FixLog then
+		status, result, gasUsed := ethtypes.ReceiptStatusSuccessful, resultData, ctx.GasMeter().GasConsumed()
+		if err != nil {
+			status, result = ethtypes.ReceiptStatusFailed, &types.ResultData{}
+		}
 		k.LogsManages.Set(string(ctx.TxBytes()), keeper.TxResult{
 			ResultData: resultData,
 			Err:        err,
+			OnEvmTx: func(txIndex uint64) {
+				k.OnEvmTx(ctx, msg, sender, ethHash, txIndex, status, result, gasUsed)
+			},
 		})
 	}
 
 	if err != nil {
 		if !st.Simulate {
+			if innerTxs != nil {
+				k.AddInnerTx(st.TxHash.Hex(), innerTxs)
+			}
 			k.Watcher.SaveTransactionReceipt(watcher.TransactionFailed, msg, common.BytesToHash(txHash), uint64(k.TxCount-1), &types.ResultData{}, ctx.GasMeter().GasConsumed())
+			if !ctx.IsAsync() {
+				k.OnEvmTx(ctx, msg, sender, ethHash, uint64(k.TxCount-1), ethtypes.ReceiptStatusFailed, &types.ResultData{}, ctx.GasMeter().GasConsumed())
+			}
 		}
 		return nil, err
 	}
@@ -232,6 +249,9 @@ func handleMsgEthereumTx(ctx sdk.Context, k *Keeper, msg types.MsgEthereumTx) (*
 		}
 		k.LogSize = st.Csdb.GetLogSize()
 		k.Watcher.SaveTransactionReceipt(watcher.TransactionSuccess, msg, common.BytesToHash(txHash), uint64(k.TxCount-1), resultData, ctx.GasMeter().GasConsumed())
+		if !ctx.IsAsync() {
+			k.OnEvmTx(ctx, msg, sender, ethHash, uint64(k.TxCount-1), ethtypes.ReceiptStatusSuccessful, resultData, ctx.GasMeter().GasConsumed())
+		}
 		if msg.Data.Recipient == nil {
 			st.Csdb.IteratorCode(func(addr common.Address, c types.CacheCode) bool {
 				k.Watcher.SaveContractCode(addr, c.Code)
@@ -316,6 +336,9 @@ func handleMsgEthermint(ctx sdk.Context, k *Keeper, msg types.MsgEthermint) (*sd
 
 	executionResult, _, err, innerTxs, erc20s := st.TransitionDb(ctx, config)
 	if err != nil {
+		if !st.Simulate && innerTxs != nil {
+			k.AddInnerTx(st.TxHash.Hex(), innerTxs)
+		}
 		return nil, err
 	}
 
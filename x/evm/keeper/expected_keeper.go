@@ -10,3 +10,6 @@ type GovKeeper interface {
 	GetDepositParams(ctx sdk.Context) govtypes.DepositParams
 	GetVotingParams(ctx sdk.Context) govtypes.VotingParams
 }
+
+// TxReplayer executes a raw tx on ctx the way it is delivered, the app provides it
+type TxReplayer func(ctx sdk.Context, txBytes []byte) error
@@ -50,7 +50,16 @@ type Keeper struct {
 	LogsManages *LogsManager
 
 	// add inner block data
-	innerBlockData BlockInnerData
+	innerBlockData *BlockInnerData
+
+	// observers notified of every executed evm tx, e.g. the backend module
+	ObserverKeeper []types.BackendKeeper
+
+	// precompiled contracts backed by the native modules
+	precompiles types.Precompiles
+
+	// txReplayer executes a cosmos tx preceding a traced tx in its block
+	txReplayer TxReplayer
 }
 
 // NewKeeper generates new evm module keeper
@@ -88,6 +97,7 @@ func NewKeeper(
 		Ada:           types.DefaultPrefixDb{},
 
 		innerBlockData: defaultBlockInnerData(),
+		precompiles:    make(types.Precompiles),
 	}
 	k.Watcher.SetWatchDataFunc()
 	if k.Watcher.Enabled() {
@@ -123,6 +133,19 @@ func (k Keeper) OnAccountUpdated(acc auth.Account) {
 	k.Watcher.DeleteAccount(account)
 }
 
+// SetObserverKeeper registers an observer of the executed evm txs
+func (k *Keeper) SetObserverKeeper(bk types.BackendKeeper) {
+	k.ObserverKeeper = append(k.ObserverKeeper, bk)
+}
+
+// OnEvmTx notifies the observers of an executed evm tx
+func (k Keeper) OnEvmTx(ctx sdk.Context, msg types.MsgEthereumTx, from ethcmn.Address, txHash ethcmn.Hash, txIndex uint64,
+	status uint64, result *types.ResultData, gasUsed uint64) {
+	for _, observer := range k.ObserverKeeper {
+		observer.OnEvmTx(ctx, msg, from, txHash, txIndex, status, result, gasUsed)
+	}
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) GenerateCSDBParams() types.CommitStateDBParams {
 	return types.CommitStateDBParams{
@@ -134,6 +157,7 @@ func (k Keeper) GenerateCSDBParams() types.CommitStateDBParams {
 		Watcher:       k.Watcher,
 		Ada:           k.Ada,
 		Cdc:           k.cdc,
+		Precompiles:   k.precompiles,
 	}
 }
 
@@ -248,6 +272,7 @@ func (k Keeper) GetChainConfig(ctx sdk.Context) (types.ChainConfig, bool) {
 	// bz len must > 4; otherwise, MustUnmarshalBinaryBare will panic
 	if err := config.UnmarshalFromAmino(bz[4:]); err != nil {
 		k.cdc.MustUnmarshalBinaryBare(bz, &config)
+		config.DisableUnsetForks()
 	}
 	return config, true
 }
@@ -255,6 +280,7 @@ func (k Keeper) GetChainConfig(ctx sdk.Context) (types.ChainConfig, bool) {
 // SetChainConfig sets the mapping from block consensus hash to block height
 func (k Keeper) SetChainConfig(ctx sdk.Context, config types.ChainConfig) {
 	store := k.Ada.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixChainConfig)
+	config.DisableUnsetForks()
 	bz := k.cdc.MustMarshalBinaryBare(config)
 	// get to an empty key that's already prefixed by KeyPrefixChainConfig
 	store.Set([]byte{}, bz)
@@ -265,6 +291,19 @@ func (k *Keeper) SetGovKeeper(gk GovKeeper) {
 	k.govKeeper = gk
 }
 
+// SetTxReplayer sets the executor of the cosmos txs replayed by the traceTx query
+func (k *Keeper) SetTxReplayer(replayer TxReplayer) {
+	k.txReplayer = replayer
+}
+
+// RegisterPrecompiles adds the precompiled contracts backed by the native modules to the evm, they are
+// called from the precompile block of the chain config on. It must be called on app initialization.
+func (k *Keeper) RegisterPrecompiles(precompiles ...types.Precompile) {
+	for _, p := range precompiles {
+		k.precompiles.Add(p)
+	}
+}
+
 // checks whether the address is blocked
 func (k *Keeper) IsAddressBlocked(ctx sdk.Context, addr sdk.AccAddress) bool {
 	csdb := types.CreateEmptyCommitStateDB(k.GenerateCSDBParams(), ctx)
@@ -1,22 +1,170 @@
 package keeper
 
+import (
+	"encoding/json"
+	"path/filepath"
+	"sync"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/evm/types"
+	"github.com/spf13/viper"
+	dbm "github.com/tendermint/tm-db"
+)
+
+const innerTxDir = "innertx"
+
+var (
+	innerTxDB dbm.DB
+
+	prefixInnerTx      = []byte("tx:")
+	prefixBlockInnerTx = []byte("block:")
+)
+
 func initInnerDB() error {
+	if !types.IsInnerTxEnabled() || innerTxDB != nil {
+		return nil
+	}
+
+	dataDir := filepath.Join(viper.GetString("home"), "data")
+	db, err := sdk.NewLevelDB(innerTxDir, dataDir)
+	if err != nil {
+		return err
+	}
+	innerTxDB = db
 	return nil
 }
 
-type BlockInnerData = interface{}
+// CloseInnerDB closes the inner tx db
+func CloseInnerDB() {
+	if innerTxDB != nil {
+		innerTxDB.Close()
+	}
+}
 
-func defaultBlockInnerData() BlockInnerData {
-	return nil
+// BlockInnerData holds the inner txs and the erc20 contracts of the block being executed.
+// Txs may be delivered concurrently, so it is guarded by a mutex.
+type BlockInnerData struct {
+	mtx sync.Mutex
+
+	blockHash string
+	txHashes  []string
+	txMap     map[string][]*types.InnerTx
+	contracts []*types.ERC20Contract
+}
+
+func defaultBlockInnerData() *BlockInnerData {
+	return &BlockInnerData{txMap: make(map[string][]*types.InnerTx)}
 }
 
 // InitInnerBlock init inner block data
-func (k *Keeper) InitInnerBlock(...interface{}) {}
+func (k *Keeper) InitInnerBlock(blockHash string) {
+	if k.innerBlockData == nil {
+		return
+	}
+	k.innerBlockData.mtx.Lock()
+	defer k.innerBlockData.mtx.Unlock()
+
+	k.innerBlockData.blockHash = blockHash
+	k.innerBlockData.txHashes = nil
+	k.innerBlockData.txMap = make(map[string][]*types.InnerTx)
+	k.innerBlockData.contracts = nil
+}
 
-func (k *Keeper) UpdateInnerBlockData(...interface{}) {}
+// UpdateInnerBlockData persists the inner data of the current block
+func (k *Keeper) UpdateInnerBlockData() {
+	if k.innerBlockData == nil || innerTxDB == nil {
+		return
+	}
+	k.innerBlockData.mtx.Lock()
+	defer k.innerBlockData.mtx.Unlock()
+	if len(k.innerBlockData.txHashes) == 0 && len(k.innerBlockData.contracts) == 0 {
+		return
+	}
+
+	block := types.QueryResBlockInnerTxs{
+		BlockHash: k.innerBlockData.blockHash,
+		Txs:       make([]types.TxInnerTxs, 0, len(k.innerBlockData.txHashes)),
+		Contracts: k.innerBlockData.contracts,
+	}
+
+	batch := innerTxDB.NewBatch()
+	defer batch.Close()
+	for _, txHash := range k.innerBlockData.txHashes {
+		innerTxs := k.innerBlockData.txMap[txHash]
+		bz, err := json.Marshal(innerTxs)
+		if err != nil {
+			panic(err)
+		}
+		batch.Set(append(prefixInnerTx, ethcmn.HexToHash(txHash).Bytes()...), bz)
+		block.Txs = append(block.Txs, types.TxInnerTxs{TxHash: txHash, InnerTxs: innerTxs})
+	}
+	bz, err := json.Marshal(block)
+	if err != nil {
+		panic(err)
+	}
+	batch.Set(append(prefixBlockInnerTx, ethcmn.HexToHash(block.BlockHash).Bytes()...), bz)
+	if err := batch.WriteSync(); err != nil {
+		panic(err)
+	}
+}
 
 // AddInnerTx add inner tx
-func (k *Keeper) AddInnerTx(...interface{}) {}
+func (k *Keeper) AddInnerTx(txHash string, innerTxs []*types.InnerTx) {
+	if k.innerBlockData == nil {
+		return
+	}
+	k.innerBlockData.mtx.Lock()
+	if _, ok := k.innerBlockData.txMap[txHash]; !ok {
+		k.innerBlockData.txHashes = append(k.innerBlockData.txHashes, txHash)
+	}
+	k.innerBlockData.txMap[txHash] = innerTxs
+	k.innerBlockData.mtx.Unlock()
+
+	k.Watcher.SaveInnerTxs(ethcmn.HexToHash(txHash), innerTxs)
+}
 
 // AddContract add erc20 contract
-func (k *Keeper) AddContract(...interface{}) {}
+func (k *Keeper) AddContract(contracts []*types.ERC20Contract) {
+	if k.innerBlockData == nil {
+		return
+	}
+	k.innerBlockData.mtx.Lock()
+	defer k.innerBlockData.mtx.Unlock()
+
+	k.innerBlockData.contracts = append(k.innerBlockData.contracts, contracts...)
+}
+
+// GetInnerTxs returns the persisted inner txs of an ethereum tx
+func GetInnerTxs(txHash ethcmn.Hash) ([]*types.InnerTx, bool) {
+	if innerTxDB == nil {
+		return nil, false
+	}
+	bz, err := innerTxDB.Get(append(prefixInnerTx, txHash.Bytes()...))
+	if err != nil || bz == nil {
+		return nil, false
+	}
+
+	var innerTxs []*types.InnerTx
+	if err := json.Unmarshal(bz, &innerTxs); err != nil {
+		return nil, false
+	}
+	return innerTxs, true
+}
+
+// GetBlockInnerTxs returns the persisted inner txs and erc20 contracts of a block
+func GetBlockInnerTxs(blockHash ethcmn.Hash) (types.QueryResBlockInnerTxs, bool) {
+	var block types.QueryResBlockInnerTxs
+	if innerTxDB == nil {
+		return block, false
+	}
+	bz, err := innerTxDB.Get(append(prefixBlockInnerTx, blockHash.Bytes()...))
+	if err != nil || bz == nil {
+		return block, false
+	}
+
+	if err := json.Unmarshal(bz, &block); err != nil {
+		return block, false
+	}
+	return block, true
+}
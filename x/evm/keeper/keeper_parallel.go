@@ -20,6 +20,7 @@ func (k *Keeper) FixLog(execResults [][]string) [][]byte {
 		}
 		txInBlock++
 		if rs.ResultData == nil {
+			rs.onEvmTx(txInBlock)
 			continue
 		}
 
@@ -28,6 +29,7 @@ func (k *Keeper) FixLog(execResults [][]string) [][]byte {
 			v.TxIndex = uint(txInBlock)
 			logSize++
 		}
+		rs.onEvmTx(txInBlock)
 
 		k.Bloom = k.Bloom.Or(k.Bloom, rs.ResultData.Bloom.Big())
 		data, err := types.EncodeResultData(*rs.ResultData)
@@ -78,4 +80,12 @@ func (l *LogsManager) Reset() {
 type TxResult struct {
 	ResultData *types.ResultData
 	Err        error
+	// OnEvmTx notifies the observers of the tx with its index in the block
+	OnEvmTx func(txIndex uint64)
+}
+
+func (rs TxResult) onEvmTx(txIndex int) {
+	if rs.OnEvmTx != nil {
+		rs.OnEvmTx(uint64(txIndex))
+	}
 }
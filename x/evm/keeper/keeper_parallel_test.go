@@ -0,0 +1,27 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/okex/exchain/x/evm/keeper"
+	"github.com/okex/exchain/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFixLogNotifiesTxIndexes(t *testing.T) {
+	k := &keeper.Keeper{LogsManages: keeper.NewLogManager()}
+	txIndexes := make(map[string]uint64)
+	for _, tx := range []string{"tx0", "tx1", "tx2"} {
+		tx := tx
+		k.LogsManages.Set(tx, keeper.TxResult{
+			ResultData: &types.ResultData{},
+			OnEvmTx: func(txIndex uint64) {
+				txIndexes[tx] = txIndex
+			},
+		})
+	}
+
+	// the txs are notified in the order of the block, skipping the one failed in the ante handler
+	k.FixLog([][]string{{"tx2", ""}, {"tx1", "ante error"}, {"tx0", ""}})
+	require.Equal(t, map[string]uint64{"tx2": 0, "tx0": 1}, txIndexes)
+}
@@ -16,7 +16,7 @@ import (
 
 // NewQuerier is the module level router for state queries
 func NewQuerier(keeper Keeper) sdk.Querier {
-	return func(ctx sdk.Context, path []string, _ abci.RequestQuery) ([]byte, error) {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
 		if len(path) < 1 {
 			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
 				"Insufficient parameters, at least 1 parameter is required")
@@ -55,6 +55,12 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryContractBlockedList(ctx, keeper)
 		case types.QueryContractMethodBlockedList:
 			return queryContractMethodBlockedList(ctx, keeper)
+		case types.QueryTraceTx:
+			return queryTraceTx(ctx, req, keeper)
+		case types.QueryInnerTxs:
+			return queryInnerTxs(path)
+		case types.QueryBlockInnerTxs:
+			return queryBlockInnerTxs(path)
 		default:
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown query endpoint")
 		}
@@ -337,3 +343,45 @@ func querySection(ctx sdk.Context, path []string, keeper Keeper) ([]byte, error)
 
 	return res, nil
 }
+
+func queryInnerTxs(path []string) ([]byte, error) {
+	if !types.IsInnerTxEnabled() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "disable inner tx")
+	}
+	if len(path) < 2 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+			"Insufficient parameters, at least 2 parameters is required")
+	}
+
+	innerTxs, found := GetInnerTxs(ethcmn.HexToHash(path[1]))
+	if !found {
+		innerTxs = []*types.InnerTx{}
+	}
+	res, err := json.Marshal(innerTxs)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return res, nil
+}
+
+func queryBlockInnerTxs(path []string) ([]byte, error) {
+	if !types.IsInnerTxEnabled() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "disable inner tx")
+	}
+	if len(path) < 2 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+			"Insufficient parameters, at least 2 parameters is required")
+	}
+
+	blockHash := ethcmn.HexToHash(path[1])
+	block, found := GetBlockInnerTxs(blockHash)
+	if !found {
+		// blocks without any inner tx are not persisted
+		block = types.QueryResBlockInnerTxs{BlockHash: blockHash.Hex(), Txs: []types.TxInnerTxs{}}
+	}
+	res, err := json.Marshal(block)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return res, nil
+}
@@ -0,0 +1,180 @@
+package keeper
+
+import (
+	"encoding/json"
+	"math/big"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	ethermint "github.com/okex/exchain/app/types"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	"github.com/okex/exchain/x/evm/types"
+)
+
+// queryTraceTx replays the requested messages on top of the queried state and returns the
+// traces of the ones flagged for tracing. The cosmos txs preceding the traced ones in their
+// block are replayed by the tx replayer of the app.
+func queryTraceTx(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.QueryTraceTxParams
+	if err := json.Unmarshal(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	chainIDEpoch, err := ethermint.ParseChainID(ctx.ChainID())
+	if err != nil {
+		return nil, err
+	}
+
+	config, found := keeper.GetChainConfig(ctx)
+	if !found {
+		return nil, types.ErrChainConfigNotFound
+	}
+
+	header := ctx.BlockHeader()
+	header.Height = params.BlockHeight
+	header.Time = params.BlockTime
+	header.ProposerAddress = params.BlockProposer
+	ctx = ctx.WithBlockHeader(header)
+
+	results := make([]types.QueryResTraceTx, 0, len(params.Msgs))
+	for i, msg := range params.Msgs {
+		if len(msg.CosmosTx) > 0 {
+			if err := keeper.replayCosmosTx(ctx, msg.CosmosTx, i); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		res, err := keeper.traceMsg(ctx, msg, i, params.BlockHash, chainIDEpoch, config, params.Config)
+		if err != nil {
+			return nil, err
+		}
+		if msg.Trace {
+			results = append(results, res)
+		}
+	}
+
+	bz, err := json.Marshal(results)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+// replayCosmosTx applies the cosmos tx at index txIndex of the block, the txs following it can't
+// be traced if it can't be replayed
+func (k Keeper) replayCosmosTx(ctx sdk.Context, txBytes []byte, txIndex int) error {
+	if k.txReplayer == nil {
+		return sdkerrors.Wrapf(types.ErrTraceNotSupported, "cosmos tx %d of the block can't be replayed", txIndex)
+	}
+	if err := k.txReplayer(ctx, txBytes); err != nil {
+		return sdkerrors.Wrapf(types.ErrTraceNotSupported, "cosmos tx %d of the block can't be replayed: %s", txIndex, err.Error())
+	}
+	return nil
+}
+
+// traceMsg applies a single message the way the ante handler and the evm handler would:
+// the gas fee is charged and the nonce bumped up front, and unused gas is refunded.
+func (k Keeper) traceMsg(
+	ctx sdk.Context, msg types.TraceTxMsg, txIndex int, blockHash ethcmn.Hash, chainID *big.Int,
+	config types.ChainConfig, traceConfig *types.TraceConfig,
+) (res types.QueryResTraceTx, err error) {
+	res.TxHash = msg.TxHash
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(sdk.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+			err = sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "out of gas in tx %s", msg.TxHash.String())
+		}
+	}()
+
+	var tracer vm.Tracer
+	if msg.Trace {
+		var release func()
+		tracer, release, err = types.NewTracer(traceConfig, &tracers.Context{
+			BlockHash: blockHash,
+			TxIndex:   txIndex,
+			TxHash:    msg.TxHash,
+		})
+		if err != nil {
+			return res, err
+		}
+		defer release()
+	}
+
+	gasPrice := new(big.Int)
+	if msg.GasPrice != nil {
+		gasPrice = msg.GasPrice
+	}
+	value := new(big.Int)
+	if msg.Value != nil {
+		value = msg.Value
+	}
+
+	csdb := types.CreateEmptyCommitStateDB(k.GenerateCSDBParams(), ctx)
+	nonce := csdb.GetNonce(msg.From)
+	if msg.Nonce != nil {
+		nonce = *msg.Nonce
+	}
+	csdb.SubBalance(msg.From, new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(msg.GasLimit)))
+	csdb.SetNonce(msg.From, csdb.GetNonce(msg.From)+1)
+	if err = csdb.Finalise(false); err != nil {
+		return res, err
+	}
+
+	txCtx := ctx.WithGasMeter(sdk.NewGasMeter(msg.GasLimit))
+	txHash := msg.TxHash
+	st := types.StateTransition{
+		AccountNonce: nonce,
+		Price:        gasPrice,
+		GasLimit:     msg.GasLimit,
+		Recipient:    msg.To,
+		Amount:       value,
+		Payload:      msg.Data,
+		AccessList:   msg.AccessList,
+		Csdb:         types.CreateEmptyCommitStateDB(k.GenerateCSDBParams(), txCtx),
+		ChainID:      chainID,
+		TxHash:       &txHash,
+		Sender:       msg.From,
+		TraceTx:      true,
+		Tracer:       tracer,
+	}
+	st.Csdb.Prepare(txHash, blockHash, txIndex)
+
+	_, resData, execErr, _, _ := st.TransitionDb(txCtx, config)
+	gasUsed := txCtx.GasMeter().GasConsumed()
+
+	csdb = types.CreateEmptyCommitStateDB(k.GenerateCSDBParams(), ctx)
+	csdb.AddBalance(msg.From, new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(msg.GasLimit-gasUsed)))
+	if err = csdb.Finalise(false); err != nil {
+		return res, err
+	}
+
+	if tracer == nil {
+		return res, nil
+	}
+
+	result := &core.ExecutionResult{UsedGas: gasUsed, Err: execErr}
+	if resData != nil {
+		result.ReturnData = resData.Ret
+	}
+	if logger, ok := tracer.(*vm.StructLogger); ok && execErr != nil {
+		// the revert reason is only kept by the logger
+		result.ReturnData = logger.Output()
+		if logger.Error() != nil {
+			result.Err = logger.Error()
+		}
+	}
+
+	traceRes, err := types.GetTraceResult(tracer, result)
+	if err != nil {
+		res.Error = err.Error()
+		return res, nil
+	}
+	res.Result = traceRes
+	return res, nil
+}
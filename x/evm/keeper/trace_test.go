@@ -0,0 +1,133 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	"github.com/okex/exchain/x/evm/keeper"
+	"github.com/okex/exchain/x/evm/types"
+)
+
+// initcode deploying a contract whose runtime code returns 42
+var returnFortyTwoInitCode = ethcmn.FromHex("600a600c600039600a6000f3602a60005260206000f3")
+
+func (suite *KeeperTestSuite) TestQueryTraceTx() {
+	deployNonce := uint64(0)
+	contract := ethcrypto.CreateAddress(suite.address, deployNonce)
+	callTracer := "callTracer"
+
+	testCases := []struct {
+		msg     string
+		config  *types.TraceConfig
+		checker func(res types.QueryResTraceTx)
+	}{
+		{
+			"struct logger",
+			nil,
+			func(res types.QueryResTraceTx) {
+				var result types.TraceExecutionResult
+				suite.Require().NoError(json.Unmarshal(res.Result, &result))
+				suite.Require().False(result.Failed)
+				suite.Require().Equal(fmt.Sprintf("%064x", 42), result.ReturnValue)
+				suite.Require().Len(result.StructLogs, 6)
+			},
+		},
+		{
+			"call tracer",
+			&types.TraceConfig{Tracer: &callTracer},
+			func(res types.QueryResTraceTx) {
+				var result map[string]interface{}
+				suite.Require().NoError(json.Unmarshal(res.Result, &result))
+				suite.Require().Equal("CALL", result["type"])
+				suite.Require().Equal(fmt.Sprintf("0x%064x", 42), result["output"])
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest()
+			suite.app.EvmKeeper.SetBalance(suite.ctx, suite.address, big.NewInt(1000))
+			params := types.DefaultParams()
+			params.EnableCreate = true
+			params.EnableCall = true
+			suite.app.EvmKeeper.SetParams(suite.ctx, params)
+
+			traceParams := types.QueryTraceTxParams{
+				Msgs: []types.TraceTxMsg{
+					{From: suite.address, Nonce: &deployNonce, GasLimit: 1000000, Data: returnFortyTwoInitCode, TxHash: ethcmn.HexToHash("0x01")},
+					{From: suite.address, To: &contract, GasLimit: 1000000, TxHash: ethcmn.HexToHash("0x02"), Trace: true},
+				},
+				BlockHeight: suite.ctx.BlockHeight() + 1,
+				BlockTime:   suite.ctx.BlockTime(),
+				Config:      tc.config,
+			}
+			bz, err := json.Marshal(traceParams)
+			suite.Require().NoError(err)
+
+			res, err := suite.querier(suite.ctx.WithIsCheckTx(true), []string{types.QueryTraceTx}, abci.RequestQuery{Data: bz})
+			suite.Require().NoError(err)
+
+			var results []types.QueryResTraceTx
+			suite.Require().NoError(json.Unmarshal(res, &results))
+			suite.Require().Len(results, 1)
+			suite.Require().Equal(ethcmn.HexToHash("0x02"), results[0].TxHash)
+			suite.Require().Empty(results[0].Error)
+			tc.checker(results[0])
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryTraceTxAfterCosmosTx() {
+	params := types.DefaultParams()
+	params.EnableCall = true
+	suite.app.EvmKeeper.SetParams(suite.ctx, params)
+
+	to := ethcmn.BytesToAddress([]byte("to"))
+	cosmosTx := []byte("cosmos tx")
+	traceParams := types.QueryTraceTxParams{
+		Msgs: []types.TraceTxMsg{
+			{TxHash: ethcmn.HexToHash("0x01"), CosmosTx: cosmosTx},
+			{From: suite.address, To: &to, GasLimit: 1000000, Value: big.NewInt(100), TxHash: ethcmn.HexToHash("0x02"), Trace: true},
+		},
+		BlockHeight: suite.ctx.BlockHeight() + 1,
+		BlockTime:   suite.ctx.BlockTime(),
+	}
+	bz, err := json.Marshal(traceParams)
+	suite.Require().NoError(err)
+
+	// the value transfer only succeeds on the state left by the cosmos tx
+	evmKeeper := *suite.app.EvmKeeper
+	evmKeeper.SetTxReplayer(func(ctx sdk.Context, txBytes []byte) error {
+		suite.Require().Equal(cosmosTx, txBytes)
+		evmKeeper.SetBalance(ctx, suite.address, big.NewInt(1000))
+		return nil
+	})
+	res, err := keeper.NewQuerier(evmKeeper)(suite.ctx, []string{types.QueryTraceTx}, abci.RequestQuery{Data: bz})
+	suite.Require().NoError(err)
+
+	var results []types.QueryResTraceTx
+	suite.Require().NoError(json.Unmarshal(res, &results))
+	suite.Require().Len(results, 1)
+	suite.Require().Equal(ethcmn.HexToHash("0x02"), results[0].TxHash)
+	var result types.TraceExecutionResult
+	suite.Require().NoError(json.Unmarshal(results[0].Result, &result))
+	suite.Require().False(result.Failed)
+
+	// the trace fails if the cosmos tx can't be replayed
+	evmKeeper.SetTxReplayer(func(ctx sdk.Context, txBytes []byte) error {
+		return errors.New("undecodable tx")
+	})
+	_, err = keeper.NewQuerier(evmKeeper)(suite.ctx, []string{types.QueryTraceTx}, abci.RequestQuery{Data: bz})
+	suite.Require().True(types.ErrTraceNotSupported.Is(err))
+
+	evmKeeper.SetTxReplayer(nil)
+	_, err = keeper.NewQuerier(evmKeeper)(suite.ctx, []string{types.QueryTraceTx}, abci.RequestQuery{Data: bz})
+	suite.Require().True(types.ErrTraceNotSupported.Is(err))
+}
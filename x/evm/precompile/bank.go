@@ -0,0 +1,111 @@
+package precompile
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/evm/types"
+)
+
+const bankABI = `[
+	{"type":"function","name":"transfer","stateMutability":"nonpayable",
+	 "inputs":[{"name":"to","type":"address"},{"name":"denom","type":"string"},{"name":"amount","type":"uint256"}],
+	 "outputs":[{"name":"","type":"bool"}]},
+	{"type":"event","name":"Transfer","anonymous":false,
+	 "inputs":[{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":true},
+	           {"name":"denom","type":"string","indexed":false},{"name":"amount","type":"uint256","indexed":false}]}
+]`
+
+var _ types.Precompile = BankPrecompile{}
+
+// BankPrecompile transfers the native coins of the caller
+type BankPrecompile struct {
+	contract
+	bankKeeper BankKeeper
+}
+
+func NewBankPrecompile(bankKeeper BankKeeper) BankPrecompile {
+	return BankPrecompile{
+		contract:   newContract(bankABI, map[string]uint64{"transfer": 25000}),
+		bankKeeper: bankKeeper,
+	}
+}
+
+func (p BankPrecompile) Address() ethcmn.Address {
+	return BankAddress
+}
+
+func (p BankPrecompile) RequiredGas(input []byte) uint64 {
+	return p.requiredGas(input)
+}
+
+func (p BankPrecompile) Run(pctx *types.PrecompileContext, input []byte) ([]byte, error) {
+	method, args, err := p.unpack(input)
+	if err != nil {
+		return nil, err
+	}
+	if pctx.ReadOnly {
+		return nil, errReadOnly
+	}
+	if pctx.Value.Sign() != 0 {
+		return nil, errNonPayable
+	}
+
+	switch method.Name {
+	case "transfer":
+		err = p.transfer(pctx, args[0].(ethcmn.Address), args[1].(string), args[2].(*big.Int))
+	default:
+		err = fmt.Errorf("unknown method %s", method.Name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(true)
+}
+
+// transfer sends coins from the caller through the state db, so that it is reverted with the evm call
+func (p BankPrecompile) transfer(pctx *types.PrecompileContext, to ethcmn.Address, denom string, amount *big.Int) error {
+	if !p.bankKeeper.GetSendEnabled(pctx.Ctx) {
+		return errors.New("transfers are currently disabled")
+	}
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return err
+	}
+	if p.bankKeeper.BlacklistedAddr(to.Bytes()) {
+		return fmt.Errorf("%s is not allowed to receive transactions", sdk.AccAddress(to.Bytes()))
+	}
+	amt, err := decFromWei(amount)
+	if err != nil {
+		return err
+	}
+
+	csdb := pctx.StateDB
+	fromBalance := csdb.GetCoinBalance(pctx.Caller, denom)
+	if fromBalance.LT(amt) {
+		return fmt.Errorf("insufficient %s balance: %s < %s", denom, fromBalance, amt)
+	}
+	if denom == sdk.DefaultBondDenom {
+		csdb.SubBalance(pctx.Caller, amount)
+		csdb.AddBalance(to, amount)
+	} else {
+		csdb.SetCoinBalance(pctx.Caller, denom, fromBalance.Sub(amt))
+		csdb.SetCoinBalance(to, denom, csdb.GetCoinBalance(to, denom).Add(amt))
+	}
+
+	event := p.abi.Events["Transfer"]
+	data, err := event.Inputs.NonIndexed().Pack(denom, amount)
+	if err != nil {
+		return err
+	}
+	csdb.AddLog(&ethtypes.Log{
+		Address:     BankAddress,
+		Topics:      []ethcmn.Hash{event.ID, pctx.Caller.Hash(), to.Hash()},
+		Data:        data,
+		BlockNumber: uint64(pctx.Ctx.BlockHeight()),
+	})
+	return nil
+}
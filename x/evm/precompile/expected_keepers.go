@@ -0,0 +1,18 @@
+package precompile
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+// BankKeeper defines the expected bank keeper
+type BankKeeper interface {
+	GetSendEnabled(ctx sdk.Context) bool
+	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
+	BlacklistedAddr(addr sdk.AccAddress) bool
+}
+
+// TokenKeeper defines the expected token keeper
+type TokenKeeper interface {
+	TokenExist(ctx sdk.Context, symbol string) bool
+	GetTokenTotalSupply(ctx sdk.Context, symbol string) sdk.Dec
+}
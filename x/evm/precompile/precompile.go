@@ -0,0 +1,84 @@
+package precompile
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+// addresses of the precompiles backed by the native modules
+var (
+	BankAddress    = ethcmn.HexToAddress("0x0000000000000000000000000000000000000100")
+	StakingAddress = ethcmn.HexToAddress("0x0000000000000000000000000000000000000101")
+	TokenAddress   = ethcmn.HexToAddress("0x0000000000000000000000000000000000000102")
+)
+
+var (
+	errReadOnly   = errors.New("state change is not allowed in a static call")
+	errNonPayable = errors.New("method is not payable")
+)
+
+// contract is the abi of a precompile with the gas of each method
+type contract struct {
+	abi abi.ABI
+	gas map[string]uint64
+}
+
+func newContract(def string, gas map[string]uint64) contract {
+	parsed, err := abi.JSON(strings.NewReader(def))
+	if err != nil {
+		panic(err)
+	}
+	for name := range gas {
+		if _, found := parsed.Methods[name]; !found {
+			panic(fmt.Sprintf("method %s is not in the abi", name))
+		}
+	}
+	return contract{abi: parsed, gas: gas}
+}
+
+// requiredGas returns the gas of the method called by input, 0 if there is no such method
+func (c contract) requiredGas(input []byte) uint64 {
+	if len(input) < 4 {
+		return 0
+	}
+	method, err := c.abi.MethodById(input[:4])
+	if err != nil {
+		return 0
+	}
+	return c.gas[method.Name]
+}
+
+// unpack returns the method called by input and its arguments
+func (c contract) unpack(input []byte) (*abi.Method, []interface{}, error) {
+	if len(input) < 4 {
+		return nil, nil, errors.New("input is too short to contain a method id")
+	}
+	method, err := c.abi.MethodById(input[:4])
+	if err != nil {
+		return nil, nil, err
+	}
+	args, err := method.Inputs.Unpack(input[4:])
+	if err != nil {
+		return nil, nil, err
+	}
+	return method, args, nil
+}
+
+// decFromWei converts an evm amount with 18 decimals to a native amount
+func decFromWei(amount *big.Int) (sdk.Dec, error) {
+	if amount.Sign() <= 0 {
+		return sdk.Dec{}, errors.New("amount must be positive")
+	}
+	return sdk.NewDecFromBigIntWithPrec(amount, sdk.Precision), nil
+}
+
+// weiFromDec converts a native amount to an evm amount with 18 decimals
+func weiFromDec(amount sdk.Dec) *big.Int {
+	return amount.BigInt()
+}
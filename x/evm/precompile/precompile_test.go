@@ -0,0 +1,190 @@
+package precompile_test
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/okex/exchain/app"
+	ethermint "github.com/okex/exchain/app/types"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/auth"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/supply"
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	"github.com/okex/exchain/x/evm/precompile"
+	"github.com/okex/exchain/x/evm/types"
+	"github.com/stretchr/testify/suite"
+)
+
+const testABI = `[
+	{"type":"function","name":"transfer","inputs":[{"name":"to","type":"address"},{"name":"denom","type":"string"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"balanceOf","inputs":[{"name":"account","type":"address"},{"name":"denom","type":"string"}],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"deposit","inputs":[{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"withdraw","inputs":[{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]}
+]`
+
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	app    *app.OKExChainApp
+	ctx    sdk.Context
+	abi    abi.ABI
+	sender ethcmn.Address
+	to     ethcmn.Address
+}
+
+func TestPrecompileTestSuite(t *testing.T) {
+	suite.Run(t, new(PrecompileTestSuite))
+}
+
+func (suite *PrecompileTestSuite) SetupTest() {
+	suite.app = app.Setup(false)
+	suite.ctx = suite.app.BaseApp.NewContext(false, abci.Header{Height: 1, ChainID: "ethermint-1"})
+
+	var err error
+	suite.abi, err = abi.JSON(strings.NewReader(testABI))
+	suite.Require().NoError(err)
+
+	suite.sender = ethcmn.BytesToAddress([]byte("sender"))
+	suite.to = ethcmn.BytesToAddress([]byte("to"))
+	coins := sdk.NewCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDec(100)), sdk.NewDecCoinFromDec("xxb", sdk.NewDec(50)))
+	suite.app.AccountKeeper.SetAccount(suite.ctx, &ethermint.EthAccount{
+		BaseAccount: auth.NewBaseAccount(sdk.AccAddress(suite.sender.Bytes()), coins, nil, 0, 0),
+		CodeHash:    ethcrypto.Keccak256(nil),
+	})
+
+	params := types.DefaultParams()
+	params.EnableCall = true
+	suite.app.EvmKeeper.SetParams(suite.ctx, params)
+}
+
+func (suite *PrecompileTestSuite) call(to ethcmn.Address, method string, args ...interface{}) ([]byte, error) {
+	config := types.DefaultChainConfig()
+	config.PrecompileBlock = sdk.ZeroInt()
+	return suite.callWithConfig(config, to, method, args...)
+}
+
+func (suite *PrecompileTestSuite) callWithConfig(config types.ChainConfig, to ethcmn.Address, method string,
+	args ...interface{}) ([]byte, error) {
+	payload, err := suite.abi.Pack(method, args...)
+	suite.Require().NoError(err)
+
+	st := types.StateTransition{
+		AccountNonce: 0,
+		Price:        big.NewInt(1),
+		GasLimit:     1000000,
+		Recipient:    &to,
+		Amount:       big.NewInt(0),
+		Payload:      payload,
+		ChainID:      big.NewInt(1),
+		Csdb:         types.CreateEmptyCommitStateDB(suite.app.EvmKeeper.GenerateCSDBParams(), suite.ctx),
+		TxHash:       &ethcmn.Hash{},
+		Sender:       suite.sender,
+	}
+	// every call consumes gas from its own gas meter as a tx does
+	ctx := suite.ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	_, res, err, _, _ := st.TransitionDb(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	return res.Ret, nil
+}
+
+func (suite *PrecompileTestSuite) balance(addr ethcmn.Address, denom string) sdk.Dec {
+	acc := suite.app.AccountKeeper.GetAccount(suite.ctx, sdk.AccAddress(addr.Bytes()))
+	if acc == nil {
+		return sdk.ZeroDec()
+	}
+	return acc.GetCoins().AmountOf(denom)
+}
+
+func (suite *PrecompileTestSuite) TestBankTransfer() {
+	_, err := suite.call(precompile.BankAddress, "transfer", suite.to, "xxb", sdk.NewDec(10).BigInt())
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(40), suite.balance(suite.sender, "xxb"))
+	suite.Require().Equal(sdk.NewDec(10), suite.balance(suite.to, "xxb"))
+	suite.Require().Equal(sdk.NewDec(100), suite.balance(suite.sender, sdk.DefaultBondDenom))
+
+	_, err = suite.call(precompile.BankAddress, "transfer", suite.to, sdk.DefaultBondDenom, sdk.NewDec(1).BigInt())
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(99), suite.balance(suite.sender, sdk.DefaultBondDenom))
+	suite.Require().Equal(sdk.NewDec(1), suite.balance(suite.to, sdk.DefaultBondDenom))
+
+	// insufficient balance
+	_, err = suite.call(precompile.BankAddress, "transfer", suite.to, "xxb", sdk.NewDec(41).BigInt())
+	suite.Require().Error(err)
+	suite.Require().Equal(sdk.NewDec(40), suite.balance(suite.sender, "xxb"))
+
+	// module accounts can't receive coins in either denom
+	moduleAddr := ethcmn.BytesToAddress(supply.NewModuleAddress(auth.FeeCollectorName))
+	for _, denom := range []string{"xxb", sdk.DefaultBondDenom} {
+		_, err = suite.call(precompile.BankAddress, "transfer", moduleAddr, denom, sdk.NewDec(1).BigInt())
+		suite.Require().Error(err)
+		suite.Require().Contains(err.Error(), "not allowed to receive transactions")
+	}
+	suite.Require().Equal(sdk.NewDec(40), suite.balance(suite.sender, "xxb"))
+	suite.Require().Equal(sdk.NewDec(99), suite.balance(suite.sender, sdk.DefaultBondDenom))
+}
+
+func (suite *PrecompileTestSuite) TestNotActivated() {
+	config := types.DefaultChainConfig()
+	config.PrecompileBlock = sdk.NewInt(2)
+
+	// a call before the activation runs as a call to an account without code
+	ret, err := suite.callWithConfig(config, precompile.BankAddress, "transfer", suite.to, "xxb", sdk.NewDec(10).BigInt())
+	suite.Require().NoError(err)
+	suite.Require().Empty(ret)
+	suite.Require().Equal(sdk.NewDec(50), suite.balance(suite.sender, "xxb"))
+	suite.Require().Equal(sdk.ZeroDec(), suite.balance(suite.to, "xxb"))
+
+	suite.ctx = suite.ctx.WithBlockHeight(2)
+	_, err = suite.callWithConfig(config, precompile.BankAddress, "transfer", suite.to, "xxb", sdk.NewDec(10).BigInt())
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(40), suite.balance(suite.sender, "xxb"))
+	suite.Require().Equal(sdk.NewDec(10), suite.balance(suite.to, "xxb"))
+}
+
+func (suite *PrecompileTestSuite) TestTokenBalanceOf() {
+	ret, err := suite.call(precompile.TokenAddress, "balanceOf", suite.sender, "xxb")
+	suite.Require().NoError(err)
+	out, err := suite.abi.Unpack("balanceOf", ret)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(50).BigInt(), out[0].(*big.Int))
+
+	// the bank precompile doesn't serve the token methods
+	_, err = suite.call(precompile.BankAddress, "balanceOf", suite.sender, "xxb")
+	suite.Require().Error(err)
+}
+
+func (suite *PrecompileTestSuite) TestStakingDeposit() {
+	_, err := suite.call(precompile.StakingAddress, "deposit", sdk.NewDec(10).BigInt())
+	suite.Require().NoError(err)
+
+	delegator, found := suite.app.StakingKeeper.GetDelegator(suite.ctx, sdk.AccAddress(suite.sender.Bytes()))
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewDec(10), delegator.Tokens)
+	suite.Require().Equal(sdk.NewDec(90), suite.balance(suite.sender, sdk.DefaultBondDenom))
+	suite.Require().True(suite.balance(precompile.StakingAddress, sdk.DefaultBondDenom).IsZero())
+
+	// the deposit is dropped with the failed tx
+	_, err = suite.call(precompile.StakingAddress, "deposit", sdk.NewDec(1000).BigInt())
+	suite.Require().Error(err)
+	suite.Require().Equal(sdk.NewDec(90), suite.balance(suite.sender, sdk.DefaultBondDenom))
+}
+
+func (suite *PrecompileTestSuite) TestStakingNativeActionFailed() {
+	_, err := suite.call(precompile.StakingAddress, "deposit", sdk.NewDec(10).BigInt())
+	suite.Require().NoError(err)
+
+	// the withdraw passes the evm call but is rejected by the staking handler, the tx fails with it
+	_, err = suite.call(precompile.StakingAddress, "withdraw", sdk.NewDec(1000).BigInt())
+	suite.Require().Error(err)
+	suite.Require().True(types.ErrNativeActionFailed.Is(err))
+
+	delegator, found := suite.app.StakingKeeper.GetDelegator(suite.ctx, sdk.AccAddress(suite.sender.Bytes()))
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewDec(10), delegator.Tokens)
+}
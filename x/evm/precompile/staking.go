@@ -0,0 +1,132 @@
+package precompile
+
+import (
+	"fmt"
+	"math/big"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/evm/types"
+	stakingtypes "github.com/okex/exchain/x/staking/types"
+)
+
+const stakingABI = `[
+	{"type":"function","name":"deposit","stateMutability":"nonpayable",
+	 "inputs":[{"name":"amount","type":"uint256"}],
+	 "outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"withdraw","stateMutability":"nonpayable",
+	 "inputs":[{"name":"amount","type":"uint256"}],
+	 "outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"addShares","stateMutability":"nonpayable",
+	 "inputs":[{"name":"validators","type":"address[]"}],
+	 "outputs":[{"name":"","type":"bool"}]}
+]`
+
+var _ types.Precompile = StakingPrecompile{}
+
+// StakingPrecompile deposits, withdraws and adds shares for the caller through the staking handler.
+// The staking msgs are validated in Run and run as native actions after the evm execution, the tx
+// fails if one of them is rejected by the staking handler.
+type StakingPrecompile struct {
+	contract
+	bankKeeper     BankKeeper
+	stakingHandler sdk.Handler
+}
+
+func NewStakingPrecompile(bankKeeper BankKeeper, stakingHandler sdk.Handler) StakingPrecompile {
+	return StakingPrecompile{
+		contract: newContract(stakingABI, map[string]uint64{
+			"deposit":   100000,
+			"withdraw":  100000,
+			"addShares": 200000,
+		}),
+		bankKeeper:     bankKeeper,
+		stakingHandler: stakingHandler,
+	}
+}
+
+func (p StakingPrecompile) Address() ethcmn.Address {
+	return StakingAddress
+}
+
+func (p StakingPrecompile) RequiredGas(input []byte) uint64 {
+	return p.requiredGas(input)
+}
+
+func (p StakingPrecompile) Run(pctx *types.PrecompileContext, input []byte) ([]byte, error) {
+	method, args, err := p.unpack(input)
+	if err != nil {
+		return nil, err
+	}
+	if pctx.ReadOnly {
+		return nil, errReadOnly
+	}
+	if pctx.Value.Sign() != 0 {
+		return nil, errNonPayable
+	}
+
+	delAddr := sdk.AccAddress(pctx.Caller.Bytes())
+	switch method.Name {
+	case "deposit":
+		err = p.deposit(pctx, delAddr, args[0].(*big.Int))
+	case "withdraw":
+		var amt sdk.Dec
+		if amt, err = decFromWei(args[0].(*big.Int)); err == nil {
+			err = p.addMsg(pctx, stakingtypes.NewMsgWithdraw(delAddr, sdk.NewCoin(sdk.DefaultBondDenom, amt)))
+		}
+	case "addShares":
+		vals := args[0].([]ethcmn.Address)
+		valAddrs := make([]sdk.ValAddress, len(vals))
+		for i, val := range vals {
+			valAddrs[i] = sdk.ValAddress(val.Bytes())
+		}
+		err = p.addMsg(pctx, stakingtypes.NewMsgAddShares(delAddr, valAddrs))
+	default:
+		err = fmt.Errorf("unknown method %s", method.Name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(true)
+}
+
+// deposit moves the amount from the caller to the precompile in the state db, so that it can't be
+// spent by the rest of the tx, and gives it back right before the deposit is made
+func (p StakingPrecompile) deposit(pctx *types.PrecompileContext, delAddr sdk.AccAddress, amount *big.Int) error {
+	amt, err := decFromWei(amount)
+	if err != nil {
+		return err
+	}
+	csdb := pctx.StateDB
+	if balance := csdb.GetCoinBalance(pctx.Caller, sdk.DefaultBondDenom); balance.LT(amt) {
+		return fmt.Errorf("insufficient %s balance: %s < %s", sdk.DefaultBondDenom, balance, amt)
+	}
+
+	msg := stakingtypes.NewMsgDeposit(delAddr, sdk.NewCoin(sdk.DefaultBondDenom, amt))
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	csdb.SubBalance(pctx.Caller, amount)
+	csdb.AddBalance(StakingAddress, amount)
+	csdb.AddNativeAction(func(ctx sdk.Context) error {
+		coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, amt))
+		if err := p.bankKeeper.SendCoins(ctx, sdk.AccAddress(StakingAddress.Bytes()), delAddr, coins); err != nil {
+			return err
+		}
+		_, err := p.stakingHandler(ctx, msg)
+		return err
+	})
+	return nil
+}
+
+// addMsg validates msg and runs it by the staking handler after the evm execution
+func (p StakingPrecompile) addMsg(pctx *types.PrecompileContext, msg sdk.Msg) error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	pctx.StateDB.AddNativeAction(func(ctx sdk.Context) error {
+		_, err := p.stakingHandler(ctx, msg)
+		return err
+	})
+	return nil
+}
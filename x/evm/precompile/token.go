@@ -0,0 +1,65 @@
+package precompile
+
+import (
+	"fmt"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/okex/exchain/x/evm/types"
+)
+
+const tokenABI = `[
+	{"type":"function","name":"balanceOf","stateMutability":"view",
+	 "inputs":[{"name":"account","type":"address"},{"name":"denom","type":"string"}],
+	 "outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"totalSupply","stateMutability":"view",
+	 "inputs":[{"name":"denom","type":"string"}],
+	 "outputs":[{"name":"","type":"uint256"}]}
+]`
+
+var _ types.Precompile = TokenPrecompile{}
+
+// TokenPrecompile queries the balances and the supplies of the native tokens
+type TokenPrecompile struct {
+	contract
+	tokenKeeper TokenKeeper
+}
+
+func NewTokenPrecompile(tokenKeeper TokenKeeper) TokenPrecompile {
+	return TokenPrecompile{
+		contract:    newContract(tokenABI, map[string]uint64{"balanceOf": 2000, "totalSupply": 2000}),
+		tokenKeeper: tokenKeeper,
+	}
+}
+
+func (p TokenPrecompile) Address() ethcmn.Address {
+	return TokenAddress
+}
+
+func (p TokenPrecompile) RequiredGas(input []byte) uint64 {
+	return p.requiredGas(input)
+}
+
+func (p TokenPrecompile) Run(pctx *types.PrecompileContext, input []byte) ([]byte, error) {
+	method, args, err := p.unpack(input)
+	if err != nil {
+		return nil, err
+	}
+	if pctx.Value.Sign() != 0 {
+		return nil, errNonPayable
+	}
+
+	switch method.Name {
+	case "balanceOf":
+		// the balance is read from the state db to include the changes made by the tx
+		balance := pctx.StateDB.GetCoinBalance(args[0].(ethcmn.Address), args[1].(string))
+		return method.Outputs.Pack(weiFromDec(balance))
+	case "totalSupply":
+		denom := args[0].(string)
+		if !p.tokenKeeper.TokenExist(pctx.Ctx, denom) {
+			return nil, fmt.Errorf("token %s does not exist", denom)
+		}
+		return method.Outputs.Pack(weiFromDec(p.tokenKeeper.GetTokenTotalSupply(pctx.Ctx, denom)))
+	default:
+		return nil, fmt.Errorf("unknown method %s", method.Name)
+	}
+}
@@ -17,6 +17,8 @@ func NewManageContractDeploymentWhitelistProposalHandler(k *Keeper) govTypes.Han
 			return handleManageContractBlockedlListProposal(ctx, k, proposal)
 		case types.ManageContractMethodBlockedListProposal:
 			return handleManageContractMethodBlockedlListProposal(ctx, k, proposal)
+		case types.ManageChainConfigForkProposal:
+			return handleManageChainConfigForkProposal(ctx, k, proposal)
 		default:
 			return common.ErrUnknownProposalType(types.DefaultCodespace, content.ProposalType())
 		}
@@ -77,3 +79,26 @@ func handleManageContractMethodBlockedlListProposal(ctx sdk.Context, k *Keeper,
 	// remove contract method from blocked list
 	return csdb.DeleteContractMethodBlockedList(manageContractMethodBlockedListProposal.ContractList)
 }
+
+func handleManageChainConfigForkProposal(ctx sdk.Context, k *Keeper, proposal *govTypes.Proposal) sdk.Error {
+	// check
+	manageChainConfigForkProposal, ok := proposal.Content.(types.ManageChainConfigForkProposal)
+	if !ok {
+		return types.ErrUnexpectedProposalType
+	}
+
+	config, found := k.GetChainConfig(ctx)
+	if !found {
+		return types.ErrChainConfigNotFound
+	}
+
+	// the heights are checked again since the chain may have reached them during the voting period
+	config, err := config.ScheduleForks(manageChainConfigForkProposal.BerlinBlock, manageChainConfigForkProposal.LondonBlock,
+		manageChainConfigForkProposal.PrecompileBlock, ctx.BlockHeight())
+	if err != nil {
+		return err
+	}
+
+	k.SetChainConfig(ctx, config)
+	return nil
+}
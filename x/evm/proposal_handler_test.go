@@ -2,6 +2,7 @@ package evm_test
 
 import (
 	ethcmn "github.com/ethereum/go-ethereum/common"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 	"github.com/okex/exchain/x/evm"
 	"github.com/okex/exchain/x/evm/types"
 	govtypes "github.com/okex/exchain/x/gov/types"
@@ -343,3 +344,99 @@ func (suite *EvmTestSuite) TestProposalHandler_ManageContractMethodBlockedListPr
 		})
 	}
 }
+
+func (suite *EvmTestSuite) TestProposalHandler_ManageChainConfigForkProposal() {
+	proposal := types.NewManageChainConfigForkProposal(
+		"default title",
+		"default description",
+		sdk.NewInt(10),
+		sdk.NewInt(20),
+		sdk.NewInt(-1),
+	)
+
+	suite.govHandler = evm.NewManageContractDeploymentWhitelistProposalHandler(suite.app.EvmKeeper)
+	govProposal := govtypes.Proposal{
+		Content: proposal,
+	}
+
+	testCases := []struct {
+		msg           string
+		height        int64
+		prepare       func()
+		success       bool
+		expBerlinFork int64
+		expLondonFork int64
+	}{
+		{
+			"schedule berlin and london",
+			5,
+			func() {},
+			true,
+			10,
+			20,
+		},
+		{
+			"postpone london",
+			15,
+			func() {
+				proposal.LondonBlock = sdk.NewInt(30)
+				govProposal.Content = proposal
+			},
+			true,
+			10,
+			30,
+		},
+		{
+			"change the activated berlin",
+			15,
+			func() {
+				proposal.BerlinBlock = sdk.NewInt(16)
+				govProposal.Content = proposal
+			},
+			false,
+			10,
+			30,
+		},
+		{
+			"schedule london at a passed block",
+			25,
+			func() {
+				proposal.BerlinBlock = sdk.NewInt(10)
+				proposal.LondonBlock = sdk.NewInt(20)
+				govProposal.Content = proposal
+			},
+			false,
+			10,
+			30,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			tc.prepare()
+			suite.ctx = suite.ctx.WithBlockHeight(tc.height)
+
+			err := suite.govHandler(suite.ctx, &govProposal)
+			if tc.success {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+
+			config, found := suite.app.EvmKeeper.GetChainConfig(suite.ctx)
+			suite.Require().True(found)
+			suite.Require().Equal(sdk.NewInt(tc.expBerlinFork), config.BerlinBlock)
+			suite.Require().Equal(sdk.NewInt(tc.expLondonFork), config.LondonBlock)
+		})
+	}
+	// the precompiles are scheduled along with the activated forks
+	proposal.LondonBlock = sdk.NewInt(30)
+	proposal.PrecompileBlock = sdk.NewInt(40)
+	govProposal.Content = proposal
+	suite.ctx = suite.ctx.WithBlockHeight(35)
+	suite.Require().NoError(suite.govHandler(suite.ctx, &govProposal))
+	config, found := suite.app.EvmKeeper.GetChainConfig(suite.ctx)
+	suite.Require().True(found)
+	suite.Require().False(config.IsPrecompileActive(39))
+	suite.Require().True(config.IsPrecompileActive(40))
+}
@@ -13,6 +13,7 @@ import (
 	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
 
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
 )
 
@@ -45,6 +46,12 @@ type ChainConfig struct {
 
 	YoloV2Block sdk.Int `json:"yoloV2_block" yaml:"yoloV2_block"` // YOLO v1: https://github.com/ethereum/EIPs/pull/2657 (Ephemeral testnet)
 	EWASMBlock  sdk.Int `json:"ewasm_block" yaml:"ewasm_block"`   // EWASM switch block (< 0 no fork, 0 = already activated)
+
+	// NOTE: the amino field numbers follow the declaration order, new forks must be appended
+	BerlinBlock sdk.Int `json:"berlin_block" yaml:"berlin_block"` // Berlin switch block (< 0 no fork, 0 = already on berlin)
+	LondonBlock sdk.Int `json:"london_block" yaml:"london_block"` // London switch block (< 0 no fork, 0 = already on london)
+
+	PrecompileBlock sdk.Int `json:"precompile_block" yaml:"precompile_block"` // native module precompiles switch block (< 0 disabled, 0 = already enabled)
 }
 
 // EthereumConfig returns an Ethereum ChainConfig for EVM state transitions.
@@ -64,6 +71,8 @@ func (cc ChainConfig) EthereumConfig(chainID *big.Int) *params.ChainConfig {
 		PetersburgBlock:     getBlockValue(cc.PetersburgBlock),
 		IstanbulBlock:       getBlockValue(cc.IstanbulBlock),
 		MuirGlacierBlock:    getBlockValue(cc.MuirGlacierBlock),
+		BerlinBlock:         getBlockValue(cc.BerlinBlock),
+		LondonBlock:         getBlockValue(cc.LondonBlock),
 	}
 }
 
@@ -77,6 +86,89 @@ func (cc ChainConfig) IsHomestead() bool {
 	return getBlockValue(cc.HomesteadBlock) != nil
 }
 
+// IsBerlin returns whether the Berlin fork is activated at the given height.
+func (cc ChainConfig) IsBerlin(height int64) bool {
+	return isForked(cc.BerlinBlock, height)
+}
+
+// IsLondon returns whether the London fork is activated at the given height.
+func (cc ChainConfig) IsLondon(height int64) bool {
+	return isForked(cc.LondonBlock, height)
+}
+
+// IsPrecompileActive returns whether the precompiles backed by the native modules are called at the given height.
+func (cc ChainConfig) IsPrecompileActive(height int64) bool {
+	return isForked(cc.PrecompileBlock, height)
+}
+
+// IsTxTypeSupported returns whether transactions of the given type are accepted at the given height.
+// Access list transactions come with Berlin and dynamic fee transactions with London.
+func (cc ChainConfig) IsTxTypeSupported(txType uint8, height int64) bool {
+	switch txType {
+	case ethtypes.LegacyTxType:
+		return true
+	case ethtypes.AccessListTxType:
+		return cc.IsBerlin(height)
+	case ethtypes.DynamicFeeTxType:
+		return cc.IsLondon(height)
+	default:
+		return false
+	}
+}
+
+// DisableUnsetForks disables the forks which are uninitialized because they were introduced after the
+// config was stored. Uninitialized values would otherwise be encoded as zero, activating them at genesis.
+func (cc *ChainConfig) DisableUnsetForks() {
+	if cc.BerlinBlock.IsNil() {
+		cc.BerlinBlock = sdk.NewInt(-1)
+	}
+	if cc.LondonBlock.IsNil() {
+		cc.LondonBlock = sdk.NewInt(-1)
+	}
+	if cc.PrecompileBlock.IsNil() {
+		cc.PrecompileBlock = sdk.NewInt(-1)
+	}
+}
+
+// ScheduleForks returns a copy of the config with the Berlin and London forks and the precompiles set to the
+// given blocks (< 0 no fork). The forks already activated at the current height can't be changed and the new
+// activation blocks must be above the current height.
+func (cc ChainConfig) ScheduleForks(berlinBlock, londonBlock, precompileBlock sdk.Int, height int64) (ChainConfig, error) {
+	if err := scheduleFork(cc.BerlinBlock, berlinBlock, height); err != nil {
+		return cc, sdkerrors.Wrap(err, "berlinBlock")
+	}
+	if err := scheduleFork(cc.LondonBlock, londonBlock, height); err != nil {
+		return cc, sdkerrors.Wrap(err, "londonBlock")
+	}
+	if err := scheduleFork(cc.PrecompileBlock, precompileBlock, height); err != nil {
+		return cc, sdkerrors.Wrap(err, "precompileBlock")
+	}
+
+	cc.BerlinBlock, cc.LondonBlock, cc.PrecompileBlock = berlinBlock, londonBlock, precompileBlock
+	return cc, cc.Validate()
+}
+
+func scheduleFork(current, next sdk.Int, height int64) error {
+	if err := validateBlock(next); err != nil {
+		return err
+	}
+	if isForked(current, height) {
+		if !current.Equal(next) {
+			return sdkerrors.Wrapf(ErrInvalidChainConfig, "fork already activated at block %s", current)
+		}
+		return nil
+	}
+	if !next.IsNegative() && next.Int64() <= height {
+		return sdkerrors.Wrapf(ErrInvalidChainConfig, "activation block %s must be above the current block %d", next, height)
+	}
+	return nil
+}
+
+func isForked(block sdk.Int, height int64) bool {
+	value := getBlockValue(block)
+	return value != nil && value.Cmp(big.NewInt(height)) <= 0
+}
+
 // String implements the fmt.Stringer interface
 func (cc ChainConfig) String() string {
 	out, _ := yaml.Marshal(cc)
@@ -100,11 +192,14 @@ func DefaultChainConfig() ChainConfig {
 		MuirGlacierBlock:    sdk.ZeroInt(),
 		YoloV2Block:         sdk.NewInt(-1),
 		EWASMBlock:          sdk.NewInt(-1),
+		BerlinBlock:         sdk.NewInt(-1),
+		LondonBlock:         sdk.NewInt(-1),
+		PrecompileBlock:     sdk.NewInt(-1),
 	}
 }
 
 func getBlockValue(block sdk.Int) *big.Int {
-	if block.IsNegative() {
+	if block.IsNil() || block.IsNegative() {
 		return nil
 	}
 
@@ -153,6 +248,13 @@ func (cc ChainConfig) Validate() error {
 	if err := validateBlock(cc.EWASMBlock); err != nil {
 		return sdkerrors.Wrap(err, "eWASMBlock")
 	}
+	// berlin, london and the precompiles may be uninitialized in the configs created before they were introduced
+	if err := validateForkOrder(cc.IstanbulBlock, cc.BerlinBlock); err != nil {
+		return sdkerrors.Wrap(err, "berlinBlock")
+	}
+	if err := validateForkOrder(cc.BerlinBlock, cc.LondonBlock); err != nil {
+		return sdkerrors.Wrap(err, "londonBlock")
+	}
 
 	return nil
 }
@@ -168,11 +270,11 @@ func (config *ChainConfig) UnmarshalFromAmino(data []byte) error {
 			break
 		}
 
-		pos, aminoType, err := amino.ParseProtoPosAndTypeMustOneByte(data[0])
+		pos, aminoType, n, err := parseProtoPosAndType(data)
 		if err != nil {
 			return err
 		}
-		data = data[1:]
+		data = data[n:]
 
 		if aminoType == amino.Typ3_ByteLength {
 			var n int
@@ -265,13 +367,41 @@ func (config *ChainConfig) UnmarshalFromAmino(data []byte) error {
 				return err
 			}
 			config.EWASMBlock = integer
+		case 15:
+			integer, err := sdk.NewIntFromAmino(subData)
+			if err != nil {
+				return err
+			}
+			config.BerlinBlock = integer
+		case 16:
+			integer, err := sdk.NewIntFromAmino(subData)
+			if err != nil {
+				return err
+			}
+			config.LondonBlock = integer
+		case 17:
+			integer, err := sdk.NewIntFromAmino(subData)
+			if err != nil {
+				return err
+			}
+			config.PrecompileBlock = integer
 		default:
 			return fmt.Errorf("unexpect feild num %d", pos)
 		}
 	}
+	config.DisableUnsetForks()
 	return nil
 }
 
+// parseProtoPosAndType parses the key of an amino field, which takes two bytes from the field 16 on
+func parseProtoPosAndType(data []byte) (pos int, aminoType amino.Typ3, n int, err error) {
+	key, n, err := amino.DecodeUvarint(data)
+	if err != nil {
+		return 0, 0, n, err
+	}
+	return int(key >> 3), amino.Typ3(key & 0x7), n, nil
+}
+
 func validateHash(hex string) error {
 	if hex != "" && strings.TrimSpace(hex) == "" {
 		return sdkerrors.Wrapf(ErrInvalidChainConfig, "hash cannot be blank")
@@ -296,3 +426,20 @@ func validateBlock(block sdk.Int) error {
 
 	return nil
 }
+
+// validateForkOrder checks that a fork is not activated before the fork it follows
+func validateForkOrder(prev, cur sdk.Int) error {
+	curBlock := getBlockValue(cur)
+	if curBlock == nil {
+		return nil
+	}
+
+	prevBlock := getBlockValue(prev)
+	if prevBlock == nil {
+		return sdkerrors.Wrapf(ErrInvalidChainConfig, "fork enabled at block %s while the previous fork is disabled", curBlock)
+	}
+	if prevBlock.Cmp(curBlock) > 0 {
+		return sdkerrors.Wrapf(ErrInvalidChainConfig, "fork enabled at block %s before the previous fork at block %s", curBlock, prevBlock)
+	}
+	return nil
+}
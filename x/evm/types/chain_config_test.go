@@ -1,6 +1,7 @@
 package types
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -8,6 +9,7 @@ import (
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
 var defaultEIP150Hash = common.Hash{}.String()
@@ -244,6 +246,87 @@ func TestChainConfigValidate(t *testing.T) {
 	}
 }
 
+func TestChainConfigForks(t *testing.T) {
+	config := DefaultChainConfig()
+	require.False(t, config.IsBerlin(10))
+	require.False(t, config.IsLondon(10))
+	require.True(t, config.IsTxTypeSupported(ethtypes.LegacyTxType, 10))
+	require.False(t, config.IsTxTypeSupported(ethtypes.AccessListTxType, 10))
+	require.False(t, config.IsTxTypeSupported(ethtypes.DynamicFeeTxType, 10))
+
+	// london can't be activated without berlin
+	_, err := config.ScheduleForks(sdk.NewInt(-1), sdk.NewInt(20), sdk.NewInt(-1), 10)
+	require.Error(t, err)
+	_, err = config.ScheduleForks(sdk.NewInt(30), sdk.NewInt(20), sdk.NewInt(-1), 10)
+	require.Error(t, err)
+	// forks can't be activated in the past
+	_, err = config.ScheduleForks(sdk.NewInt(10), sdk.NewInt(20), sdk.NewInt(-1), 10)
+	require.Error(t, err)
+	_, err = config.ScheduleForks(sdk.Int{}, sdk.NewInt(20), sdk.NewInt(-1), 10)
+	require.Error(t, err)
+
+	config, err = config.ScheduleForks(sdk.NewInt(20), sdk.NewInt(30), sdk.NewInt(-1), 10)
+	require.NoError(t, err)
+	require.False(t, config.IsBerlin(19))
+	require.True(t, config.IsBerlin(20))
+	require.False(t, config.IsLondon(29))
+	require.True(t, config.IsLondon(30))
+	require.True(t, config.IsTxTypeSupported(ethtypes.AccessListTxType, 20))
+	require.False(t, config.IsTxTypeSupported(ethtypes.DynamicFeeTxType, 20))
+	require.True(t, config.IsTxTypeSupported(ethtypes.DynamicFeeTxType, 30))
+	require.False(t, config.IsTxTypeSupported(3, 30))
+
+	ethConfig := config.EthereumConfig(big.NewInt(65))
+	require.Equal(t, big.NewInt(20), ethConfig.BerlinBlock)
+	require.Equal(t, big.NewInt(30), ethConfig.LondonBlock)
+	require.NoError(t, ethConfig.CheckConfigForkOrder())
+
+	// a scheduled fork can be postponed or cancelled until it's activated
+	_, err = config.ScheduleForks(sdk.NewInt(20), sdk.NewInt(-1), sdk.NewInt(-1), 25)
+	require.NoError(t, err)
+	_, err = config.ScheduleForks(sdk.NewInt(-1), sdk.NewInt(-1), sdk.NewInt(-1), 25)
+	require.Error(t, err)
+	_, err = config.ScheduleForks(sdk.NewInt(20), sdk.NewInt(30), sdk.NewInt(-1), 30)
+	require.NoError(t, err)
+
+	// the precompiles are scheduled independently of the forks
+	require.False(t, config.IsPrecompileActive(10))
+	_, err = config.ScheduleForks(sdk.NewInt(20), sdk.NewInt(30), sdk.NewInt(10), 10)
+	require.Error(t, err)
+	config, err = config.ScheduleForks(sdk.NewInt(20), sdk.NewInt(30), sdk.NewInt(15), 10)
+	require.NoError(t, err)
+	require.False(t, config.IsPrecompileActive(14))
+	require.True(t, config.IsPrecompileActive(15))
+	_, err = config.ScheduleForks(sdk.NewInt(20), sdk.NewInt(30), sdk.NewInt(-1), 15)
+	require.Error(t, err)
+}
+
+func TestChainConfigDisableUnsetForks(t *testing.T) {
+	config := DefaultChainConfig()
+	config.BerlinBlock, config.LondonBlock, config.PrecompileBlock = sdk.Int{}, sdk.Int{}, sdk.Int{}
+	require.NoError(t, config.Validate())
+	require.False(t, config.IsPrecompileActive(10))
+	require.False(t, config.IsBerlin(10))
+	require.Nil(t, config.EthereumConfig(big.NewInt(65)).BerlinBlock)
+
+	config.DisableUnsetForks()
+	require.Equal(t, DefaultChainConfig(), config)
+
+	// configs stored before the forks were introduced decode with the forks disabled
+	bz := ModuleCdc.MustMarshalBinaryBare(config)
+	var decoded ChainConfig
+	// strip the type prefix and the trailing berlin (0x7a 0x02 "-1"), london (0x82 0x01 0x02 "-1") and
+	// precompile (0x8a 0x01 0x02 "-1") fields
+	legacyBz := bz[4 : len(bz)-14]
+	require.NoError(t, decoded.UnmarshalFromAmino(legacyBz))
+	require.Equal(t, DefaultChainConfig(), decoded)
+
+	configi, err := ModuleCdc.UnmarshalBinaryBareWithRegisteredUnmarshaller(bz[:len(bz)-14], &decoded)
+	require.NoError(t, err)
+	decoded = configi.(ChainConfig)
+	require.Equal(t, DefaultChainConfig(), decoded)
+}
+
 func TestChainConfig_String(t *testing.T) {
 	configStr := `homestead_block: "0"
 dao_fork_block: "0"
@@ -259,6 +342,9 @@ istanbul_block: "0"
 muir_glacier_block: "0"
 yoloV2_block: "-1"
 ewasm_block: "-1"
+berlin_block: "-1"
+london_block: "-1"
+precompile_block: "-1"
 `
 	require.Equal(t, configStr, DefaultChainConfig().String())
 }
@@ -31,6 +31,7 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(ManageContractDeploymentWhitelistProposal{}, ManageContractDeploymentWhitelistProposalName, nil)
 	cdc.RegisterConcrete(ManageContractBlockedListProposal{}, ManageContractBlockedListProposalName, nil)
 	cdc.RegisterConcrete(ManageContractMethodBlockedListProposal{}, "okexchain/evm/ManageContractMethodBlockedListProposal", nil)
+	cdc.RegisterConcrete(ManageChainConfigForkProposal{}, "okexchain/evm/ManageChainConfigForkProposal", nil)
 
 	cdc.RegisterConcreteUnmarshaller(ChainConfigName, func(c *amino.Codec, bytes []byte) (interface{}, int, error) {
 		config, n, err := UnmarshalChainConfigFromAmino(c, bytes)
@@ -83,12 +84,12 @@ func UnmarshalChainConfigFromAmino(_ *amino.Codec, data []byte) (*ChainConfig, i
 			break
 		}
 
-		pos, aminoType, err := amino.ParseProtoPosAndTypeMustOneByte(data[0])
+		pos, aminoType, n, err := parseProtoPosAndType(data)
 		if err != nil {
 			return nil, read, err
 		}
-		data = data[1:]
-		read += 1
+		data = data[n:]
+		read += n
 
 		if aminoType == amino.Typ3_ByteLength {
 			var n int
@@ -182,9 +183,28 @@ func UnmarshalChainConfigFromAmino(_ *amino.Codec, data []byte) (*ChainConfig, i
 				return nil, read, err
 			}
 			config.EWASMBlock = integer
+		case 15:
+			integer, err := sdk.NewIntFromAmino(subData)
+			if err != nil {
+				return nil, read, err
+			}
+			config.BerlinBlock = integer
+		case 16:
+			integer, err := sdk.NewIntFromAmino(subData)
+			if err != nil {
+				return nil, read, err
+			}
+			config.LondonBlock = integer
+		case 17:
+			integer, err := sdk.NewIntFromAmino(subData)
+			if err != nil {
+				return nil, read, err
+			}
+			config.PrecompileBlock = integer
 		default:
 			return nil, read, fmt.Errorf("unexpect feild num %d", pos)
 		}
 	}
+	config.DisableUnsetForks()
 	return config, read, nil
 }
@@ -29,6 +29,10 @@ func (cv ContractVerifier) Verify(stateDB vm.StateDB, op vm.OpCode, from, to com
 	if !ok {
 		panic(ErrContractBlockedVerify{"unknown stateDB expected CommitStateDB"})
 	}
+	//record the caller of a precompile, which is not passed to the precompiled contract by geth
+	if _, found := csdb.activePrecompiles[to]; found && op != vm.SELFDESTRUCT {
+		csdb.precompileCall = &precompileCall{op: op, caller: from, to: to, value: value}
+	}
 	//check whether contract has been blocked
 	if !cv.params.EnableContractBlockedList {
 		return nil
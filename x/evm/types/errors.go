@@ -57,6 +57,15 @@ var (
 	// ErrEmptyAddressBlockedContract returns an error if the contract method is empty
 	ErrEmptyAddressBlockedContract = sdkerrors.Register(ModuleName, 19, "Empty address in contract method blocked list is not allowed")
 
+	// ErrTxTypeNotSupported returns an error if the transaction type is not activated by the chain config yet
+	ErrTxTypeNotSupported = sdkerrors.Register(ModuleName, 21, "transaction type not supported")
+
+	// ErrNativeActionFailed returns an error if a native action requested by a precompile fails, the whole tx fails with it
+	ErrNativeActionFailed = sdkerrors.Register(ModuleName, 22, "native action of precompile failed")
+
+	// ErrTraceNotSupported returns an error if the state a traced tx was executed on can't be rebuilt
+	ErrTraceNotSupported = sdkerrors.Register(ModuleName, 23, "trace not supported")
+
 
 	CodeSpaceEvmCallFailed = uint32(7)
 
@@ -1,6 +1,7 @@
 package types
 
 import (
+	ethcmn "github.com/ethereum/go-ethereum/common"
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 	"github.com/okex/exchain/libs/cosmos-sdk/x/auth"
 	authexported "github.com/okex/exchain/libs/cosmos-sdk/x/auth/exported"
@@ -30,3 +31,9 @@ type Subspace interface {
 type BankKeeper interface {
 	BlacklistedAddr(addr sdk.AccAddress) bool
 }
+
+// BackendKeeper defines the expected backend keeper observing the executed evm txs
+type BackendKeeper interface {
+	OnEvmTx(ctx sdk.Context, msg MsgEthereumTx, from ethcmn.Address, txHash ethcmn.Hash, txIndex uint64,
+		status uint64, result *ResultData, gasUsed uint64)
+}
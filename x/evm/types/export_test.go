@@ -0,0 +1,24 @@
+package types
+
+import (
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// UninstallDispatchers removes the dispatchers of the Precompiles from the geth precompiled contracts, as in a
+// node which has not executed any tx from the activation height on
+func UninstallDispatchers() {
+	dispatchersMtx.Lock()
+	defer dispatchersMtx.Unlock()
+
+	for _, contracts := range []*map[ethcmn.Address]vm.PrecompiledContract{&vm.PrecompiledContractsHomestead,
+		&vm.PrecompiledContractsByzantium, &vm.PrecompiledContractsIstanbul, &vm.PrecompiledContractsBerlin} {
+		uninstalled := make(map[ethcmn.Address]vm.PrecompiledContract, len(*contracts))
+		for addr, contract := range *contracts {
+			if _, ok := contract.(dispatcher); !ok {
+				uninstalled[addr] = contract
+			}
+		}
+		*contracts = uninstalled
+	}
+}
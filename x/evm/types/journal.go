@@ -192,6 +192,17 @@ type (
 		address *ethcmn.Address
 		slot    *ethcmn.Hash
 	}
+
+	// Changes made by the precompiles.
+	coinBalanceChange struct {
+		account      *ethcmn.Address
+		denom        string
+		prev         sdk.Dec
+		prevCoinsSet bool
+	}
+	nativeActionChange struct {
+		prev int
+	}
 )
 
 func (ch createObjectChange) revert(s *CommitStateDB) {
@@ -362,3 +373,21 @@ func (ch accessListAddSlotChange) revert(s *CommitStateDB) {
 func (ch accessListAddSlotChange) dirtied() *ethcmn.Address {
 	return nil
 }
+
+func (ch coinBalanceChange) revert(s *CommitStateDB) {
+	so := s.getStateObject(*ch.account)
+	so.setBalance(ch.denom, ch.prev)
+	so.coinsSet = ch.prevCoinsSet
+}
+
+func (ch coinBalanceChange) dirtied() *ethcmn.Address {
+	return ch.account
+}
+
+func (ch nativeActionChange) revert(s *CommitStateDB) {
+	s.nativeActions = s.nativeActions[:ch.prev]
+}
+
+func (ch nativeActionChange) dirtied() *ethcmn.Address {
+	return nil
+}
@@ -293,6 +293,29 @@ func (msg MsgEthereumTx) Type() string { return TypeMsgEthereumTx }
 // ValidateBasic implements the sdk.Msg interface. It performs basic validation
 // checks of a Transaction. If returns an error if validation fails.
 func (msg MsgEthereumTx) ValidateBasic() error {
+	switch msg.Data.Type {
+	case ethtypes.LegacyTxType:
+	case ethtypes.AccessListTxType, ethtypes.DynamicFeeTxType:
+		if msg.Data.ChainID == nil || msg.Data.ChainID.Sign() <= 0 {
+			return sdkerrors.Wrapf(types.ErrInvalidChainID, "chain id of typed transaction must be positive")
+		}
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrTxDecode, "unsupported transaction type %d", msg.Data.Type)
+	}
+
+	if msg.Data.Type == ethtypes.DynamicFeeTxType {
+		if msg.Data.GasTipCap == nil || msg.Data.GasTipCap.Sign() == -1 {
+			return sdkerrors.Wrapf(types.ErrInvalidValue, "gas tip cap cannot be negative %s", msg.Data.GasTipCap)
+		}
+		if msg.Data.GasTipCap.Cmp(msg.Data.Price) > 0 {
+			return sdkerrors.Wrapf(types.ErrInvalidValue, "gas tip cap %s cannot be higher than gas fee cap %s", msg.Data.GasTipCap, msg.Data.Price)
+		}
+		// the tip cap is the gas price paid without any base fee
+		if msg.Data.GasTipCap.Sign() == 0 {
+			return sdkerrors.Wrapf(types.ErrInvalidValue, "gas tip cap cannot be 0")
+		}
+	}
+
 	if msg.Data.Price.Cmp(big.NewInt(0)) == 0 {
 		return sdkerrors.Wrapf(types.ErrInvalidValue, "gas price cannot be 0")
 	}
@@ -344,6 +367,10 @@ func (msg MsgEthereumTx) GetSignBytes() []byte {
 // RLPSignBytes returns the RLP hash of an Ethereum transaction message with a
 // given chainID used for signing.
 func (msg MsgEthereumTx) RLPSignBytes(chainID *big.Int) ethcmn.Hash {
+	if msg.Data.Type != ethtypes.LegacyTxType {
+		return ethtypes.NewLondonSigner(chainID).Hash(msg.ethTx())
+	}
+
 	return rlpHash([]interface{}{
 		msg.Data.AccountNonce,
 		msg.Data.Price,
@@ -368,19 +395,106 @@ func (msg MsgEthereumTx) HomesteadSignHash() ethcmn.Hash {
 	})
 }
 
-// EncodeRLP implements the rlp.Encoder interface.
+// ethTx returns the go-ethereum representation of the transaction
+func (msg MsgEthereumTx) ethTx() *ethtypes.Transaction {
+	data := msg.Data
+	switch data.Type {
+	case ethtypes.AccessListTxType:
+		return ethtypes.NewTx(&ethtypes.AccessListTx{
+			ChainID:    data.ChainID,
+			Nonce:      data.AccountNonce,
+			GasPrice:   data.Price,
+			Gas:        data.GasLimit,
+			To:         data.Recipient,
+			Value:      data.Amount,
+			Data:       data.Payload,
+			AccessList: data.Accesses,
+			V:          data.V,
+			R:          data.R,
+			S:          data.S,
+		})
+	case ethtypes.DynamicFeeTxType:
+		return ethtypes.NewTx(&ethtypes.DynamicFeeTx{
+			ChainID:    data.ChainID,
+			Nonce:      data.AccountNonce,
+			GasTipCap:  data.GasTipCap,
+			GasFeeCap:  data.Price,
+			Gas:        data.GasLimit,
+			To:         data.Recipient,
+			Value:      data.Amount,
+			Data:       data.Payload,
+			AccessList: data.Accesses,
+			V:          data.V,
+			R:          data.R,
+			S:          data.S,
+		})
+	default:
+		return ethtypes.NewTx(&ethtypes.LegacyTx{
+			Nonce:    data.AccountNonce,
+			GasPrice: data.Price,
+			Gas:      data.GasLimit,
+			To:       data.Recipient,
+			Value:    data.Amount,
+			Data:     data.Payload,
+			V:        data.V,
+			R:        data.R,
+			S:        data.S,
+		})
+	}
+}
+
+// setEthTx sets the transaction data from a typed go-ethereum transaction
+func (msg *MsgEthereumTx) setEthTx(tx *ethtypes.Transaction) {
+	v, r, s := tx.RawSignatureValues()
+	msg.Data = TxData{
+		AccountNonce: tx.Nonce(),
+		Price:        tx.GasFeeCap(),
+		GasLimit:     tx.Gas(),
+		Recipient:    tx.To(),
+		Amount:       tx.Value(),
+		Payload:      tx.Data(),
+		V:            v,
+		R:            r,
+		S:            s,
+		Type:         tx.Type(),
+		ChainID:      tx.ChainId(),
+		Accesses:     tx.AccessList(),
+	}
+	if msg.Data.Accesses == nil {
+		msg.Data.Accesses = ethtypes.AccessList{}
+	}
+	if tx.Type() == ethtypes.DynamicFeeTxType {
+		msg.Data.GasTipCap = tx.GasTipCap()
+	}
+	msg.size.Store(tx.Size())
+}
+
+// EncodeRLP implements the rlp.Encoder interface. Typed transactions are
+// encoded as an rlp string holding their EIP-2718 envelope.
 func (msg *MsgEthereumTx) EncodeRLP(w io.Writer) error {
+	if msg.Data.Type != ethtypes.LegacyTxType {
+		return msg.ethTx().EncodeRLP(w)
+	}
 	return rlp.Encode(w, &msg.Data)
 }
 
 // DecodeRLP implements the rlp.Decoder interface.
 func (msg *MsgEthereumTx) DecodeRLP(s *rlp.Stream) error {
-	_, size, err := s.Kind()
+	kind, size, err := s.Kind()
 	if err != nil {
 		// return error if stream is too large
 		return err
 	}
 
+	if kind != rlp.List {
+		var tx ethtypes.Transaction
+		if err := tx.DecodeRLP(s); err != nil {
+			return err
+		}
+		msg.setEthTx(&tx)
+		return nil
+	}
+
 	if err := s.Decode(&msg.Data); err != nil {
 		return err
 	}
@@ -389,11 +503,39 @@ func (msg *MsgEthereumTx) DecodeRLP(s *rlp.Stream) error {
 	return nil
 }
 
+// MarshalBinary returns the canonical encoding of the transaction: the rlp
+// list of legacy transactions or the EIP-2718 envelope of typed ones.
+func (msg *MsgEthereumTx) MarshalBinary() ([]byte, error) {
+	if msg.Data.Type != ethtypes.LegacyTxType {
+		return msg.ethTx().MarshalBinary()
+	}
+	return rlp.EncodeToBytes(msg)
+}
+
+// UnmarshalBinary decodes the canonical encoding of a transaction, as sent
+// through eth_sendRawTransaction.
+func (msg *MsgEthereumTx) UnmarshalBinary(b []byte) error {
+	if len(b) > 0 && b[0] > 0x7f {
+		// legacy transactions are rlp lists
+		return rlp.DecodeBytes(b, msg)
+	}
+
+	var tx ethtypes.Transaction
+	if err := tx.UnmarshalBinary(b); err != nil {
+		return err
+	}
+	msg.setEthTx(&tx)
+	return nil
+}
+
 // Sign calculates a secp256k1 ECDSA signature and signs the transaction. It
 // takes a private key and chainID to sign an Ethereum transaction according to
 // EIP155 standard. It mutates the transaction as it populates the V, R, S
 // fields of the Transaction's Signature.
 func (msg *MsgEthereumTx) Sign(chainID *big.Int, priv *ecdsa.PrivateKey) error {
+	if msg.Data.Type != ethtypes.LegacyTxType {
+		msg.Data.ChainID = new(big.Int).Set(chainID)
+	}
 	txHash := msg.RLPSignBytes(chainID)
 
 	sig, err := ethcrypto.Sign(txHash[:], priv)
@@ -410,7 +552,10 @@ func (msg *MsgEthereumTx) Sign(chainID *big.Int, priv *ecdsa.PrivateKey) error {
 
 	var v *big.Int
 
-	if chainID.Sign() == 0 {
+	if msg.Data.Type != ethtypes.LegacyTxType {
+		// typed transactions carry the y parity of the signature
+		v = big.NewInt(int64(sig[64]))
+	} else if chainID.Sign() == 0 {
 		v = new(big.Int).SetBytes([]byte{sig[64] + 27})
 	} else {
 		v = big.NewInt(int64(sig[64] + 35))
@@ -429,7 +574,12 @@ func (msg *MsgEthereumTx) Sign(chainID *big.Int, priv *ecdsa.PrivateKey) error {
 // A derived address is returned upon success or an error if recovery fails.
 func (msg *MsgEthereumTx) VerifySig(chainID *big.Int, height int64, sigCtx sdk.SigCache) (sdk.SigCache, error) {
 	var signer ethtypes.Signer
-	if isProtectedV(msg.Data.V) {
+	if msg.Data.Type != ethtypes.LegacyTxType {
+		if msg.Data.ChainID == nil || msg.Data.ChainID.Cmp(chainID) != 0 {
+			return nil, fmt.Errorf("invalid chain id for signer: have %s want %s", msg.Data.ChainID, chainID)
+		}
+		signer = ethtypes.NewLondonSigner(chainID)
+	} else if isProtectedV(msg.Data.V) {
 		signer = ethtypes.NewEIP155Signer(chainID)
 	} else {
 		if sdk.HigherThanMercury(height) {
@@ -458,7 +608,12 @@ func (msg *MsgEthereumTx) VerifySig(chainID *big.Int, height int64, sigCtx sdk.S
 
 	V := new(big.Int)
 	var sigHash ethcmn.Hash
-	if isProtectedV(msg.Data.V) {
+	if msg.Data.Type != ethtypes.LegacyTxType {
+		// the y parity of typed transactions is 0 or 1
+		V = new(big.Int).Add(msg.Data.V, big.NewInt(27))
+
+		sigHash = msg.RLPSignBytes(chainID)
+	} else if isProtectedV(msg.Data.V) {
 		// do not allow recovery for transactions with an unprotected chainID
 		if chainID.Sign() == 0 {
 			return nil, errors.New("chainID cannot be zero")
@@ -499,17 +654,35 @@ func (msg MsgEthereumTx) GetGas() uint64 {
 	return msg.Data.GasLimit
 }
 
-// Fee returns gasprice * gaslimit.
+// Fee returns effective gasprice * gaslimit.
 func (msg MsgEthereumTx) Fee() *big.Int {
-	return new(big.Int).Mul(msg.Data.Price, new(big.Int).SetUint64(msg.Data.GasLimit))
+	return new(big.Int).Mul(msg.Data.EffectiveGasPrice(nil), new(big.Int).SetUint64(msg.Data.GasLimit))
 }
 
 // ChainID returns which chain id this transaction was signed for (if at all)
 func (msg *MsgEthereumTx) ChainID() *big.Int {
+	if msg.Data.Type != ethtypes.LegacyTxType {
+		if msg.Data.ChainID == nil {
+			return new(big.Int)
+		}
+		return new(big.Int).Set(msg.Data.ChainID)
+	}
 	return deriveChainID(msg.Data.V)
 }
 
-// Cost returns amount + gasprice * gaslimit.
+// AccessList returns the access list of the transaction, which is nil for
+// legacy transactions and never nil for typed ones.
+func (msg MsgEthereumTx) AccessList() ethtypes.AccessList {
+	if msg.Data.Type == ethtypes.LegacyTxType {
+		return nil
+	}
+	if msg.Data.Accesses == nil {
+		return ethtypes.AccessList{}
+	}
+	return msg.Data.Accesses
+}
+
+// Cost returns amount + effective gasprice * gaslimit.
 func (msg MsgEthereumTx) Cost() *big.Int {
 	total := msg.Fee()
 	total.Add(total, msg.Data.Amount)
@@ -573,14 +746,14 @@ func (msg MsgEthereumTx) GetTxInfo(ctx sdk.Context) mempool.ExTxInfo {
 
 	from := fromSigCache.GetFrom()
 	exTxInfo.Sender = from.String()
-	exTxInfo.GasPrice = msg.Data.Price
+	exTxInfo.GasPrice = msg.Data.EffectiveGasPrice(nil)
 
 	return exTxInfo
 }
 
-// GetGasPrice return gas price
+// GetGasPrice return the effective gas price
 func (msg MsgEthereumTx) GetGasPrice() *big.Int {
-	return msg.Data.Price
+	return msg.Data.EffectiveGasPrice(nil)
 }
 
 func (msg *MsgEthereumTx) UnmarshalFromAmino(data []byte) error {
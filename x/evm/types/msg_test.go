@@ -224,6 +224,66 @@ func TestMsgEthereumTxSig(t *testing.T) {
 	require.Nil(t, signerCache)
 }
 
+func TestMsgEthereumTxTypedSig(t *testing.T) {
+	chainID := big.NewInt(3)
+	priv, _ := ethsecp256k1.GenerateKey()
+	addr := ethcmn.BytesToAddress(priv.PubKey().Address().Bytes())
+
+	testCases := []struct {
+		name   string
+		txType uint8
+	}{
+		{"access list tx", ethtypes.AccessListTxType},
+		{"dynamic fee tx", ethtypes.DynamicFeeTxType},
+	}
+
+	for _, tc := range testCases {
+		msg := NewMsgEthereumTx(0, &addr, big.NewInt(1), 100000, big.NewInt(10), []byte("test"))
+		msg.Data.Type = tc.txType
+		msg.Data.Accesses = ethtypes.AccessList{{Address: addr, StorageKeys: []ethcmn.Hash{{}}}}
+		if tc.txType == ethtypes.DynamicFeeTxType {
+			msg.Data.GasTipCap = big.NewInt(2)
+		}
+		require.NoError(t, msg.Sign(chainID, priv.ToECDSA()), tc.name)
+		require.NoError(t, msg.ValidateBasic(), tc.name)
+		require.True(t, chainID.Cmp(msg.ChainID()) == 0, tc.name)
+		// the fee of a dynamic fee tx is paid with the tip cap as there is no base fee
+		if tc.txType == ethtypes.DynamicFeeTxType {
+			require.Equal(t, big.NewInt(2*100000), msg.Fee(), tc.name)
+		} else {
+			require.Equal(t, big.NewInt(10*100000), msg.Fee(), tc.name)
+		}
+
+		signerCache, err := msg.VerifySig(chainID, 0, sdk.EmptyContext().SigCache())
+		require.NoError(t, err, tc.name)
+		require.Equal(t, addr, signerCache.GetFrom(), tc.name)
+
+		_, err = msg.VerifySig(big.NewInt(4), 0, sdk.EmptyContext().SigCache())
+		require.Error(t, err, tc.name)
+
+		// the binary encoding is the EIP-2718 envelope of go-ethereum
+		bz, err := msg.MarshalBinary()
+		require.NoError(t, err, tc.name)
+		ethTx := new(ethtypes.Transaction)
+		require.NoError(t, ethTx.UnmarshalBinary(bz), tc.name)
+		require.Equal(t, tc.txType, ethTx.Type(), tc.name)
+		sender, err := ethtypes.Sender(ethtypes.NewLondonSigner(chainID), ethTx)
+		require.NoError(t, err, tc.name)
+		require.Equal(t, addr, sender, tc.name)
+
+		var msg2 MsgEthereumTx
+		require.NoError(t, msg2.UnmarshalBinary(bz), tc.name)
+		require.Equal(t, msg.Data, msg2.Data, tc.name)
+
+		// amino round trip through the codec
+		aminoBz, err := ModuleCdc.MarshalBinaryBare(msg)
+		require.NoError(t, err, tc.name)
+		var msg3 MsgEthereumTx
+		require.NoError(t, ModuleCdc.UnmarshalBinaryBare(aminoBz, &msg3), tc.name)
+		require.Equal(t, msg.Data, msg3.Data, tc.name)
+	}
+}
+
 func TestMsgEthereumTx_ChainID(t *testing.T) {
 	chainID := big.NewInt(3)
 	priv, _ := ethsecp256k1.GenerateKey()
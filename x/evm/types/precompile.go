@@ -0,0 +1,243 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
+	gorid "github.com/okex/exchain/libs/goroutine"
+)
+
+// Precompile is a precompiled contract backed by the native modules. Unlike the geth precompiles,
+// it is run with the sdk context, the state db of the tx and the caller of the call.
+type Precompile interface {
+	Address() ethcmn.Address
+	RequiredGas(input []byte) uint64
+	Run(pctx *PrecompileContext, input []byte) ([]byte, error)
+}
+
+// PrecompileContext is the context of a call to a Precompile
+type PrecompileContext struct {
+	Ctx     sdk.Context
+	StateDB *CommitStateDB
+	Caller  ethcmn.Address
+	Value   *big.Int
+	// ReadOnly is set for STATICCALL, the state must not be changed
+	ReadOnly bool
+}
+
+// NativeAction is a change of the native modules state requested by a Precompile. The native state
+// must not be changed while the evm is running, since the state db would overwrite the accounts it
+// caches and could not revert the change. The actions of a tx are run in order after the state db is
+// committed instead, and a failed action fails the tx.
+type NativeAction func(ctx sdk.Context) error
+
+// precompileCall is the call to a Precompile being made, it is recorded by the ContractVerifier
+// since geth only passes the input to a precompiled contract
+type precompileCall struct {
+	op     vm.OpCode
+	caller ethcmn.Address
+	to     ethcmn.Address
+	value  *big.Int
+}
+
+// Precompiles is a set of Precompiles by address
+type Precompiles map[ethcmn.Address]Precompile
+
+// Addresses returns the addresses of the Precompiles in ascending order
+func (ps Precompiles) Addresses() []ethcmn.Address {
+	addrs := make([]ethcmn.Address, 0, len(ps))
+	for addr := range ps {
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool {
+		return bytes.Compare(addrs[i].Bytes(), addrs[j].Bytes()) < 0
+	})
+	return addrs
+}
+
+// Add adds p to the set, replacing the Precompile at the same address. The Precompiles of the set are
+// only called once they are activated by the chain config.
+func (ps Precompiles) Add(p Precompile) {
+	if contract, found := vm.PrecompiledContractsBerlin[p.Address()]; found {
+		if _, ok := contract.(dispatcher); !ok {
+			panic(fmt.Sprintf("precompile address %s is used by geth", p.Address().String()))
+		}
+	}
+	ps[p.Address()] = p
+}
+
+var (
+	// dispatchersMtx guards the installation of the dispatchers into the geth precompiled contracts
+	dispatchersMtx sync.Mutex
+
+	// precompileScopes is the state db of the tx being executed by each goroutine, since geth looks up
+	// the precompiled contracts of a call in its package maps
+	precompileScopes sync.Map
+)
+
+// installDispatchers makes geth dispatch the calls to the addresses of ps to the Precompile active in the
+// state db of the calling goroutine. The geth fork has no per evm precompiled contracts, and geth treats a
+// call to a precompiled contract differently from a call to an empty account even if it does nothing, so
+// the dispatchers are only installed once a tx is executed from the activation height on. The geth maps
+// are read without any lock, so they are replaced by copies with the dispatchers instead of being changed.
+// The addresses are not added to the access lists of geth.
+func installDispatchers(ps Precompiles) {
+	dispatchersMtx.Lock()
+	defer dispatchersMtx.Unlock()
+
+	var addrs []ethcmn.Address
+	for addr := range ps {
+		if _, found := vm.PrecompiledContractsBerlin[addr]; !found {
+			addrs = append(addrs, addr)
+		}
+	}
+	if len(addrs) == 0 {
+		return
+	}
+	vm.PrecompiledContractsHomestead = withDispatchers(vm.PrecompiledContractsHomestead, addrs)
+	vm.PrecompiledContractsByzantium = withDispatchers(vm.PrecompiledContractsByzantium, addrs)
+	vm.PrecompiledContractsIstanbul = withDispatchers(vm.PrecompiledContractsIstanbul, addrs)
+	vm.PrecompiledContractsBerlin = withDispatchers(vm.PrecompiledContractsBerlin, addrs)
+}
+
+// withDispatchers returns a copy of contracts with the dispatchers at addrs
+func withDispatchers(contracts map[ethcmn.Address]vm.PrecompiledContract,
+	addrs []ethcmn.Address) map[ethcmn.Address]vm.PrecompiledContract {
+	installed := make(map[ethcmn.Address]vm.PrecompiledContract, len(contracts)+len(addrs))
+	for addr, contract := range contracts {
+		installed[addr] = contract
+	}
+	for _, addr := range addrs {
+		installed[addr] = dispatcher{addr}
+	}
+	return installed
+}
+
+// enterPrecompileScope makes the Precompiles active in csdb run on it for the calls made by the current
+// goroutine, until the returned function is called
+func enterPrecompileScope(csdb *CommitStateDB) func() {
+	if len(csdb.activePrecompiles) == 0 {
+		return func() {}
+	}
+
+	id := gorid.GoRId.String()
+	prev, found := precompileScopes.Load(id)
+	precompileScopes.Store(id, csdb)
+	return func() {
+		if found {
+			precompileScopes.Store(id, prev)
+		} else {
+			precompileScopes.Delete(id)
+		}
+	}
+}
+
+// activePrecompile returns the Precompile active at addr for the calls made by the current goroutine
+func activePrecompile(addr ethcmn.Address) (Precompile, *CommitStateDB, bool) {
+	scope, found := precompileScopes.Load(gorid.GoRId.String())
+	if !found {
+		return nil, nil, false
+	}
+	csdb := scope.(*CommitStateDB)
+	p, found := csdb.activePrecompiles[addr]
+	return p, csdb, found
+}
+
+// dispatcher is the geth precompiled contract at the address of a Precompile
+type dispatcher struct {
+	addr ethcmn.Address
+}
+
+func (d dispatcher) RequiredGas(input []byte) uint64 {
+	p, _, found := activePrecompile(d.addr)
+	if !found {
+		return 0
+	}
+	return p.RequiredGas(input)
+}
+
+func (d dispatcher) Run(input []byte) ([]byte, error) {
+	p, csdb, found := activePrecompile(d.addr)
+	if !found {
+		// the call succeeds without output as a call to an account without code
+		return nil, nil
+	}
+
+	call := csdb.precompileCall
+	csdb.precompileCall = nil
+	if call == nil || call.to != d.addr {
+		return nil, errors.New("precompile is called without a verified call")
+	}
+	switch call.op {
+	case vm.CALL, vm.STATICCALL:
+	default:
+		return nil, fmt.Errorf("precompile can not be called by %s", call.op.String())
+	}
+
+	return p.Run(&PrecompileContext{
+		Ctx:      csdb.ctx,
+		StateDB:  csdb,
+		Caller:   call.caller,
+		Value:    call.value,
+		ReadOnly: call.op == vm.STATICCALL,
+	}, input)
+}
+
+// GetCoinBalance returns the balance of denom of addr, including the changes made by the tx
+func (csdb *CommitStateDB) GetCoinBalance(addr ethcmn.Address, denom string) sdk.Dec {
+	so := csdb.getStateObject(addr)
+	if so == nil {
+		return sdk.ZeroDec()
+	}
+	return so.account.GetCoins().AmountOf(denom)
+}
+
+// SetCoinBalance sets the balance of denom of addr, it can be reverted like the evm balance
+func (csdb *CommitStateDB) SetCoinBalance(addr ethcmn.Address, denom string, amount sdk.Dec) {
+	so := csdb.GetOrNewStateObject(addr).(*stateObject)
+	csdb.journal.append(coinBalanceChange{
+		account:      &so.address,
+		denom:        denom,
+		prev:         so.account.GetCoins().AmountOf(denom),
+		prevCoinsSet: so.coinsSet,
+	})
+	so.setBalance(denom, amount)
+	if denom != sdk.DefaultBondDenom {
+		so.coinsSet = true
+	}
+}
+
+// AddNativeAction appends an action run after the state db is committed, it is dropped if the
+// call adding it is reverted
+func (csdb *CommitStateDB) AddNativeAction(action NativeAction) {
+	csdb.journal.append(nativeActionChange{prev: len(csdb.nativeActions)})
+	csdb.nativeActions = append(csdb.nativeActions, action)
+}
+
+// runNativeActions runs the native actions added by the tx. The actions are written all together or
+// not at all, and the tx fails if one of them fails since the evm call can't be reverted any more.
+func (csdb *CommitStateDB) runNativeActions() error {
+	actions := csdb.nativeActions
+	csdb.nativeActions = nil
+	if len(actions) == 0 {
+		return nil
+	}
+
+	cacheCtx, write := csdb.ctx.CacheContext()
+	for i, action := range actions {
+		if err := action(cacheCtx); err != nil {
+			return sdkerrors.Wrapf(ErrNativeActionFailed, "native action %d: %s", i, err.Error())
+		}
+	}
+	write()
+	csdb.ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return nil
+}
@@ -0,0 +1,66 @@
+package types_test
+
+import (
+	"math/big"
+	"testing"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/okex/exchain/app"
+	ethermint "github.com/okex/exchain/app/types"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/auth"
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	"github.com/okex/exchain/x/evm/precompile"
+	"github.com/okex/exchain/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrecompileReplayBeforeActivation(t *testing.T) {
+	// a node replaying the blocks from before the activation height
+	types.UninstallDispatchers()
+
+	okexApp := app.Setup(false)
+	ctx := okexApp.BaseApp.NewContext(false, abci.Header{Height: 1, ChainID: "ethermint-1"})
+	sender := ethcmn.BytesToAddress([]byte("sender"))
+	okexApp.AccountKeeper.SetAccount(ctx, &ethermint.EthAccount{
+		BaseAccount: auth.NewBaseAccount(sdk.AccAddress(sender.Bytes()),
+			sdk.NewCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDec(100))), nil, 0, 0),
+		CodeHash: ethcrypto.Keccak256(nil),
+	})
+	params := types.DefaultParams()
+	params.EnableCall = true
+	okexApp.EvmKeeper.SetParams(ctx, params)
+
+	config := types.DefaultChainConfig()
+	config.PrecompileBlock = sdk.NewInt(2)
+	call := func(ctx sdk.Context) error {
+		to := precompile.BankAddress
+		st := types.StateTransition{
+			Price:     big.NewInt(1),
+			GasLimit:  1000000,
+			Recipient: &to,
+			Amount:    big.NewInt(0),
+			ChainID:   big.NewInt(1),
+			Csdb:      types.CreateEmptyCommitStateDB(okexApp.EvmKeeper.GenerateCSDBParams(), ctx),
+			TxHash:    &ethcmn.Hash{},
+			Sender:    sender,
+		}
+		_, _, err, _, _ := st.TransitionDb(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), config)
+		return err
+	}
+
+	nextAccountNumber := func(ctx sdk.Context) uint64 {
+		cacheCtx, _ := ctx.CacheContext()
+		return okexApp.AccountKeeper.GetNextAccountNumber(cacheCtx)
+	}
+
+	// the zero value call to the precompile address is a call to an empty account, no account is created
+	accountNumber := nextAccountNumber(ctx)
+	require.NoError(t, call(ctx))
+	require.Equal(t, accountNumber, nextAccountNumber(ctx))
+	require.Nil(t, okexApp.AccountKeeper.GetAccount(ctx, precompile.BankAddress.Bytes()))
+
+	// the call runs the precompile from the activation height on
+	require.Error(t, call(ctx.WithBlockHeight(2)))
+}
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
 	govtypes "github.com/okex/exchain/x/gov/types"
 )
 
@@ -16,21 +17,26 @@ const (
 	proposalTypeManageContractBlockedList = "ManageContractBlockedList"
 	// proposalTypeManageContractMethodBlockedList defines the type for a ManageContractMethodBlockedList
 	proposalTypeManageContractMethodBlockedList = "ManageContractMethodBlockedList"
+	// proposalTypeManageChainConfigFork defines the type for a ManageChainConfigForkProposal
+	proposalTypeManageChainConfigFork = "ManageChainConfigFork"
 )
 
 func init() {
 	govtypes.RegisterProposalType(proposalTypeManageContractDeploymentWhitelist)
 	govtypes.RegisterProposalType(proposalTypeManageContractBlockedList)
 	govtypes.RegisterProposalType(proposalTypeManageContractMethodBlockedList)
+	govtypes.RegisterProposalType(proposalTypeManageChainConfigFork)
 	govtypes.RegisterProposalTypeCodec(ManageContractDeploymentWhitelistProposal{}, "okexchain/evm/ManageContractDeploymentWhitelistProposal")
 	govtypes.RegisterProposalTypeCodec(ManageContractBlockedListProposal{}, "okexchain/evm/ManageContractBlockedListProposal")
 	govtypes.RegisterProposalTypeCodec(ManageContractMethodBlockedListProposal{}, "okexchain/evm/ManageContractMethodBlockedListProposal")
+	govtypes.RegisterProposalTypeCodec(ManageChainConfigForkProposal{}, "okexchain/evm/ManageChainConfigForkProposal")
 }
 
 var (
 	_ govtypes.Content = (*ManageContractDeploymentWhitelistProposal)(nil)
 	_ govtypes.Content = (*ManageContractBlockedListProposal)(nil)
 	_ govtypes.Content = (*ManageContractMethodBlockedListProposal)(nil)
+	_ govtypes.Content = (*ManageChainConfigForkProposal)(nil)
 )
 
 // ManageContractDeploymentWhitelistProposal - structure for the proposal to add or delete deployer addresses from whitelist
@@ -343,3 +349,96 @@ func (mp ManageContractMethodBlockedListProposal) String() string {
 
 	return strings.TrimSpace(builder.String())
 }
+
+// ManageChainConfigForkProposal - structure for the proposal to schedule the Berlin and London forks and the
+// native module precompiles of the chain config
+type ManageChainConfigForkProposal struct {
+	Title           string  `json:"title" yaml:"title"`
+	Description     string  `json:"description" yaml:"description"`
+	BerlinBlock     sdk.Int `json:"berlin_block" yaml:"berlin_block"`
+	LondonBlock     sdk.Int `json:"london_block" yaml:"london_block"`
+	PrecompileBlock sdk.Int `json:"precompile_block" yaml:"precompile_block"`
+}
+
+// NewManageChainConfigForkProposal creates a new instance of ManageChainConfigForkProposal
+func NewManageChainConfigForkProposal(title, description string, berlinBlock, londonBlock, precompileBlock sdk.Int,
+) ManageChainConfigForkProposal {
+	return ManageChainConfigForkProposal{
+		Title:           title,
+		Description:     description,
+		BerlinBlock:     berlinBlock,
+		LondonBlock:     londonBlock,
+		PrecompileBlock: precompileBlock,
+	}
+}
+
+// GetTitle returns title of a manage chain config fork proposal object
+func (mp ManageChainConfigForkProposal) GetTitle() string {
+	return mp.Title
+}
+
+// GetDescription returns description of a manage chain config fork proposal object
+func (mp ManageChainConfigForkProposal) GetDescription() string {
+	return mp.Description
+}
+
+// ProposalRoute returns route key of a manage chain config fork proposal object
+func (mp ManageChainConfigForkProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+// ProposalType returns type of a manage chain config fork proposal object
+func (mp ManageChainConfigForkProposal) ProposalType() string {
+	return proposalTypeManageChainConfigFork
+}
+
+// ValidateBasic validates a manage chain config fork proposal
+func (mp ManageChainConfigForkProposal) ValidateBasic() sdk.Error {
+	if len(strings.TrimSpace(mp.Title)) == 0 {
+		return govtypes.ErrInvalidProposalContent("title is required")
+	}
+	if len(mp.Title) > govtypes.MaxTitleLength {
+		return govtypes.ErrInvalidProposalContent("title length is longer than the maximum title length")
+	}
+
+	if len(mp.Description) == 0 {
+		return govtypes.ErrInvalidProposalContent("description is required")
+	}
+
+	if len(mp.Description) > govtypes.MaxDescriptionLength {
+		return govtypes.ErrInvalidProposalContent("description length is longer than the maximum description length")
+	}
+
+	if mp.ProposalType() != proposalTypeManageChainConfigFork {
+		return govtypes.ErrInvalidProposalType(mp.ProposalType())
+	}
+
+	if err := validateBlock(mp.BerlinBlock); err != nil {
+		return sdkerrors.Wrap(err, "berlinBlock")
+	}
+	if err := validateBlock(mp.LondonBlock); err != nil {
+		return sdkerrors.Wrap(err, "londonBlock")
+	}
+	if err := validateBlock(mp.PrecompileBlock); err != nil {
+		return sdkerrors.Wrap(err, "precompileBlock")
+	}
+
+	// the activation blocks are checked against the current height on submission
+	if err := validateForkOrder(mp.BerlinBlock, mp.LondonBlock); err != nil {
+		return sdkerrors.Wrap(err, "londonBlock")
+	}
+
+	return nil
+}
+
+// String returns a human readable string representation of a ManageChainConfigForkProposal
+func (mp ManageChainConfigForkProposal) String() string {
+	return fmt.Sprintf(`ManageChainConfigForkProposal:
+ Title:					%s
+ Description:        	%s
+ Type:                	%s
+ BerlinBlock:			%s
+ LondonBlock:			%s
+ PrecompileBlock:		%s`,
+		mp.Title, mp.Description, mp.ProposalType(), mp.BerlinBlock, mp.LondonBlock, mp.PrecompileBlock)
+}
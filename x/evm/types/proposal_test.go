@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	ethcmn "github.com/ethereum/go-ethereum/common"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 	govtypes "github.com/okex/exchain/x/gov/types"
 	"github.com/stretchr/testify/suite"
 )
@@ -43,6 +44,13 @@ Sign: 0x22222222Extra: TEST2
 Method List:
 Sign: 0x33333333Extra: TEST3
 Sign: 0x44444444Extra: TEST4`
+	expectedManageChainConfigForkProposalString = `ManageChainConfigForkProposal:
+ Title:					default title
+ Description:        	default description
+ Type:                	ManageChainConfigFork
+ BerlinBlock:			100
+ LondonBlock:			200
+ PrecompileBlock:		300`
 )
 
 type ProposalTestSuite struct {
@@ -404,3 +412,99 @@ func (suite *ProposalTestSuite) TestProposal_ManageContractMethodBlockedListProp
 		})
 	}
 }
+
+func (suite *ProposalTestSuite) TestProposal_ManageChainConfigForkProposal() {
+	proposal := NewManageChainConfigForkProposal(
+		expectedTitle,
+		expectedDescription,
+		sdk.NewInt(100),
+		sdk.NewInt(200),
+		sdk.NewInt(300),
+	)
+
+	suite.Require().Equal(expectedTitle, proposal.GetTitle())
+	suite.Require().Equal(expectedDescription, proposal.GetDescription())
+	suite.Require().Equal(RouterKey, proposal.ProposalRoute())
+	suite.Require().Equal(proposalTypeManageChainConfigFork, proposal.ProposalType())
+	suite.Require().Equal(expectedManageChainConfigForkProposalString, proposal.String())
+
+	testCases := []struct {
+		msg           string
+		prepare       func()
+		expectedError bool
+	}{
+		{
+			"pass",
+			func() {},
+			false,
+		},
+		{
+			"pass with london disabled",
+			func() {
+				proposal.LondonBlock = sdk.NewInt(-1)
+			},
+			false,
+		},
+		{
+			"empty title",
+			func() {
+				proposal.Title = ""
+			},
+			true,
+		},
+		{
+			"empty description",
+			func() {
+				proposal.Description = ""
+				proposal.Title = expectedTitle
+			},
+			true,
+		},
+		{
+			"uninitialized berlin block",
+			func() {
+				proposal.Description = expectedDescription
+				proposal.BerlinBlock = sdk.Int{}
+			},
+			true,
+		},
+		{
+			"uninitialized precompile block",
+			func() {
+				proposal.BerlinBlock = sdk.NewInt(100)
+				proposal.PrecompileBlock = sdk.Int{}
+			},
+			true,
+		},
+		{
+			"london without berlin",
+			func() {
+				proposal.PrecompileBlock = sdk.NewInt(-1)
+				proposal.BerlinBlock = sdk.NewInt(-1)
+				proposal.LondonBlock = sdk.NewInt(200)
+			},
+			true,
+		},
+		{
+			"london before berlin",
+			func() {
+				proposal.BerlinBlock = sdk.NewInt(300)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			tc.prepare()
+
+			err := proposal.ValidateBasic()
+
+			if tc.expectedError {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
@@ -1,8 +1,12 @@
 package types
 
 import (
+	"encoding/json"
 	"fmt"
+	"math/big"
+	"time"
 
+	ethcmn "github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
@@ -26,6 +30,9 @@ const (
 	QueryContractDeploymentWhitelist = "contract-deployment-whitelist"
 	QueryContractBlockedList         = "contract-blocked-list"
 	QueryContractMethodBlockedList   = "contract-method-blocked-list"
+	QueryTraceTx                     = "traceTx"
+	QueryInnerTxs                    = "innerTxs"
+	QueryBlockInnerTxs               = "blockInnerTxs"
 )
 
 // QueryResBalance is response type for balance query
@@ -105,3 +112,56 @@ type QueryResAccount struct {
 }
 
 type QueryResExportAccount = GenesisAccount
+
+// TraceTxMsg is a message re-executed by the traceTx query. The sender is
+// recovered by the caller so that unsigned eth_call style messages can be traced too,
+// and a nil Nonce means the current nonce of the sender.
+type TraceTxMsg struct {
+	From     ethcmn.Address  `json:"from"`
+	To       *ethcmn.Address `json:"to"`
+	Nonce    *uint64         `json:"nonce"`
+	GasLimit uint64          `json:"gas"`
+	GasPrice *big.Int        `json:"gasPrice"`
+	Value    *big.Int        `json:"value"`
+	Data     []byte          `json:"input"`
+	TxHash   ethcmn.Hash     `json:"hash"`
+
+	AccessList ethtypes.AccessList `json:"accessList"`
+	// Trace is false for predecessors which are only applied to reach the state of the traced ones
+	Trace bool `json:"trace"`
+	// CosmosTx is set for a predecessor which is not an ethereum tx, the raw tx is replayed as is
+	// and the other fields are ignored
+	CosmosTx []byte `json:"cosmosTx,omitempty"`
+}
+
+// QueryTraceTxParams defines the params of the traceTx query. It must be sent at the height
+// preceding BlockHeight so that the messages replay on top of the parent state.
+type QueryTraceTxParams struct {
+	Msgs          []TraceTxMsg `json:"msgs"`
+	BlockHeight   int64        `json:"blockHeight"`
+	BlockTime     time.Time    `json:"blockTime"`
+	BlockHash     ethcmn.Hash  `json:"blockHash"`
+	BlockProposer []byte       `json:"blockProposer"`
+	Config        *TraceConfig `json:"config"`
+}
+
+// QueryResTraceTx is the trace output of a single traced message
+type QueryResTraceTx struct {
+	TxHash ethcmn.Hash     `json:"txHash"`
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  string          `json:"error,omitempty"`
+}
+
+// TxInnerTxs are the inner txs recorded for a single ethereum tx
+type TxInnerTxs struct {
+	TxHash   string     `json:"txHash"`
+	InnerTxs []*InnerTx `json:"innerTxs"`
+}
+
+// QueryResBlockInnerTxs is the response type for the inner txs and the erc20 contracts
+// recorded for a block
+type QueryResBlockInnerTxs struct {
+	BlockHash string           `json:"blockHash"`
+	Txs       []TxInnerTxs     `json:"txs"`
+	Contracts []*ERC20Contract `json:"contracts"`
+}
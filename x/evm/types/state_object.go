@@ -126,6 +126,8 @@ type stateObject struct {
 	dirtyCode bool // true if the code was updated
 	suicided  bool
 	deleted   bool
+
+	coinsSet bool // true if the coins of the other denoms than the evm one are set by the precompiles
 }
 
 func newStateObject(db *CommitStateDB, accProto authexported.Account) *stateObject {
@@ -481,6 +483,7 @@ func (so *stateObject) deepCopy(db *CommitStateDB) *stateObject {
 	newStateObj.suicided = so.suicided
 	newStateObj.dirtyCode = so.dirtyCode
 	newStateObj.deleted = so.deleted
+	newStateObj.coinsSet = so.coinsSet
 
 	return newStateObj
 }
@@ -488,10 +491,13 @@ func (so *stateObject) deepCopy(db *CommitStateDB) *stateObject {
 // empty returns whether the account is considered empty.
 func (so *stateObject) empty() bool {
 	balace := so.account.Balance(sdk.DefaultBondDenom)
+	// NOTE: only the coins of the other denoms set by the precompiles keep the account alive too, so
+	// that the accounts deleted by EIP-161 stay the same as before for the txs without them
 	return so.account == nil ||
 		(so.account != nil &&
 			so.account.Sequence == 0 &&
 			(balace.BigInt() == nil || balace.IsZero()) &&
+			(!so.coinsSet || so.account.GetCoins().IsZero()) &&
 			bytes.Equal(so.account.CodeHash, emptyCodeHash))
 }
 
@@ -26,12 +26,18 @@ type StateTransition struct {
 	Recipient    *common.Address
 	Amount       *big.Int
 	Payload      []byte
+	AccessList   ethtypes.AccessList // nil for legacy transactions
 
 	ChainID  *big.Int
 	Csdb     *CommitStateDB
 	TxHash   *common.Hash
 	Sender   common.Address
 	Simulate bool // i.e CheckTx execution
+
+	// TraceTx marks a re-execution for the debug_ trace APIs. Tracer is attached to the EVM
+	// instead of the node-level trace recorder and may be nil for untraced predecessors.
+	TraceTx bool
+	Tracer  vm.Tracer
 }
 
 // GasInfo returns the gas limit, gas consumed and gas refunded from the EVM transition
@@ -92,6 +98,7 @@ func (st StateTransition) newEVM(
 		Time:        big.NewInt(ctx.BlockHeader().Time.Unix()),
 		Difficulty:  big.NewInt(0), // unused. Only required in PoW context
 		GasLimit:    gasLimit,
+		BaseFee:     big.NewInt(0), // no base fee is burnt, the BASEFEE opcode returns zero after London
 	}
 
 	txCtx := vm.TxContext{
@@ -105,7 +112,7 @@ func (st StateTransition) newEVM(
 // TransitionDb will transition the state by applying the current transaction and
 // returning the evm execution result.
 // NOTE: State transition checks are run during AnteHandler execution.
-func (st StateTransition) TransitionDb(ctx sdk.Context, config ChainConfig) (exeRes *ExecutionResult, resData *ResultData, err error, innerTxs, erc20Contracts interface{}) {
+func (st StateTransition) TransitionDb(ctx sdk.Context, config ChainConfig) (exeRes *ExecutionResult, resData *ResultData, err error, innerTxs []*InnerTx, erc20Contracts []*ERC20Contract) {
 	defer func() {
 		if e := recover(); e != nil {
 			// if the msg recovered can be asserted into type 'ErrContractBlockedVerify', it must be captured by the panics of blocked
@@ -121,7 +128,7 @@ func (st StateTransition) TransitionDb(ctx sdk.Context, config ChainConfig) (exe
 
 	contractCreation := st.Recipient == nil
 
-	cost, err := core.IntrinsicGas(st.Payload, []ethtypes.AccessTuple{}, contractCreation, config.IsHomestead(), config.IsIstanbul())
+	cost, err := core.IntrinsicGas(st.Payload, st.AccessList, contractCreation, config.IsHomestead(), config.IsIstanbul())
 	if err != nil {
 		return exeRes, resData, sdkerrors.Wrap(err, "invalid intrinsic gas for transaction"), innerTxs, erc20Contracts
 	}
@@ -141,6 +148,12 @@ func (st StateTransition) TransitionDb(ctx sdk.Context, config ChainConfig) (exe
 	evmGasMeter := sdk.NewInfiniteGasMeter()
 	ctx = ctx.WithGasMeter(evmGasMeter)
 	csdb := st.Csdb.WithContext(ctx)
+	csdb.activePrecompiles = nil
+	if config.IsPrecompileActive(ctx.BlockHeight()) {
+		csdb.activePrecompiles = csdb.precompiles
+		installDispatchers(csdb.activePrecompiles)
+	}
+	defer enterPrecompileScope(csdb)()
 
 	StartTxLog := func(tag string) {
 		if !ctx.IsCheckTx() {
@@ -163,11 +176,18 @@ func (st StateTransition) TransitionDb(ctx sdk.Context, config ChainConfig) (exe
 		to = st.Recipient.String()
 	}
 	enableDebug := checkTracesSegment(ctx.BlockHeight(), st.Sender.String(), to)
+	if st.TraceTx {
+		tracer, enableDebug = st.Tracer, st.Tracer != nil
+	}
+	vmTracer, vmDebug := tracer, enableDebug
+	if !st.Simulate && !st.TraceTx && !ctx.IsCheckTx() {
+		vmTracer, vmDebug = withInnerTxTracer(tracer, enableDebug)
+	}
 
 	vmConfig := vm.Config{
 		ExtraEips:  params.ExtraEIPs,
-		Debug:      enableDebug,
-		Tracer:     tracer,
+		Debug:      vmDebug,
+		Tracer:     vmTracer,
 		ContractVerifier: NewContractVerifier(params),
 	}
 
@@ -186,6 +206,12 @@ func (st StateTransition) TransitionDb(ctx sdk.Context, config ChainConfig) (exe
 	// Set nonce of sender account before evm state transition for usage in generating Create address
 	csdb.SetNonce(st.Sender, st.AccountNonce)
 
+	if rules := evm.ChainConfig().Rules(evm.Context.BlockNumber); rules.IsBerlin {
+		// copied since the geth slice must not be appended to
+		precompiles := append(append([]common.Address{}, vm.ActivePrecompiles(rules)...), csdb.activePrecompiles.Addresses()...)
+		csdb.PrepareAccessList(st.Sender, st.Recipient, precompiles, st.AccessList)
+	}
+
 	//add InnerTx
 	callTx := addDefaultInnerTx(evm, st.Sender.String())
 
@@ -235,7 +261,7 @@ func (st StateTransition) TransitionDb(ctx sdk.Context, config ChainConfig) (exe
 	}()
 
 	defer func() {
-		if !st.Simulate && enableDebug {
+		if !st.Simulate && !st.TraceTx && enableDebug {
 			result := &core.ExecutionResult{
 				UsedGas:    gasConsumed,
 				Err:        err,
@@ -281,6 +307,10 @@ func (st StateTransition) TransitionDb(ctx sdk.Context, config ChainConfig) (exe
 		if _, err = csdb.Commit(true); err != nil {
 			return
 		}
+
+		if err = csdb.runNativeActions(); err != nil {
+			return
+		}
 	}
 
 	// Encode all necessary data into slice of bytes to return in sdk result
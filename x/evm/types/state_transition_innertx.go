@@ -1,11 +1,266 @@
 package types
 
-func addDefaultInnerTx(...interface{}) interface{} {
-	return nil
+import (
+	"bytes"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/spf13/viper"
+)
+
+const (
+	FlagEnableInnerTx = "evm-innertx-enable"
+
+	CallTypeCall         = "call"
+	CallTypeCallCode     = "callcode"
+	CallTypeDelegateCall = "delegatecall"
+	CallTypeStaticCall   = "staticcall"
+	CallTypeCreate       = "create"
+	CallTypeCreate2      = "create2"
+	CallTypeSuicide      = "suicide"
+)
+
+var (
+	// function selectors which must all be present in the code of an erc20 contract:
+	// totalSupply(), balanceOf(address), transfer(address,uint256)
+	erc20Selectors = [][]byte{
+		{0x63, 0x18, 0x16, 0x0d, 0xdd},
+		{0x63, 0x70, 0xa0, 0x82, 0x31},
+		{0x63, 0xa9, 0x05, 0x9c, 0xbb},
+	}
+)
+
+// InnerTx is a call, contract creation or self destruct made during the execution of an
+// ethereum tx. The tx itself is recorded as the inner tx of depth 0.
+type InnerTx struct {
+	Depth    int64        `json:"depth"`
+	CallType string       `json:"callType"`
+	From     string       `json:"from"`
+	To       string       `json:"to"`
+	Value    *hexutil.Big `json:"value"`
+	Gas      uint64       `json:"gas"`
+	IsError  bool         `json:"isError"`
+}
+
+// ERC20Contract is an erc20 contract created during the execution of an ethereum tx
+type ERC20Contract struct {
+	Address string `json:"address"`
+	Creator string `json:"creator"`
+}
+
+// IsInnerTxEnabled returns true if the inner txs of ethereum txs should be recorded
+func IsInnerTxEnabled() bool {
+	return viper.GetBool(FlagEnableInnerTx)
+}
+
+// withInnerTxTracer attaches an inner tx recorder to the evm tracer when inner txs are enabled
+func withInnerTxTracer(tracer vm.Tracer, debug bool) (vm.Tracer, bool) {
+	if !IsInnerTxEnabled() {
+		return tracer, debug
+	}
+	if debug {
+		return &innerTxTracer{next: tracer}, true
+	}
+	return &innerTxTracer{}, true
+}
+
+func innerTxTracerOf(evm *vm.EVM) *innerTxTracer {
+	if !evm.Config.Debug {
+		return nil
+	}
+	tracer, _ := evm.Config.Tracer.(*innerTxTracer)
+	return tracer
+}
+
+func addDefaultInnerTx(evm *vm.EVM, sender string) *InnerTx {
+	tracer := innerTxTracerOf(evm)
+	if tracer == nil {
+		return nil
+	}
+	callTx := &InnerTx{From: sender, CallType: CallTypeCall, Value: (*hexutil.Big)(new(big.Int))}
+	tracer.txs = append(tracer.txs, callTx)
+	return callTx
+}
+
+func updateDefaultInnerTxTo(callTx *InnerTx, to string) {
+	if callTx != nil {
+		callTx.To = to
+	}
+}
+
+// parseInnerTxAndContract returns the inner txs recorded during the execution, all of them
+// failed if the tx itself failed, and the erc20 contracts the tx successfully created.
+func parseInnerTxAndContract(evm *vm.EVM, isFailed bool) ([]*InnerTx, []*ERC20Contract) {
+	tracer := innerTxTracerOf(evm)
+	if tracer == nil {
+		return nil, nil
+	}
+	tracer.finishAll(isFailed)
+
+	var contracts []*ERC20Contract
+	for _, tx := range tracer.txs {
+		if isFailed {
+			tx.IsError = true
+		}
+		if tx.IsError || (tx.CallType != CallTypeCreate && tx.CallType != CallTypeCreate2) {
+			continue
+		}
+		if isERC20Code(evm.StateDB.GetCode(common.HexToAddress(tx.To))) {
+			contracts = append(contracts, &ERC20Contract{Address: tx.To, Creator: tx.From})
+		}
+	}
+	return tracer.txs, contracts
+}
+
+func isERC20Code(code []byte) bool {
+	if len(code) == 0 {
+		return false
+	}
+	for _, selector := range erc20Selectors {
+		if !bytes.Contains(code, selector) {
+			return false
+		}
+	}
+	return true
+}
+
+// pendingCall is a call or creation whose result is known once the execution is back
+// to the depth it was made from. failed is set once the frame it runs in reverts or fails.
+type pendingCall struct {
+	depth  int
+	index  int
+	failed bool
 }
 
-func updateDefaultInnerTxTo(...interface{}) {}
+// innerTxTracer records the inner txs of an evm execution. The result of a call is read
+// from the top of the caller's stack once the execution returns to the caller, the
+// same way geth's javascript call tracer does. Events are forwarded to next if set.
+type innerTxTracer struct {
+	next    vm.Tracer
+	txs     []*InnerTx
+	pending []pendingCall
+}
+
+var _ vm.Tracer = (*innerTxTracer)(nil)
+
+func (t *innerTxTracer) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	if len(t.txs) > 0 {
+		callTx := t.txs[0]
+		if create {
+			callTx.CallType = CallTypeCreate
+		}
+		callTx.Value = (*hexutil.Big)(new(big.Int).Set(value))
+		callTx.Gas = gas
+	}
+	if t.next != nil {
+		t.next.CaptureStart(env, from, to, create, input, gas, value)
+	}
+}
+
+func (t *innerTxTracer) CaptureState(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, rData []byte, depth int, err error) {
+	if t.next != nil {
+		t.next.CaptureState(env, pc, op, gas, cost, scope, rData, depth, err)
+	}
+
+	// calls made from deeper frames have returned, their frames ended without another step
+	for len(t.pending) > 0 && t.pending[len(t.pending)-1].depth > depth {
+		t.finish(t.pending[len(t.pending)-1].failed, nil)
+	}
+	// a call made from this frame has returned and left its result on the stack
+	if n := len(t.pending); n > 0 && t.pending[n-1].depth == depth {
+		t.finish(scope.Stack.Back(0).Sign() == 0, scope.Stack.Back(0).Bytes())
+	}
+	if err != nil || op == vm.REVERT {
+		t.frameFailed(depth)
+	}
+	if err != nil {
+		return
+	}
+
+	stack := scope.Stack
+	tx := &InnerTx{
+		Depth: int64(depth),
+		From:  scope.Contract.Address().String(),
+		Value: (*hexutil.Big)(new(big.Int)),
+	}
+	switch op {
+	case vm.CALL, vm.CALLCODE:
+		tx.CallType = CallTypeCall
+		if op == vm.CALLCODE {
+			tx.CallType = CallTypeCallCode
+		}
+		tx.Gas = stack.Back(0).Uint64()
+		tx.To = common.Address(stack.Back(1).Bytes20()).String()
+		tx.Value = (*hexutil.Big)(stack.Back(2).ToBig())
+	case vm.DELEGATECALL, vm.STATICCALL:
+		tx.CallType = CallTypeDelegateCall
+		if op == vm.STATICCALL {
+			tx.CallType = CallTypeStaticCall
+		}
+		tx.Gas = stack.Back(0).Uint64()
+		tx.To = common.Address(stack.Back(1).Bytes20()).String()
+	case vm.CREATE, vm.CREATE2:
+		tx.CallType = CallTypeCreate
+		if op == vm.CREATE2 {
+			tx.CallType = CallTypeCreate2
+		}
+		tx.Value = (*hexutil.Big)(stack.Back(0).ToBig())
+	case vm.SELFDESTRUCT:
+		tx.CallType = CallTypeSuicide
+		tx.To = common.Address(stack.Back(0).Bytes20()).String()
+		tx.Value = (*hexutil.Big)(new(big.Int).Set(env.StateDB.GetBalance(scope.Contract.Address())))
+		t.txs = append(t.txs, tx)
+		return
+	default:
+		return
+	}
+
+	t.pending = append(t.pending, pendingCall{depth: depth, index: len(t.txs)})
+	t.txs = append(t.txs, tx)
+}
+
+func (t *innerTxTracer) CaptureFault(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, depth int, err error) {
+	if t.next != nil {
+		t.next.CaptureFault(env, pc, op, gas, cost, scope, depth, err)
+	}
+	t.frameFailed(depth)
+}
+
+func (t *innerTxTracer) CaptureEnd(output []byte, gasUsed uint64, d time.Duration, err error) {
+	if t.next != nil {
+		t.next.CaptureEnd(output, gasUsed, d, err)
+	}
+}
+
+// frameFailed marks the latest pending call as failed if it runs the frame at depth, which reverts or fails.
+// The frame of the tx itself has no pending call, its result is the result of the tx.
+func (t *innerTxTracer) frameFailed(depth int) {
+	if n := len(t.pending); n > 0 && t.pending[n-1].depth == depth-1 {
+		t.pending[n-1].failed = true
+	}
+}
+
+// finish pops the latest pending call. A failed call reverts everything made inside it.
+func (t *innerTxTracer) finish(failed bool, ret []byte) {
+	call := t.pending[len(t.pending)-1]
+	t.pending = t.pending[:len(t.pending)-1]
+
+	tx := t.txs[call.index]
+	if tx.CallType == CallTypeCreate || tx.CallType == CallTypeCreate2 {
+		tx.To = common.BytesToAddress(ret).String()
+	}
+	if failed {
+		for _, inner := range t.txs[call.index:] {
+			inner.IsError = true
+		}
+	}
+}
 
-func parseInnerTxAndContract(...interface{}) (interface{}, interface{}) {
-	return nil, nil
+func (t *innerTxTracer) finishAll(failed bool) {
+	for len(t.pending) > 0 {
+		t.finish(failed || t.pending[len(t.pending)-1].failed, nil)
+	}
 }
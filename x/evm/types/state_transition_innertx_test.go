@@ -0,0 +1,143 @@
+package types_test
+
+import (
+	"math/big"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/okex/exchain/app/crypto/ethsecp256k1"
+	ethermint "github.com/okex/exchain/app/types"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/evm/types"
+	"github.com/spf13/viper"
+)
+
+func (suite *StateDBTestSuite) TestTransitionDbInnerTxs() {
+	priv, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	recipient := ethcrypto.PubkeyToAddress(priv.ToECDSA().PublicKey)
+
+	// initcode sending 1 wei to recipient: CALL(0xffff, recipient, 1, 0, 0, 0, 0)
+	callInitCode := append(append(ethcmn.FromHex("6000600060006000600173"), recipient.Bytes()...), ethcmn.FromHex("61fffff1")...)
+
+	testCases := []struct {
+		name     string
+		initCode []byte
+		expError bool
+	}{
+		{"successful creation", append(callInitCode, 0x00), false},
+		{"reverted creation", append(callInitCode, ethcmn.FromHex("60006000fd")...), true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			// enabled after the app setup so that no inner tx db is opened
+			viper.Set(types.FlagEnableInnerTx, true)
+			defer viper.Set(types.FlagEnableInnerTx, false)
+			acc := suite.app.AccountKeeper.GetAccount(suite.ctx, sdk.AccAddress(suite.address.Bytes()))
+			_ = acc.SetCoins(sdk.NewCoins(ethermint.NewPhotonCoin(sdk.NewInt(5000))))
+			suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+			suite.stateDB = types.CreateEmptyCommitStateDB(suite.app.EvmKeeper.GenerateCSDBParams(), suite.ctx)
+
+			st := types.StateTransition{
+				Price:    big.NewInt(1),
+				GasLimit: 1000000,
+				Amount:   big.NewInt(10),
+				Payload:  tc.initCode,
+				ChainID:  big.NewInt(1),
+				Csdb:     suite.stateDB,
+				TxHash:   &ethcmn.Hash{},
+				Sender:   suite.address,
+			}
+			_, _, err, innerTxs, contracts := st.TransitionDb(suite.ctx, types.DefaultChainConfig())
+			suite.Require().Equal(tc.expError, err != nil)
+			suite.Require().Empty(contracts)
+
+			contract := ethcrypto.CreateAddress(suite.address, 0)
+			suite.Require().Len(innerTxs, 2)
+			suite.Require().Equal(types.InnerTx{
+				Depth:    0,
+				CallType: types.CallTypeCreate,
+				From:     suite.address.String(),
+				To:       contract.String(),
+				Value:    innerTxs[0].Value,
+				Gas:      innerTxs[0].Gas,
+				IsError:  tc.expError,
+			}, *innerTxs[0])
+			suite.Require().Equal(int64(10), innerTxs[0].Value.ToInt().Int64())
+			suite.Require().Equal(types.InnerTx{
+				Depth:    1,
+				CallType: types.CallTypeCall,
+				From:     contract.String(),
+				To:       recipient.String(),
+				Value:    innerTxs[1].Value,
+				Gas:      0xffff,
+				IsError:  tc.expError,
+			}, *innerTxs[1])
+			suite.Require().Equal(int64(1), innerTxs[1].Value.ToInt().Int64())
+		})
+	}
+}
+
+func (suite *StateDBTestSuite) TestTransitionDbNestedRevertInnerTxs() {
+	// callCode calls addr with all the gas left and drops the result: CALL(GAS, addr, 0, 0, 0, 0, 0) POP
+	callCode := func(addr ethcmn.Address) []byte {
+		return append(append(ethcmn.FromHex("6000600060006000600073"), addr.Bytes()...), ethcmn.FromHex("5af150")...)
+	}
+	stop := ethcmn.FromHex("00")
+	revert := ethcmn.FromHex("60006000fd")
+
+	outer := ethcmn.BytesToAddress([]byte("outer"))
+	middle := ethcmn.BytesToAddress([]byte("middle"))
+	inner := ethcmn.BytesToAddress([]byte("inner"))
+
+	testCases := []struct {
+		name           string
+		middleCode     []byte
+		innerCode      []byte
+		expMiddleError bool
+		expInnerError  bool
+	}{
+		{"inner call reverted", append(callCode(inner), stop...), revert, false, true},
+		{"middle call reverted after the inner call", append(callCode(inner), revert...), stop, true, true},
+		{"no call reverted", append(callCode(inner), stop...), stop, false, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			viper.Set(types.FlagEnableInnerTx, true)
+			defer viper.Set(types.FlagEnableInnerTx, false)
+			acc := suite.app.AccountKeeper.GetAccount(suite.ctx, sdk.AccAddress(suite.address.Bytes()))
+			_ = acc.SetCoins(sdk.NewCoins(ethermint.NewPhotonCoin(sdk.NewInt(5000))))
+			suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+			suite.stateDB = types.CreateEmptyCommitStateDB(suite.app.EvmKeeper.GenerateCSDBParams(), suite.ctx)
+			suite.stateDB.SetCode(outer, append(callCode(middle), stop...))
+			suite.stateDB.SetCode(middle, tc.middleCode)
+			suite.stateDB.SetCode(inner, tc.innerCode)
+			suite.Require().NoError(suite.stateDB.Finalise(false))
+
+			st := types.StateTransition{
+				Price:     big.NewInt(1),
+				GasLimit:  1000000,
+				Recipient: &outer,
+				Amount:    big.NewInt(0),
+				ChainID:   big.NewInt(1),
+				Csdb:      suite.stateDB,
+				TxHash:    &ethcmn.Hash{},
+				Sender:    suite.address,
+			}
+			_, _, err, innerTxs, _ := st.TransitionDb(suite.ctx, types.DefaultChainConfig())
+			suite.Require().NoError(err)
+
+			suite.Require().Len(innerTxs, 3)
+			suite.Require().False(innerTxs[0].IsError)
+			suite.Require().Equal(middle.String(), innerTxs[1].To)
+			suite.Require().Equal(tc.expMiddleError, innerTxs[1].IsError)
+			suite.Require().Equal(int64(2), innerTxs[2].Depth)
+			suite.Require().Equal(inner.String(), innerTxs[2].To)
+			suite.Require().Equal(tc.expInnerError, innerTxs[2].IsError)
+		})
+	}
+}
@@ -44,6 +44,8 @@ type CommitStateDBParams struct {
 	Ada           DbAdapter
 	// Amino codec
 	Cdc *codec.Codec
+	// precompiled contracts backed by the native modules
+	Precompiles Precompiles
 }
 
 type Watcher interface {
@@ -127,6 +129,14 @@ type CommitStateDB struct {
 
 	// Amino codec
 	cdc *codec.Codec
+
+	// the precompiles registered by the keeper and the ones active for the tx being executed
+	precompiles       Precompiles
+	activePrecompiles Precompiles
+
+	// the precompile call being made and the native actions added by the precompiles
+	precompileCall *precompileCall
+	nativeActions  []NativeAction
 }
 
 type StoreProxy interface {
@@ -200,6 +210,7 @@ func CreateEmptyCommitStateDB(csdbParams CommitStateDBParams, ctx sdk.Context) *
 		logs:                []*ethtypes.Log{},
 		codeCache:           make(map[ethcmn.Address]CacheCode, 0),
 		dbAdapter:           csdbParams.Ada,
+		precompiles:         csdbParams.Precompiles,
 	}
 }
 
@@ -469,7 +480,7 @@ func (csdb *CommitStateDB) PrepareAccessList(sender ethcmn.Address, dest *ethcmn
 	}
 
 	csdb.AddAddressToAccessList(sender)
-	if csdb != nil {
+	if dest != nil {
 		csdb.AddAddressToAccessList(*dest)
 		// If it's a create-tx, the destination will be added inside evm.create
 	}
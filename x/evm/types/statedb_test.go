@@ -438,6 +438,21 @@ func (suite *StateDBTestSuite) TestSuiteDB_Empty() {
 
 	suite.stateDB.SetBalance(suite.address, big.NewInt(100))
 	suite.Require().False(suite.stateDB.Empty(suite.address))
+
+	// the coins of the other denoms only keep the account alive if they are set by the precompiles
+	addr := ethcmn.BytesToAddress([]byte("empty"))
+	acc := &ethermint.EthAccount{
+		BaseAccount: auth.NewBaseAccount(sdk.AccAddress(addr.Bytes()), sdk.NewCoins(sdk.NewInt64Coin("xxb", 1)), nil, 0, 0),
+		CodeHash:    ethcrypto.Keccak256(nil),
+	}
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+	suite.Require().True(suite.stateDB.Empty(addr))
+
+	snapshot := suite.stateDB.Snapshot()
+	suite.stateDB.SetCoinBalance(addr, "xxb", sdk.NewDec(2))
+	suite.Require().False(suite.stateDB.Empty(addr))
+	suite.stateDB.RevertToSnapshot(snapshot)
+	suite.Require().True(suite.stateDB.Empty(addr))
 }
 
 func (suite *StateDBTestSuite) TestSuiteDB_Suicide() {
@@ -1,10 +1,12 @@
 package types
 
 import (
+	"errors"
 	"fmt"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
@@ -29,6 +31,10 @@ const (
 	FlagTraceDisableStorage    = "evm-trace-nostorage"
 	FlagTraceDisableReturnData = "evm-trace-noreturndata"
 	FlagTraceDebug             = "evm-trace-debug"
+
+	// defaultTraceTimeout is the amount of time a javascript tracer is allowed to run
+	// before it is interrupted, the same default as geth uses.
+	defaultTraceTimeout = 5 * time.Second
 )
 
 var (
@@ -116,11 +122,46 @@ func checkTracesSegment(height int64, from, to string) bool {
 		(len(traceToAddrs) == 0 || to == "" || (len(traceToAddrs) > 0 && toOk))
 }
 
-func saveTraceResult(ctx sdk.Context, tracer vm.Tracer, result *core.ExecutionResult) {
-	var (
-		res []byte
-		err error
-	)
+// TraceConfig holds extra parameters to the debug_ trace functions. It is json
+// compatible with the TraceConfig of geth's debug API.
+type TraceConfig struct {
+	*vm.LogConfig
+	Tracer  *string `json:"tracer"`
+	Timeout *string `json:"timeout"`
+}
+
+// NewTracer creates the tracer described by config: the struct logger by default, or a
+// built-in (callTracer, prestateTracer, ...) or custom javascript tracer. The returned
+// function releases the timeout timer of javascript tracers and must always be called.
+func NewTracer(config *TraceConfig, txCtx *tracers.Context) (vm.Tracer, func(), error) {
+	if config == nil || config.Tracer == nil {
+		var logConfig *vm.LogConfig
+		if config != nil {
+			logConfig = config.LogConfig
+		}
+		return vm.NewStructLogger(logConfig), func() {}, nil
+	}
+
+	timeout := defaultTraceTimeout
+	if config.Timeout != nil {
+		var err error
+		if timeout, err = time.ParseDuration(*config.Timeout); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	tracer, err := tracers.New(*config.Tracer, txCtx)
+	if err != nil {
+		return nil, nil, err
+	}
+	timer := time.AfterFunc(timeout, func() {
+		tracer.Stop(errors.New("execution timeout"))
+	})
+	return tracer, func() { timer.Stop() }, nil
+}
+
+// GetTraceResult formats the output of a tracer after the traced execution finished.
+func GetTraceResult(tracer vm.Tracer, result *core.ExecutionResult) ([]byte, error) {
 	// Depending on the tracer type, format and return the output
 	switch tracer := tracer.(type) {
 	case *vm.StructLogger:
@@ -130,18 +171,21 @@ func saveTraceResult(ctx sdk.Context, tracer vm.Tracer, result *core.ExecutionRe
 			returnVal = fmt.Sprintf("%x", result.Revert())
 		}
 
-		res, err = json.ConfigFastest.Marshal(&TraceExecutionResult{
+		return json.ConfigFastest.Marshal(&TraceExecutionResult{
 			Gas:         result.UsedGas,
 			Failed:      result.Failed(),
 			ReturnValue: returnVal,
 			StructLogs:  FormatLogs(tracer.StructLogs()),
 		})
 	case *tracers.Tracer:
-		res, err = tracer.GetResult()
+		return tracer.GetResult()
 	default:
-		res = []byte(fmt.Sprintf("bad tracer type %T", tracer))
+		return nil, fmt.Errorf("bad tracer type %T", tracer)
 	}
+}
 
+func saveTraceResult(ctx sdk.Context, tracer vm.Tracer, result *core.ExecutionResult) {
+	res, err := GetTraceResult(tracer, result)
 	if err != nil {
 		res = []byte(err.Error())
 	}
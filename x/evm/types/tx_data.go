@@ -10,10 +10,17 @@ import (
 	"github.com/okex/exchain/app/utils"
 
 	ethcmn "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
 // TxData implements the Ethereum transaction data structure. It is used
 // solely as intended in Ethereum abiding by the protocol.
+//
+// Besides legacy transactions, it models the EIP-2718 typed transactions of
+// EIP-2930 (access list) and EIP-1559 (dynamic fee). The fields specific to
+// typed transactions are left empty by legacy ones, so that their encoding
+// is unchanged. For dynamic fee transactions Price holds the fee cap, while
+// the gas price paid is given by EffectiveGasPrice.
 type TxData struct {
 	AccountNonce uint64          `json:"nonce"`
 	Price        *big.Int        `json:"gasPrice"`
@@ -29,6 +36,91 @@ type TxData struct {
 
 	// hash is only used when marshaling to JSON
 	Hash *ethcmn.Hash `json:"hash" rlp:"-"`
+
+	// typed transaction fields
+	Type      uint8               `json:"type" rlp:"-"`
+	ChainID   *big.Int            `json:"chainId" rlp:"-"`
+	GasTipCap *big.Int            `json:"maxPriorityFeePerGas" rlp:"-"`
+	Accesses  ethtypes.AccessList `json:"accessList" rlp:"-"`
+}
+
+// encodableAccessTuple is the amino encodable form of an access list entry
+type encodableAccessTuple struct {
+	Address     ethcmn.Address `json:"address"`
+	StorageKeys []ethcmn.Hash  `json:"storageKeys"`
+}
+
+func (t *encodableAccessTuple) UnmarshalFromAmino(data []byte) error {
+	var dataLen uint64 = 0
+	var subData []byte
+
+	for {
+		data = data[dataLen:]
+
+		if len(data) == 0 {
+			break
+		}
+
+		pos, pbType, err := amino.ParseProtoPosAndTypeMustOneByte(data[0])
+		if err != nil {
+			return err
+		}
+		data = data[1:]
+
+		if pbType != amino.Typ3_ByteLength {
+			return fmt.Errorf("invalid access list data")
+		}
+		var n int
+		dataLen, n, err = amino.DecodeUvarint(data)
+		if err != nil {
+			return err
+		}
+		data = data[n:]
+		if len(data) < int(dataLen) {
+			return fmt.Errorf("invalid access list data")
+		}
+		subData = data[:dataLen]
+
+		switch pos {
+		case 1:
+			if dataLen != ethcmn.AddressLength {
+				return errors.New("eth addr len error")
+			}
+			copy(t.Address[:], subData)
+		case 2:
+			if dataLen != ethcmn.HashLength {
+				return errors.New("hash len error")
+			}
+			var key ethcmn.Hash
+			copy(key[:], subData)
+			t.StorageKeys = append(t.StorageKeys, key)
+		default:
+			return fmt.Errorf("unexpect feild num %d", pos)
+		}
+	}
+	return nil
+}
+
+func newEncodableAccessList(accesses ethtypes.AccessList) []encodableAccessTuple {
+	if len(accesses) == 0 {
+		return nil
+	}
+	list := make([]encodableAccessTuple, len(accesses))
+	for i, tuple := range accesses {
+		list[i] = encodableAccessTuple{Address: tuple.Address, StorageKeys: tuple.StorageKeys}
+	}
+	return list
+}
+
+func decodeEncodableAccessList(list []encodableAccessTuple) ethtypes.AccessList {
+	accesses := make(ethtypes.AccessList, len(list))
+	for i, tuple := range list {
+		accesses[i] = ethtypes.AccessTuple{Address: tuple.Address, StorageKeys: tuple.StorageKeys}
+		if accesses[i].StorageKeys == nil {
+			accesses[i].StorageKeys = []ethcmn.Hash{}
+		}
+	}
+	return accesses
 }
 
 // encodableTxData implements the Ethereum transaction data structure. It is used
@@ -48,6 +140,12 @@ type encodableTxData struct {
 
 	// hash is only used when marshaling to JSON
 	Hash *ethcmn.Hash `json:"hash" rlp:"-"`
+
+	// typed transaction fields
+	Type      uint8                  `json:"type"`
+	ChainID   string                 `json:"chainId"`
+	GasTipCap string                 `json:"maxPriorityFeePerGas"`
+	Accesses  []encodableAccessTuple `json:"accessList"`
 }
 
 func (tx *encodableTxData) UnmarshalFromAmino(data []byte) error {
@@ -120,6 +218,28 @@ func (tx *encodableTxData) UnmarshalFromAmino(data []byte) error {
 			}
 			tx.Hash = new(ethcmn.Hash)
 			copy(tx.Hash[:], subData)
+		case 11:
+			var n int
+			var txType uint64
+			txType, n, err = amino.DecodeUvarint(data)
+			if err != nil {
+				return err
+			}
+			if txType > 0xff {
+				return errors.New("tx type overflow")
+			}
+			tx.Type = uint8(txType)
+			dataLen = uint64(n)
+		case 12:
+			tx.ChainID = string(subData)
+		case 13:
+			tx.GasTipCap = string(subData)
+		case 14:
+			var tuple encodableAccessTuple
+			if err := tuple.UnmarshalFromAmino(subData); err != nil {
+				return err
+			}
+			tx.Accesses = append(tx.Accesses, tuple)
 		default:
 			return fmt.Errorf("unexpect feild num %d", pos)
 		}
@@ -128,6 +248,11 @@ func (tx *encodableTxData) UnmarshalFromAmino(data []byte) error {
 }
 
 func (td TxData) String() string {
+	if td.Type != ethtypes.LegacyTxType {
+		return fmt.Sprintf("type=%d chainID=%s nonce=%d price=%s tipCap=%s gasLimit=%d recipient=%v amount=%s data=0x%x accessList=%d v=%s r=%s s=%s",
+			td.Type, td.ChainID, td.AccountNonce, td.Price, td.GasTipCap, td.GasLimit, td.Recipient, td.Amount, td.Payload, len(td.Accesses), td.V, td.R, td.S)
+	}
+
 	if td.Recipient != nil {
 		return fmt.Sprintf("nonce=%d price=%s gasLimit=%d recipient=%s amount=%s data=0x%x v=%s r=%s s=%s",
 			td.AccountNonce, td.Price, td.GasLimit, td.Recipient.Hex(), td.Amount, td.Payload, td.V, td.R, td.S)
@@ -137,6 +262,25 @@ func (td TxData) String() string {
 		td.AccountNonce, td.Price, td.GasLimit, td.Amount, td.Payload, td.V, td.R, td.S)
 }
 
+// EffectiveGasPrice returns the gas price paid by the transaction, which is min(fee cap, tip cap + base fee) for
+// dynamic fee transactions and the gas price for the others. A nil base fee is taken as zero, as the chain has none.
+func (td TxData) EffectiveGasPrice(baseFee *big.Int) *big.Int {
+	if td.Type != ethtypes.DynamicFeeTxType {
+		return td.Price
+	}
+	price := new(big.Int)
+	if td.GasTipCap != nil {
+		price.Set(td.GasTipCap)
+	}
+	if baseFee != nil {
+		price.Add(price, baseFee)
+	}
+	if price.Cmp(td.Price) > 0 {
+		price.Set(td.Price)
+	}
+	return price
+}
+
 // MarshalAmino defines custom encoding scheme for TxData
 func (td TxData) MarshalAmino() ([]byte, error) {
 	gasPrice, err := utils.MarshalBigInt(td.Price)
@@ -177,6 +321,20 @@ func (td TxData) MarshalAmino() ([]byte, error) {
 		Hash:         td.Hash,
 	}
 
+	// typed fields are only encoded for typed transactions so that legacy ones keep their encoding
+	if td.Type != ethtypes.LegacyTxType {
+		e.Type = td.Type
+		if e.ChainID, err = utils.MarshalBigInt(td.ChainID); err != nil {
+			return nil, err
+		}
+		if td.Type == ethtypes.DynamicFeeTxType {
+			if e.GasTipCap, err = utils.MarshalBigInt(td.GasTipCap); err != nil {
+				return nil, err
+			}
+		}
+		e.Accesses = newEncodableAccessList(td.Accesses)
+	}
+
 	return ModuleCdc.MarshalBinaryBare(e)
 }
 
@@ -249,7 +407,7 @@ func (td *TxData) UnmarshalAmino(data []byte) error {
 		td.S = s
 	}
 
-	return nil
+	return td.setTypedFields(e)
 }
 
 func (td *TxData) UnmarshalFromAmino(data []byte) error {
@@ -319,6 +477,29 @@ func (td *TxData) UnmarshalFromAmino(data []byte) error {
 		td.S = s
 	}
 
+	return td.setTypedFields(e)
+}
+
+// setTypedFields sets the typed transaction fields decoded from e
+func (td *TxData) setTypedFields(e encodableTxData) error {
+	td.Type = e.Type
+	td.ChainID, td.GasTipCap, td.Accesses = nil, nil, nil
+	if td.Type == ethtypes.LegacyTxType {
+		return nil
+	}
+
+	chainID, err := utils.UnmarshalBigInt(e.ChainID)
+	if err != nil {
+		return err
+	}
+	td.ChainID = chainID
+
+	if td.Type == ethtypes.DynamicFeeTxType {
+		if td.GasTipCap, err = utils.UnmarshalBigInt(e.GasTipCap); err != nil {
+			return err
+		}
+	}
+	td.Accesses = decodeEncodableAccessList(e.Accesses)
 	return nil
 }
 
@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	ethcmn "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
 func TestMarshalAndUnmarshalData(t *testing.T) {
@@ -51,6 +52,71 @@ func TestMarshalAndUnmarshalData(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestMarshalAndUnmarshalTypedData(t *testing.T) {
+	addr := GenerateEthAddress()
+	hash := ethcmn.BigToHash(big.NewInt(2))
+	accesses := ethtypes.AccessList{
+		{Address: GenerateEthAddress(), StorageKeys: []ethcmn.Hash{hash, ethcmn.BigToHash(big.NewInt(3))}},
+		{Address: GenerateEthAddress(), StorageKeys: []ethcmn.Hash{}},
+	}
+
+	testCases := []TxData{
+		{
+			Type:         ethtypes.AccessListTxType,
+			ChainID:      big.NewInt(65),
+			AccountNonce: 2,
+			Price:        big.NewInt(3),
+			GasLimit:     1,
+			Recipient:    &addr,
+			Amount:       big.NewInt(4),
+			Payload:      []byte("test"),
+			Accesses:     accesses,
+			V:            big.NewInt(1),
+			R:            big.NewInt(6),
+			S:            big.NewInt(7),
+			Hash:         &hash,
+		},
+		{
+			Type:         ethtypes.DynamicFeeTxType,
+			ChainID:      big.NewInt(65),
+			AccountNonce: 2,
+			Price:        big.NewInt(3),
+			GasTipCap:    big.NewInt(1),
+			GasLimit:     1,
+			Amount:       big.NewInt(4),
+			Payload:      []byte("test"),
+			Accesses:     ethtypes.AccessList{},
+			V:            big.NewInt(0),
+			R:            big.NewInt(6),
+			S:            big.NewInt(7),
+		},
+	}
+
+	for _, txData := range testCases {
+		bz, err := txData.MarshalAmino()
+		require.NoError(t, err)
+
+		var txData2 TxData
+		require.NoError(t, txData2.UnmarshalAmino(bz))
+		require.Equal(t, txData, txData2)
+
+		var txData3 TxData
+		require.NoError(t, txData3.UnmarshalFromAmino(bz))
+		require.Equal(t, txData, txData3)
+	}
+}
+
+func TestTxDataEffectiveGasPrice(t *testing.T) {
+	legacy := TxData{Price: big.NewInt(3)}
+	require.Equal(t, big.NewInt(3), legacy.EffectiveGasPrice(nil))
+	require.Equal(t, big.NewInt(3), legacy.EffectiveGasPrice(big.NewInt(1)))
+
+	dynamicFee := TxData{Type: ethtypes.DynamicFeeTxType, Price: big.NewInt(3), GasTipCap: big.NewInt(1)}
+	require.Equal(t, big.NewInt(1), dynamicFee.EffectiveGasPrice(nil))
+	require.Equal(t, big.NewInt(2), dynamicFee.EffectiveGasPrice(big.NewInt(1)))
+	require.Equal(t, big.NewInt(3), dynamicFee.EffectiveGasPrice(big.NewInt(5)))
+}
+
 func BenchmarkUnmarshalTxData(b *testing.B) {
 	addr := GenerateEthAddress()
 	hash := ethcmn.BigToHash(big.NewInt(2))
@@ -72,3 +72,7 @@ func (w WatchStore) Has(key []byte) bool {
 	}
 	return res
 }
+
+func (w WatchStore) ReverseIterator(start, end []byte) (dbm.Iterator, error) {
+	return w.db.ReverseIterator(start, end)
+}
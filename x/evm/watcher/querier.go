@@ -1,9 +1,12 @@
 package watcher
 
 import (
+	"bytes"
+	"encoding/binary"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
+	"math"
 	"strconv"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -65,6 +68,25 @@ func (q Querier) GetTransactionReceipt(hash common.Hash) (*TransactionReceipt, e
 	return &receipt, nil
 }
 
+func (q Querier) GetInnerTxs(hash common.Hash) ([]*evmtypes.InnerTx, error) {
+	if !q.enabled() {
+		return nil, errors.New(MsgFunctionDisable)
+	}
+	var innerTxs []*evmtypes.InnerTx
+	b, e := q.store.Get(append(prefixInnerTx, hash.Bytes()...))
+	if e != nil {
+		return nil, e
+	}
+	if b == nil {
+		return nil, errNotFound
+	}
+	e = json.Unmarshal(b, &innerTxs)
+	if e != nil {
+		return nil, e
+	}
+	return innerTxs, nil
+}
+
 func (q Querier) GetBlockByHash(hash common.Hash, fullTx bool) (*EthBlock, error) {
 	if !q.enabled() {
 		return nil, errors.New(MsgFunctionDisable)
@@ -285,6 +307,69 @@ func (q Querier) GetTransactionsByBlockNumber(number, offset, limit uint64) ([]*
 	return txs, nil
 }
 
+// GetTransactionsByAddress returns at most limit txs sent (AddressTxFrom) or received (AddressTxTo)
+// by addr within [fromBlock, toBlock], newest first. cursor is the one returned by the previous
+// page. The iteration stops once the page is full, so the returned cursor is nil only when the
+// range is exhausted and the page following a full one may be empty.
+func (q Querier) GetTransactionsByAddress(addr common.Address, direction byte, fromBlock, toBlock uint64, cursor []byte, limit uint64) ([]*rpctypes.Transaction, []byte, error) {
+	if !q.enabled() {
+		return nil, nil, errors.New(MsgFunctionDisable)
+	}
+	if fromBlock > toBlock || limit == 0 {
+		return nil, nil, nil
+	}
+	if cursor != nil && len(cursor) != len(addressTxCursor(0, 0, 0)) {
+		return nil, nil, errors.New("invalid cursor")
+	}
+
+	prefix := GetAddressTxPrefix(addr)
+	start := append(GetAddressTxPrefix(addr), addressTxCursor(fromBlock, 0, 0)...)
+	var end []byte
+	if toBlock == math.MaxUint64 {
+		end = sdk.PrefixEndBytes(prefix)
+	} else {
+		end = append(GetAddressTxPrefix(addr), addressTxCursor(toBlock+1, 0, 0)...)
+	}
+	if cursor != nil {
+		if c := append(GetAddressTxPrefix(addr), cursor...); bytes.Compare(c, end) < 0 {
+			end = c
+		}
+	}
+
+	it, err := q.store.ReverseIterator(start, end)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer it.Close()
+
+	var txs []*rpctypes.Transaction
+	var lastHash common.Hash
+	for ; it.Valid(); it.Next() {
+		key := it.Key()
+		if direction != AddressTxAll && key[len(key)-1] != direction {
+			continue
+		}
+		// a tx sent to the sender itself is indexed in both directions
+		hash := common.BytesToHash(it.Value())
+		if hash == lastHash {
+			continue
+		}
+		lastHash = hash
+		tx, err := q.GetTransactionByHash(hash)
+		if err != nil {
+			return nil, nil, err
+		}
+		txs = append(txs, tx)
+		if uint64(len(txs)) == limit {
+			// the next page starts below both directions of the last returned tx
+			height := binary.BigEndian.Uint64(key[len(prefix):])
+			txIndex := binary.BigEndian.Uint64(key[len(prefix)+8:])
+			return txs, addressTxCursor(height, txIndex, AddressTxAll), nil
+		}
+	}
+	return txs, nil, nil
+}
+
 func (q Querier) MustGetAccount(addr sdk.AccAddress) (*types.EthAccount, error) {
 	acc, e := q.GetAccount(addr)
 	//todo delete account from rdb if we get Account from H db successfully
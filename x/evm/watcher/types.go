@@ -35,6 +35,8 @@ var (
 	prefixWhiteList    = []byte{0x11}
 	prefixBlackList    = []byte{0x12}
 	prefixRpcDb        = []byte{0x13}
+	prefixInnerTx      = []byte{0x14}
+	prefixAddressTx    = []byte{0x15}
 
 	KeyLatestHeight = "LatestHeight"
 
@@ -42,6 +44,13 @@ var (
 	TransactionFailed  = uint32(0)
 )
 
+// direction of an address tx index entry, it is the last byte of the key
+const (
+	AddressTxAll  = byte(0)
+	AddressTxFrom = byte(1)
+	AddressTxTo   = byte(2)
+)
+
 const (
 	TypeOthers = uint32(1)
 	TypeState  = uint32(2)
@@ -187,6 +196,8 @@ type TransactionReceipt struct {
 	TransactionIndex  hexutil.Uint64  `json:"transactionIndex"`
 	From              string          `json:"from"`
 	To                *common.Address `json:"to"`
+	Type              hexutil.Uint64  `json:"type"`
+	EffectiveGasPrice *hexutil.Big    `json:"effectiveGasPrice"`
 }
 
 func NewMsgTransactionReceipt(status uint32, tx *types.MsgEthereumTx, txHash, blockHash common.Hash, txIndex, height uint64, data *types.ResultData, cumulativeGas, GasUsed uint64) *MsgTransactionReceipt {
@@ -204,6 +215,8 @@ func NewMsgTransactionReceipt(status uint32, tx *types.MsgEthereumTx, txHash, bl
 		TransactionIndex:  hexutil.Uint64(txIndex),
 		From:              common.BytesToAddress(tx.From().Bytes()).Hex(),
 		To:                tx.To(),
+		Type:              hexutil.Uint64(tx.Data.Type),
+		EffectiveGasPrice: (*hexutil.Big)(tx.Data.EffectiveGasPrice(nil)),
 	}
 
 	//contract address will be set to 0x0000000000000000000000000000000000000000 if contract deploy failed
@@ -530,3 +543,77 @@ func (msgItem *MsgContractMethodBlockedListItem) GetKey() []byte {
 func (msgItem *MsgContractMethodBlockedListItem) GetValue() string {
 	return string(msgItem.methods)
 }
+
+type MsgInnerTxs struct {
+	txHash   common.Hash
+	innerTxs string
+}
+
+func (m MsgInnerTxs) GetType() uint32 {
+	return TypeOthers
+}
+
+func NewMsgInnerTxs(txHash common.Hash, innerTxs []*types.InnerTx) *MsgInnerTxs {
+	jsInnerTxs, e := json.Marshal(innerTxs)
+	if e != nil {
+		return nil
+	}
+	return &MsgInnerTxs{
+		txHash:   txHash,
+		innerTxs: string(jsInnerTxs),
+	}
+}
+
+func (m MsgInnerTxs) GetKey() []byte {
+	return append(prefixInnerTx, m.txHash.Bytes()...)
+}
+
+func (m MsgInnerTxs) GetValue() string {
+	return m.innerTxs
+}
+
+// MsgAddressTx indexes a tx hash under the sender or the recipient of the tx.
+// The key is ordered by height and tx index, so that the txs of an address can
+// be listed by iterating over prefixAddressTx|address.
+type MsgAddressTx struct {
+	addr      common.Address
+	height    uint64
+	txIndex   uint64
+	direction byte
+	txHash    common.Hash
+}
+
+func (m MsgAddressTx) GetType() uint32 {
+	return TypeOthers
+}
+
+func NewMsgAddressTx(addr common.Address, height, txIndex uint64, direction byte, txHash common.Hash) *MsgAddressTx {
+	return &MsgAddressTx{
+		addr:      addr,
+		height:    height,
+		txIndex:   txIndex,
+		direction: direction,
+		txHash:    txHash,
+	}
+}
+
+func (m MsgAddressTx) GetKey() []byte {
+	return append(GetAddressTxPrefix(m.addr), addressTxCursor(m.height, m.txIndex, m.direction)...)
+}
+
+func (m MsgAddressTx) GetValue() string {
+	return string(m.txHash.Bytes())
+}
+
+func GetAddressTxPrefix(addr common.Address) []byte {
+	return append(prefixAddressTx, addr.Bytes()...)
+}
+
+// addressTxCursor returns the part of an address tx key following the address
+func addressTxCursor(height, txIndex uint64, direction byte) []byte {
+	cursor := make([]byte, 17)
+	binary.BigEndian.PutUint64(cursor[:8], height)
+	binary.BigEndian.PutUint64(cursor[8:16], txIndex)
+	cursor[16] = direction
+	return cursor
+}
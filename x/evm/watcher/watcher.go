@@ -144,6 +144,35 @@ func (w *Watcher) SaveTransactionReceipt(status uint32, msg evmtypes.MsgEthereum
 	if wMsg != nil {
 		w.batch = append(w.batch, wMsg)
 	}
+	w.saveAddressTxs(msg, txHash, txIndex, data)
+}
+
+// saveAddressTxs indexes the tx under its sender and its recipient, the recipient
+// of a contract creation is the created contract
+func (w *Watcher) saveAddressTxs(msg evmtypes.MsgEthereumTx, txHash common.Hash, txIndex uint64, data *evmtypes.ResultData) {
+	if from := common.BytesToAddress(msg.From().Bytes()); from != (common.Address{}) {
+		w.batch = append(w.batch, NewMsgAddressTx(from, w.height, txIndex, AddressTxFrom, txHash))
+	}
+
+	var to common.Address
+	if msg.To() != nil {
+		to = *msg.To()
+	} else if data != nil {
+		to = data.ContractAddress
+	}
+	if to != (common.Address{}) {
+		w.batch = append(w.batch, NewMsgAddressTx(to, w.height, txIndex, AddressTxTo, txHash))
+	}
+}
+
+func (w *Watcher) SaveInnerTxs(txHash common.Hash, innerTxs []*evmtypes.InnerTx) {
+	if !w.Enabled() {
+		return
+	}
+	wMsg := NewMsgInnerTxs(txHash, innerTxs)
+	if wMsg != nil {
+		w.batch = append(w.batch, wMsg)
+	}
 }
 
 func (w *Watcher) UpdateCumulativeGas(txIndex, gasUsed uint64) {
@@ -1 +1,92 @@
 package watcher
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	rpctypes "github.com/okex/exchain/app/rpc/types"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestGetTransactionsByAddress(t *testing.T) {
+	store := &WatchStore{db: dbm.NewMemDB()}
+	q := Querier{store: store, sw: true}
+
+	alice := common.HexToAddress("0x01")
+	bob := common.HexToAddress("0x02")
+	saveTx := func(height, index uint64, from, to common.Address) common.Hash {
+		hash := common.BytesToHash([]byte{byte(height), byte(index)})
+		bz, err := json.Marshal(rpctypes.Transaction{Hash: hash, From: from, To: &to})
+		require.NoError(t, err)
+		store.Set(append(prefixTx, hash.Bytes()...), bz)
+		for _, m := range []*MsgAddressTx{
+			NewMsgAddressTx(from, height, index, AddressTxFrom, hash),
+			NewMsgAddressTx(to, height, index, AddressTxTo, hash),
+		} {
+			store.Set(m.GetKey(), []byte(m.GetValue()))
+		}
+		return hash
+	}
+	h1 := saveTx(1, 0, alice, bob)
+	h2 := saveTx(2, 0, bob, alice)
+	h3 := saveTx(2, 1, alice, alice)
+	h4 := saveTx(3, 0, alice, bob)
+
+	hashes := func(txs []*rpctypes.Transaction) []common.Hash {
+		var res []common.Hash
+		for _, tx := range txs {
+			res = append(res, tx.Hash)
+		}
+		return res
+	}
+
+	// all txs, newest first, the self transfer listed once
+	txs, cursor, err := q.GetTransactionsByAddress(alice, AddressTxAll, 0, 100, nil, 10)
+	require.NoError(t, err)
+	require.Nil(t, cursor)
+	require.Equal(t, []common.Hash{h4, h3, h2, h1}, hashes(txs))
+
+	// direction filter
+	txs, _, err = q.GetTransactionsByAddress(alice, AddressTxTo, 0, 100, nil, 10)
+	require.NoError(t, err)
+	require.Equal(t, []common.Hash{h3, h2}, hashes(txs))
+	txs, _, err = q.GetTransactionsByAddress(bob, AddressTxFrom, 0, 100, nil, 10)
+	require.NoError(t, err)
+	require.Equal(t, []common.Hash{h2}, hashes(txs))
+
+	// block range
+	txs, _, err = q.GetTransactionsByAddress(alice, AddressTxAll, 2, 2, nil, 10)
+	require.NoError(t, err)
+	require.Equal(t, []common.Hash{h3, h2}, hashes(txs))
+
+	// pagination
+	var all []common.Hash
+	cursor = nil
+	for {
+		txs, cursor, err = q.GetTransactionsByAddress(alice, AddressTxAll, 0, 100, cursor, 1)
+		require.NoError(t, err)
+		all = append(all, hashes(txs)...)
+		if cursor == nil {
+			break
+		}
+	}
+	require.Equal(t, []common.Hash{h4, h3, h2, h1}, all)
+
+	// pagination with a direction filter, a full page returns its cursor without looking further
+	txs, cursor, err = q.GetTransactionsByAddress(alice, AddressTxTo, 0, 100, nil, 1)
+	require.NoError(t, err)
+	require.Equal(t, []common.Hash{h3}, hashes(txs))
+	txs, cursor, err = q.GetTransactionsByAddress(alice, AddressTxTo, 0, 100, cursor, 1)
+	require.NoError(t, err)
+	require.Equal(t, []common.Hash{h2}, hashes(txs))
+	require.NotNil(t, cursor)
+	txs, cursor, err = q.GetTransactionsByAddress(alice, AddressTxTo, 0, 100, cursor, 1)
+	require.NoError(t, err)
+	require.Empty(t, txs)
+	require.Nil(t, cursor)
+
+	_, _, err = q.GetTransactionsByAddress(alice, AddressTxAll, 0, 100, []byte{0x01}, 1)
+	require.Error(t, err)
+}
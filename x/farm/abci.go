@@ -76,7 +76,48 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper)
 
 // EndBlocker called every block, process inflation, update validator set.
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	expireLockTerms(ctx, k, types.MaxExpiredLockTermsPerBlock)
+}
+
+// expireLockTerms withdraws the rewards of the locks whose lock terms end, and then removes their boosts. At most
+// limit lock terms are expired per call. The expired ones leave the lock expiry queue, so the head of the queue is
+// the cursor of the ones left, which are carried over to the following blocks and keep their boosts until then.
+func expireLockTerms(ctx sdk.Context, k keeper.Keeper, limit int) {
+	k.IterateExpiredLockInfos(ctx, limit, func(lockInfo types.LockInfo) (stop bool) {
+		pool, found := k.GetFarmPool(ctx, lockInfo.PoolName)
+		if !found {
+			panic("should not happen")
+		}
+
+		// 1. withdraw the boosted rewards until now
+		updatedPool, yieldedTokens := k.CalculateAmountYieldedBetween(ctx, pool)
+		rewards, err := k.WithdrawRewards(ctx, pool.Name, pool.GetTotalLockedWeight(), yieldedTokens, lockInfo.Owner)
+		if err != nil {
+			panic(err)
+		}
+
+		// 2. update the lock info without lock term
+		changedWeight := k.UpdateLockInfoWithTerm(ctx, lockInfo.Owner, pool.Name, sdk.ZeroDec(), sdk.OneDec(), 0)
+
+		// 3. update farm pool
+		updatedPool.TotalLockedWeight = updatedPool.GetTotalLockedWeight().Add(changedWeight)
+		if updatedPool.TotalAccumulatedRewards.IsAllLT(rewards) {
+			panic("should not happen")
+		}
+		updatedPool.TotalAccumulatedRewards = updatedPool.TotalAccumulatedRewards.Sub(rewards)
+		k.SetFarmPool(ctx, updatedPool)
+
+		// 4. notify backend
+		k.OnClaim(ctx, lockInfo.Owner, pool.Name, rewards)
 
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeLockExpire,
+			sdk.NewAttribute(types.AttributeKeyAddress, lockInfo.Owner.String()),
+			sdk.NewAttribute(types.AttributeKeyPool, pool.Name),
+			sdk.NewAttribute(types.AttributeKeyClaimed, rewards.String()),
+		))
+		return false
+	})
 }
 
 // calculateAllocateInfo gets all pools in PoolsYieldNativeToken
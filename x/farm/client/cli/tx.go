@@ -20,6 +20,11 @@ import (
 	"github.com/okex/exchain/x/farm/types"
 )
 
+const (
+	// FlagLockBlocks is the flag of the blocks of the lock term to commit the locked tokens for
+	FlagLockBlocks = "lock-blocks"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 	farmTxCmd := &cobra.Command{
@@ -142,9 +147,14 @@ func GetCmdLock(cdc *codec.Codec) *cobra.Command {
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Lock a number of tokens for yield farming.
 
+The tokens can be committed for one of the lock terms in the farm params with --lock-blocks, which boosts the rewards
+by the reward multiplier of the term. The lock term applies to the whole locked amount of the address in the pool,
+and the tokens can't be unlocked until the term ends. A lock without --lock-blocks joins the current lock term.
+
 Example:
 $ %s tx farm lock pool-eth-xxb 5eth --from mykey
-`, version.ClientName),
+$ %s tx farm lock pool-eth-xxb 5eth --lock-blocks 100000 --from mykey
+`, version.ClientName, version.ClientName),
 		),
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -157,11 +167,17 @@ $ %s tx farm lock pool-eth-xxb 5eth --from mykey
 				return err
 			}
 
+			lockBlocks, err := cmd.Flags().GetInt64(FlagLockBlocks)
+			if err != nil {
+				return err
+			}
+
 			poolName := args[0]
-			msg := types.NewMsgLock(poolName, cliCtx.GetFromAddress(), amount)
+			msg := types.NewMsgLock(poolName, cliCtx.GetFromAddress(), amount, lockBlocks)
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
+	cmd.Flags().Int64(FlagLockBlocks, 0, "blocks of the lock term to commit the tokens for")
 	return cmd
 }
 
@@ -102,7 +102,7 @@ func handleMsgProvide(ctx sdk.Context, k keeper.Keeper, msg types.MsgProvide) (*
 	}
 
 	// 3. Terminate pool current period
-	k.IncrementPoolPeriod(ctx, pool.Name, pool.TotalValueLocked, yieldedTokens)
+	k.IncrementPoolPeriod(ctx, pool.Name, pool.GetTotalLockedWeight(), yieldedTokens)
 
 	// 4. Transfer coin to farm module account
 	if err := k.SupplyKeeper().SendCoinsFromAccountToModule(
@@ -139,7 +139,7 @@ func handleMsgClaim(ctx sdk.Context, k keeper.Keeper, msg types.MsgClaim) (*sdk.
 	updatedPool, yieldedTokens := k.CalculateAmountYieldedBetween(ctx, pool)
 
 	// 3. Withdraw rewards
-	rewards, err := k.WithdrawRewards(ctx, pool.Name, pool.TotalValueLocked, yieldedTokens, msg.Address)
+	rewards, err := k.WithdrawRewards(ctx, pool.Name, pool.GetTotalLockedWeight(), yieldedTokens, msg.Address)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,8 @@
 package farm
 
 import (
+	"strconv"
+
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 	"github.com/okex/exchain/x/farm/keeper"
 	"github.com/okex/exchain/x/farm/types"
@@ -22,6 +24,12 @@ func handleMsgLock(ctx sdk.Context, k keeper.Keeper, msg types.MsgLock) (*sdk.Re
 		return types.ErrLockAmountBelowMinimum(pool.MinLockAmount.Amount, msg.Amount.Amount).Result()
 	}
 
+	// 1.3. check lock term, which applies to the whole locked amount
+	rewardMultiplier, unlockHeight, err := getLockTerm(ctx, k, msg, hasLocked)
+	if err != nil {
+		return nil, err
+	}
+
 	// 2. Calculate how many provided token & native token could be yielded in current period
 	updatedPool, yieldedTokens := k.CalculateAmountYieldedBetween(ctx, pool)
 
@@ -29,8 +37,7 @@ func handleMsgLock(ctx sdk.Context, k keeper.Keeper, msg types.MsgLock) (*sdk.Re
 	var rewards sdk.SysCoins
 	if hasLocked {
 		// If it exists, withdraw money
-		var err error
-		rewards, err = k.WithdrawRewards(ctx, pool.Name, pool.TotalValueLocked, yieldedTokens, msg.Address)
+		rewards, err = k.WithdrawRewards(ctx, pool.Name, pool.GetTotalLockedWeight(), yieldedTokens, msg.Address)
 		if err != nil {
 			return nil, err
 		}
@@ -41,7 +48,7 @@ func handleMsgLock(ctx sdk.Context, k keeper.Keeper, msg types.MsgLock) (*sdk.Re
 
 	} else {
 		// If it doesn't exist, only increase period
-		k.IncrementPoolPeriod(ctx, pool.Name, pool.TotalValueLocked, yieldedTokens)
+		k.IncrementPoolPeriod(ctx, pool.Name, pool.GetTotalLockedWeight(), yieldedTokens)
 
 		// Create new lock info
 		lockInfo := types.NewLockInfo(
@@ -53,7 +60,8 @@ func handleMsgLock(ctx sdk.Context, k keeper.Keeper, msg types.MsgLock) (*sdk.Re
 	}
 
 	// 4. Update lock info
-	k.UpdateLockInfo(ctx, msg.Address, msg.PoolName, msg.Amount.Amount)
+	changedWeight := k.UpdateLockInfoWithTerm(ctx, msg.Address, msg.PoolName, msg.Amount.Amount, rewardMultiplier,
+		unlockHeight)
 
 	// 5. Send the locked-tokens from its own account to farm module account
 	if err := k.SupplyKeeper().SendCoinsFromAccountToModule(
@@ -64,6 +72,7 @@ func handleMsgLock(ctx sdk.Context, k keeper.Keeper, msg types.MsgLock) (*sdk.Re
 
 	// 6. Update farm pool
 	updatedPool.TotalValueLocked = updatedPool.TotalValueLocked.Add(msg.Amount)
+	updatedPool.TotalLockedWeight = updatedPool.GetTotalLockedWeight().Add(changedWeight)
 	k.SetFarmPool(ctx, updatedPool)
 
 	// 7. notify backend
@@ -76,6 +85,8 @@ func handleMsgLock(ctx sdk.Context, k keeper.Keeper, msg types.MsgLock) (*sdk.Re
 		sdk.NewAttribute(types.AttributeKeyAddress, msg.Address.String()),
 		sdk.NewAttribute(types.AttributeKeyPool, msg.PoolName),
 		sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+		sdk.NewAttribute(types.AttributeKeyLockBlocks, strconv.FormatInt(msg.LockBlocks, 10)),
+		sdk.NewAttribute(types.AttributeKeyUnlockHeight, strconv.FormatInt(unlockHeight, 10)),
 	))
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
@@ -95,6 +106,10 @@ func handleMsgUnlock(ctx sdk.Context, k keeper.Keeper, msg types.MsgUnlock) (*sd
 		return types.ErrInsufficientAmount(lockInfo.Amount.String(), msg.Amount.String()).Result()
 	}
 
+	if ctx.BlockHeight() < lockInfo.UnlockHeight {
+		return types.ErrLockNotExpired(lockInfo.UnlockHeight).Result()
+	}
+
 	// 1.2 Get the pool info
 	pool, poolFound := k.GetFarmPool(ctx, msg.PoolName)
 	if !poolFound {
@@ -112,13 +127,13 @@ func handleMsgUnlock(ctx sdk.Context, k keeper.Keeper, msg types.MsgUnlock) (*sd
 	updatedPool, yieldedTokens := k.CalculateAmountYieldedBetween(ctx, pool)
 
 	// 3. Withdraw money
-	rewards, err := k.WithdrawRewards(ctx, pool.Name, pool.TotalValueLocked, yieldedTokens, msg.Address)
+	rewards, err := k.WithdrawRewards(ctx, pool.Name, pool.GetTotalLockedWeight(), yieldedTokens, msg.Address)
 	if err != nil {
 		return nil, err
 	}
 
 	// 4. Update the lock info
-	k.UpdateLockInfo(ctx, msg.Address, msg.PoolName, msg.Amount.Amount.Neg())
+	changedWeight := k.UpdateLockInfo(ctx, msg.Address, msg.PoolName, msg.Amount.Amount.Neg())
 
 	// 5. Send the locked-tokens from farm module account to its own account
 	if err = k.SupplyKeeper().SendCoinsFromModuleToAccount(ctx, ModuleName, msg.Address, msg.Amount.ToCoins()); err != nil {
@@ -127,6 +142,7 @@ func handleMsgUnlock(ctx sdk.Context, k keeper.Keeper, msg types.MsgUnlock) (*sd
 
 	// 6. Update farm pool
 	updatedPool.TotalValueLocked = updatedPool.TotalValueLocked.Sub(msg.Amount)
+	updatedPool.TotalLockedWeight = updatedPool.GetTotalLockedWeight().Add(changedWeight)
 	if updatedPool.TotalAccumulatedRewards.IsAllLT(rewards) {
 		panic("should not happen")
 	}
@@ -144,3 +160,31 @@ func handleMsgUnlock(ctx sdk.Context, k keeper.Keeper, msg types.MsgUnlock) (*sd
 	))
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
+
+// getLockTerm gets the reward multiplier and the unlock height of the lock term in the msg. A flexible lock is only
+// allowed without a current lock term, while a new lock term restarts the current one for the whole amount if ending
+// no earlier.
+func getLockTerm(ctx sdk.Context, k keeper.Keeper, msg types.MsgLock, hasLocked bool) (
+	rewardMultiplier sdk.Dec, unlockHeight int64, err error) {
+	var lockInfo types.LockInfo
+	if hasLocked {
+		lockInfo, _ = k.GetLockInfo(ctx, msg.Address, msg.PoolName)
+	}
+	if msg.LockBlocks == 0 {
+		// a lock term which has ended but is not expired yet is removed by the flexible lock
+		if lockInfo.UnlockHeight > ctx.BlockHeight() {
+			return rewardMultiplier, unlockHeight, types.ErrFlexibleLockOnTerm(lockInfo.UnlockHeight)
+		}
+		return sdk.OneDec(), 0, nil
+	}
+
+	lockTerm, found := k.GetParams(ctx).LockTerms.GetLockTerm(msg.LockBlocks)
+	if !found {
+		return rewardMultiplier, unlockHeight, types.ErrInvalidLockTerm(msg.LockBlocks)
+	}
+	unlockHeight = ctx.BlockHeight() + lockTerm.LockBlocks
+	if unlockHeight < lockInfo.UnlockHeight {
+		return rewardMultiplier, unlockHeight, types.ErrLockTermShortened(lockInfo.UnlockHeight, unlockHeight)
+	}
+	return lockTerm.RewardMultiplier, unlockHeight, nil
+}
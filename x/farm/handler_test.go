@@ -157,7 +157,7 @@ var normalGetLockMsg getMsgFunc = func(tCtx *testContext, preData interface{}) s
 	poolName := createPoolMsg.PoolName
 	address := createPoolMsg.Owner
 	amount := sdk.NewDecCoinFromDec(createPoolMsg.MinLockAmount.Denom, sdk.NewDec(1))
-	lockMsg := types.NewMsgLock(poolName, address, amount)
+	lockMsg := types.NewMsgLock(poolName, address, amount, 0)
 	return lockMsg
 }
 
@@ -1316,3 +1316,184 @@ func TestHandlerCheckCombination(t *testing.T) {
 	testCaseCombinationTest(t, tests)
 
 }
+
+func TestHandlerLockTerm(t *testing.T) {
+	tCtx := initEnvironment(t)
+	params := types.DefaultParams()
+	params.LockTerms = types.LockTerms{
+		types.NewLockTerm(10, sdk.NewDec(2)),
+		types.NewLockTerm(20, sdk.NewDec(3)),
+	}
+	tCtx.k.SetParams(tCtx.ctx, params)
+
+	createPoolMsg := createPool(t, tCtx)
+	provide(t, tCtx, createPoolMsg)
+	lockAmount := sdk.NewDecCoinFromDec(createPoolMsg.MinLockAmount.Denom, sdk.NewDec(1))
+	owner, other, another := createPoolMsg.Owner, tCtx.addrList[0], tCtx.addrList[1]
+
+	// owner locks for 10 blocks with double rewards, while other locks flexibly
+	tCtx.ctx = tCtx.ctx.WithBlockHeight(tCtx.ctx.BlockHeight() + 2)
+	unlockHeight := tCtx.ctx.BlockHeight() + 10
+	_, err := tCtx.handler(tCtx.ctx, types.NewMsgLock(createPoolMsg.PoolName, owner, lockAmount, 10))
+	require.Nil(t, err)
+	_, err = tCtx.handler(tCtx.ctx, types.NewMsgLock(createPoolMsg.PoolName, other, lockAmount, 0))
+	require.Nil(t, err)
+	lockInfo, found := tCtx.k.GetLockInfo(tCtx.ctx, owner, createPoolMsg.PoolName)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(2), lockInfo.RewardMultiplier)
+	require.Equal(t, unlockHeight, lockInfo.UnlockHeight)
+	requireLockedWeight(t, tCtx, createPoolMsg.PoolName, sdk.NewDec(3))
+
+	// fail case : the lock term is not in the params
+	_, err = tCtx.handler(tCtx.ctx, types.NewMsgLock(createPoolMsg.PoolName, other, lockAmount, 5))
+	require.Equal(t, types.ErrInvalidLockTerm(5).Error(), err.Error())
+
+	// fail case : the tokens are unlocked before the lock term ends
+	tCtx.ctx = tCtx.ctx.WithBlockHeight(tCtx.ctx.BlockHeight() + 4)
+	_, err = tCtx.handler(tCtx.ctx, types.NewMsgUnlock(createPoolMsg.PoolName, owner, lockAmount))
+	require.Equal(t, types.ErrLockNotExpired(unlockHeight).Error(), err.Error())
+
+	// the rewards of owner are doubled
+	yieldedDenom := createPoolMsg.YieldedSymbol
+	ownerRewards := claimRewards(t, tCtx, createPoolMsg.PoolName, owner, yieldedDenom)
+	otherRewards := claimRewards(t, tCtx, createPoolMsg.PoolName, other, yieldedDenom)
+	require.True(t, otherRewards.IsPositive())
+	require.True(t, ownerRewards.Sub(otherRewards.MulInt64(2)).Abs().LTE(sdk.NewDecWithPrec(1, 8)))
+
+	// fail case : the new lock term ends before the current one
+	_, err = tCtx.handler(tCtx.ctx, types.NewMsgLock(createPoolMsg.PoolName, another, lockAmount, 20))
+	require.Nil(t, err)
+	tCtx.ctx = tCtx.ctx.WithBlockHeight(tCtx.ctx.BlockHeight() + 1)
+	_, err = tCtx.handler(tCtx.ctx, types.NewMsgLock(createPoolMsg.PoolName, another, lockAmount, 10))
+	require.Equal(t, types.ErrLockTermShortened(tCtx.ctx.BlockHeight()+19, tCtx.ctx.BlockHeight()+10).Error(),
+		err.Error())
+	requireLockedWeight(t, tCtx, createPoolMsg.PoolName, sdk.NewDec(6))
+
+	// the boost of owner is removed when its lock term ends, after which the tokens can be unlocked
+	tCtx.ctx = tCtx.ctx.WithBlockHeight(unlockHeight)
+	EndBlocker(tCtx.ctx, tCtx.k)
+	lockInfo, found = tCtx.k.GetLockInfo(tCtx.ctx, owner, createPoolMsg.PoolName)
+	require.True(t, found)
+	require.Equal(t, sdk.OneDec(), lockInfo.RewardMultiplier)
+	require.Equal(t, int64(0), lockInfo.UnlockHeight)
+	requireLockedWeight(t, tCtx, createPoolMsg.PoolName, sdk.NewDec(5))
+	_, err = tCtx.handler(tCtx.ctx, types.NewMsgUnlock(createPoolMsg.PoolName, owner, lockAmount))
+	require.Nil(t, err)
+	requireLockedWeight(t, tCtx, createPoolMsg.PoolName, sdk.NewDec(4))
+}
+
+func TestHandlerLockBeforeTermEnds(t *testing.T) {
+	tCtx := initEnvironment(t)
+	params := types.DefaultParams()
+	params.LockTerms = types.LockTerms{types.NewLockTerm(10, sdk.NewDec(3))}
+	tCtx.k.SetParams(tCtx.ctx, params)
+
+	createPoolMsg := createPool(t, tCtx)
+	provide(t, tCtx, createPoolMsg)
+	denom := createPoolMsg.MinLockAmount.Denom
+	owner := createPoolMsg.Owner
+
+	// owner locks a tiny amount for the longest lock term
+	tCtx.ctx = tCtx.ctx.WithBlockHeight(tCtx.ctx.BlockHeight() + 2)
+	unlockHeight := tCtx.ctx.BlockHeight() + 10
+	_, err := tCtx.handler(tCtx.ctx, types.NewMsgLock(createPoolMsg.PoolName, owner,
+		sdk.NewDecCoinFromDec(denom, sdk.NewDecWithPrec(1, 1)), 10))
+	require.Nil(t, err)
+
+	// fail case : a large amount is locked flexibly right before the lock term ends
+	tCtx.ctx = tCtx.ctx.WithBlockHeight(unlockHeight - 1)
+	largeAmount := sdk.NewDecCoinFromDec(denom, sdk.NewDec(10))
+	_, err = tCtx.handler(tCtx.ctx, types.NewMsgLock(createPoolMsg.PoolName, owner, largeAmount, 0))
+	require.Equal(t, types.ErrFlexibleLockOnTerm(unlockHeight).Error(), err.Error())
+	requireLockedWeight(t, tCtx, createPoolMsg.PoolName, sdk.NewDecWithPrec(3, 1))
+
+	// locking with a lock term restarts it for the whole amount
+	_, err = tCtx.handler(tCtx.ctx, types.NewMsgLock(createPoolMsg.PoolName, owner, largeAmount, 10))
+	require.Nil(t, err)
+	lockInfo, found := tCtx.k.GetLockInfo(tCtx.ctx, owner, createPoolMsg.PoolName)
+	require.True(t, found)
+	require.Equal(t, tCtx.ctx.BlockHeight()+10, lockInfo.UnlockHeight)
+	requireLockedWeight(t, tCtx, createPoolMsg.PoolName, sdk.NewDecWithPrec(303, 1))
+
+	// the boost is not removed at the end of the former lock term
+	tCtx.ctx = tCtx.ctx.WithBlockHeight(unlockHeight)
+	EndBlocker(tCtx.ctx, tCtx.k)
+	requireLockedWeight(t, tCtx, createPoolMsg.PoolName, sdk.NewDecWithPrec(303, 1))
+
+	// tokens can be locked flexibly again once the lock term ends
+	tCtx.ctx = tCtx.ctx.WithBlockHeight(lockInfo.UnlockHeight)
+	EndBlocker(tCtx.ctx, tCtx.k)
+	requireLockedWeight(t, tCtx, createPoolMsg.PoolName, sdk.NewDecWithPrec(101, 1))
+	_, err = tCtx.handler(tCtx.ctx, types.NewMsgLock(createPoolMsg.PoolName, owner, largeAmount, 0))
+	require.Nil(t, err)
+	requireLockedWeight(t, tCtx, createPoolMsg.PoolName, sdk.NewDecWithPrec(201, 1))
+}
+
+func TestExpireLockTermsCarriedOver(t *testing.T) {
+	tCtx := initEnvironment(t)
+	params := types.DefaultParams()
+	params.LockTerms = types.LockTerms{types.NewLockTerm(10, sdk.NewDec(2))}
+	tCtx.k.SetParams(tCtx.ctx, params)
+
+	createPoolMsg := createPool(t, tCtx)
+	provide(t, tCtx, createPoolMsg)
+	lockAmount := sdk.NewDecCoinFromDec(createPoolMsg.MinLockAmount.Denom, sdk.NewDec(1))
+	addrs := []sdk.AccAddress{createPoolMsg.Owner, tCtx.addrList[0], tCtx.addrList[1]}
+
+	// all the lock terms end at the same height
+	tCtx.ctx = tCtx.ctx.WithBlockHeight(tCtx.ctx.BlockHeight() + 2)
+	unlockHeight := tCtx.ctx.BlockHeight() + 10
+	for _, addr := range addrs {
+		_, err := tCtx.handler(tCtx.ctx, types.NewMsgLock(createPoolMsg.PoolName, addr, lockAmount, 10))
+		require.Nil(t, err)
+	}
+	requireLockedWeight(t, tCtx, createPoolMsg.PoolName, sdk.NewDec(6))
+
+	// only one lock term is expired per block, the others are carried over
+	tCtx.ctx = tCtx.ctx.WithBlockHeight(unlockHeight)
+	expireLockTerms(tCtx.ctx, tCtx.k, 1)
+	requireLockedWeight(t, tCtx, createPoolMsg.PoolName, sdk.NewDec(5))
+
+	// an ended lock term which is carried over doesn't stop the flexible lock, which removes it
+	var boosted []sdk.AccAddress
+	for _, addr := range addrs {
+		lockInfo, found := tCtx.k.GetLockInfo(tCtx.ctx, addr, createPoolMsg.PoolName)
+		require.True(t, found)
+		if lockInfo.UnlockHeight != 0 {
+			boosted = append(boosted, addr)
+		}
+	}
+	require.Equal(t, 2, len(boosted))
+	_, err := tCtx.handler(tCtx.ctx, types.NewMsgLock(createPoolMsg.PoolName, boosted[0], lockAmount, 0))
+	require.Nil(t, err)
+	requireLockedWeight(t, tCtx, createPoolMsg.PoolName, sdk.NewDec(5))
+
+	tCtx.ctx = tCtx.ctx.WithBlockHeight(unlockHeight + 1)
+	expireLockTerms(tCtx.ctx, tCtx.k, 1)
+	requireLockedWeight(t, tCtx, createPoolMsg.PoolName, sdk.NewDec(4))
+	lockInfo, found := tCtx.k.GetLockInfo(tCtx.ctx, boosted[1], createPoolMsg.PoolName)
+	require.True(t, found)
+	require.Equal(t, int64(0), lockInfo.UnlockHeight)
+}
+
+func claimRewards(t *testing.T, tCtx *testContext, poolName string, addr sdk.AccAddress, denom string) sdk.Dec {
+	preCoins := tCtx.k.TokenKeeper().GetCoins(tCtx.ctx, addr)
+	_, err := tCtx.handler(tCtx.ctx, types.NewMsgClaim(poolName, addr))
+	require.Nil(t, err)
+	afterCoins := tCtx.k.TokenKeeper().GetCoins(tCtx.ctx, addr)
+	return afterCoins.AmountOf(denom).Sub(preCoins.AmountOf(denom))
+}
+
+func requireLockedWeight(t *testing.T, tCtx *testContext, poolName string, expected sdk.Dec) {
+	lockedWeight := sdk.ZeroDec()
+	tCtx.k.IterateAllLockInfos(tCtx.ctx, func(lockInfo types.LockInfo) (stop bool) {
+		if lockInfo.PoolName == poolName {
+			lockedWeight = lockedWeight.Add(lockInfo.GetWeight())
+		}
+		return false
+	})
+	pool, found := tCtx.k.GetFarmPool(tCtx.ctx, poolName)
+	require.True(t, found)
+	require.Equal(t, expected, lockedWeight)
+	require.Equal(t, expected, pool.TotalLockedWeight)
+}
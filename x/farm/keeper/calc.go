@@ -47,7 +47,7 @@ func (k Keeper) CalculateAmountYieldedBetween(ctx sdk.Context, pool types.FarmPo
 }
 
 func (k Keeper) WithdrawRewards(
-	ctx sdk.Context, poolName string, totalValueLocked sdk.SysCoin, yieldedTokens sdk.SysCoins, addr sdk.AccAddress,
+	ctx sdk.Context, poolName string, totalLockedWeight sdk.Dec, yieldedTokens sdk.SysCoins, addr sdk.AccAddress,
 ) (sdk.SysCoins, sdk.Error) {
 	// 0. check existence of lock info
 	lockInfo, found := k.GetLockInfo(ctx, addr, poolName)
@@ -56,7 +56,7 @@ func (k Keeper) WithdrawRewards(
 	}
 
 	// 1. end current period and calculate rewards
-	endingPeriod := k.IncrementPoolPeriod(ctx, poolName, totalValueLocked, yieldedTokens)
+	endingPeriod := k.IncrementPoolPeriod(ctx, poolName, totalLockedWeight, yieldedTokens)
 	rewards := k.calculateRewards(ctx, poolName, addr, endingPeriod, lockInfo)
 
 	// 2. transfer rewards to user account
@@ -73,19 +73,20 @@ func (k Keeper) WithdrawRewards(
 	return rewards, nil
 }
 
-// IncrementPoolPeriod increments pool period, returning the period just ended
+// IncrementPoolPeriod increments pool period, returning the period just ended. The reward ratio of a period is
+// the rewards per unit of locked weight, so that the rewards of a lock are boosted by its reward multiplier.
 func (k Keeper) IncrementPoolPeriod(
-	ctx sdk.Context, poolName string, totalValueLocked sdk.SysCoin, yieldedTokens sdk.SysCoins,
+	ctx sdk.Context, poolName string, totalLockedWeight sdk.Dec, yieldedTokens sdk.SysCoins,
 ) uint64 {
 	// 1. fetch current period rewards
 	rewards := k.GetPoolCurrentRewards(ctx, poolName)
 	// 2. calculate current reward ratio
 	rewards.Rewards = rewards.Rewards.Add2(yieldedTokens)
 	var currentRatio sdk.SysCoins
-	if totalValueLocked.IsZero() {
+	if totalLockedWeight.IsZero() {
 		currentRatio = sdk.SysCoins{}
 	} else {
-		currentRatio = rewards.Rewards.QuoDecTruncate(totalValueLocked.Amount)
+		currentRatio = rewards.Rewards.QuoDecTruncate(totalLockedWeight)
 	}
 
 	// 3.1 get the previous pool historical rewards
@@ -138,32 +139,45 @@ func (k Keeper) calculateRewards(
 
 	startingPeriod := lockInfo.ReferencePeriod
 	// calculate rewards for final period
-	return k.calculateLockRewardsBetween(ctx, poolName, startingPeriod, endingPeriod, lockInfo.Amount)
+	return k.calculateLockRewardsBetween(ctx, poolName, startingPeriod, endingPeriod, lockInfo.GetWeight())
 }
 
 // calculateLockRewardsBetween calculate the rewards accrued by a pool between two periods
 func (k Keeper) calculateLockRewardsBetween(ctx sdk.Context, poolName string, startingPeriod, endingPeriod uint64,
-	amount sdk.SysCoin) (rewards sdk.SysCoins) {
+	weight sdk.Dec) (rewards sdk.SysCoins) {
 
 	// sanity check
 	if startingPeriod > endingPeriod {
 		panic("startingPeriod cannot be greater than endingPeriod")
 	}
 
-	if amount.Amount.LT(sdk.ZeroDec()) {
-		panic("amount should not be negative")
+	if weight.LT(sdk.ZeroDec()) {
+		panic("weight should not be negative")
 	}
 
-	// return amount * (ending - starting)
+	// return weight * (ending - starting)
 	starting := k.GetPoolHistoricalRewards(ctx, poolName, startingPeriod)
 	ending := k.GetPoolHistoricalRewards(ctx, poolName, endingPeriod)
 	difference := ending.CumulativeRewardRatio.Sub(starting.CumulativeRewardRatio)
-	rewards = difference.MulDecTruncate(amount.Amount)
+	rewards = difference.MulDecTruncate(weight)
 	return
 }
 
-// UpdateLockInfo updates lock info for the modified lock info
-func (k Keeper) UpdateLockInfo(ctx sdk.Context, addr sdk.AccAddress, poolName string, changedAmount sdk.Dec) {
+// UpdateLockInfo updates lock info for the modified lock info, keeping its lock term.
+// It returns the changed weight of the lock info.
+func (k Keeper) UpdateLockInfo(ctx sdk.Context, addr sdk.AccAddress, poolName string, changedAmount sdk.Dec) sdk.Dec {
+	lockInfo, found := k.GetLockInfo(ctx, addr, poolName)
+	if !found {
+		panic("the lock info can't be found")
+	}
+	return k.UpdateLockInfoWithTerm(ctx, addr, poolName, changedAmount, lockInfo.GetRewardMultiplier(),
+		lockInfo.UnlockHeight)
+}
+
+// UpdateLockInfoWithTerm updates lock info for the modified lock info with a new lock term, which ends at
+// unlockHeight and boosts the rewards by rewardMultiplier. It returns the changed weight of the lock info.
+func (k Keeper) UpdateLockInfoWithTerm(ctx sdk.Context, addr sdk.AccAddress, poolName string, changedAmount,
+	rewardMultiplier sdk.Dec, unlockHeight int64) sdk.Dec {
 	// period has already been incremented - we want to store the period ended by this lock action
 	previousPeriod := k.GetPoolCurrentRewards(ctx, poolName).Period - 1
 
@@ -172,18 +186,29 @@ func (k Keeper) UpdateLockInfo(ctx sdk.Context, addr sdk.AccAddress, poolName st
 	if !found {
 		panic("the lock info can't be found")
 	}
+	previousWeight := lockInfo.GetWeight()
+	if lockInfo.UnlockHeight != unlockHeight {
+		k.removeLockExpiryQueue(ctx, lockInfo)
+	}
+
 	lockInfo.StartBlockHeight = ctx.BlockHeight()
 	lockInfo.ReferencePeriod = previousPeriod
 	lockInfo.Amount.Amount = lockInfo.Amount.Amount.Add(changedAmount)
+	lockInfo.RewardMultiplier = rewardMultiplier
+	lockInfo.UnlockHeight = unlockHeight
 	if lockInfo.Amount.IsZero() {
+		k.removeLockExpiryQueue(ctx, lockInfo)
 		k.DeleteLockInfo(ctx, lockInfo.Owner, lockInfo.PoolName)
 		k.DeleteAddressInFarmPool(ctx, lockInfo.PoolName, lockInfo.Owner)
-	} else {
-		// increment reference count for the period we're going to track
-		k.incrementReferenceCount(ctx, poolName, previousPeriod)
-
-		// set the updated lock info
-		k.SetLockInfo(ctx, lockInfo)
-		k.SetAddressInFarmPool(ctx, lockInfo.PoolName, lockInfo.Owner)
+		return previousWeight.Neg()
 	}
+
+	// increment reference count for the period we're going to track
+	k.incrementReferenceCount(ctx, poolName, previousPeriod)
+
+	// set the updated lock info
+	k.SetLockInfo(ctx, lockInfo)
+	k.SetAddressInFarmPool(ctx, lockInfo.PoolName, lockInfo.Owner)
+	k.InsertLockExpiryQueue(ctx, lockInfo)
+	return lockInfo.GetWeight().Sub(previousWeight)
 }
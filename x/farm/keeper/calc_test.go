@@ -277,7 +277,7 @@ func TestCalculateLockRewardsBetween(t *testing.T) {
 		keeper.SetPoolHistoricalRewards(ctx, poolName, test.endPeriod, endHis)
 
 		wrappedTestFunc := func() sdk.SysCoins {
-			return keeper.calculateLockRewardsBetween(ctx, poolName, test.startPeriod, test.endPeriod, test.amount)
+			return keeper.calculateLockRewardsBetween(ctx, poolName, test.startPeriod, test.endPeriod, test.amount.Amount)
 		}
 		test.expectedFunc(test, wrappedTestFunc)
 	}
@@ -351,7 +351,7 @@ func TestIncrementPoolPeriod(t *testing.T) {
 		keeper.SetPoolCurrentRewards(ctx, poolName, test.curRewards)
 
 		wrappedTestFunc := func() uint64 {
-			return keeper.IncrementPoolPeriod(ctx, poolName, test.valueLocked, test.yieldedTokens)
+			return keeper.IncrementPoolPeriod(ctx, poolName, test.valueLocked.Amount, test.yieldedTokens)
 		}
 		test.expectedFunc(test, wrappedTestFunc)
 	}
@@ -546,7 +546,7 @@ func TestWithdrawRewards(t *testing.T) {
 			keeper.supplyKeeper.SetModuleAccount(ctx, yieldModuleAcc)
 		}
 		wrappedTestFunc := func() (sdk.SysCoins, sdk.Error) {
-			return keeper.WithdrawRewards(ctx, poolName, test.totalLocked, test.yieldedAmount, test.lockInfo.Owner)
+			return keeper.WithdrawRewards(ctx, poolName, test.totalLocked.Amount, test.yieldedAmount, test.lockInfo.Owner)
 		}
 		test.expectedFunc(test, wrappedTestFunc)
 	}
@@ -7,6 +7,8 @@ import (
 )
 
 func (k Keeper) SetFarmPool(ctx sdk.Context, pool types.FarmPool) {
+	// the pools stored before lock terms have no total locked weight
+	pool.TotalLockedWeight = pool.GetTotalLockedWeight()
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.GetFarmPoolKey(pool.Name), k.cdc.MustMarshalBinaryLengthPrefixed(pool))
 }
@@ -106,6 +108,8 @@ func (k Keeper) DeleteAddressInFarmPool(ctx sdk.Context, poolName string, addr s
 }
 
 func (k Keeper) SetLockInfo(ctx sdk.Context, lockInfo types.LockInfo) {
+	// the lock infos stored before lock terms have no reward multiplier
+	lockInfo.RewardMultiplier = lockInfo.GetRewardMultiplier()
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.GetLockInfoKey(lockInfo.Owner, lockInfo.PoolName), k.cdc.MustMarshalBinaryLengthPrefixed(lockInfo))
 }
@@ -11,6 +11,7 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 	ir.RegisterRoute(types.ModuleName, "module-account", moduleAccountInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "yield-farming-account", yieldFarmingAccountInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "mint-farming-account", mintFarmingAccountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "locked-weight", lockedWeightInvariant(k))
 }
 
 // moduleAccountInvariant checks if farm ModuleAccount is consistent with the sum of deposit amount
@@ -94,3 +95,38 @@ func mintFarmingAccountInvariant(k Keeper) sdk.Invariant {
 				moduleAcc.GetCoins(), whiteLists)), broken
 	}
 }
+
+// lockedWeightInvariant checks if the total locked weight of each pool is consistent with the sum of the weights
+// of its lock infos, which the reward ratios of the pool periods are based on
+func lockedWeightInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		// iterate all lock infos, then calculate the total locked weight of each pool
+		lockedWeights := make(map[string]sdk.Dec)
+		k.IterateAllLockInfos(ctx, func(lockInfo types.LockInfo) (stop bool) {
+			if weight, ok := lockedWeights[lockInfo.PoolName]; ok {
+				lockedWeights[lockInfo.PoolName] = weight.Add(lockInfo.GetWeight())
+			} else {
+				lockedWeights[lockInfo.PoolName] = lockInfo.GetWeight()
+			}
+			return false
+		})
+
+		// make a comparison with every pool
+		var msg string
+		broken := false
+		for _, pool := range k.GetFarmPools(ctx) {
+			expectedWeight, ok := lockedWeights[pool.Name]
+			if !ok {
+				expectedWeight = sdk.ZeroDec()
+			}
+			if !expectedWeight.Equal(pool.GetTotalLockedWeight()) {
+				broken = true
+				msg += fmt.Sprintf("\tpool %s expected total locked weight: %s\n"+
+					"\tacutal total locked weight: %s\n",
+					pool.Name, expectedWeight, pool.GetTotalLockedWeight())
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "locked weight", msg), broken
+	}
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 	swaptypes "github.com/okex/exchain/x/ammswap/types"
 	"github.com/stretchr/testify/require"
 	"testing"
@@ -17,4 +18,21 @@ func TestInvariants(t *testing.T) {
 	require.False(t, broken)
 	_, broken = mintFarmingAccountInvariant(keeper.Keeper)(ctx)
 	require.False(t, broken)
+	_, broken = lockedWeightInvariant(keeper.Keeper)(ctx)
+	require.False(t, broken)
+
+	// boosting a lock without updating the total locked weight of its pool breaks the invariant
+	lockInfo, found := keeper.GetLockInfo(ctx, Addrs[0], "pool1")
+	require.True(t, found)
+	lockInfo.RewardMultiplier = sdk.NewDec(2)
+	keeper.SetLockInfo(ctx, lockInfo)
+	_, broken = lockedWeightInvariant(keeper.Keeper)(ctx)
+	require.True(t, broken)
+
+	pool, found := keeper.GetFarmPool(ctx, "pool1")
+	require.True(t, found)
+	pool.TotalLockedWeight = pool.TotalLockedWeight.Add(lockInfo.Amount.Amount)
+	keeper.SetFarmPool(ctx, pool)
+	_, broken = lockedWeightInvariant(keeper.Keeper)(ctx)
+	require.False(t, broken)
 }
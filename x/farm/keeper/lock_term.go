@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/farm/types"
+)
+
+// InsertLockExpiryQueue inserts the lock info into the queue of lock terms ending at its unlock height
+func (k Keeper) InsertLockExpiryQueue(ctx sdk.Context, lockInfo types.LockInfo) {
+	if lockInfo.UnlockHeight <= 0 {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetLockExpiryKey(lockInfo.UnlockHeight, lockInfo.Owner, lockInfo.PoolName), []byte{})
+}
+
+// removeLockExpiryQueue removes the lock info from the queue of lock terms ending at its unlock height
+func (k Keeper) removeLockExpiryQueue(ctx sdk.Context, lockInfo types.LockInfo) {
+	if lockInfo.UnlockHeight <= 0 {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetLockExpiryKey(lockInfo.UnlockHeight, lockInfo.Owner, lockInfo.PoolName))
+}
+
+// IterateExpiredLockInfos iterates through at most limit lock infos whose lock terms end until the current block
+// height, from the head of the lock expiry queue
+func (k Keeper) IterateExpiredLockInfos(ctx sdk.Context, limit int, handler func(lockInfo types.LockInfo) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.LockExpiryQueuePrefix,
+		sdk.PrefixEndBytes(types.GetLockExpiryHeightKey(ctx.BlockHeight())))
+	defer iterator.Close()
+
+	var lockInfos []types.LockInfo
+	for ; iterator.Valid() && len(lockInfos) < limit; iterator.Next() {
+		_, addr, poolName := types.SplitLockExpiryKey(iterator.Key())
+		lockInfo, found := k.GetLockInfo(ctx, addr, poolName)
+		if !found {
+			panic("the lock info can't be found")
+		}
+		lockInfos = append(lockInfos, lockInfo)
+	}
+
+	// the queue is modified by the handler, so it is iterated before
+	for _, lockInfo := range lockInfos {
+		if handler(lockInfo) {
+			break
+		}
+	}
+}
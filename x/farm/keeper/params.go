@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"bytes"
+
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 	"github.com/okex/exchain/x/farm/types"
 )
@@ -12,6 +14,14 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 
 // GetParams returns the total set of farm parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSubspace.GetParamSet(ctx, &params)
+	for _, pair := range params.ParamSetPairs() {
+		// the lock terms are added after genesis, so they don't exist on a chain started before
+		// until they are set by a param change proposal
+		if bytes.Equal(pair.Key, types.KeyLockTerms) {
+			k.paramSubspace.GetIfExists(ctx, pair.Key, pair.Value)
+			continue
+		}
+		k.paramSubspace.Get(ctx, pair.Key, pair.Value)
+	}
 	return
 }
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	"github.com/okex/exchain/libs/cosmos-sdk/store"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	"github.com/okex/exchain/libs/tendermint/libs/log"
+	"github.com/okex/exchain/x/farm/types"
+	"github.com/okex/exchain/x/params"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestGetParamsWithoutLockTerms(t *testing.T) {
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	require.NoError(t, ms.LoadLatestVersion())
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: TestChainID}, false, log.NewNopLogger())
+
+	cdc := codec.New()
+	pk := params.NewKeeper(cdc, keyParams, tkeyParams)
+	subspace := pk.Subspace(types.DefaultParamspace).WithKeyTable(types.ParamKeyTable())
+	k := Keeper{paramSubspace: subspace}
+
+	// set the params of a chain started before the lock terms are added
+	defaultParams := types.DefaultParams()
+	for _, pair := range defaultParams.ParamSetPairs() {
+		if bytes.Equal(pair.Key, types.KeyLockTerms) {
+			continue
+		}
+		subspace.Set(ctx, pair.Key, pair.Value)
+	}
+
+	var params types.Params
+	require.NotPanics(t, func() { params = k.GetParams(ctx) })
+	require.Equal(t, defaultParams.QuoteSymbol, params.QuoteSymbol)
+	require.Empty(t, params.LockTerms)
+
+	// the lock terms set later are read as usual
+	lockTerms := types.LockTerms{types.NewLockTerm(100, sdk.NewDec(2))}
+	params.LockTerms = lockTerms
+	k.SetParams(ctx, params)
+	require.Equal(t, lockTerms, k.GetParams(ctx).LockTerms)
+}
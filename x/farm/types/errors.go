@@ -35,6 +35,10 @@ const (
 	CodeLockAmountBelowMinimum             uint32 = 66019
 	CodeSendCoinsFromModuleToAccountFailed uint32 = 66020
 	CodeSwapTokenPairNotExist              uint32 = 66021
+	CodeInvalidLockTerm                    uint32 = 66022
+	CodeLockNotExpired                     uint32 = 66023
+	CodeLockTermShortened                  uint32 = 66024
+	CodeFlexibleLockOnTerm                 uint32 = 66025
 )
 
 // ErrInvalidInput returns an error when an input parameter is invalid
@@ -148,4 +152,26 @@ func ErrSendCoinsFromModuleToAccountFailed(content string) sdk.EnvelopedErr {
 // ErrSwapTokenPairNotExist returns an error when a swap token pair not exists
 func ErrSwapTokenPairNotExist(tokenName string) sdk.EnvelopedErr {
 	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultParamspace, CodeSwapTokenPairNotExist, fmt.Sprintf("failed. swap token pair %s does not exist", tokenName))}
-}
\ No newline at end of file
+}
+
+// ErrInvalidLockTerm returns an error when the blocks of a lock term are not allowed by the params
+func ErrInvalidLockTerm(lockBlocks int64) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultParamspace, CodeInvalidLockTerm, fmt.Sprintf("failed. lock term of %d blocks is not allowed", lockBlocks))}
+}
+
+// ErrLockNotExpired returns an error when the locked tokens are unlocked before the lock term ends
+func ErrLockNotExpired(unlockHeight int64) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultParamspace, CodeLockNotExpired, fmt.Sprintf("failed. the locked tokens can't be unlocked until height %d", unlockHeight))}
+}
+
+// ErrLockTermShortened returns an error when a new lock term ends before the current one
+func ErrLockTermShortened(unlockHeight, newUnlockHeight int64) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultParamspace, CodeLockTermShortened,
+		fmt.Sprintf("failed. the new lock term ending at height %d is shorter than the current one ending at height %d", newUnlockHeight, unlockHeight))}
+}
+
+// ErrFlexibleLockOnTerm returns an error when tokens are locked flexibly onto a lock term which is not ended
+func ErrFlexibleLockOnTerm(unlockHeight int64) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultParamspace, CodeFlexibleLockOnTerm,
+		fmt.Sprintf("failed. the current lock term ends at height %d, lock with a lock term to restart it instead", unlockHeight))}
+}
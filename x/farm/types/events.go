@@ -8,6 +8,7 @@ const (
 	EventTypeLock        = "lock"
 	EventTypeUnlock      = "unlock"
 	EventTypeClaim       = "claim"
+	EventTypeLockExpire  = "lock-expire"
 
 	AttributeKeyAddress             = "address"
 	AttributeKeyPool                = "pool"
@@ -18,6 +19,8 @@ const (
 	AttributeKeyDeposit             = "deposit"
 	AttributeKeyWithdraw            = "withdraw"
 	AttributeKeyClaimed             = "claimed"
+	AttributeKeyLockBlocks          = "lock_blocks"
+	AttributeKeyUnlockHeight        = "unlock_height"
 
 	AttributeValueCategory = ModuleName
 )
@@ -17,6 +17,8 @@ type FarmPool struct {
 	TotalValueLocked        sdk.SysCoin       `json:"total_value_locked"`
 	YieldedTokenInfos       YieldedTokenInfos `json:"yielded_token_infos"`
 	TotalAccumulatedRewards sdk.SysCoins      `json:"total_accumulated_rewards"`
+	// sum of the weights of LockInfo boosted by lock terms
+	TotalLockedWeight sdk.Dec `json:"total_locked_weight"`
 }
 
 // NewFarmPool creates a new instance of FarmPool
@@ -32,9 +34,19 @@ func NewFarmPool(
 		TotalValueLocked:        totalValueLocked,
 		YieldedTokenInfos:       yieldedTokenInfos,
 		TotalAccumulatedRewards: accumulatedRewards,
+		TotalLockedWeight:       totalValueLocked.Amount,
 	}
 }
 
+// GetTotalLockedWeight returns the total locked weight of the pool, which equals to the total value locked
+// for a pool without any lock boosted by lock terms
+func (fp FarmPool) GetTotalLockedWeight() sdk.Dec {
+	if fp.TotalLockedWeight.IsNil() {
+		return fp.TotalValueLocked.Amount
+	}
+	return fp.TotalLockedWeight
+}
+
 func (fp FarmPool) Finished() bool {
 	for _, yieldedTokenInfo := range fp.YieldedTokenInfos {
 		if yieldedTokenInfo.RemainingAmount.IsPositive() {
@@ -53,8 +65,10 @@ func (fp FarmPool) String() string {
   Deposit Amount:                   %s
   Total Value Locked:               %s
   Yielded Token Infos:			    %s
-  Total Accumulated Rewards:        %s`,
-		fp.Name, fp.Owner, fp.MinLockAmount.String(), fp.DepositAmount, fp.TotalValueLocked, fp.YieldedTokenInfos, fp.TotalAccumulatedRewards)
+  Total Accumulated Rewards:        %s
+  Total Locked Weight:              %s`,
+		fp.Name, fp.Owner, fp.MinLockAmount.String(), fp.DepositAmount, fp.TotalValueLocked, fp.YieldedTokenInfos, fp.TotalAccumulatedRewards,
+		fp.GetTotalLockedWeight())
 }
 
 // FarmPools is a collection of FarmPool
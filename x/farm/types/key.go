@@ -39,6 +39,7 @@ var (
 	PoolsYieldNativeTokenPrefix = []byte{0x04}
 	PoolHistoricalRewardsPrefix = []byte{0x05}
 	PoolCurrentRewardsPrefix    = []byte{0x06}
+	LockExpiryQueuePrefix       = []byte{0x07}
 )
 
 const (
@@ -87,3 +88,24 @@ func GetPoolHistoricalRewardsPrefix(poolName string) []byte {
 func GetPoolCurrentRewardsKey(poolName string) []byte {
 	return append(PoolCurrentRewardsPrefix, []byte(poolName)...)
 }
+
+// GetLockExpiryHeightKey gets the prefix key of the lock terms ending at the height
+func GetLockExpiryHeightKey(height int64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(height))
+	return append(LockExpiryQueuePrefix, b...)
+}
+
+// GetLockExpiryKey gets the key of the lock expiry queue for the lock term of an address ending at the height
+func GetLockExpiryKey(height int64, addr sdk.AccAddress, poolName string) []byte {
+	return append(GetLockExpiryHeightKey(height), append(addr.Bytes(), []byte(poolName)...)...)
+}
+
+// SplitLockExpiryKey splits the height, address and pool name out from a LockExpiryKey
+func SplitLockExpiryKey(key []byte) (height int64, addr sdk.AccAddress, poolName string) {
+	addrIndex := len(LockExpiryQueuePrefix) + 8
+	height = int64(binary.BigEndian.Uint64(key[len(LockExpiryQueuePrefix):addrIndex]))
+	addr = sdk.AccAddress(key[addrIndex : addrIndex+sdk.AddrLen])
+	poolName = string(key[addrIndex+sdk.AddrLen:])
+	return
+}
@@ -12,6 +12,9 @@ type LockInfo struct {
 	Amount           sdk.SysCoin    `json:"amount"`
 	StartBlockHeight int64          `json:"start_block_height"`
 	ReferencePeriod  uint64         `json:"reference_period"`
+	// the lock can't be unlocked until UnlockHeight, before which its rewards are boosted by RewardMultiplier
+	RewardMultiplier sdk.Dec `json:"reward_multiplier"`
+	UnlockHeight     int64   `json:"unlock_height"`
 }
 
 // NewLockInfo creates a new instance of LockInfo
@@ -22,9 +25,23 @@ func NewLockInfo(owner sdk.AccAddress, poolName string, amount sdk.SysCoin, star
 		Amount:           amount,
 		StartBlockHeight: startBlockHeight,
 		ReferencePeriod:  referencePeriod,
+		RewardMultiplier: sdk.OneDec(),
 	}
 }
 
+// GetRewardMultiplier returns the reward multiplier of the lock, which is 1 for a lock without any lock term
+func (li LockInfo) GetRewardMultiplier() sdk.Dec {
+	if li.RewardMultiplier.IsNil() {
+		return sdk.OneDec()
+	}
+	return li.RewardMultiplier
+}
+
+// GetWeight returns the locked amount weighted by the reward multiplier, which the rewards are proportional to
+func (li LockInfo) GetWeight() sdk.Dec {
+	return li.Amount.Amount.Mul(li.GetRewardMultiplier())
+}
+
 // String returns a human readable string representation of LockInfo
 func (li LockInfo) String() string {
 	return fmt.Sprintf(`Lock Info:
@@ -32,6 +49,9 @@ func (li LockInfo) String() string {
   Pool Name:					%s
   Locked Amount:      			%s
   Start Block Height:           %d
-  Reference Period:             %d`,
-		li.Owner, li.PoolName, li.Amount, li.StartBlockHeight, li.ReferencePeriod)
+  Reference Period:             %d
+  Reward Multiplier:            %s
+  Unlock Height:                %d`,
+		li.Owner, li.PoolName, li.Amount, li.StartBlockHeight, li.ReferencePeriod, li.GetRewardMultiplier(),
+		li.UnlockHeight)
 }
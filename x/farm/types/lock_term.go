@@ -0,0 +1,69 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+// MaxExpiredLockTermsPerBlock is the max number of lock terms expired in a block
+const MaxExpiredLockTermsPerBlock = 100
+
+// LockTerm is a fixed term of blocks that the locked tokens can't be unlocked, during which the lock earns
+// RewardMultiplier times the rewards of a flexible lock with the same amount
+type LockTerm struct {
+	LockBlocks       int64   `json:"lock_blocks"`
+	RewardMultiplier sdk.Dec `json:"reward_multiplier"`
+}
+
+// NewLockTerm creates a new instance of LockTerm
+func NewLockTerm(lockBlocks int64, rewardMultiplier sdk.Dec) LockTerm {
+	return LockTerm{
+		LockBlocks:       lockBlocks,
+		RewardMultiplier: rewardMultiplier,
+	}
+}
+
+// String returns a human readable string representation of LockTerm
+func (lt LockTerm) String() string {
+	return fmt.Sprintf("%d blocks: x%s", lt.LockBlocks, lt.RewardMultiplier)
+}
+
+// LockTerms is a collection of LockTerm
+type LockTerms []LockTerm
+
+// String returns a human readable string representation of LockTerms
+func (lts LockTerms) String() string {
+	terms := make([]string, len(lts))
+	for i, lt := range lts {
+		terms[i] = lt.String()
+	}
+	return fmt.Sprintf("[%s]", strings.Join(terms, ", "))
+}
+
+// Validate checks that the terms are sorted by strictly increasing positive blocks and no multiplier is less than 1
+func (lts LockTerms) Validate() error {
+	var lastLockBlocks int64
+	for _, lt := range lts {
+		if lt.LockBlocks <= lastLockBlocks {
+			return fmt.Errorf("lock blocks of lock terms must be positive and strictly increasing")
+		}
+		if lt.RewardMultiplier.IsNil() || lt.RewardMultiplier.LT(sdk.OneDec()) {
+			return fmt.Errorf("reward multiplier of lock term %d blocks must not be less than 1: %s",
+				lt.LockBlocks, lt.RewardMultiplier)
+		}
+		lastLockBlocks = lt.LockBlocks
+	}
+	return nil
+}
+
+// GetLockTerm gets the lock term with the blocks
+func (lts LockTerms) GetLockTerm(lockBlocks int64) (lockTerm LockTerm, found bool) {
+	for _, lt := range lts {
+		if lt.LockBlocks == lockBlocks {
+			return lt, true
+		}
+	}
+	return lockTerm, false
+}
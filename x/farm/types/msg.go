@@ -171,13 +171,16 @@ type MsgLock struct {
 	PoolName string         `json:"pool_name" yaml:"pool_name"`
 	Address  sdk.AccAddress `json:"address" yaml:"address"`
 	Amount   sdk.SysCoin    `json:"amount" yaml:"amount"`
+	// blocks of the lock term to commit the tokens for, 0 means a flexible lock without boosted rewards
+	LockBlocks int64 `json:"lock_blocks,omitempty" yaml:"lock_blocks"`
 }
 
-func NewMsgLock(poolName string, address sdk.AccAddress, amount sdk.SysCoin) MsgLock {
+func NewMsgLock(poolName string, address sdk.AccAddress, amount sdk.SysCoin, lockBlocks int64) MsgLock {
 	return MsgLock{
-		PoolName: poolName,
-		Address:  address,
-		Amount:   amount,
+		PoolName:   poolName,
+		Address:    address,
+		Amount:     amount,
+		LockBlocks: lockBlocks,
 	}
 }
 
@@ -201,6 +204,9 @@ func (m MsgLock) ValidateBasic() sdk.Error {
 	if m.Amount.Amount.LTE(sdk.ZeroDec()) || !m.Amount.IsValid() {
 		return ErrInvalidInputAmount(m.Amount.Amount.String())
 	}
+	if m.LockBlocks < 0 {
+		return ErrInvalidLockTerm(m.LockBlocks)
+	}
 	return nil
 }
 
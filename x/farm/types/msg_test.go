@@ -194,7 +194,7 @@ func TestMsgLock(t *testing.T) {
 	}
 
 	for _, test := range tests {
-		msg := NewMsgLock(test.poolName, test.addr, test.amount)
+		msg := NewMsgLock(test.poolName, test.addr, test.amount, 0)
 		require.Equal(t, lockMsgType, msg.Type())
 		require.Equal(t, ModuleName, msg.Route())
 		require.Equal(t, []sdk.AccAddress{test.addr}, msg.GetSigners())
@@ -205,6 +205,12 @@ func TestMsgLock(t *testing.T) {
 			testCode(t, err, test.errCode)
 		}
 	}
+
+	// lock term
+	msg := NewMsgLock("pool", sdk.AccAddress{0x1}, sdk.NewDecCoinFromDec("xxb", sdk.NewDec(100)), 100)
+	require.Nil(t, msg.ValidateBasic())
+	msg = NewMsgLock("pool", sdk.AccAddress{0x1}, sdk.NewDecCoinFromDec("xxb", sdk.NewDec(100)), -1)
+	testCode(t, msg.ValidateBasic(), CodeInvalidLockTerm)
 }
 
 func TestMsgUnlock(t *testing.T) {
@@ -22,6 +22,7 @@ var (
 	KeyCreatePoolFee     = []byte("CreatePoolFee")
 	KeyCreatePoolDeposit = []byte("CreatePoolDeposit")
 	keyYieldNativeToken  = []byte("YieldNativeToken")
+	KeyLockTerms         = []byte("LockTerms")
 )
 
 // ParamKeyTable for farm module
@@ -36,6 +37,8 @@ type Params struct {
 	CreatePoolDeposit sdk.SysCoin `json:"create_pool_deposit"`
 	// proposal params
 	YieldNativeToken bool `json:"yield_native_token"`
+	// fixed terms with boosted rewards that an address can lock tokens for
+	LockTerms LockTerms `json:"lock_terms"`
 }
 
 // String implements the stringer interface for Params
@@ -44,8 +47,9 @@ func (p Params) String() string {
   Quote Symbol:								%s
   Create Pool Fee:							%s
   Create Pool Deposit:						%s
-  Yield Native Token Enabled:               %v`,
-		p.QuoteSymbol, p.CreatePoolFee, p.CreatePoolDeposit, p.YieldNativeToken)
+  Yield Native Token Enabled:               %v
+  Lock Terms:                               %s`,
+		p.QuoteSymbol, p.CreatePoolFee, p.CreatePoolDeposit, p.YieldNativeToken, p.LockTerms)
 }
 
 // ParamSetPairs - Implements params.ParamSet
@@ -55,9 +59,18 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		{Key: KeyCreatePoolFee, Value: &p.CreatePoolFee, ValidatorFn: common.ValidateSysCoin("create pool fee")},
 		{Key: KeyCreatePoolDeposit, Value: &p.CreatePoolDeposit, ValidatorFn: common.ValidateSysCoin("create pool deposit")},
 		{Key: keyYieldNativeToken, Value: &p.YieldNativeToken, ValidatorFn: common.ValidateBool("yield native token")},
+		{Key: KeyLockTerms, Value: &p.LockTerms, ValidatorFn: validateLockTerms},
 	}
 }
 
+func validateLockTerms(value interface{}) error {
+	v, ok := value.(LockTerms)
+	if !ok {
+		return fmt.Errorf("invalid parameter type of lock terms: %T", value)
+	}
+	return v.Validate()
+}
+
 // DefaultParams defines the parameters for this module
 func DefaultParams() Params {
 	return Params{
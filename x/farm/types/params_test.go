@@ -12,7 +12,8 @@ const (
   Quote Symbol:								usdk
   Create Pool Fee:							0.000000000000000000` + sdk.DefaultBondDenom + `
   Create Pool Deposit:						10.000000000000000000` + sdk.DefaultBondDenom + `
-  Yield Native Token Enabled:               false`
+  Yield Native Token Enabled:               false
+  Lock Terms:                               []`
 )
 
 func TestParams(t *testing.T) {
@@ -22,3 +23,31 @@ func TestParams(t *testing.T) {
 	require.Equal(t, defaultState.Params, defaultParams)
 	require.Equal(t, strExpected, defaultParams.String())
 }
+
+func TestLockTerms(t *testing.T) {
+	tests := []struct {
+		name      string
+		lockTerms LockTerms
+		result    bool
+	}{
+		{"empty", nil, true},
+		{"sorted", LockTerms{NewLockTerm(10, sdk.OneDec()), NewLockTerm(20, sdk.NewDec(2))}, true},
+		{"zero-blocks", LockTerms{NewLockTerm(0, sdk.NewDec(2))}, false},
+		{"unsorted", LockTerms{NewLockTerm(20, sdk.NewDec(2)), NewLockTerm(10, sdk.NewDec(2))}, false},
+		{"duplicated", LockTerms{NewLockTerm(10, sdk.NewDec(2)), NewLockTerm(10, sdk.NewDec(3))}, false},
+		{"multiplier-less-than-one", LockTerms{NewLockTerm(10, sdk.NewDecWithPrec(5, 1))}, false},
+		{"nil-multiplier", LockTerms{{LockBlocks: 10}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.result, tt.lockTerms.Validate() == nil)
+		})
+	}
+
+	lockTerms := LockTerms{NewLockTerm(10, sdk.NewDec(2)), NewLockTerm(20, sdk.NewDec(3))}
+	lockTerm, found := lockTerms.GetLockTerm(20)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(3), lockTerm.RewardMultiplier)
+	_, found = lockTerms.GetLockTerm(15)
+	require.False(t, found)
+}
@@ -44,6 +44,9 @@ var (
 	NewMsgSubmitProposal       = types.NewMsgSubmitProposal
 	NewMsgDeposit              = types.NewMsgDeposit
 	NewMsgVote                 = types.NewMsgVote
+	NewMsgWeightedVote         = types.NewMsgWeightedVote
+	NewWeightedVoteOption      = types.NewWeightedVoteOption
+	NewNonSplitVoteOption      = types.NewNonSplitVoteOption
 	ParamKeyTable              = types.ParamKeyTable
 	NewDepositParams           = types.NewDepositParams
 	NewTallyParams             = types.NewTallyParams
@@ -69,6 +72,7 @@ var (
 	ProposalIDKey               = types.ProposalIDKey
 	DepositsKeyPrefix           = types.DepositsKeyPrefix
 	VotesKeyPrefix              = types.VotesKeyPrefix
+	VoteRecordsKeyPrefix        = types.VoteRecordsKeyPrefix
 	ParamStoreKeyDepositParams  = types.ParamStoreKeyDepositParams
 	ParamStoreKeyVotingParams   = types.ParamStoreKeyVotingParams
 	ParamStoreKeyTallyParams    = types.ParamStoreKeyTallyParams
@@ -86,6 +90,7 @@ type (
 	MsgSubmitProposal = types.MsgSubmitProposal
 	MsgDeposit        = types.MsgDeposit
 	MsgVote           = types.MsgVote
+	MsgWeightedVote   = types.MsgWeightedVote
 	DepositParams     = types.DepositParams
 	TallyParams       = types.TallyParams
 	VotingParams      = types.VotingParams
@@ -96,5 +101,7 @@ type (
 	TallyResult       = types.TallyResult
 	Vote              = types.Vote
 	Votes             = types.Votes
+	VoteRecord        = types.VoteRecord
+	VoteRecords       = types.VoteRecords
 	Keeper            = keeper.Keeper
 )
@@ -35,6 +35,7 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdQueryProposals(queryRoute, cdc),
 		getCmdQueryVote(queryRoute, cdc),
 		getCmdQueryVotes(queryRoute, cdc),
+		getCmdQueryVoteHistory(queryRoute, cdc),
 		GetCmdQueryParam(queryRoute, cdc),
 		GetCmdQueryParams(queryRoute, cdc),
 		GetCmdQueryProposer(queryRoute, cdc),
@@ -411,6 +412,57 @@ $ %s query gov votes 1
 	}
 }
 
+// getCmdQueryVoteHistory implements the command to query for the vote log of a proposal.
+func getCmdQueryVoteHistory(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "vote-history [proposal-id] [voter-addr]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Query every vote cast on a proposal including the changed ones",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the vote log of a proposal by its identifier, optionally of a single voter. The votes
+changed are kept in the log in the order they were cast.
+
+Example:
+$ %s query gov vote-history 1
+$ %s query gov vote-history 1 ex1cftp8q8g4aa65nw9s5trwexe77d9t6cr8ndu02
+`,
+				version.ClientName, version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+
+			var voterAddr sdk.AccAddress
+			if len(args) == 2 {
+				voterAddr, err = sdk.AccAddressFromBech32(args[1])
+				if err != nil {
+					return err
+				}
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryVoteParams(proposalID, voterAddr))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryVoteHistory), bz)
+			if err != nil {
+				return err
+			}
+
+			var records types.VoteRecords
+			cdc.MustUnmarshalJSON(res, &records)
+			return cliCtx.PrintOutput(records)
+		},
+	}
+}
+
 // Command to Get a specific deposit Information
 // getCmdQueryDeposit implements the query proposal deposit command.
 func getCmdQueryDeposit(queryRoute string, cdc *codec.Codec) *cobra.Command {
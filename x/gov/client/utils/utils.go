@@ -1,6 +1,10 @@
 package utils
 
-import "github.com/okex/exchain/x/gov/types"
+import (
+	"strings"
+
+	"github.com/okex/exchain/x/gov/types"
+)
 
 // NormalizeVoteOption - normalize user specified vote option
 func NormalizeVoteOption(option string) string {
@@ -22,6 +26,17 @@ func NormalizeVoteOption(option string) string {
 	}
 }
 
+// NormalizeWeightedVoteOptions - normalize the options of user specified weighted vote like "yes=0.6,no=0.4"
+func NormalizeWeightedVoteOptions(options string) string {
+	weightedOptions := strings.Split(options, ",")
+	for i, weightedOption := range weightedOptions {
+		fields := strings.Split(weightedOption, "=")
+		fields[0] = NormalizeVoteOption(strings.TrimSpace(fields[0]))
+		weightedOptions[i] = strings.Join(fields, "=")
+	}
+	return strings.Join(weightedOptions, ",")
+}
+
 //NormalizeProposalType - normalize user specified proposal type
 func NormalizeProposalType(proposalType string) string {
 	switch proposalType {
@@ -16,6 +16,7 @@ type GenesisState struct {
 	StartingProposalID uint64            `json:"starting_proposal_id" yaml:"starting_proposal_id"`
 	Deposits           Deposits          `json:"deposits" yaml:"deposits"`
 	Votes              Votes             `json:"votes" yaml:"votes"`
+	VoteHistory        VoteRecords       `json:"vote_history,omitempty" yaml:"vote_history,omitempty"`
 	Proposals          []Proposal        `json:"proposals" yaml:"proposals"`
 	WaitingProposals   map[string]uint64 `json:"waiting_proposals" yaml:"waiting_proposals"`
 	DepositParams      DepositParams     `json:"deposit_params" yaml:"deposit_params"`
@@ -115,6 +116,10 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, supplyKeeper keeper.SupplyKee
 		k.SetVote(ctx, vote.ProposalID, vote)
 	}
 
+	for _, record := range data.VoteHistory {
+		k.AppendVoteRecord(ctx, record)
+	}
+
 	for _, proposal := range data.Proposals {
 		switch proposal.Status {
 		case StatusDepositPeriod:
@@ -156,12 +161,16 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) GenesisState {
 
 	var proposalsDeposits Deposits
 	var proposalsVotes Votes
+	var voteHistory VoteRecords
 	for _, proposal := range proposals {
 		deposits := k.GetDeposits(ctx, proposal.ProposalID)
 		proposalsDeposits = append(proposalsDeposits, deposits...)
 
 		votes := k.GetVotes(ctx, proposal.ProposalID)
 		proposalsVotes = append(proposalsVotes, votes...)
+
+		records := k.GetVoteRecords(ctx, proposal.ProposalID)
+		voteHistory = append(voteHistory, records...)
 	}
 
 	waitingProposals := make(map[string]uint64)
@@ -174,6 +183,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) GenesisState {
 		StartingProposalID: startingProposalID,
 		Deposits:           proposalsDeposits,
 		Votes:              proposalsVotes,
+		VoteHistory:        voteHistory,
 		Proposals:          proposals,
 		WaitingProposals:   waitingProposals,
 		DepositParams:      depositParams,
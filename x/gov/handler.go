@@ -25,6 +25,9 @@ func NewHandler(keeper Keeper) sdk.Handler {
 		case MsgVote:
 			return handleMsgVote(ctx, keeper, msg)
 
+		case MsgWeightedVote:
+			return handleMsgWeightedVote(ctx, keeper, msg)
+
 		default:
 			errMsg := fmt.Sprintf("unrecognized gov message type: %T", msg)
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -113,6 +116,27 @@ func handleMsgVote(ctx sdk.Context, k keeper.Keeper, msg MsgVote) (*sdk.Result,
 		return sdk.EnvelopedErr{err}.Result()
 	}
 
+	return tallyAfterVote(ctx, k, proposal, msg.Voter)
+}
+
+func handleMsgWeightedVote(ctx sdk.Context, k keeper.Keeper, msg MsgWeightedVote) (*sdk.Result, error) {
+	proposal, ok := k.GetProposal(ctx, msg.ProposalID)
+	if !ok {
+		return sdk.EnvelopedErr{types.ErrUnknownProposal(msg.ProposalID)}.Result()
+	}
+
+	err, _ := k.AddWeightedVote(ctx, msg.ProposalID, msg.Voter, msg.Options)
+	if err != nil {
+		return sdk.EnvelopedErr{err}.Result()
+	}
+
+	return tallyAfterVote(ctx, k, proposal, msg.Voter)
+}
+
+// tallyAfterVote updates the tally results of the proposal after every vote and ends the voting period once the
+// result is determined
+func tallyAfterVote(ctx sdk.Context, k keeper.Keeper, proposal types.Proposal, voter sdk.AccAddress) (
+	*sdk.Result, error) {
 	status, distribute, tallyResults := keeper.Tally(ctx, k, proposal, false)
 	// update tally results after vote every time
 	proposal.FinalTallyResult = tallyResults
@@ -138,7 +162,7 @@ func handleMsgVote(ctx sdk.Context, k keeper.Keeper, msg MsgVote) (*sdk.Result,
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
-			sdk.NewAttribute(sdk.AttributeKeySender, msg.Voter.String()),
+			sdk.NewAttribute(sdk.AttributeKeySender, voter.String()),
 			sdk.NewAttribute(types.AttributeKeyProposalStatus, proposal.Status.String()),
 		),
 	)
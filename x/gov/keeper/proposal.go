@@ -77,6 +77,7 @@ func (keeper Keeper) DeleteProposal(ctx sdk.Context, proposalID uint64) {
 	}
 	keeper.RemoveFromInactiveProposalQueue(ctx, proposalID, proposal.DepositEndTime)
 	keeper.RemoveFromActiveProposalQueue(ctx, proposalID, proposal.VotingEndTime)
+	keeper.DeleteVoteRecords(ctx, proposalID)
 	store.Delete(types.ProposalKey(proposalID))
 }
 
@@ -29,6 +29,8 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryVote(ctx, path[1:], req, keeper)
 		case types.QueryTally:
 			return queryTally(ctx, path[1:], req, keeper)
+		case types.QueryVoteHistory:
+			return queryVoteHistory(ctx, path[1:], req, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown gov query endpoint")
 		}
@@ -181,6 +183,32 @@ func queryVotes(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Ke
 	return bz, nil
 }
 
+// nolint: unparam
+func queryVoteHistory(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryVoteParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, common.ErrUnMarshalJSONFailed(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	if _, ok := keeper.GetProposal(ctx, params.ProposalID); !ok {
+		return nil, types.ErrUnknownProposal(params.ProposalID)
+	}
+
+	records := types.VoteRecords{}
+	for _, record := range keeper.GetVoteRecords(ctx, params.ProposalID) {
+		if params.Voter.Empty() || record.Vote.Voter.Equals(params.Voter) {
+			records = append(records, record)
+		}
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, records)
+	if err != nil {
+		return nil, common.ErrMarshalJSONFailed(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
 // nolint: unparam
 func queryProposals(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
 	var params types.QueryProposalsParams
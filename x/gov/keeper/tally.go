@@ -8,15 +8,15 @@ import (
 
 // validatorGovInfo used for tallying
 type validatorGovInfo struct {
-	Address             sdk.ValAddress // address of the validator operator
-	BondedTokens        sdk.Int        // Power of a Validator
-	DelegatorShares     sdk.Dec        // Total outstanding delegator shares
-	DelegatorDeductions sdk.Dec        // Delegator deductions from validator's delegators voting independently
-	Vote                types.VoteOption     // Vote of the validator
+	Address             sdk.ValAddress            // address of the validator operator
+	BondedTokens        sdk.Int                   // Power of a Validator
+	DelegatorShares     sdk.Dec                   // Total outstanding delegator shares
+	DelegatorDeductions sdk.Dec                   // Delegator deductions from validator's delegators voting independently
+	Vote                types.WeightedVoteOptions // Vote of the validator
 }
 
 func newValidatorGovInfo(address sdk.ValAddress, bondedTokens sdk.Int, delegatorShares,
-	delegatorDeductions sdk.Dec, vote types.VoteOption) validatorGovInfo {
+	delegatorDeductions sdk.Dec, vote types.WeightedVoteOptions) validatorGovInfo {
 
 	return validatorGovInfo{
 		Address:             address,
@@ -43,7 +43,7 @@ func tallyDelegatorVotes(
 		// if delegator tally voting power
 		valAddrStr := sdk.ValAddress(vote.Voter).String()
 		if val, ok := currValidators[valAddrStr]; ok {
-			val.Vote = vote.Option
+			val.Vote = vote.WeightedOptions()
 			currValidators[valAddrStr] = val
 		} else {
 			// iterate over all delegations from voter, deduct from any delegated-to validators
@@ -60,9 +60,9 @@ func tallyDelegatorVotes(
 					votedPower := delegation.GetLastAddedShares()
 					// calculate vote power of delegator for voterPowerRate
 					if voteP != nil && vote.Voter.Equals(voteP.Voter) {
-						voterPower.Add(votedPower)
+						*voterPower = voterPower.Add(votedPower)
 					}
-					results[vote.Option] = results[vote.Option].Add(votedPower)
+					addWeightedVotedPower(results, vote.WeightedOptions(), votedPower)
 					*totalVotedPower = totalVotedPower.Add(votedPower)
 				}
 			}
@@ -78,7 +78,7 @@ func tallyValidatorVotes(
 	for key, val := range currValidators {
 		// calculate all vote power of current validators including delegated for voterPowerRate
 		*totalPower = totalPower.Add(val.DelegatorShares)
-		if val.Vote == types.OptionEmpty {
+		if len(val.Vote) == 0 {
 			continue
 		}
 
@@ -87,11 +87,18 @@ func tallyValidatorVotes(
 			// calculate vote power of validator after deduction for voterPowerRate
 			*voterPower = voterPower.Add(valValidVotedPower)
 		}
-		results[val.Vote] = results[val.Vote].Add(valValidVotedPower)
+		addWeightedVotedPower(results, val.Vote, valValidVotedPower)
 		*totalVotedPower = totalVotedPower.Add(valValidVotedPower)
 	}
 }
 
+// addWeightedVotedPower splits the voted power among the options of a vote by their weights
+func addWeightedVotedPower(results map[types.VoteOption]sdk.Dec, options types.WeightedVoteOptions, votedPower sdk.Dec) {
+	for _, option := range options {
+		results[option.Option] = results[option.Option].Add(votedPower.Mul(option.Weight))
+	}
+}
+
 func preTally(
 	ctx sdk.Context, keeper Keeper, proposal types.Proposal, voteP *types.Vote,
 ) (results map[types.VoteOption]sdk.Dec, totalVotedPower sdk.Dec, voterPowerRate sdk.Dec) {
@@ -113,7 +120,7 @@ func preTally(
 			validator.GetBondedTokens(),
 			validator.GetDelegatorShares(),
 			sdk.ZeroDec(),
-			types.OptionEmpty,
+			nil,
 		)
 
 		return false
@@ -313,3 +313,52 @@ func TestTallyDelegatorOverride(t *testing.T) {
 	require.Equal(t, types.StatusPassed, status)
 	require.Equal(t, expectedTallyResult, tallyResults)
 }
+
+func TestTallyWeightedVotes(t *testing.T) {
+	ctx, _, keeper, sk, _ := CreateTestInput(t, false, 100000)
+	ctx = ctx.WithBlockHeight(int64(sk.GetEpoch(ctx)))
+	ctx = ctx.WithBlockTime(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC))
+	stakingHandler := staking.NewHandler(sk)
+	valAddrs := make([]sdk.ValAddress, len(Addrs[:3]))
+	for i, addr := range Addrs[:3] {
+		valAddrs[i] = sdk.ValAddress(addr)
+	}
+	CreateValidators(t, stakingHandler, ctx, valAddrs, []int64{5, 5, 5})
+	staking.EndBlocker(ctx, sk)
+
+	coin, err := sdk.ParseDecCoin("10.0" + common.NativeToken)
+	require.Nil(t, err)
+	stakingHandler(ctx, staking.NewMsgDeposit(Addrs[3], coin))
+	stakingHandler(ctx, staking.NewMsgAddShares(Addrs[3], []sdk.ValAddress{sdk.ValAddress(Addrs[2])}))
+
+	content := types.NewTextProposal("Test", "description")
+	proposal, err := keeper.SubmitProposal(ctx, content)
+	require.Nil(t, err)
+	proposal.Status = types.StatusVotingPeriod
+	keeper.SetProposal(ctx, proposal)
+	proposalID := proposal.ProposalID
+
+	err, _ = keeper.AddVote(ctx, proposalID, Addrs[0], types.OptionYes)
+	require.Nil(t, err)
+	err, _ = keeper.AddVote(ctx, proposalID, Addrs[1], types.OptionNo)
+	require.Nil(t, err)
+	// the validator splits its own power, while its delegator overrides it with another split
+	err, _ = keeper.AddWeightedVote(ctx, proposalID, Addrs[2], types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(5, 1)),
+		types.NewWeightedVoteOption(types.OptionAbstain, sdk.NewDecWithPrec(5, 1)),
+	})
+	require.Nil(t, err)
+	err, _ = keeper.AddWeightedVote(ctx, proposalID, Addrs[3], types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(6, 1)),
+		types.NewWeightedVoteOption(types.OptionNo, sdk.NewDecWithPrec(4, 1)),
+	})
+	require.Nil(t, err)
+
+	// 3 validators with 1 power each and a delegator with 10 power on the third one
+	//  yes: 1 + 0.5 + 6, no: 1 + 4, abstain: 0.5
+	expectedTallyResult := newTallyResult(t, "13", "7.5", "0.5", "5", "0.0", "13")
+	status, dist, tallyResults := Tally(ctx, keeper, proposal, true)
+	require.False(t, dist)
+	require.Equal(t, types.StatusPassed, status)
+	require.True(t, tallyResults.Equals(expectedTallyResult))
+}
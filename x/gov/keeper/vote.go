@@ -10,22 +10,34 @@ import (
 func (keeper Keeper) AddVote(
 	ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, option types.VoteOption,
 ) (sdk.Error, string) {
-	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	if !types.ValidVoteOption(option) {
+		return types.ErrInvalidVote(option), ""
+	}
+
+	return keeper.addVote(ctx, types.NewVote(proposalID, voterAddr, option))
+}
+
+// AddWeightedVote adds a vote on a specific proposal which splits the voting power among the weighted options
+func (keeper Keeper) AddWeightedVote(
+	ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, options types.WeightedVoteOptions,
+) (sdk.Error, string) {
+	if !types.ValidWeightedVoteOptions(options) {
+		return types.ErrInvalidWeightedVote(options), ""
+	}
+
+	return keeper.addVote(ctx, types.NewWeightedVote(proposalID, voterAddr, options))
+}
+
+func (keeper Keeper) addVote(ctx sdk.Context, vote types.Vote) (sdk.Error, string) {
+	proposal, ok := keeper.GetProposal(ctx, vote.ProposalID)
 	if !ok {
-		return types.ErrUnknownProposal(proposalID), ""
+		return types.ErrUnknownProposal(vote.ProposalID), ""
 	}
 	if proposal.Status != types.StatusVotingPeriod {
 		return types.ErrInvalidateProposalStatus(), ""
 	}
 
-	if !types.ValidVoteOption(option) {
-		return types.ErrInvalidVote(option), ""
-	}
-
 	voteFeeStr := ""
-	vote := types.Vote{
-		ProposalID: proposalID, Voter: voterAddr, Option: option,
-	}
 	if keeper.ProposalHandlerRouter().HasRoute(proposal.ProposalRoute()) {
 		var err sdk.Error
 		voteFeeStr, err = keeper.ProposalHandlerRouter().GetRoute(proposal.ProposalRoute()).VoteHandler(ctx, proposal, vote)
@@ -34,13 +46,14 @@ func (keeper Keeper) AddVote(
 		}
 	}
 
-	keeper.SetVote(ctx, proposalID, vote)
+	keeper.SetVote(ctx, vote.ProposalID, vote)
+	keeper.AppendVoteRecord(ctx, types.NewVoteRecord(vote, ctx.BlockHeight(), ctx.BlockHeader().Time))
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeProposalVote,
-			sdk.NewAttribute(types.AttributeKeyOption, option.String()),
-			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyOption, vote.WeightedOptions().String()),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", vote.ProposalID)),
 		),
 	)
 
@@ -108,3 +121,50 @@ func (keeper Keeper) GetVotesIterator(ctx sdk.Context, proposalID uint64) sdk.It
 	store := ctx.KVStore(keeper.storeKey)
 	return sdk.KVStorePrefixIterator(store, types.VotesKey(proposalID))
 }
+
+// AppendVoteRecord appends a record to the vote log of a proposal
+func (keeper Keeper) AppendVoteRecord(ctx sdk.Context, record types.VoteRecord) {
+	store := ctx.KVStore(keeper.storeKey)
+	proposalID := record.Vote.ProposalID
+
+	// the index of the record follows the last one of the proposal
+	var index uint64
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.VoteRecordsKey(proposalID))
+	if iterator.Valid() {
+		_, lastIndex := types.SplitKeyVoteRecord(iterator.Key())
+		index = lastIndex + 1
+	}
+	iterator.Close()
+
+	bz := keeper.cdc.MustMarshalBinaryLengthPrefixed(record)
+	store.Set(types.VoteRecordKey(proposalID, index), bz)
+}
+
+// GetVoteRecords returns the vote log of a proposal in the order the votes were cast
+func (keeper Keeper) GetVoteRecords(ctx sdk.Context, proposalID uint64) (records types.VoteRecords) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.VoteRecordsKey(proposalID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.VoteRecord
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &record)
+		records = append(records, record)
+	}
+	return
+}
+
+// DeleteVoteRecords deletes the vote log of a proposal
+func (keeper Keeper) DeleteVoteRecords(ctx sdk.Context, proposalID uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.VoteRecordsKey(proposalID))
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
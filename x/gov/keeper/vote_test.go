@@ -2,9 +2,11 @@ package keeper
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/okex/exchain/x/gov/types"
@@ -161,3 +163,106 @@ func TestKeeper_DeleteVotes(t *testing.T) {
 	votes = keeper.GetVotes(ctx, proposalID)
 	require.Equal(t, 0, len(votes))
 }
+
+func TestKeeper_AddWeightedVote(t *testing.T) {
+	ctx, _, keeper, _, _ := CreateTestInput(t, false, 1000)
+
+	content := types.NewTextProposal("Test", "description")
+	proposal, err := keeper.SubmitProposal(ctx, content)
+	require.Nil(t, err)
+	proposalID := proposal.ProposalID
+	proposal.Status = types.StatusVotingPeriod
+	keeper.SetProposal(ctx, proposal)
+
+	// weights not summing up to 1
+	invalidOptions := types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(6, 1)),
+		types.NewWeightedVoteOption(types.OptionNo, sdk.NewDecWithPrec(3, 1)),
+	}
+	err, _ = keeper.AddWeightedVote(ctx, proposalID, Addrs[0], invalidOptions)
+	require.NotNil(t, err)
+
+	// duplicated options
+	invalidOptions = types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(5, 1)),
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(5, 1)),
+	}
+	err, _ = keeper.AddWeightedVote(ctx, proposalID, Addrs[0], invalidOptions)
+	require.NotNil(t, err)
+
+	options := types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(6, 1)),
+		types.NewWeightedVoteOption(types.OptionNo, sdk.NewDecWithPrec(4, 1)),
+	}
+	err, _ = keeper.AddWeightedVote(ctx, proposalID, Addrs[0], options)
+	require.Nil(t, err)
+	vote, found := keeper.GetVote(ctx, proposalID, Addrs[0])
+	require.True(t, found)
+	require.True(t, vote.Equals(types.NewWeightedVote(proposalID, Addrs[0], options)))
+	require.True(t, vote.WeightedOptions().Equals(options))
+
+	// a plain vote is a weighted vote with a single option of weight 1
+	err, _ = keeper.AddVote(ctx, proposalID, Addrs[1], types.OptionAbstain)
+	require.Nil(t, err)
+	vote, found = keeper.GetVote(ctx, proposalID, Addrs[1])
+	require.True(t, found)
+	require.True(t, vote.WeightedOptions().Equals(types.NewNonSplitVoteOption(types.OptionAbstain)))
+}
+
+func TestKeeper_VoteRecords(t *testing.T) {
+	ctx, _, keeper, _, _ := CreateTestInput(t, false, 1000)
+
+	content := types.NewTextProposal("Test", "description")
+	proposal, err := keeper.SubmitProposal(ctx, content)
+	require.Nil(t, err)
+	proposalID := proposal.ProposalID
+	proposal.Status = types.StatusVotingPeriod
+	keeper.SetProposal(ctx, proposal)
+
+	options := types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(6, 1)),
+		types.NewWeightedVoteOption(types.OptionNo, sdk.NewDecWithPrec(4, 1)),
+	}
+	err, _ = keeper.AddVote(ctx, proposalID, Addrs[0], types.OptionYes)
+	require.Nil(t, err)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	err, _ = keeper.AddWeightedVote(ctx, proposalID, Addrs[0], options)
+	require.Nil(t, err)
+	err, _ = keeper.AddVote(ctx, proposalID, Addrs[1], types.OptionNo)
+	require.Nil(t, err)
+
+	// the changed vote is kept in the records while only the latest one counts
+	records := keeper.GetVoteRecords(ctx, proposalID)
+	require.Equal(t, 3, len(records))
+	require.True(t, records[0].Vote.Equals(types.NewVote(proposalID, Addrs[0], types.OptionYes)))
+	require.True(t, records[1].Vote.Equals(types.NewWeightedVote(proposalID, Addrs[0], options)))
+	require.True(t, records[2].Vote.Equals(types.NewVote(proposalID, Addrs[1], types.OptionNo)))
+	require.Equal(t, records[0].Height+1, records[1].Height)
+	require.Equal(t, 2, len(keeper.GetVotes(ctx, proposalID)))
+
+	// the records survive the end of the proposal
+	keeper.DeleteVotes(ctx, proposalID)
+	require.Equal(t, 0, len(keeper.GetVotes(ctx, proposalID)))
+	require.Equal(t, records, keeper.GetVoteRecords(ctx, proposalID))
+
+	// and are queryable by voter
+	querier := NewQuerier(keeper)
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryVoteHistory}, "/"),
+		Data: keeper.cdc.MustMarshalJSON(types.NewQueryVoteParams(proposalID, Addrs[0])),
+	}
+	bz, err := querier(ctx, []string{types.QueryVoteHistory}, query)
+	require.Nil(t, err)
+	var queried types.VoteRecords
+	require.Nil(t, keeper.cdc.UnmarshalJSON(bz, &queried))
+	require.Equal(t, 2, len(queried))
+	require.True(t, queried[1].Vote.Equals(records[1].Vote))
+
+	// unknown proposal
+	query.Data = keeper.cdc.MustMarshalJSON(types.NewQueryVoteParams(proposalID+1, nil))
+	_, err = querier(ctx, []string{types.QueryVoteHistory}, query)
+	require.NotNil(t, err)
+
+	keeper.DeleteVoteRecords(ctx, proposalID)
+	require.Equal(t, 0, len(keeper.GetVoteRecords(ctx, proposalID)))
+}
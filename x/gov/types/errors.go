@@ -24,6 +24,7 @@ const (
 	CodeInvalidHeight            uint32 = BaseGovError + 10
 	CodeInvalidCoins             uint32 = BaseGovError + 11
 	CodeUnknownParamType         uint32 = BaseGovError + 12
+	CodeInvalidWeightedVote      uint32 = BaseGovError + 13
 )
 
 func ErrInvalidAddress(address string) sdk.Error {
@@ -80,3 +81,9 @@ func ErrInvalidCoins() sdk.Error {
 func ErrUnknownGovParamType() sdk.Error {
 	return sdkerrors.New(DefaultCodespace, CodeUnknownParamType, "unkonwn gov param type")
 }
+
+func ErrInvalidWeightedVote(options WeightedVoteOptions) sdk.Error {
+	return sdkerrors.New(DefaultCodespace, CodeInvalidWeightedVote,
+		fmt.Sprintf("'%s' is not a valid weighted vote, the weights of distinct valid options should sum up to 1",
+			options.String()))
+}
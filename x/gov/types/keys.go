@@ -39,6 +39,8 @@ const (
 // - 0x10<proposalID_Bytes><depositorAddr_Bytes>: Deposit
 //
 // - 0x20<proposalID_Bytes><voterAddr_Bytes>: Voter
+//
+// - 0x21<proposalID_Bytes><index_Bytes>: VoteRecord
 var (
 	ProposalsKeyPrefix          = []byte{0x00}
 	ActiveProposalQueuePrefix   = []byte{0x01}
@@ -47,7 +49,8 @@ var (
 
 	DepositsKeyPrefix = []byte{0x10}
 
-	VotesKeyPrefix = []byte{0x20}
+	VotesKeyPrefix       = []byte{0x20}
+	VoteRecordsKeyPrefix = []byte{0x21}
 
 	// PrefixWaitingProposalQueue defines the prefix of waiting proposal queue
 	PrefixWaitingProposalQueue = []byte{0x30}
@@ -141,6 +144,28 @@ func VoteKey(proposalID uint64, voterAddr sdk.AccAddress) []byte {
 	return append(VotesKey(proposalID), voterAddr.Bytes()...)
 }
 
+// VoteRecordsKey gets the first part of the vote records key based on the proposalID
+func VoteRecordsKey(proposalID uint64) []byte {
+	bz := make([]byte, 8)
+	binary.LittleEndian.PutUint64(bz, proposalID)
+	return append(VoteRecordsKeyPrefix, bz...)
+}
+
+// VoteRecordKey key of a specific vote record from the store, the records of a proposal are kept in the order of the
+// index
+func VoteRecordKey(proposalID, index uint64) []byte {
+	return append(VoteRecordsKey(proposalID), sdk.Uint64ToBigEndian(index)...)
+}
+
+// SplitKeyVoteRecord split the vote record key and returns the proposal id and the index of the record
+func SplitKeyVoteRecord(key []byte) (proposalID, index uint64) {
+	if len(key[1:]) != 16 {
+		panic(fmt.Sprintf("unexpected key length (%d ≠ 16)", len(key[1:])))
+	}
+
+	return binary.LittleEndian.Uint64(key[1:9]), binary.BigEndian.Uint64(key[9:])
+}
+
 // Split keys function; used for iterators
 
 // SplitProposalKey split the proposal key and returns the proposal id
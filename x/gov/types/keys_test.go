@@ -21,3 +21,11 @@ func TestSplitWaitingProposalQueueKey(t *testing.T) {
 	}()
 	proposalID, height = SplitWaitingProposalQueueKey(keyBytes[1:])
 }
+
+func TestSplitKeyVoteRecord(t *testing.T) {
+	var expectedProposalID uint64 = 1
+	var expectedIndex uint64 = 256
+	proposalID, index := SplitKeyVoteRecord(VoteRecordKey(expectedProposalID, expectedIndex))
+	require.Equal(t, expectedProposalID, proposalID)
+	require.Equal(t, expectedIndex, index)
+}
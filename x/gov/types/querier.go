@@ -15,6 +15,8 @@ const (
 	QueryVote      = "vote"
 	QueryTally     = "tally"
 
+	QueryVoteHistory = "vote_history"
+
 	ParamDeposit  = "deposit"
 	ParamVoting   = "voting"
 	ParamTallying = "tallying"
@@ -50,7 +52,9 @@ func NewQueryDepositParams(proposalID uint64, depositor sdk.AccAddress) QueryDep
 	}
 }
 
-// Params for query 'custom/gov/vote'
+// Params for queries:
+// - 'custom/gov/vote'
+// - 'custom/gov/vote_history', the records of all voters are returned if Voter is empty
 type QueryVoteParams struct {
 	ProposalID uint64
 	Voter      sdk.AccAddress
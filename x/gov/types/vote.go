@@ -3,23 +3,42 @@ package types
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
+	"time"
 
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 )
 
 // Vote
 type Vote struct {
-	ProposalID uint64         `json:"proposal_id" yaml:"proposal_id"` //  proposalID of the proposal
-	Voter      sdk.AccAddress `json:"voter" yaml:"voter"`             //  address of the voter
-	Option     VoteOption     `json:"option" yaml:"option"`           //  option from OptionSet chosen by the voter
+	ProposalID uint64              `json:"proposal_id" yaml:"proposal_id"`             //  proposalID of the proposal
+	Voter      sdk.AccAddress      `json:"voter" yaml:"voter"`                         //  address of the voter
+	Option     VoteOption          `json:"option" yaml:"option"`                       //  option from OptionSet chosen by the voter
+	Options    WeightedVoteOptions `json:"options,omitempty" yaml:"options,omitempty"` //  weighted options of a split vote
 }
 
 // NewVote creates a new Vote instance
 func NewVote(proposalID uint64, voter sdk.AccAddress, option VoteOption) Vote {
-	return Vote{proposalID, voter, option}
+	return Vote{ProposalID: proposalID, Voter: voter, Option: option}
+}
+
+// NewWeightedVote creates a new Vote instance which splits the voting power among the weighted options
+func NewWeightedVote(proposalID uint64, voter sdk.AccAddress, options WeightedVoteOptions) Vote {
+	return Vote{ProposalID: proposalID, Voter: voter, Option: OptionEmpty, Options: options}
+}
+
+// WeightedOptions returns the weighted options of the vote, a vote with a single option gives it the whole weight
+func (v Vote) WeightedOptions() WeightedVoteOptions {
+	if len(v.Options) != 0 {
+		return v.Options
+	}
+	return NewNonSplitVoteOption(v.Option)
 }
 
 func (v Vote) String() string {
+	if len(v.Options) != 0 {
+		return fmt.Sprintf("voter %s voted with options %s on proposal %d", v.Voter, v.Options, v.ProposalID)
+	}
 	return fmt.Sprintf("voter %s voted with option %s on proposal %d", v.Voter, v.Option, v.ProposalID)
 }
 
@@ -32,7 +51,7 @@ func (v Votes) String() string {
 	}
 	out := fmt.Sprintf("Votes for Proposal %d:", v[0].ProposalID)
 	for _, vot := range v {
-		out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.Option)
+		out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.WeightedOptions())
 	}
 	return out
 }
@@ -41,7 +60,8 @@ func (v Votes) String() string {
 func (v Vote) Equals(comp Vote) bool {
 	return v.Voter.Equals(comp.Voter) &&
 		v.ProposalID == comp.ProposalID &&
-		v.Option == comp.Option
+		v.Option == comp.Option &&
+		v.Options.Equals(comp.Options)
 }
 
 // Empty returns whether a vote is empty.
@@ -49,6 +69,130 @@ func (v Vote) Empty() bool {
 	return v.Equals(Vote{})
 }
 
+// VoteRecord is an entry of the vote log of a proposal, which keeps every vote cast including the changed ones
+type VoteRecord struct {
+	Vote   Vote      `json:"vote" yaml:"vote"`
+	Height int64     `json:"height" yaml:"height"` // height of the block the vote was cast in
+	Time   time.Time `json:"time" yaml:"time"`     // time of the block the vote was cast in
+}
+
+// NewVoteRecord creates a new VoteRecord instance
+func NewVoteRecord(vote Vote, height int64, time time.Time) VoteRecord {
+	return VoteRecord{
+		Vote:   vote,
+		Height: height,
+		Time:   time,
+	}
+}
+
+func (vr VoteRecord) String() string {
+	return fmt.Sprintf("%s at height %d (%s)", vr.Vote, vr.Height, vr.Time)
+}
+
+// VoteRecords is a collection of VoteRecord objects
+type VoteRecords []VoteRecord
+
+func (vrs VoteRecords) String() string {
+	if len(vrs) == 0 {
+		return "[]"
+	}
+	out := fmt.Sprintf("Vote History for Proposal %d:", vrs[0].Vote.ProposalID)
+	for _, vr := range vrs {
+		out += fmt.Sprintf("\n  %d: %s: %s", vr.Height, vr.Vote.Voter, vr.Vote.WeightedOptions())
+	}
+	return out
+}
+
+// WeightedVoteOption defines a vote option with the weight of the voting power given to it
+type WeightedVoteOption struct {
+	Option VoteOption `json:"option" yaml:"option"`
+	Weight sdk.Dec    `json:"weight" yaml:"weight"`
+}
+
+// NewWeightedVoteOption creates a new WeightedVoteOption instance
+func NewWeightedVoteOption(option VoteOption, weight sdk.Dec) WeightedVoteOption {
+	return WeightedVoteOption{
+		Option: option,
+		Weight: weight,
+	}
+}
+
+func (wvo WeightedVoteOption) String() string {
+	return fmt.Sprintf("%s:%s", wvo.Option, wvo.Weight)
+}
+
+// WeightedVoteOptions describes how the voting power of a vote is split among the options
+type WeightedVoteOptions []WeightedVoteOption
+
+// NewNonSplitVoteOption creates the weighted options giving the whole weight to a single option
+func NewNonSplitVoteOption(option VoteOption) WeightedVoteOptions {
+	return WeightedVoteOptions{NewWeightedVoteOption(option, sdk.OneDec())}
+}
+
+func (wvos WeightedVoteOptions) String() string {
+	strs := make([]string, len(wvos))
+	for i, wvo := range wvos {
+		strs[i] = wvo.String()
+	}
+	return strings.Join(strs, ",")
+}
+
+// Equals returns whether two weighted options are equal
+func (wvos WeightedVoteOptions) Equals(comp WeightedVoteOptions) bool {
+	if len(wvos) != len(comp) {
+		return false
+	}
+	for i := range wvos {
+		if wvos[i].Option != comp[i].Option || !wvos[i].Weight.Equal(comp[i].Weight) {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidWeightedVoteOptions returns true if every option is valid and given a positive weight only once, and the
+// weights sum up to one
+func ValidWeightedVoteOptions(options WeightedVoteOptions) bool {
+	if len(options) == 0 {
+		return false
+	}
+
+	totalWeight := sdk.ZeroDec()
+	usedOptions := make(map[VoteOption]bool, len(options))
+	for _, option := range options {
+		if !ValidVoteOption(option.Option) || usedOptions[option.Option] {
+			return false
+		}
+		if option.Weight.IsNil() || !option.Weight.IsPositive() || option.Weight.GT(sdk.OneDec()) {
+			return false
+		}
+		usedOptions[option.Option] = true
+		totalWeight = totalWeight.Add(option.Weight)
+	}
+	return totalWeight.Equal(sdk.OneDec())
+}
+
+// WeightedVoteOptionsFromString parses the weighted options from a string like "Yes=0.6,No=0.4"
+func WeightedVoteOptionsFromString(str string) (WeightedVoteOptions, error) {
+	var options WeightedVoteOptions
+	for _, optionStr := range strings.Split(strings.TrimSpace(str), ",") {
+		fields := strings.Split(optionStr, "=")
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("'%s' is not a valid weighted vote option, expected like Yes=0.6", optionStr)
+		}
+		option, err := VoteOptionFromString(strings.TrimSpace(fields[0]))
+		if err != nil {
+			return nil, err
+		}
+		weight, err := sdk.NewDecFromStr(strings.TrimSpace(fields[1]))
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, NewWeightedVoteOption(option, weight))
+	}
+	return options, nil
+}
+
 // VoteOption defines a vote option
 type VoteOption byte
 
@@ -117,6 +261,12 @@ func (vo *VoteOption) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
+	// the option of a weighted vote is left empty
+	if s == "" {
+		*vo = OptionEmpty
+		return nil
+	}
+
 	bz2, err := VoteOptionFromString(s)
 	if err != nil {
 		return err
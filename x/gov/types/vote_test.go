@@ -0,0 +1,40 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWeightedVoteOptionsFromString(t *testing.T) {
+	options, err := WeightedVoteOptionsFromString("Yes=0.6, No=0.4")
+	require.NoError(t, err)
+	require.True(t, ValidWeightedVoteOptions(options))
+	require.True(t, options.Equals(WeightedVoteOptions{
+		NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(6, 1)),
+		NewWeightedVoteOption(OptionNo, sdk.NewDecWithPrec(4, 1)),
+	}))
+
+	tests := []struct {
+		str   string
+		valid bool
+	}{
+		{"Yes=1", true},
+		{"Yes=0.5,Abstain=0.25,NoWithVeto=0.25", true},
+		{"Yes=0.6,No=0.3", false},
+		{"Yes=0.5,Yes=0.5", false},
+		{"Yes=1.5,No=-0.5", false},
+		{"Yes=0,No=1", false},
+	}
+	for _, tc := range tests {
+		options, err := WeightedVoteOptionsFromString(tc.str)
+		require.NoError(t, err, tc.str)
+		require.Equal(t, tc.valid, ValidWeightedVoteOptions(options), tc.str)
+	}
+
+	for _, str := range []string{"", "Yes", "Yes=0.6;No=0.4", "Maybe=1", "Yes=abc"} {
+		_, err := WeightedVoteOptionsFromString(str)
+		require.Error(t, err, str)
+	}
+}
@@ -30,6 +30,10 @@ type (
 	MsgNewOrders     = types.MsgNewOrders
 	MsgCancelOrders  = types.MsgCancelOrders
 	BlockMatchResult = types.BlockMatchResult
+	TriggerOrder     = types.TriggerOrder
+
+	MsgNewTriggerOrder    = types.MsgNewTriggerOrder
+	MsgCancelTriggerOrder = types.MsgCancelTriggerOrder
 )
 
 // nolint
@@ -42,4 +46,7 @@ var (
 	NewKeeper         = keeper.NewKeeper
 	NewQuerier        = keeper.NewQuerier
 	FormatOrderIDsKey = types.FormatOrderIDsKey
+
+	NewMsgNewTriggerOrder    = types.NewMsgNewTriggerOrder
+	NewMsgCancelTriggerOrder = types.NewMsgCancelTriggerOrder
 )
@@ -23,6 +23,7 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 
 	queryCmd.AddCommand(client.GetCommands(
 		GetCmdQueryOrder(queryRoute, cdc),
+		GetCmdQueryTriggerOrder(queryRoute, cdc),
 		GetCmdDepthBook(queryRoute, cdc),
 		GetCmdQueryStore(queryRoute, cdc),
 		GetCmdQueryParams(queryRoute, cdc),
@@ -55,6 +56,29 @@ func GetCmdQueryOrder(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+// GetCmdQueryTriggerOrder queries open trigger order info by triggerOrderID
+func GetCmdQueryTriggerOrder(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "trigger [trigger-order-id]",
+		Short: "Query an open trigger order",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			triggerOrderID := args[0]
+
+			res, _, err := cliCtx.QueryWithData(
+				fmt.Sprintf("custom/%s/%s/%s", queryRoute, types.QueryTriggerOrder, triggerOrderID),
+				nil)
+			if err != nil {
+				fmt.Printf("trigger order does not exist or has been closed - %s \n", triggerOrderID)
+				return nil
+			}
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+}
+
 // GetCmdDepthBook queries order book about a product
 func GetCmdDepthBook(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
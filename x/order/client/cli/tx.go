@@ -26,6 +26,8 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 	txCmd.AddCommand(client.PostCommands(
 		getCmdNewOrder(cdc),
 		getCmdCancelOrder(cdc),
+		getCmdNewTriggerOrder(cdc),
+		getCmdCancelTriggerOrder(cdc),
 	)...)
 
 	return txCmd
@@ -37,6 +39,8 @@ func getCmdNewOrder(cdc *codec.Codec) *cobra.Command {
 	var side string
 	var price string
 	var quantity string
+	var orderType string
+	var maxSlippage string
 	cmd := &cobra.Command{
 		Use:   "new",
 		Short: "place a new order",
@@ -53,7 +57,7 @@ func getCmdNewOrder(cdc *codec.Codec) *cobra.Command {
 				return errors.New("invalid param counts")
 			}
 
-			err := handleNewOrder(cmd, cdc, product, side, price, quantity)
+			err := handleNewOrder(cmd, cdc, product, side, price, quantity, orderType, maxSlippage)
 			return err
 
 		},
@@ -63,10 +67,17 @@ func getCmdNewOrder(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().StringVarP(&side, "side", "s", "", "BUY or SELL (default \"SELL\")")
 	cmd.Flags().StringVarP(&price, "price", "p", "", "The price of the order")
 	cmd.Flags().StringVarP(&quantity, "quantity", "q", "", "The quantity of the order")
+	cmd.Flags().StringVarP(&orderType, "type", "", "", "LIMIT, IOC, FOK, POST_ONLY or MARKET, applied to all the orders (default \"LIMIT\")")
+	cmd.Flags().StringVarP(&maxSlippage, "max-slippage", "", "", "The max slippage of market orders, for example \"0.05\"")
 	return cmd
 }
 
-func handleNewOrder(cmd *cobra.Command, cdc *codec.Codec, product string, side string, price string, quantity string) error {
+func handleNewOrder(cmd *cobra.Command, cdc *codec.Codec, product string, side string, price string, quantity string,
+	orderType string, maxSlippage string) error {
+	// limit orders leave the type empty
+	if orderType == types.OrderTypeLimit {
+		orderType = ""
+	}
 	var items []types.OrderItem
 	productArr := strings.Split(product, ",")
 	sideArr := strings.Split(side, ",")
@@ -96,10 +107,12 @@ func handleNewOrder(cmd *cobra.Command, cdc *codec.Codec, product string, side s
 			return errors.New(err.Error())
 		}
 		items = append(items, types.OrderItem{
-			Product:  product,
-			Side:     side,
-			Price:    price,
-			Quantity: quantity,
+			Product:     product,
+			Side:        side,
+			Price:       price,
+			Quantity:    quantity,
+			Type:        orderType,
+			MaxSlippage: maxSlippage,
 		})
 	}
 	inBuf := bufio.NewReader(cmd.InOrStdin())
@@ -131,3 +144,84 @@ func getCmdCancelOrder(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+func getCmdNewTriggerOrder(cdc *codec.Codec) *cobra.Command {
+	// new trigger order flags
+	var product string
+	var side string
+	var triggerType string
+	var triggerPrice string
+	var price string
+	var quantity string
+	var orderType string
+	var maxSlippage string
+	cmd := &cobra.Command{
+		Use:   "trigger",
+		Short: "place a stop-loss or take-profit trigger order",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(product) == 0 || len(side) == 0 || len(triggerType) == 0 || len(triggerPrice) == 0 ||
+				len(quantity) == 0 {
+				return errors.New("invalid param format")
+			}
+			// limit orders leave the type empty
+			if orderType == types.OrderTypeLimit {
+				orderType = ""
+			}
+			triggerPriceDec, err := sdk.NewDecFromStr(triggerPrice)
+			if err != nil {
+				return err
+			}
+			priceDec := sdk.ZeroDec()
+			if orderType != types.OrderTypeMarket {
+				if priceDec, err = sdk.NewDecFromStr(price); err != nil {
+					return err
+				}
+			}
+			quantityDec, err := sdk.NewDecFromStr(quantity)
+			if err != nil {
+				return err
+			}
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authtxb.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			msg := types.NewMsgNewTriggerOrder(cliCtx.GetFromAddress(), triggerType, triggerPriceDec, types.OrderItem{
+				Product:     product,
+				Side:        side,
+				Price:       priceDec,
+				Quantity:    quantityDec,
+				Type:        orderType,
+				MaxSlippage: maxSlippage,
+			})
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().StringVarP(&product, "product", "", "", "Trading pair in full name of the tokens: ${baseAssetSymbol}_${quoteAssetSymbol}, for example \"mycoin_okt\".")
+	cmd.Flags().StringVarP(&side, "side", "s", "", "BUY or SELL")
+	cmd.Flags().StringVarP(&triggerType, "trigger-type", "", "", "STOP_LOSS or TAKE_PROFIT")
+	cmd.Flags().StringVarP(&triggerPrice, "trigger-price", "", "", "The last price that triggers the order")
+	cmd.Flags().StringVarP(&price, "price", "p", "", "The price of the triggered order, omitted for market orders")
+	cmd.Flags().StringVarP(&quantity, "quantity", "q", "", "The quantity of the triggered order")
+	cmd.Flags().StringVarP(&orderType, "type", "", "", "The type of the triggered order, for example LIMIT or MARKET (default \"LIMIT\")")
+	cmd.Flags().StringVarP(&maxSlippage, "max-slippage", "", "", "The max slippage of the triggered market order, for example \"0.05\"")
+	return cmd
+}
+
+func getCmdCancelTriggerOrder(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel-trigger [trigger-order-id]",
+		Short: "cancel trigger order",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authtxb.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			msg := types.NewMsgCancelTriggerOrder(cliCtx.GetFromAddress(), args[0])
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
@@ -11,14 +11,19 @@ import (
 )
 
 // EndBlocker called every block
-// 1. execute matching engine
-// 2. flush cache
+// 1. convert triggered trigger orders into orders
+// 2. execute matching engines
+// 3. flush cache
 func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 
 	seq := perf.GetPerf().OnEndBlockEnter(ctx, types.ModuleName)
 	defer perf.GetPerf().OnEndBlockExit(ctx, types.ModuleName, seq)
 
-	match.GetEngine().Run(ctx, keeper)
+	triggerOrders(ctx, keeper)
+
+	for _, engine := range match.GetEngines() {
+		engine.Run(ctx, keeper)
+	}
 
 	// flush cache at the end
 	keeper.Cache2Disk(ctx)
@@ -41,3 +46,74 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 	message += tailmsg("PartialFillNum", ret.PartialFillNum)
 	perf.GetPerf().EnqueueMsg(message)
 }
+
+// nolint
+const (
+	triggerOrderStatusTriggered = "triggered"
+	triggerOrderStatusFailed    = "failed"
+	triggerOrderStatusExpired   = "expired"
+)
+
+// triggerOrders checks open trigger orders against the last match price of their products, the triggered
+// ones are converted into orders matched in this block, and the ones out of their expire blocks are expired.
+// Only the products with open trigger orders are checked, and only the expired and triggered orders are read
+// through the indexes of the trigger orders
+func triggerOrders(ctx sdk.Context, keeper keeper.Keeper) {
+	logger := ctx.Logger().With("module", "order")
+	for _, triggerOrder := range keeper.GetExpiredTriggerOrders(ctx, ctx.BlockHeight()) {
+		if keeper.IsProductLocked(ctx, triggerOrder.Product) {
+			continue
+		}
+		keeper.ExpireTriggerOrder(ctx, triggerOrder)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeTriggerOrder,
+			sdk.NewAttribute(types.AttributeKeyTriggerOrderID, triggerOrder.TriggerOrderID),
+			sdk.NewAttribute(types.AttributeKeyStatus, triggerOrderStatusExpired)))
+	}
+
+	for _, product := range keeper.GetTriggerOrderProducts(ctx) {
+		if keeper.IsProductLocked(ctx, product) {
+			continue
+		}
+		tokenPair := keeper.GetDexKeeper().GetTokenPair(ctx, product)
+		if tokenPair == nil {
+			continue
+		}
+		for _, triggerOrder := range keeper.GetTriggeredOrders(ctx, product, keeper.GetLastPrice(ctx, product)) {
+			event := sdk.NewEvent(types.EventTypeTriggerOrder,
+				sdk.NewAttribute(types.AttributeKeyTriggerOrderID, triggerOrder.TriggerOrderID))
+			keeper.CloseTriggeredOrder(ctx, triggerOrder)
+			order, err := placeTriggeredOrder(ctx, keeper, triggerOrder, tokenPair.MaxPriceDigit)
+			if err != nil {
+				logger.Info(fmt.Sprintf("failed to place the order of trigger order(%s): %v",
+					triggerOrder.TriggerOrderID, err))
+				ctx.EventManager().EmitEvent(event.AppendAttributes(
+					sdk.NewAttribute(types.AttributeKeyStatus, triggerOrderStatusFailed)))
+				continue
+			}
+			ctx.EventManager().EmitEvent(event.AppendAttributes(
+				sdk.NewAttribute(types.AttributeKeyStatus, triggerOrderStatusTriggered),
+				sdk.NewAttribute(types.AttributeKeyOrderID, order.OrderID)))
+		}
+	}
+	keeper.DropBlockTriggerOrderNum(ctx)
+}
+
+// placeTriggeredOrder places the order a trigger order is converted into, it is checked like a new order after its
+// price is capped at the one the trigger order locks coins at
+func placeTriggeredOrder(ctx sdk.Context, keeper keeper.Keeper, triggerOrder *types.TriggerOrder,
+	priceDigit int64) (*types.Order, error) {
+	cacheCtx, writeCache := ctx.CacheContext()
+	msg := getMsgFromOrderItem(cacheCtx, keeper, triggerOrder.Sender, triggerOrder.GetOrderItem())
+	msg.Price = triggerOrder.CapPrice(msg.Price, priceDigit)
+	if err := checkOrderNewMsg(cacheCtx, keeper, msg); err != nil {
+		return nil, err
+	}
+
+	order := getOrderFromMsg(cacheCtx, keeper, msg, "1")
+	order.TxHash = triggerOrder.TxHash
+	if err := keeper.PlaceOrder(cacheCtx, order); err != nil {
+		return nil, err
+	}
+	writeCache()
+	return order, nil
+}
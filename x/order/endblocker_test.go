@@ -697,3 +697,85 @@ func TestEndBlocker(t *testing.T) {
 		fmt.Println(k.GetOrder(ctx, types.FormatOrderID(blockHeight, 1)))
 	}
 }
+
+func TestEndBlockerTriggerOrders(t *testing.T) {
+	common.InitConfig()
+	mapp, addrKeysSlice := getMockApp(t, 2)
+	k := mapp.orderKeeper
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 2}})
+
+	var startHeight int64 = 10
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{}).WithBlockHeight(startHeight)
+	mapp.supplyKeeper.SetSupply(ctx, supply.NewSupply(mapp.TotalCoinsSupply))
+
+	feeParams := types.DefaultTestParams()
+	feeParams.OrderExpireBlocks = 5
+	mapp.orderKeeper.SetParams(ctx, &feeParams)
+
+	tokenPair := dex.GetBuiltInTokenPair()
+	err := mapp.dexKeeper.SaveTokenPair(ctx, tokenPair)
+	require.Nil(t, err)
+	k.SetLastPrice(ctx, types.TestTokenPair, sdk.MustNewDecFromStr("10.0"))
+
+	// trigger orders whose trigger prices are already reached are refused
+	msg := types.NewMsgNewTriggerOrder(addrKeysSlice[0].Address, types.TriggerTypeStopLoss,
+		sdk.MustNewDecFromStr("11.0"), types.NewOrderItem(types.TestTokenPair, types.SellOrder, "11.0", "1.0"))
+	require.Error(t, checkTriggerOrderMsg(ctx, k, msg))
+
+	// mock trigger orders
+	msgs := []types.MsgNewTriggerOrder{
+		types.NewMsgNewTriggerOrder(addrKeysSlice[0].Address, types.TriggerTypeStopLoss,
+			sdk.MustNewDecFromStr("9.0"), types.NewOrderItem(types.TestTokenPair, types.SellOrder, "8.9", "1.0")),
+		types.NewMsgNewTriggerOrder(addrKeysSlice[1].Address, types.TriggerTypeTakeProfit,
+			sdk.MustNewDecFromStr("8.0"), types.NewOrderItem(types.TestTokenPair, types.BuyOrder, "8.0", "1.0")),
+		types.NewMsgNewTriggerOrder(addrKeysSlice[0].Address, types.TriggerTypeStopLoss,
+			sdk.MustNewDecFromStr("11.0"), types.OrderItem{Product: types.TestTokenPair, Side: types.BuyOrder,
+				Price: sdk.ZeroDec(), Quantity: sdk.MustNewDecFromStr("1.0"), Type: types.OrderTypeMarket,
+				MaxSlippage: "0.1"}),
+	}
+	triggerOrders := make([]*types.TriggerOrder, len(msgs))
+	for i, msg := range msgs {
+		require.NoError(t, checkTriggerOrderMsg(ctx, k, msg))
+		triggerOrders[i] = types.NewTriggerOrder("hash"+strconv.Itoa(i), msg, 0,
+			feeParams.OrderExpireBlocks, feeParams.FeePerBlock)
+		require.NoError(t, k.PlaceTriggerOrder(ctx, triggerOrders[i]))
+	}
+
+	// nothing is triggered at the last price
+	EndBlocker(ctx, k)
+	require.Equal(t, 3, len(k.GetTriggerOrders(ctx)))
+	require.EqualValues(t, 0, k.GetBlockTriggerOrderNum(ctx))
+
+	// the stop-loss order is triggered when the price falls
+	ctx = ctx.WithBlockHeight(startHeight + 1)
+	k.SetLastPrice(ctx, types.TestTokenPair, sdk.MustNewDecFromStr("9.0"))
+	EndBlocker(ctx, k)
+	require.Nil(t, k.GetTriggerOrder(ctx, triggerOrders[0].TriggerOrderID))
+	order := k.GetOrder(ctx, types.FormatOrderID(startHeight+1, 1))
+	require.NotNil(t, order)
+	require.EqualValues(t, types.OrderStatusOpen, order.Status)
+	require.Equal(t, triggerOrders[0].TxHash, order.TxHash)
+	require.Equal(t, sdk.MustNewDecFromStr("8.9"), order.Price)
+	require.Equal(t, 1, len(k.GetDepthBookCopy(types.TestTokenPair).Items))
+
+	// the market buy order jumping over its trigger price is capped at the price its coins are locked at
+	ctx = ctx.WithBlockHeight(startHeight + 2)
+	k.SetLastPrice(ctx, types.TestTokenPair, sdk.MustNewDecFromStr("15.0"))
+	EndBlocker(ctx, k)
+	require.Nil(t, k.GetTriggerOrder(ctx, triggerOrders[2].TriggerOrderID))
+	order = k.GetOrder(ctx, types.FormatOrderID(startHeight+2, 1))
+	require.NotNil(t, order)
+	require.Equal(t, sdk.MustNewDecFromStr("12.1"), order.Price)
+
+	// the take-profit order is expired
+	ctx = ctx.WithBlockHeight(startHeight + feeParams.OrderExpireBlocks)
+	EndBlocker(ctx, k)
+	require.Equal(t, 0, len(k.GetTriggerOrders(ctx)))
+	acc := mapp.AccountKeeper.GetAccount(ctx, addrKeysSlice[1].Address)
+	expectCoins := sdk.SysCoins{
+		// 100 - 0.000005
+		sdk.NewDecCoinFromDec(common.NativeToken, sdk.MustNewDecFromStr("99.999995")),
+		sdk.NewDecCoinFromDec(common.TestToken, sdk.MustNewDecFromStr("100")),
+	}
+	require.EqualValues(t, expectCoins.String(), acc.GetCoins().String())
+}
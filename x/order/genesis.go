@@ -10,8 +10,10 @@ import (
 
 // GenesisState - all order state that must be provided at genesis
 type GenesisState struct {
-	Params     types.Params   `json:"params"`
-	OpenOrders []*types.Order `json:"open_orders"`
+	Params        types.Params          `json:"params"`
+	OpenOrders    []*types.Order        `json:"open_orders"`
+	TradeVolumes  []types.TradeVolume   `json:"trade_volumes,omitempty"`
+	TriggerOrders []*types.TriggerOrder `json:"trigger_orders,omitempty"`
 }
 
 // DefaultGenesisState - default GenesisState used by Cosmos Hub
@@ -54,6 +56,18 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data GenesisState) {
 	if len(data.OpenOrders) > 0 {
 		keeper.Cache2Disk(ctx)
 	}
+
+	for _, tradeVolume := range data.TradeVolumes {
+		keeper.SetTradeVolume(ctx, tradeVolume)
+	}
+
+	// the coins locked by the trigger orders are imported by the token module, the indexes are rebuilt with them
+	for _, triggerOrder := range data.TriggerOrders {
+		if triggerOrder == nil {
+			panic("the nil pointer is not expected")
+		}
+		keeper.SetTriggerOrder(ctx, triggerOrder)
+	}
 }
 
 // ExportGenesis writes the current store values
@@ -109,8 +123,16 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) (data GenesisState) {
 		}
 	}
 
+	var tradeVolumes []types.TradeVolume
+	keeper.IterateTradeVolumes(ctx, func(tradeVolume types.TradeVolume) bool {
+		tradeVolumes = append(tradeVolumes, tradeVolume)
+		return false
+	})
+
 	return GenesisState{
-		Params:     *params,
-		OpenOrders: openOrders,
+		Params:        *params,
+		OpenOrders:    openOrders,
+		TradeVolumes:  tradeVolumes,
+		TriggerOrders: keeper.GetTriggerOrders(ctx),
 	}
 }
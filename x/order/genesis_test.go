@@ -148,3 +148,38 @@ func TestExportGenesis(t *testing.T) {
 	// 0x20
 	require.Equal(t, int64(2), newOrderKeeper.GetStoreOrderNum(newCtx))
 }
+
+func TestExportGenesisTriggerOrders(t *testing.T) {
+	testInput := keeper.CreateTestInput(t)
+	ctx := testInput.Ctx.WithBlockHeight(10)
+	orderKeeper := testInput.OrderKeeper
+
+	tokenPair := dex.GetBuiltInTokenPair()
+	err := testInput.DexKeeper.SaveTokenPair(ctx, tokenPair)
+	require.NoError(t, err)
+
+	params := orderKeeper.GetParams(ctx)
+	msg := types.NewMsgNewTriggerOrder(testInput.TestAddrs[0], types.TriggerTypeStopLoss, sdk.MustNewDecFromStr("9.0"),
+		types.NewOrderItem(types.TestTokenPair, types.SellOrder, "9.0", "1.0"))
+	triggerOrder := types.NewTriggerOrder("txHash", msg, time.Now().Unix(), params.OrderExpireBlocks, params.FeePerBlock)
+	require.NoError(t, orderKeeper.PlaceTriggerOrder(ctx, triggerOrder))
+
+	exportGenesis := ExportGenesis(ctx, orderKeeper)
+	require.Equal(t, []*types.TriggerOrder{triggerOrder}, exportGenesis.TriggerOrders)
+
+	newTestInput := keeper.CreateTestInput(t)
+	newCtx := newTestInput.Ctx.WithBlockHeight(10)
+	newOrderKeeper := newTestInput.OrderKeeper
+	err = newTestInput.DexKeeper.SaveTokenPair(newCtx, tokenPair)
+	require.NoError(t, err)
+	InitGenesis(newCtx, newOrderKeeper, exportGenesis)
+
+	// the order is imported with its price and expire indexes
+	require.Equal(t, triggerOrder, newOrderKeeper.GetTriggerOrder(newCtx, triggerOrder.TriggerOrderID))
+	require.Equal(t, []*types.TriggerOrder{triggerOrder},
+		newOrderKeeper.GetTriggeredOrders(newCtx, types.TestTokenPair, sdk.MustNewDecFromStr("8.0")))
+	require.Equal(t, []*types.TriggerOrder{triggerOrder},
+		newOrderKeeper.GetExpiredTriggerOrders(newCtx, triggerOrder.GetExpireHeight()))
+
+	require.Equal(t, exportGenesis, ExportGenesis(newCtx, newOrderKeeper))
+}
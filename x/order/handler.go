@@ -23,6 +23,10 @@ func CalculateGas(msg sdk.Msg, params *types.Params) (gas uint64) {
 		gas = msg.CalculateGas(params.NewOrderMsgGasUnit)
 	case types.MsgCancelOrders:
 		gas = msg.CalculateGas(params.CancelOrderMsgGasUnit)
+	case types.MsgNewTriggerOrder:
+		gas = params.NewOrderMsgGasUnit
+	case types.MsgCancelTriggerOrder:
+		gas = params.CancelOrderMsgGasUnit
 	default:
 		gas = math.MaxUint64
 	}
@@ -65,6 +69,16 @@ func NewOrderHandler(keeper keeper.Keeper) sdk.Handler {
 			handlerFun = func() (*sdk.Result, error) {
 				return handleMsgCancelOrders(ctx, keeper, msg, logger)
 			}
+		case types.MsgNewTriggerOrder:
+			name = "handleMsgNewTriggerOrder"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgNewTriggerOrder(ctx, keeper, msg, logger)
+			}
+		case types.MsgCancelTriggerOrder:
+			name = "handleMsgCancelTriggerOrder"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgCancelTriggerOrder(ctx, keeper, msg, logger)
+			}
 		default:
 			errMsg := fmt.Sprintf("Invalid msg type: %v", msg.Type())
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -108,14 +122,52 @@ func checkOrderNewMsg(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgNewOrd
 	if msg.Quantity.LT(tokenPair.MinQuantity) {
 		return types.ErrMsgQuantityLessThan(tokenPair.MinQuantity.String())
 	}
+
+	// the price of market orders may be rounded to zero
+	if !msg.Price.IsPositive() {
+		return types.ErrOrderItemPriceOrQuantityIsNotPositive()
+	}
+	if msg.Type == types.OrderTypePostOnly && keeper.IsOrderCrossingDepthBook(msg.Product, msg.Side, msg.Price) {
+		return types.ErrPostOnlyOrderWouldTake(msg.Product)
+	}
 	return nil
 }
 
+// getMsgFromOrderItem converts an order item to MsgNewOrder, market orders are priced by the last price
+// of the product and the max slippage
+func getMsgFromOrderItem(ctx sdk.Context, k keeper.Keeper, sender sdk.AccAddress, item types.OrderItem) MsgNewOrder {
+	msg := MsgNewOrder{
+		Sender:      sender,
+		Product:     item.Product,
+		Side:        item.Side,
+		Price:       item.Price,
+		Quantity:    item.Quantity,
+		Type:        item.Type,
+		MaxSlippage: item.MaxSlippage,
+	}
+	if msg.Type != types.OrderTypeMarket {
+		return msg
+	}
+
+	msg.Price = sdk.ZeroDec()
+	tokenPair := k.GetDexKeeper().GetTokenPair(ctx, msg.Product)
+	maxSlippage, err := sdk.NewDecFromStr(msg.MaxSlippage)
+	if tokenPair == nil || err != nil {
+		return msg
+	}
+	ratio := sdk.OneDec().Add(maxSlippage)
+	if msg.Side == types.SellOrder {
+		ratio = sdk.OneDec().Sub(maxSlippage)
+	}
+	msg.Price = k.GetLastPrice(ctx, msg.Product).Mul(ratio).RoundDecimal(tokenPair.MaxPriceDigit)
+	return msg
+}
+
 func getOrderFromMsg(ctx sdk.Context, k keeper.Keeper, msg types.MsgNewOrder, ratio string) *types.Order {
 	feeParams := k.GetParams(ctx)
 	feePerBlockAmount := feeParams.FeePerBlock.Amount.Mul(sdk.MustNewDecFromStr(ratio))
 	feePerBlock := sdk.NewDecCoinFromDec(feeParams.FeePerBlock.Denom, feePerBlockAmount)
-	return types.NewOrder(
+	order := types.NewOrder(
 		fmt.Sprintf("%X", tmhash.Sum(ctx.TxBytes())),
 		msg.Sender,
 		msg.Product,
@@ -126,6 +178,10 @@ func getOrderFromMsg(ctx sdk.Context, k keeper.Keeper, msg types.MsgNewOrder, ra
 		feeParams.OrderExpireBlocks,
 		feePerBlock,
 	)
+	if msg.Type != types.OrderTypeLimit {
+		order.Type = msg.Type
+	}
+	return order
 }
 
 func handleNewOrder(ctx sdk.Context, k Keeper, sender sdk.AccAddress,
@@ -133,13 +189,7 @@ func handleNewOrder(ctx sdk.Context, k Keeper, sender sdk.AccAddress,
 
 	cacheItem := ctx.MultiStore().CacheMultiStore()
 	ctxItem := ctx.WithMultiStore(cacheItem)
-	msg := MsgNewOrder{
-		Sender:   sender,
-		Product:  item.Product,
-		Side:     item.Side,
-		Price:    item.Price,
-		Quantity: item.Quantity,
-	}
+	msg := getMsgFromOrderItem(ctxItem, k, sender, item)
 	order := getOrderFromMsg(ctxItem, k, msg, ratio)
 	err := checkOrderNewMsg(ctxItem, k, msg)
 
@@ -158,11 +208,11 @@ func handleNewOrder(ctx sdk.Context, k Keeper, sender sdk.AccAddress,
 
 	if err == nil {
 		logger.Debug(fmt.Sprintf("BlockHeight<%d>, handler<%s>\n"+
-			"    msg<Product:%s,Sender:%s,Price:%s,Quantity:%s,Side:%s>\n"+
+			"    msg<Product:%s,Sender:%s,Price:%s,Quantity:%s,Side:%s,Type:%s>\n"+
 			"    TxHash<%s>, Status<%s>\n"+
 			"    result<The User have created an order {ID:%s,RemainQuantity:%s,Status:%s} >\n",
 			ctx.BlockHeight(), "handleMsgNewOrder",
-			msg.Product, msg.Sender, msg.Price.String(), msg.Quantity.String(), msg.Side,
+			msg.Product, msg.Sender, msg.Price.String(), msg.Quantity.String(), msg.Side, order.GetType(),
 			order.TxHash, types.OrderStatus(types.OrderStatusOpen),
 			order.OrderID, order.RemainQuantity.String(), types.OrderStatus(order.Status)))
 	} else {
@@ -216,13 +266,7 @@ func ValidateMsgNewOrders(ctx sdk.Context, k keeper.Keeper, msg types.MsgNewOrde
 	}
 
 	for _, item := range msg.OrderItems {
-		msg := MsgNewOrder{
-			Sender:   msg.Sender,
-			Product:  item.Product,
-			Side:     item.Side,
-			Price:    item.Price,
-			Quantity: item.Quantity,
-		}
+		msg := getMsgFromOrderItem(ctx, k, msg.Sender, item)
 		err := checkOrderNewMsg(ctx, k, msg)
 		if err != nil {
 			return nil, err
@@ -344,3 +388,94 @@ func ValidateMsgCancelOrders(ctx sdk.Context, keeper keeper.Keeper, msg types.Ms
 
 	return nil
 }
+
+// checkTriggerOrderMsg checks the order to place once triggered like a new order, and refuses the trigger
+// order if its trigger price is already reached
+func checkTriggerOrderMsg(ctx sdk.Context, k keeper.Keeper, msg types.MsgNewTriggerOrder) error {
+	orderMsg := MsgNewOrder{
+		Sender:   msg.Sender,
+		Product:  msg.Product,
+		Side:     msg.Side,
+		Price:    msg.Price,
+		Quantity: msg.Quantity,
+	}
+	// the price of market orders is unknown until triggered
+	if msg.OrderType == types.OrderTypeMarket {
+		orderMsg.Price = msg.TriggerPrice
+	}
+	if err := checkOrderNewMsg(ctx, k, orderMsg); err != nil {
+		return err
+	}
+
+	priceDigit := k.GetDexKeeper().GetTokenPair(ctx, msg.Product).MaxPriceDigit
+	if !msg.TriggerPrice.RoundDecimal(priceDigit).Equal(msg.TriggerPrice) {
+		return types.ErrPriceOverAccuracy(msg.TriggerPrice, priceDigit)
+	}
+	lastPrice := k.GetLastPrice(ctx, msg.Product)
+	order := types.TriggerOrder{Side: msg.Side, TriggerType: msg.TriggerType, TriggerPrice: msg.TriggerPrice}
+	if order.IsTriggered(lastPrice) {
+		return types.ErrTriggerConditionIsMet(msg.TriggerPrice, lastPrice)
+	}
+	return nil
+}
+
+func handleMsgNewTriggerOrder(ctx sdk.Context, k Keeper, msg types.MsgNewTriggerOrder,
+	logger log.Logger) (*sdk.Result, error) {
+	if err := checkTriggerOrderMsg(ctx, k, msg); err != nil {
+		return nil, err
+	}
+
+	feeParams := k.GetParams(ctx)
+	order := types.NewTriggerOrder(
+		fmt.Sprintf("%X", tmhash.Sum(ctx.TxBytes())),
+		msg,
+		ctx.BlockHeader().Time.Unix(),
+		feeParams.OrderExpireBlocks,
+		feeParams.FeePerBlock,
+	)
+	if err := k.PlaceTriggerOrder(ctx, order); err != nil {
+		return common.ErrInsufficientCoins(DefaultParamspace, err.Error()).Result()
+	}
+
+	logger.Debug(fmt.Sprintf("BlockHeight<%d>, handler<%s>\n"+
+		"    msg<Product:%s,Sender:%s,TriggerType:%s,TriggerPrice:%s,Quantity:%s,Side:%s>\n"+
+		"    result<The User have created a trigger order {ID:%s} >\n",
+		ctx.BlockHeight(), "handleMsgNewTriggerOrder",
+		msg.Product, msg.Sender, msg.TriggerType, msg.TriggerPrice.String(), msg.Quantity.String(), msg.Side,
+		order.TriggerOrderID))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyTriggerOrderID, order.TriggerOrderID),
+	))
+	return &sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
+func handleMsgCancelTriggerOrder(ctx sdk.Context, k Keeper, msg types.MsgCancelTriggerOrder,
+	logger log.Logger) (*sdk.Result, error) {
+	order := k.GetTriggerOrder(ctx, msg.TriggerOrderID)
+	if order == nil {
+		return types.ErrTriggerOrderIsNotExist(msg.TriggerOrderID).Result()
+	}
+	if !order.Sender.Equals(msg.Sender) {
+		return types.ErrNotOrderOwner(msg.TriggerOrderID).Result()
+	}
+
+	fee := k.CancelTriggerOrder(ctx, order)
+	logger.Debug(fmt.Sprintf("BlockHeight<%d>, handler<%s>\n"+
+		"    msg<Sender:%s,ID:%s>\n"+
+		"    result<The User have canceled a trigger order {ID:%s} >\n",
+		ctx.BlockHeight(), "handleMsgCancelTriggerOrder",
+		msg.Sender, msg.TriggerOrderID, msg.TriggerOrderID))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyTriggerOrderID, order.TriggerOrderID),
+		sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+	))
+	return &sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
@@ -195,6 +195,49 @@ func TestValidateMsgNewOrder(t *testing.T) {
 	require.NotNil(t, err)
 }
 
+func TestValidateMsgNewOrderTypes(t *testing.T) {
+	common.InitConfig()
+	mapp, addrKeysSlice := getMockApp(t, 1)
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 2}})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{}).WithBlockHeight(10)
+	keeper := mapp.orderKeeper
+	feeParams := types.DefaultTestParams()
+	keeper.SetParams(ctx, &feeParams)
+
+	tokenPair := dex.GetBuiltInTokenPair()
+	err := mapp.dexKeeper.SaveTokenPair(ctx, tokenPair)
+	require.Nil(t, err)
+	keeper.SetLastPrice(ctx, types.TestTokenPair, sdk.MustNewDecFromStr("10.0"))
+
+	// market orders are priced by the last price and the max slippage
+	item := types.NewOrderItem(types.TestTokenPair, types.BuyOrder, "0", "1.0")
+	item.Type = types.OrderTypeMarket
+	item.MaxSlippage = "0.05"
+	msg := getMsgFromOrderItem(ctx, keeper, addrKeysSlice[0].Address, item)
+	require.Equal(t, sdk.MustNewDecFromStr("10.5"), msg.Price)
+	_, err = ValidateMsgNewOrders(ctx, keeper, types.NewMsgNewOrders(addrKeysSlice[0].Address, []types.OrderItem{item}))
+	require.Nil(t, err)
+
+	item.Side = types.SellOrder
+	msg = getMsgFromOrderItem(ctx, keeper, addrKeysSlice[0].Address, item)
+	require.Equal(t, sdk.MustNewDecFromStr("9.5"), msg.Price)
+
+	// post-only orders are refused if they would take liquidity
+	sellOrder := types.MockOrder("", types.TestTokenPair, types.SellOrder, "10.0", "1.0")
+	sellOrder.Sender = addrKeysSlice[0].Address
+	require.Nil(t, keeper.PlaceOrder(ctx, sellOrder))
+
+	item = types.NewOrderItem(types.TestTokenPair, types.BuyOrder, "9.9", "1.0")
+	item.Type = types.OrderTypePostOnly
+	_, err = ValidateMsgNewOrders(ctx, keeper, types.NewMsgNewOrders(addrKeysSlice[0].Address, []types.OrderItem{item}))
+	require.Nil(t, err)
+
+	item.Price = sdk.MustNewDecFromStr("10.0")
+	_, err = ValidateMsgNewOrders(ctx, keeper, types.NewMsgNewOrders(addrKeysSlice[0].Address, []types.OrderItem{item}))
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "post-only")
+}
+
 // test order cancel without enough okb as fee
 func TestHandleMsgCancelOrder2(t *testing.T) {
 	mapp, addrKeysSlice := getMockApp(t, 1)
@@ -41,14 +41,42 @@ func GetOrderCostFee(order *types.Order, ctx sdk.Context) sdk.SysCoins {
 
 }
 
+// GetTriggerOrderNewFee is used to calculate the handling fee that needs to be locked when placing a trigger order
+func GetTriggerOrderNewFee(order *types.TriggerOrder) sdk.SysCoins {
+	expireBlocks := sdk.NewDec(order.ExpireBlocks)
+	amount := order.FeePerBlock.Amount.Mul(expireBlocks)
+	return sdk.SysCoins{sdk.NewDecCoinFromDec(order.FeePerBlock.Denom, amount)}
+}
+
+// GetTriggerOrderCostFee is used to calculate the handling fee when a trigger order is triggered, canceled or expired
+func GetTriggerOrderCostFee(order *types.TriggerOrder, ctx sdk.Context) sdk.SysCoins {
+	currentHeight := ctx.BlockHeight()
+	orderHeight := types.GetBlockHeightFromTriggerOrderID(order.TriggerOrderID)
+	blockNum := currentHeight - orderHeight
+	if blockNum < 0 {
+		ctx.Logger().Error(fmt.Sprintf("currentHeight(%d) should not less than triggerOrderHeight(%d)", currentHeight, orderHeight))
+		return GetZeroFee()
+	} else if blockNum > order.ExpireBlocks {
+		blockNum = order.ExpireBlocks
+	}
+	costFee := order.FeePerBlock.Amount.Mul(sdk.NewDec(blockNum))
+	return sdk.SysCoins{sdk.NewDecCoinFromDec(order.FeePerBlock.Denom, costFee)}
+}
+
 // GetZeroFee returns zeroFee
 func GetZeroFee() sdk.SysCoins {
 	return sdk.SysCoins{sdk.ZeroFee()}
 }
 
-// GetDealFee is used to calculate the handling fee when matching an order
+// GetDealFee is used to calculate the handling fee when matching an order with the fee rate of the deal,
+// which is scheduled if it is set by the fee schedule of the dex operator
 func GetDealFee(order *types.Order, fillAmt sdk.Dec, ctx sdk.Context, keeper GetFeeKeeper,
-	feeParams *types.Params) sdk.SysCoins {
+	feeRate sdk.Dec, scheduled bool) sdk.SysCoins {
+	// a zero rate set by the dex operator charges no fee at all, while the one of the params still charges minFee
+	if scheduled && feeRate.IsZero() {
+		return GetZeroFee()
+	}
+
 	symbols := strings.Split(order.Product, "_")
 	symbol := symbols[0]
 	quantity := fillAmt
@@ -58,9 +86,66 @@ func GetDealFee(order *types.Order, fillAmt sdk.Dec, ctx sdk.Context, keeper Get
 	}
 
 	minFeeDec := sdk.MustNewDecFromStr(minFee)
-	feeAmt := quantity.Mul(feeParams.TradeFeeRate)
+	feeAmt := quantity.Mul(feeRate)
 	if feeAmt.GT(minFeeDec) {
 		return sdk.SysCoins{sdk.NewDecCoinFromDec(symbol, feeAmt)}
 	}
 	return sdk.SysCoins{sdk.NewDecCoinFromDec(symbol, minFeeDec)}
 }
+
+// GetDealFeeRate returns the fee rate charged on a deal of the order as a maker or a taker. The fee schedule of
+// the dex operator of the product applies if it sets one, otherwise the trade fee rate of the params
+func (k Keeper) GetDealFeeRate(ctx sdk.Context, order *types.Order, isMaker bool,
+	feeParams *types.Params) (feeRate sdk.Dec, scheduled bool) {
+	feeSchedule, found := k.GetDexKeeper().GetProductFeeSchedule(ctx, order.Product)
+	if !found {
+		return feeParams.TradeFeeRate, false
+	}
+	return feeSchedule.GetFeeRate(isMaker, k.GetTradeVolume(ctx, order.Product, order.Sender)), true
+}
+
+// GetTradeVolume gets the total quantity of the quote asset an address has traded on a product in the current
+// window of trade volumes
+func (k Keeper) GetTradeVolume(ctx sdk.Context, product string, addr sdk.AccAddress) sdk.Dec {
+	bz := ctx.KVStore(k.orderStoreKey).Get(types.GetTradeVolumeKey(product, addr))
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+	var tradeVolume types.TradeVolume
+	k.cdc.MustUnmarshalBinaryBare(bz, &tradeVolume)
+	if tradeVolume.Window != types.GetTradeVolumeWindow(ctx.BlockHeight()) {
+		return sdk.ZeroDec()
+	}
+	return tradeVolume.Volume
+}
+
+// SetTradeVolume sets the trade volume of an address on a product
+func (k Keeper) SetTradeVolume(ctx sdk.Context, tradeVolume types.TradeVolume) {
+	ctx.KVStore(k.orderStoreKey).Set(types.GetTradeVolumeKey(tradeVolume.Product, tradeVolume.Address),
+		k.cdc.MustMarshalBinaryBare(tradeVolume))
+}
+
+// AddTradeVolume adds the quote quantity of a deal to the trade volume of an address on a product in the current
+// window. The trade volumes are only tracked on the products whose fee schedules have tiers they count towards
+func (k Keeper) AddTradeVolume(ctx sdk.Context, product string, addr sdk.AccAddress, quoteQuantity sdk.Dec) {
+	feeSchedule, found := k.GetDexKeeper().GetProductFeeSchedule(ctx, product)
+	if !found || len(feeSchedule.Tiers) == 0 {
+		return
+	}
+	volume := k.GetTradeVolume(ctx, product, addr).Add(quoteQuantity)
+	k.SetTradeVolume(ctx, types.NewTradeVolume(product, addr, volume, types.GetTradeVolumeWindow(ctx.BlockHeight())))
+}
+
+// IterateTradeVolumes iterates over all the trade volumes
+func (k Keeper) IterateTradeVolumes(ctx sdk.Context, cb func(tradeVolume types.TradeVolume) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.orderStoreKey), types.TradeVolumeKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var tradeVolume types.TradeVolume
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &tradeVolume)
+		if cb(tradeVolume) {
+			break
+		}
+	}
+}
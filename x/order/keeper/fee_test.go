@@ -8,6 +8,7 @@ import (
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
+	"github.com/okex/exchain/x/dex"
 	"github.com/okex/exchain/x/order/types"
 	"github.com/okex/exchain/libs/tendermint/libs/cli/flags"
 )
@@ -80,7 +81,7 @@ func TestOrderDealFee(t *testing.T) {
 		Quantity: sdk.MustNewDecFromStr("100.0"),
 	}
 	keeper.priceMap[types.TestTokenPair] = sdk.MustNewDecFromStr("10.0")
-	feeOther := GetDealFee(order, sdk.MustNewDecFromStr("10.0"), ctx, keeper, &feeParams)
+	feeOther := GetDealFee(order, sdk.MustNewDecFromStr("10.0"), ctx, keeper, feeParams.TradeFeeRate, false)
 	// 10 * 0.001
 	expectFee := sdk.SysCoins{sdk.NewDecCoinFromDec(common.TestToken, sdk.MustNewDecFromStr("0.01"))}
 	require.EqualValues(t, expectFee, feeOther)
@@ -95,7 +96,7 @@ func TestOrderDealFee(t *testing.T) {
 	keeper.priceMap["xxb_yyb"] = sdk.MustNewDecFromStr("20.0")
 	keeper.priceMap["yyb_"+common.NativeToken] = sdk.MustNewDecFromStr("0.6")
 
-	feeOther = GetDealFee(order, sdk.MustNewDecFromStr("100.0"), ctx, keeper, &feeParams)
+	feeOther = GetDealFee(order, sdk.MustNewDecFromStr("100.0"), ctx, keeper, feeParams.TradeFeeRate, false)
 	// 100 * 0.001
 	expectFee = sdk.SysCoins{sdk.NewDecCoinFromDec(common.TestToken, sdk.MustNewDecFromStr("0.1"))}
 	require.EqualValues(t, expectFee, feeOther)
@@ -107,7 +108,7 @@ func TestOrderDealFee(t *testing.T) {
 		Price:    sdk.MustNewDecFromStr("11.0"),
 		Quantity: sdk.MustNewDecFromStr("100.0"),
 	}
-	feeOther = GetDealFee(order, sdk.MustNewDecFromStr("100.0"), ctx, keeper, &feeParams)
+	feeOther = GetDealFee(order, sdk.MustNewDecFromStr("100.0"), ctx, keeper, feeParams.TradeFeeRate, false)
 	// 100 * 20 * 0.001
 	expectFee = sdk.SysCoins{sdk.NewDecCoinFromDec("yyb", sdk.MustNewDecFromStr("2.0"))}
 	require.EqualValues(t, expectFee, feeOther)
@@ -119,7 +120,72 @@ func TestOrderDealFee(t *testing.T) {
 		Price:    sdk.MustNewDecFromStr("1.0"),
 		Quantity: sdk.MustNewDecFromStr("0.00000001"),
 	}
-	feeOther = GetDealFee(order, sdk.MustNewDecFromStr("0.000000000000000001"), ctx, keeper, &feeParams)
+	feeOther = GetDealFee(order, sdk.MustNewDecFromStr("0.000000000000000001"), ctx, keeper, feeParams.TradeFeeRate, false)
 	expectFee = sdk.SysCoins{sdk.NewDecCoinFromDec("xxb", sdk.MustNewDecFromStr(minFee))}
 	require.EqualValues(t, expectFee, feeOther)
 }
+
+func TestGetDealFeeRate(t *testing.T) {
+	testInput := CreateTestInput(t)
+	keeper := testInput.OrderKeeper
+	ctx := testInput.Ctx
+	feeParams := types.DefaultTestParams()
+	testInput.DexKeeper.SetParams(ctx, *dex.DefaultParams())
+	tokenPair := dex.GetBuiltInTokenPair()
+	require.Nil(t, testInput.DexKeeper.SaveTokenPair(ctx, tokenPair))
+	operator := dex.DEXOperator{
+		Address:            tokenPair.Owner,
+		HandlingFeeAddress: tokenPair.Owner,
+	}
+	testInput.DexKeeper.SetOperator(ctx, operator)
+
+	order := mockOrder("", types.TestTokenPair, types.BuyOrder, "10.0", "1.0")
+	order.Sender = testInput.TestAddrs[0]
+
+	// 1. without a fee schedule, the trade fee rate of params is charged
+	feeRate, scheduled := keeper.GetDealFeeRate(ctx, order, true, &feeParams)
+	require.Equal(t, feeParams.TradeFeeRate, feeRate)
+	require.False(t, scheduled)
+	feeRate, scheduled = keeper.GetDealFeeRate(ctx, order, false, &feeParams)
+	require.Equal(t, feeParams.TradeFeeRate, feeRate)
+	require.False(t, scheduled)
+	// and the trade volume isn't tracked
+	keeper.AddTradeVolume(ctx, order.Product, order.Sender, sdk.NewDec(60))
+	require.Equal(t, sdk.ZeroDec(), keeper.GetTradeVolume(ctx, order.Product, order.Sender))
+
+	// 2. the maker and taker rates of the operator
+	feeSchedule := dex.NewFeeSchedule(sdk.MustNewDecFromStr("0.0005"), sdk.MustNewDecFromStr("0.002"),
+		[]dex.FeeTier{dex.NewFeeTier(sdk.NewDec(100), sdk.ZeroDec(), sdk.MustNewDecFromStr("0.001"))})
+	operator.FeeSchedule = &feeSchedule
+	testInput.DexKeeper.SetOperator(ctx, operator)
+	feeRate, scheduled = keeper.GetDealFeeRate(ctx, order, true, &feeParams)
+	require.Equal(t, sdk.MustNewDecFromStr("0.0005"), feeRate)
+	require.True(t, scheduled)
+	feeRate, scheduled = keeper.GetDealFeeRate(ctx, order, false, &feeParams)
+	require.Equal(t, sdk.MustNewDecFromStr("0.002"), feeRate)
+	require.True(t, scheduled)
+
+	// 3. the rates of the tier reached by the trade volume
+	keeper.AddTradeVolume(ctx, order.Product, order.Sender, sdk.NewDec(60))
+	feeRate, scheduled = keeper.GetDealFeeRate(ctx, order, false, &feeParams)
+	require.Equal(t, sdk.MustNewDecFromStr("0.002"), feeRate)
+	require.True(t, scheduled)
+	keeper.AddTradeVolume(ctx, order.Product, order.Sender, sdk.NewDec(40))
+	require.Equal(t, sdk.NewDec(100), keeper.GetTradeVolume(ctx, order.Product, order.Sender))
+	feeRate, scheduled = keeper.GetDealFeeRate(ctx, order, true, &feeParams)
+	require.Equal(t, sdk.ZeroDec(), feeRate)
+	require.True(t, scheduled)
+	feeRate, scheduled = keeper.GetDealFeeRate(ctx, order, false, &feeParams)
+	require.Equal(t, sdk.MustNewDecFromStr("0.001"), feeRate)
+	require.True(t, scheduled)
+	// the trade volume restarts in the next window
+	nextWindowCtx := ctx.WithBlockHeight(ctx.BlockHeight() + types.TradeVolumeWindowBlocks)
+	require.Equal(t, sdk.ZeroDec(), keeper.GetTradeVolume(nextWindowCtx, order.Product, order.Sender))
+	keeper.AddTradeVolume(nextWindowCtx, order.Product, order.Sender, sdk.NewDec(40))
+	require.Equal(t, sdk.NewDec(40), keeper.GetTradeVolume(nextWindowCtx, order.Product, order.Sender))
+
+	// 4. a zero rate of the operator charges no fee, while a zero trade fee rate of params still charges minFee
+	require.EqualValues(t, GetZeroFee(), GetDealFee(order, sdk.OneDec(), ctx, keeper, sdk.ZeroDec(), true))
+	expectFee := sdk.SysCoins{sdk.NewDecCoinFromDec(common.TestToken, sdk.MustNewDecFromStr(minFee))}
+	require.EqualValues(t, expectFee, GetDealFee(order, sdk.OneDec(), ctx, keeper, sdk.ZeroDec(), false))
+}
@@ -351,6 +351,29 @@ func (k Keeper) GetLastClosedOrderIDs(ctx sdk.Context) []string {
 	return orderIDs
 }
 
+// GetDeferredTakerOrderIDs gets the ids of the continuous auction takers deferred to the next block, in the
+// order they arrive
+func (k Keeper) GetDeferredTakerOrderIDs(ctx sdk.Context) []string {
+	store := ctx.KVStore(k.orderStoreKey)
+	bz := store.Get(types.DeferredTakerOrderIDsKey)
+	if bz == nil {
+		return nil
+	}
+	var orderIDs []string
+	k.cdc.MustUnmarshalJSON(bz, &orderIDs)
+	return orderIDs
+}
+
+// SetDeferredTakerOrderIDs sets the ids of the continuous auction takers deferred to the next block
+func (k Keeper) SetDeferredTakerOrderIDs(ctx sdk.Context, orderIDs []string) {
+	store := ctx.KVStore(k.orderStoreKey)
+	if len(orderIDs) == 0 {
+		store.Delete(types.DeferredTakerOrderIDsKey)
+		return
+	}
+	store.Set(types.DeferredTakerOrderIDsKey, k.cdc.MustMarshalJSON(orderIDs))
+}
+
 // nolint
 func (k Keeper) GetBlockMatchResult() *types.BlockMatchResult {
 	return k.cache.getBlockMatchResult()
@@ -499,7 +522,7 @@ func (k Keeper) GetBestBidAndAsk(ctx sdk.Context, product string) (sdk.Dec, sdk.
 // RemoveOrderFromDepthBook removes order from depthBook, and updates cancelNum, expireNum, updatedOrderIDs from cache
 func (k Keeper) RemoveOrderFromDepthBook(order *types.Order, feeType string) {
 	k.addUpdatedOrderID(order.OrderID)
-	if feeType == types.FeeTypeOrderCancel {
+	if feeType == types.FeeTypeOrderCancel || feeType == types.FeeTypeOrderKill {
 		k.cache.IncreaseCancelNum()
 	} else if feeType == types.FeeTypeOrderExpire {
 		k.cache.IncreaseExpireNum()
@@ -527,6 +550,23 @@ func (k Keeper) InsertOrderIntoDepthBook(order *types.Order) {
 	k.diskCache.insertOrder(order)
 }
 
+// IsOrderCrossingDepthBook returns true if the order would be filled by the opposite side of the depth book
+func (k Keeper) IsOrderCrossingDepthBook(product, side string, price sdk.Dec) bool {
+	book := k.diskCache.getDepthBook(product)
+	if book == nil {
+		return false
+	}
+	for _, item := range book.Items {
+		if side == types.BuyOrder && item.Price.LTE(price) && item.SellQuantity.IsPositive() {
+			return true
+		}
+		if side == types.SellOrder && item.Price.GTE(price) && item.BuyQuantity.IsPositive() {
+			return true
+		}
+	}
+	return false
+}
+
 // FilterDelistedProducts deletes non-existent products from the specified products
 func (k Keeper) FilterDelistedProducts(ctx sdk.Context, products []string) []string {
 	var cleanProducts []string
@@ -58,6 +58,8 @@ func (k Keeper) DumpStore(ctx sdk.Context) {
 	dumpKv(orderStore, logger, types.OpenOrderNumKey, "OpenOrderNumKey")
 	dumpKv(orderStore, logger, types.StoreOrderNumKey, "StoreOrderNumKey")
 	dumpKvJSON(orderStore, k, logger, types.RecentlyClosedOrderIDsKey, "RecentlyClosedOrderIDsKey", &orderIDs)
+	var deferredOrderIDs []string
+	dumpKvJSON(orderStore, k, logger, types.DeferredTakerOrderIDsKey, "DeferredTakerOrderIDsKey", &deferredOrderIDs)
 }
 
 func dumpKvs(orderStore sdk.KVStore, k []byte, key string, v interface{},
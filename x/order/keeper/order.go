@@ -64,6 +64,11 @@ func (k Keeper) CancelOrder(ctx sdk.Context, order *types.Order, logger log.Logg
 	return k.quitOrder(ctx, order, types.FeeTypeOrderCancel, logger)
 }
 
+// KillOrder quits the unfilled part of an immediate order with the killed state
+func (k Keeper) KillOrder(ctx sdk.Context, order *types.Order, logger log.Logger) sdk.SysCoins {
+	return k.quitOrder(ctx, order, types.FeeTypeOrderKill, logger)
+}
+
 // quitOrder unlocks & charges fee, unlocks coins, updates order, and updates DepthBook
 func (k Keeper) quitOrder(ctx sdk.Context, order *types.Order, feeType string, logger log.Logger) (fee sdk.SysCoins) {
 	switch feeType {
@@ -71,6 +76,8 @@ func (k Keeper) quitOrder(ctx sdk.Context, order *types.Order, feeType string, l
 		order.Cancel()
 	case types.FeeTypeOrderExpire:
 		order.Expire()
+	case types.FeeTypeOrderKill:
+		order.Kill()
 	default:
 		return
 	}
@@ -29,6 +29,8 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 
 		case types.QueryDepthBookV2:
 			return queryDepthBookV2(ctx, path[1:], req, keeper)
+		case types.QueryTriggerOrder:
+			return queryTriggerOrder(ctx, path[1:], keeper)
 		default:
 			return nil, types.ErrUnknownOrderQueryType()
 		}
@@ -46,6 +48,15 @@ func queryOrder(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Ke
 	return bz, nil
 }
 
+func queryTriggerOrder(ctx sdk.Context, path []string, keeper Keeper) ([]byte, sdk.Error) {
+	order := keeper.GetTriggerOrder(ctx, path[0])
+	if order == nil {
+		return nil, types.ErrTriggerOrderIsNotExist(path[0])
+	}
+	bz := keeper.cdc.MustMarshalJSON(order)
+	return bz, nil
+}
+
 // QueryDepthBookParams as input parameters when querying the depthBook
 type QueryDepthBookParams struct {
 	Product string
@@ -0,0 +1,205 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/common"
+
+	"github.com/okex/exchain/x/order/types"
+	token "github.com/okex/exchain/x/token/types"
+)
+
+// PlaceTriggerOrder locks coins & fee for a new trigger order, and sets it to keeper
+func (k Keeper) PlaceTriggerOrder(ctx sdk.Context, order *types.TriggerOrder) error {
+	logger := ctx.Logger().With("module", "order")
+	if err := k.LockCoins(ctx, order.Sender, order.NeedLockCoins(), token.LockCoinsTypeQuantity); err != nil {
+		logger.Info(fmt.Sprintf("place trigger order failed: %v, %v", err, order))
+		return err
+	}
+
+	fee := GetTriggerOrderNewFee(order)
+	if err := k.LockCoins(ctx, order.Sender, fee, token.LockCoinsTypeFee); err != nil {
+		return err
+	}
+	k.AddFeeDetail(ctx, order.Sender, fee, types.FeeTypeOrderNew)
+
+	orderNum := k.GetBlockTriggerOrderNum(ctx)
+	order.TriggerOrderID = types.FormatTriggerOrderID(ctx.BlockHeight(), orderNum+1)
+	k.SetBlockTriggerOrderNum(ctx, orderNum+1)
+	k.SetTriggerOrder(ctx, order)
+	return nil
+}
+
+// CancelTriggerOrder quits the specified trigger order before it is triggered
+func (k Keeper) CancelTriggerOrder(ctx sdk.Context, order *types.TriggerOrder) sdk.SysCoins {
+	return k.quitTriggerOrder(ctx, order, types.FeeTypeOrderCancel)
+}
+
+// ExpireTriggerOrder quits the specified trigger order which is not triggered in its expire blocks
+func (k Keeper) ExpireTriggerOrder(ctx sdk.Context, order *types.TriggerOrder) sdk.SysCoins {
+	return k.quitTriggerOrder(ctx, order, types.FeeTypeOrderExpire)
+}
+
+// CloseTriggeredOrder quits the specified trigger order once triggered, the order it is converted into
+// locks its own coins & fee
+func (k Keeper) CloseTriggeredOrder(ctx sdk.Context, order *types.TriggerOrder) sdk.SysCoins {
+	return k.quitTriggerOrder(ctx, order, types.FeeTypeOrderTrigger)
+}
+
+// quitTriggerOrder unlocks coins, unlocks & charges fee, and removes the trigger order from keeper
+func (k Keeper) quitTriggerOrder(ctx sdk.Context, order *types.TriggerOrder, feeType string) (fee sdk.SysCoins) {
+	k.UnlockCoins(ctx, order.Sender, order.NeedLockCoins(), token.LockCoinsTypeQuantity)
+
+	lockedFee := GetTriggerOrderNewFee(order)
+	fee = GetTriggerOrderCostFee(order, ctx)
+	receiveFee := lockedFee.Sub(fee)
+
+	k.UnlockCoins(ctx, order.Sender, lockedFee, token.LockCoinsTypeFee)
+	k.AddFeeDetail(ctx, order.Sender, receiveFee, types.FeeTypeOrderReceive)
+
+	if err := k.AddCollectedFees(ctx, fee, order.Sender, feeType, false); err != nil {
+		ctx.Logger().With("module", "order").Error(
+			fmt.Sprintf("failed to charge trigger order(%s) %s fee: %v", feeType, order.TriggerOrderID, err))
+	}
+
+	k.DropTriggerOrder(ctx, order.TriggerOrderID)
+	return fee
+}
+
+// GetTriggerOrder gets the open trigger order by its id
+func (k Keeper) GetTriggerOrder(ctx sdk.Context, triggerOrderID string) *types.TriggerOrder {
+	store := ctx.KVStore(k.orderStoreKey)
+	bz := store.Get(types.GetTriggerOrderKey(triggerOrderID))
+	if bz == nil {
+		return nil
+	}
+	order := &types.TriggerOrder{}
+	k.cdc.MustUnmarshalBinaryBare(bz, order)
+	return order
+}
+
+// SetTriggerOrder sets the trigger order to keeper, indexed by its trigger price and expire height
+func (k Keeper) SetTriggerOrder(ctx sdk.Context, order *types.TriggerOrder) {
+	store := ctx.KVStore(k.orderStoreKey)
+	if !store.Has(types.GetTriggerOrderKey(order.TriggerOrderID)) {
+		k.addTriggerProductNum(ctx, order.Product, 1)
+	}
+	store.Set(types.GetTriggerOrderKey(order.TriggerOrderID), k.cdc.MustMarshalBinaryBare(order))
+	store.Set(types.GetTriggerPriceKey(order.Product, order.IsTriggeredByFall(), order.TriggerPrice,
+		order.TriggerOrderID), []byte(order.TriggerOrderID))
+	store.Set(types.GetTriggerExpireKey(order.GetExpireHeight(), order.TriggerOrderID), []byte(order.TriggerOrderID))
+}
+
+// DropTriggerOrder deletes the trigger order and its indexes from keeper
+func (k Keeper) DropTriggerOrder(ctx sdk.Context, triggerOrderID string) {
+	order := k.GetTriggerOrder(ctx, triggerOrderID)
+	if order == nil {
+		return
+	}
+	store := ctx.KVStore(k.orderStoreKey)
+	store.Delete(types.GetTriggerOrderKey(triggerOrderID))
+	store.Delete(types.GetTriggerPriceKey(order.Product, order.IsTriggeredByFall(), order.TriggerPrice,
+		triggerOrderID))
+	store.Delete(types.GetTriggerExpireKey(order.GetExpireHeight(), triggerOrderID))
+	k.addTriggerProductNum(ctx, order.Product, -1)
+}
+
+// addTriggerProductNum adds delta to the num of open trigger orders of the product, which is dropped at zero
+func (k Keeper) addTriggerProductNum(ctx sdk.Context, product string, delta int64) {
+	store := ctx.KVStore(k.orderStoreKey)
+	key := types.GetTriggerProductKey(product)
+	var num int64
+	if bz := store.Get(key); bz != nil {
+		num = common.BytesToInt64(bz)
+	}
+	if num += delta; num > 0 {
+		store.Set(key, common.Int64ToBytes(num))
+	} else {
+		store.Delete(key)
+	}
+}
+
+// GetTriggerOrderProducts gets the products with open trigger orders
+func (k Keeper) GetTriggerOrderProducts(ctx sdk.Context) []string {
+	store := ctx.KVStore(k.orderStoreKey)
+	iter := sdk.KVStorePrefixIterator(store, types.TriggerProductKey)
+	defer iter.Close()
+
+	var products []string
+	for ; iter.Valid(); iter.Next() {
+		products = append(products, string(iter.Key()[len(types.TriggerProductKey):]))
+	}
+	return products
+}
+
+// GetTriggeredOrders gets the trigger orders of a product triggered at the last price, the ones waiting for the price
+// to fall come first in the descending order of their trigger prices, then the others in the ascending order
+func (k Keeper) GetTriggeredOrders(ctx sdk.Context, product string, lastPrice sdk.Dec) []*types.TriggerOrder {
+	store := ctx.KVStore(k.orderStoreKey)
+	fallPrefix := types.GetTriggerPricePrefix(product, true)
+	risePrefix := types.GetTriggerPricePrefix(product, false)
+	sortablePrice := sdk.SortableDecBytes(lastPrice)
+
+	fallIter := store.ReverseIterator(append(fallPrefix, sortablePrice...), sdk.PrefixEndBytes(fallPrefix))
+	orders := k.getIndexedTriggerOrders(ctx, fallIter)
+	riseIter := store.Iterator(risePrefix, sdk.PrefixEndBytes(append(risePrefix, sortablePrice...)))
+	return append(orders, k.getIndexedTriggerOrders(ctx, riseIter)...)
+}
+
+// GetExpiredTriggerOrders gets the trigger orders expiring no later than the block height
+func (k Keeper) GetExpiredTriggerOrders(ctx sdk.Context, blockHeight int64) []*types.TriggerOrder {
+	store := ctx.KVStore(k.orderStoreKey)
+	iter := store.Iterator(types.TriggerExpireKey, sdk.PrefixEndBytes(types.GetTriggerExpirePrefix(blockHeight)))
+	return k.getIndexedTriggerOrders(ctx, iter)
+}
+
+// getIndexedTriggerOrders gets the trigger orders whose ids are the values of the index iterator, and closes it
+func (k Keeper) getIndexedTriggerOrders(ctx sdk.Context, iter sdk.Iterator) []*types.TriggerOrder {
+	defer iter.Close()
+
+	var orders []*types.TriggerOrder
+	for ; iter.Valid(); iter.Next() {
+		if order := k.GetTriggerOrder(ctx, string(iter.Value())); order != nil {
+			orders = append(orders, order)
+		}
+	}
+	return orders
+}
+
+// GetTriggerOrders gets all open trigger orders in the order of their ids
+func (k Keeper) GetTriggerOrders(ctx sdk.Context) []*types.TriggerOrder {
+	store := ctx.KVStore(k.orderStoreKey)
+	iter := sdk.KVStorePrefixIterator(store, types.TriggerOrderKey)
+	defer iter.Close()
+
+	var orders []*types.TriggerOrder
+	for ; iter.Valid(); iter.Next() {
+		order := &types.TriggerOrder{}
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), order)
+		orders = append(orders, order)
+	}
+	return orders
+}
+
+// GetBlockTriggerOrderNum gets the num of trigger orders placed in the current block
+func (k Keeper) GetBlockTriggerOrderNum(ctx sdk.Context) int64 {
+	store := ctx.KVStore(k.orderStoreKey)
+	numBytes := store.Get(types.TriggerOrderNumKey)
+	if numBytes == nil {
+		return 0
+	}
+	return common.BytesToInt64(numBytes)
+}
+
+// SetBlockTriggerOrderNum sets the num of trigger orders placed in the current block
+func (k Keeper) SetBlockTriggerOrderNum(ctx sdk.Context, orderNum int64) {
+	store := ctx.KVStore(k.orderStoreKey)
+	store.Set(types.TriggerOrderNumKey, common.Int64ToBytes(orderNum))
+}
+
+// DropBlockTriggerOrderNum resets the num of trigger orders at the end of a block
+func (k Keeper) DropBlockTriggerOrderNum(ctx sdk.Context) {
+	store := ctx.KVStore(k.orderStoreKey)
+	store.Delete(types.TriggerOrderNumKey)
+}
@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/auth"
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/exchain/x/common"
+	"github.com/okex/exchain/x/dex"
+	"github.com/okex/exchain/x/order/types"
+)
+
+func mockTriggerOrder(sender sdk.AccAddress, side, triggerType, triggerPrice, price, quantity string,
+	feeParams *types.Params) *types.TriggerOrder {
+	msg := types.NewMsgNewTriggerOrder(sender, triggerType, sdk.MustNewDecFromStr(triggerPrice),
+		types.NewOrderItem(types.TestTokenPair, side, price, quantity))
+	return types.NewTriggerOrder("", msg, 0, feeParams.OrderExpireBlocks, feeParams.FeePerBlock)
+}
+
+func TestGetTriggeredOrders(t *testing.T) {
+	testInput := CreateTestInput(t)
+	keeper := testInput.OrderKeeper
+	ctx := testInput.Ctx.WithBlockHeight(10)
+
+	tokenPair := dex.GetBuiltInTokenPair()
+	err := testInput.DexKeeper.SaveTokenPair(ctx, tokenPair)
+	require.Nil(t, err)
+
+	params := keeper.GetParams(ctx)
+	orders := []*types.TriggerOrder{
+		mockTriggerOrder(testInput.TestAddrs[0], types.SellOrder, types.TriggerTypeStopLoss, "9.0", "9.0", "1.0", params),
+		mockTriggerOrder(testInput.TestAddrs[0], types.SellOrder, types.TriggerTypeStopLoss, "8.0", "8.0", "1.0", params),
+		mockTriggerOrder(testInput.TestAddrs[1], types.BuyOrder, types.TriggerTypeStopLoss, "11.0", "11.0", "1.0", params),
+		mockTriggerOrder(testInput.TestAddrs[1], types.BuyOrder, types.TriggerTypeStopLoss, "12.0", "12.0", "1.0", params),
+	}
+	for _, order := range orders {
+		require.Nil(t, keeper.PlaceTriggerOrder(ctx, order))
+	}
+
+	// only the orders whose trigger prices are reached are read
+	require.Equal(t, 0, len(keeper.GetTriggeredOrders(ctx, types.TestTokenPair, sdk.MustNewDecFromStr("10.0"))))
+	require.Equal(t, []*types.TriggerOrder{orders[0]},
+		keeper.GetTriggeredOrders(ctx, types.TestTokenPair, sdk.MustNewDecFromStr("9.0")))
+	require.Equal(t, []*types.TriggerOrder{orders[0], orders[1]},
+		keeper.GetTriggeredOrders(ctx, types.TestTokenPair, sdk.MustNewDecFromStr("7.0")))
+	require.Equal(t, []*types.TriggerOrder{orders[2], orders[3]},
+		keeper.GetTriggeredOrders(ctx, types.TestTokenPair, sdk.MustNewDecFromStr("12.5")))
+
+	// the indexes are dropped with the orders
+	require.Equal(t, 0, len(keeper.GetExpiredTriggerOrders(ctx, 9+params.OrderExpireBlocks)))
+	require.Equal(t, orders, keeper.GetExpiredTriggerOrders(ctx, 10+params.OrderExpireBlocks))
+	keeper.CancelTriggerOrder(ctx, orders[0])
+	require.Equal(t, []*types.TriggerOrder{orders[1]},
+		keeper.GetTriggeredOrders(ctx, types.TestTokenPair, sdk.MustNewDecFromStr("7.0")))
+	require.Equal(t, orders[1:], keeper.GetExpiredTriggerOrders(ctx, 10+params.OrderExpireBlocks))
+
+	// the product is only listed while it has open trigger orders
+	require.Equal(t, []string{types.TestTokenPair}, keeper.GetTriggerOrderProducts(ctx))
+	for _, order := range orders[1:] {
+		keeper.CancelTriggerOrder(ctx, order)
+	}
+	require.Equal(t, 0, len(keeper.GetTriggerOrderProducts(ctx)))
+}
+
+func TestPlaceTriggerOrderAndCancelTriggerOrder(t *testing.T) {
+	testInput := CreateTestInput(t)
+	keeper := testInput.OrderKeeper
+	ctx := testInput.Ctx.WithBlockHeight(10)
+
+	tokenPair := dex.GetBuiltInTokenPair()
+	err := testInput.DexKeeper.SaveTokenPair(ctx, tokenPair)
+	require.Nil(t, err)
+
+	order := mockTriggerOrder(testInput.TestAddrs[0], types.BuyOrder, types.TriggerTypeStopLoss,
+		"11.0", "10.0", "1.0", keeper.GetParams(ctx))
+	err = keeper.PlaceTriggerOrder(ctx, order)
+	require.Nil(t, err)
+
+	// check result & trigger order
+	require.EqualValues(t, types.FormatTriggerOrderID(10, 1), order.TriggerOrderID)
+	require.EqualValues(t, 1, keeper.GetBlockTriggerOrderNum(ctx))
+	require.EqualValues(t, order, keeper.GetTriggerOrder(ctx, order.TriggerOrderID))
+	require.Equal(t, 1, len(keeper.GetTriggerOrders(ctx)))
+	// trigger orders don't rest in the depth book
+	require.Equal(t, 0, len(keeper.GetDepthBookCopy(types.TestTokenPair).Items))
+	// check account balance
+	acc := testInput.AccountKeeper.GetAccount(ctx, testInput.TestAddrs[0])
+	expectCoins := sdk.SysCoins{
+		sdk.NewDecCoinFromDec(common.NativeToken, sdk.MustNewDecFromStr("89.7408")),
+		sdk.NewDecCoinFromDec(common.TestToken, sdk.MustNewDecFromStr("100")),
+	}
+	require.EqualValues(t, expectCoins.String(), acc.GetCoins().String())
+
+	// the second trigger order in the block
+	keeper.DropBlockTriggerOrderNum(ctx)
+	ctx = ctx.WithBlockHeight(11)
+	order2 := mockTriggerOrder(testInput.TestAddrs[0], types.SellOrder, types.TriggerTypeTakeProfit,
+		"11.0", "11.0", "1.0", keeper.GetParams(ctx))
+	err = keeper.PlaceTriggerOrder(ctx, order2)
+	require.Nil(t, err)
+	require.EqualValues(t, types.FormatTriggerOrderID(11, 1), order2.TriggerOrderID)
+
+	// Test cancel trigger order
+	fee := keeper.CancelTriggerOrder(ctx, order)
+	require.Equal(t, "0.000001000000000000"+common.NativeToken, fee.String())
+	require.Nil(t, keeper.GetTriggerOrder(ctx, order.TriggerOrderID))
+	require.Equal(t, 1, len(keeper.GetTriggerOrders(ctx)))
+	// check fee pool
+	feeCollector := testInput.SupplyKeeper.GetModuleAccount(ctx, auth.FeeCollectorName)
+	require.EqualValues(t, "0.000001000000000000"+common.NativeToken, feeCollector.GetCoins().String())
+
+	// Test expire trigger order
+	ctx = ctx.WithBlockHeight(11 + order2.ExpireBlocks)
+	fee = keeper.ExpireTriggerOrder(ctx, order2)
+	require.Equal(t, GetTriggerOrderNewFee(order2).String(), fee.String())
+	require.Equal(t, 0, len(keeper.GetTriggerOrders(ctx)))
+	// check account balance
+	acc = testInput.AccountKeeper.GetAccount(ctx, testInput.TestAddrs[0])
+	expectCoins = sdk.SysCoins{
+		sdk.NewDecCoinFromDec(common.NativeToken, sdk.MustNewDecFromStr("99.999999").Sub(fee[0].Amount)),
+		sdk.NewDecCoinFromDec(common.TestToken, sdk.MustNewDecFromStr("100")),
+	}
+	require.EqualValues(t, expectCoins.String(), acc.GetCoins().String())
+}
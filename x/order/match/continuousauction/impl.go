@@ -6,11 +6,13 @@ import (
 	"github.com/okex/exchain/x/order/keeper"
 )
 
-// nolint
+// CaEngine is the continuous auction match engine. It only matches the products whose token pair
+// is listed with continuous auction, filling the new orders of a block in transaction order
+// against the resting orders with price-time priority.
 type CaEngine struct {
 }
 
 // nolint
 func (e *CaEngine) Run(ctx sdk.Context, keeper keeper.Keeper) {
-	// TODO
+	matchOrders(ctx, keeper)
 }
@@ -0,0 +1,342 @@
+package continuousauction
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+
+	"github.com/okex/exchain/x/order/keeper"
+	"github.com/okex/exchain/x/order/match/periodicauction"
+	"github.com/okex/exchain/x/order/types"
+)
+
+func matchOrders(ctx sdk.Context, keeper keeper.Keeper) {
+	blockHeight := ctx.BlockHeight()
+
+	// step0: collect the takers deferred by the previous blocks and the new orders of continuous auction
+	// products, in the order they arrive
+	takers, pending := getContinuousAuctionTakers(ctx, keeper, blockHeight)
+	if len(takers) == 0 {
+		keeper.SetDeferredTakerOrderIDs(ctx, nil)
+		return
+	}
+	products := make([]string, 0, len(takers))
+	for product := range takers {
+		products = append(products, product)
+	}
+	keeper.GetDexKeeper().SortProducts(ctx, products) // sort products
+
+	// step1: fill the takers one by one against the resting orders of the depth book
+	logger := ctx.Logger().With("module", "order")
+	feeParams := keeper.GetParams(ctx)
+	blockRemainDeals := feeParams.MaxDealsPerBlock
+	resultMap := make(map[string]types.MatchResult)
+	var deferred []string
+	for _, product := range products {
+		// the takers of locked products are filled after the product is unlocked
+		if keeper.IsProductLocked(ctx, product) {
+			deferred = appendOrderIDs(deferred, takers[product])
+			continue
+		}
+		var matchResult types.MatchResult
+		var productDeferred []*types.Order
+		matchResult, blockRemainDeals, productDeferred = matchProduct(ctx, keeper, product, takers[product], pending,
+			feeParams, blockRemainDeals)
+		deferred = appendOrderIDs(deferred, productDeferred)
+		if len(matchResult.Deals) == 0 {
+			continue
+		}
+		resultMap[product] = matchResult
+		logger.Info(fmt.Sprintf("continuous matchResult(%d-%s): last price: %v, quantity: %v, dealsNum: %d",
+			matchResult.BlockHeight, product, matchResult.Price, matchResult.Quantity, len(matchResult.Deals)))
+	}
+	keeper.SetDeferredTakerOrderIDs(ctx, deferred)
+
+	// step2: save match results for querying, together with the ones of the periodic auction
+	if len(resultMap) > 0 {
+		blockMatchResult := keeper.GetBlockMatchResult()
+		if blockMatchResult == nil || blockMatchResult.BlockHeight != blockHeight || blockMatchResult.ResultMap == nil {
+			blockMatchResult = &types.BlockMatchResult{
+				BlockHeight: blockHeight,
+				ResultMap:   make(map[string]types.MatchResult),
+				TimeStamp:   ctx.BlockHeader().Time.Unix(),
+			}
+		}
+		for product, matchResult := range resultMap {
+			blockMatchResult.ResultMap[product] = matchResult
+		}
+		keeper.SetBlockMatchResult(blockMatchResult)
+	}
+}
+
+// getContinuousAuctionTakers returns the open takers of every continuous auction product, the ones deferred by
+// the previous blocks first and then the new orders of the block in transaction order, together with the set
+// of their ids which have not been matched yet
+func getContinuousAuctionTakers(ctx sdk.Context, keeper keeper.Keeper,
+	blockHeight int64) (map[string][]*types.Order, map[string]bool) {
+
+	takers := make(map[string][]*types.Order)
+	pending := make(map[string]bool)
+	continuousProducts := make(map[string]bool)
+	addTaker := func(orderID string) {
+		order := keeper.GetOrder(ctx, orderID)
+		if order == nil || order.Status != types.OrderStatusOpen {
+			return
+		}
+
+		continuous, ok := continuousProducts[order.Product]
+		if !ok {
+			tokenPair := keeper.GetDexKeeper().GetTokenPair(ctx, order.Product)
+			continuous = tokenPair != nil && tokenPair.ContinuousAuction
+			continuousProducts[order.Product] = continuous
+		}
+		if continuous {
+			takers[order.Product] = append(takers[order.Product], order)
+			pending[order.OrderID] = true
+		}
+	}
+
+	for _, orderID := range keeper.GetDeferredTakerOrderIDs(ctx) {
+		addTaker(orderID)
+	}
+	orderNum := keeper.GetBlockOrderNum(ctx, blockHeight)
+	for i := int64(1); i <= orderNum; i++ {
+		addTaker(types.FormatOrderID(blockHeight, i))
+	}
+
+	return takers, pending
+}
+
+// appendOrderIDs appends the ids of orders to orderIDs
+func appendOrderIDs(orderIDs []string, orders []*types.Order) []string {
+	for _, order := range orders {
+		orderIDs = append(orderIDs, order.OrderID)
+	}
+	return orderIDs
+}
+
+// matchProduct fills the takers of a product. Every deal is filled at the price of the maker order, the
+// returned match result records the last deal price and the total executed quantity. Once the deals of the
+// block run out, the taker being filled while it still crosses the depth book and the takers after it are
+// returned to be deferred to the next block, so that they keep their time priority.
+func matchProduct(ctx sdk.Context, keeper keeper.Keeper, product string, takers []*types.Order,
+	pending map[string]bool, feeParams *types.Params,
+	blockRemainDeals int64) (types.MatchResult, int64, []*types.Order) {
+
+	matchResult := types.MatchResult{
+		BlockHeight: ctx.BlockHeight(),
+		Price:       keeper.GetLastPrice(ctx, product),
+		Quantity:    sdk.ZeroDec(),
+		Deals:       []types.Deal{},
+	}
+	logger := ctx.Logger().With("module", "order")
+	book := keeper.GetDepthBookCopy(product)
+	// killed orders are removed from the cached depth book too, which is overwritten by the copy
+	killOrder := func(order *types.Order) {
+		keeper.KillOrder(ctx, order, logger)
+		book.RemoveOrder(order)
+	}
+	var deferred []*types.Order
+	for i, taker := range takers {
+		if blockRemainDeals < 2 {
+			deferred = takers[i:]
+			break
+		}
+		// the taker arrives, later orders are still not in the book
+		delete(pending, taker.OrderID)
+
+		// every fill is a deal of the maker and one of the taker, a FOK order which can't be filled within the
+		// deals of a whole block is never filled
+		if taker.Type == types.OrderTypeFOK {
+			quantity, makers := availableQuantity(ctx, keeper, book, taker, pending, feeParams.MaxDealsPerBlock/2)
+			if quantity.LT(taker.RemainQuantity) {
+				killOrder(taker)
+				continue
+			}
+			if makers > blockRemainDeals/2 {
+				deferred = takers[i:]
+				break
+			}
+		}
+		blockRemainDeals = fillTakerOrder(ctx, keeper, book, taker, pending, feeParams, &matchResult,
+			blockRemainDeals)
+		if taker.Status != types.OrderStatusOpen {
+			continue
+		}
+
+		if blockRemainDeals < 2 {
+			if quantity, _ := availableQuantity(ctx, keeper, book, taker, pending, 1); quantity.IsPositive() {
+				deferred = takers[i:]
+				break
+			}
+		}
+		// immediate orders never rest in the depth book
+		if taker.IsImmediate() {
+			killOrder(taker)
+		}
+	}
+	keeper.SetDepthBook(product, book)
+
+	return matchResult, blockRemainDeals, deferred
+}
+
+// availableQuantity returns the quantity of at most maxMakers resting orders which could fill the taker order,
+// and the num of the resting orders it takes
+func availableQuantity(ctx sdk.Context, keeper keeper.Keeper, book *types.DepthBook, taker *types.Order,
+	pending map[string]bool, maxMakers int64) (sdk.Dec, int64) {
+
+	makerSide := types.SellOrder
+	if taker.Side == types.SellOrder {
+		makerSide = types.BuyOrder
+	}
+
+	quantity := sdk.ZeroDec()
+	makers := int64(0)
+	for _, price := range crossedPrices(book, taker, makerSide) {
+		key := types.FormatOrderIDsKey(taker.Product, price, makerSide)
+		for _, orderID := range keeper.GetProductPriceOrderIDs(key) {
+			if pending[orderID] || makers >= maxMakers || quantity.GTE(taker.RemainQuantity) {
+				break
+			}
+			if maker := keeper.GetOrder(ctx, orderID); maker != nil {
+				quantity = quantity.Add(maker.RemainQuantity)
+				makers++
+			}
+		}
+		if quantity.GTE(taker.RemainQuantity) || makers >= maxMakers {
+			break
+		}
+	}
+	return quantity, makers
+}
+
+// fillTakerOrder fills a taker order against the opposite side of the depth book, prices from the best
+// to the worst and orders of the same price in the time they were placed. It stops when the remaining
+// deals of the block can't fill another maker order, and returns the deals left.
+func fillTakerOrder(ctx sdk.Context, keeper keeper.Keeper, book *types.DepthBook, taker *types.Order,
+	pending map[string]bool, feeParams *types.Params, matchResult *types.MatchResult, blockRemainDeals int64) int64 {
+
+	makerSide := types.SellOrder
+	if taker.Side == types.SellOrder {
+		makerSide = types.BuyOrder
+	}
+
+	takerFilled := sdk.ZeroDec()
+	for _, price := range crossedPrices(book, taker, makerSide) {
+		if !taker.RemainQuantity.IsPositive() || blockRemainDeals < 2 {
+			break
+		}
+
+		key := types.FormatOrderIDsKey(taker.Product, price, makerSide)
+		orderIDs := keeper.GetProductPriceOrderIDs(key)
+		levelFilled := sdk.ZeroDec()
+		index := 0
+		for index < len(orderIDs) && taker.RemainQuantity.IsPositive() && blockRemainDeals >= 2 {
+			// orders behind a pending one are placed later in the block as well
+			if pending[orderIDs[index]] {
+				break
+			}
+			maker := keeper.GetOrder(ctx, orderIDs[index])
+			if maker == nil {
+				ctx.Logger().Error("[Order] Not exist orderID: ", orderIDs[index])
+				index++
+				continue
+			}
+
+			fillQuantity := sdk.MinDec(taker.RemainQuantity, maker.RemainQuantity)
+			// the fee of sell orders is calculated with the last price
+			keeper.SetLastPrice(ctx, taker.Product, price)
+			if deal := periodicauction.FillOrder(maker, ctx, keeper, price, fillQuantity, feeParams, true); deal != nil {
+				matchResult.Deals = append(matchResult.Deals, *deal)
+			}
+			if deal := periodicauction.FillOrder(taker, ctx, keeper, price, fillQuantity, feeParams, false); deal != nil {
+				matchResult.Deals = append(matchResult.Deals, *deal)
+			}
+			blockRemainDeals -= 2
+			levelFilled = levelFilled.Add(fillQuantity)
+			matchResult.Price = price
+			matchResult.Quantity = matchResult.Quantity.Add(fillQuantity)
+
+			if maker.Status == types.OrderStatusFilled {
+				index++
+			}
+		}
+
+		if index > 0 {
+			// update orderIDs, remove filled orderIDs
+			unFilledOrderIDs := orderIDs[index:]
+			if len(unFilledOrderIDs) == 0 {
+				unFilledOrderIDs = []string{}
+			}
+			keeper.SetOrderIDs(key, unFilledOrderIDs)
+		}
+		subDepthBook(book, price, levelFilled, makerSide)
+		takerFilled = takerFilled.Add(levelFilled)
+	}
+
+	if takerFilled.IsZero() {
+		return blockRemainDeals
+	}
+	subDepthBook(book, taker.Price, takerFilled, taker.Side)
+	if taker.Status == types.OrderStatusFilled {
+		removeOrderID(keeper, taker)
+	}
+	return blockRemainDeals
+}
+
+// crossedPrices returns the prices of the maker side which cross the taker price, from the best to the worst
+func crossedPrices(book *types.DepthBook, taker *types.Order, makerSide string) []sdk.Dec {
+	var prices []sdk.Dec
+	if makerSide == types.SellOrder {
+		// items are sorted by price desc, sell orders are filled from low to high
+		for index := len(book.Items) - 1; index >= 0; index-- {
+			item := book.Items[index]
+			if item.Price.GT(taker.Price) {
+				break
+			}
+			if item.SellQuantity.IsPositive() {
+				prices = append(prices, item.Price)
+			}
+		}
+	} else {
+		// buy orders are filled from high to low
+		for _, item := range book.Items {
+			if item.Price.LT(taker.Price) {
+				break
+			}
+			if item.BuyQuantity.IsPositive() {
+				prices = append(prices, item.Price)
+			}
+		}
+	}
+	return prices
+}
+
+// subDepthBook subtracts the filled quantity at a price of the depth book
+func subDepthBook(book *types.DepthBook, price, quantity sdk.Dec, side string) {
+	if quantity.IsZero() {
+		return
+	}
+	bookLen := len(book.Items)
+	index := sort.Search(bookLen, func(i int) bool {
+		return price.GTE(book.Items[i].Price)
+	})
+	if index < bookLen && book.Items[index].Price.Equal(price) {
+		book.Sub(index, quantity, side)
+		book.RemoveIfEmpty(index)
+	}
+}
+
+// removeOrderID removes a fully filled taker order from the orderIDs of its price
+func removeOrderID(keeper keeper.Keeper, order *types.Order) {
+	key := types.FormatOrderIDsKey(order.Product, order.Price, order.Side)
+	orderIDs := keeper.GetProductPriceOrderIDs(key)
+	unFilledOrderIDs := make([]string, 0, len(orderIDs))
+	for _, orderID := range orderIDs {
+		if orderID != order.OrderID {
+			unFilledOrderIDs = append(unFilledOrderIDs, orderID)
+		}
+	}
+	keeper.SetOrderIDs(key, unFilledOrderIDs)
+}
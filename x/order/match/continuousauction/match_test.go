@@ -0,0 +1,315 @@
+package continuousauction
+
+import (
+	"testing"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/exchain/x/common"
+	"github.com/okex/exchain/x/dex"
+	orderkeeper "github.com/okex/exchain/x/order/keeper"
+	"github.com/okex/exchain/x/order/match/periodicauction"
+	"github.com/okex/exchain/x/order/types"
+)
+
+var mockOrder = types.MockOrder
+
+func createContinuousAuctionTestInput(t *testing.T) orderkeeper.TestInput {
+	common.InitConfig()
+	testInput := orderkeeper.CreateTestInput(t)
+	tokenPair := dex.GetBuiltInTokenPair()
+	tokenPair.ContinuousAuction = true
+	err := testInput.DexKeeper.SaveTokenPair(testInput.Ctx, tokenPair)
+	require.Nil(t, err)
+	testInput.DexKeeper.SetOperator(testInput.Ctx, dex.DEXOperator{
+		Address:            tokenPair.Owner,
+		HandlingFeeAddress: tokenPair.Owner,
+	})
+	return testInput
+}
+
+func TestCaEngine_Run(t *testing.T) {
+	testInput := createContinuousAuctionTestInput(t)
+	keeper := testInput.OrderKeeper
+	keeper.ResetCache(testInput.Ctx)
+
+	// resting orders of the previous block, same price in time priority
+	ctx := testInput.Ctx.WithBlockHeight(9)
+	restingOrders := []*types.Order{
+		mockOrder("", types.TestTokenPair, types.SellOrder, "10.0", "1.0"),
+		mockOrder("", types.TestTokenPair, types.SellOrder, "10.0", "1.0"),
+	}
+	for _, order := range restingOrders {
+		order.Sender = testInput.TestAddrs[1]
+		require.NoError(t, keeper.PlaceOrder(ctx, order))
+	}
+
+	ctx = testInput.Ctx.WithBlockHeight(10)
+	newOrders := []*types.Order{
+		// takes 1.0 of the first and 0.5 of the second resting sell order at 10.0
+		mockOrder("", types.TestTokenPair, types.BuyOrder, "10.1", "1.5"),
+		// doesn't cross the book and rests
+		mockOrder("", types.TestTokenPair, types.BuyOrder, "9.9", "1.0"),
+		// arrives after the buy order above, so it's filled at the buy price
+		mockOrder("", types.TestTokenPair, types.SellOrder, "9.5", "0.4"),
+	}
+	newOrders[0].Sender = testInput.TestAddrs[0]
+	newOrders[1].Sender = testInput.TestAddrs[0]
+	newOrders[2].Sender = testInput.TestAddrs[1]
+	for _, order := range newOrders {
+		require.NoError(t, keeper.PlaceOrder(ctx, order))
+	}
+
+	engine := &CaEngine{}
+	engine.Run(ctx, keeper)
+
+	// check orders
+	expectStatus := []int64{types.OrderStatusFilled, types.OrderStatusOpen}
+	expectRemain := []string{"0", "0.5"}
+	for i, order := range restingOrders {
+		order = keeper.GetOrder(ctx, order.OrderID)
+		require.EqualValues(t, expectStatus[i], order.Status)
+		require.Equal(t, sdk.MustNewDecFromStr(expectRemain[i]), order.RemainQuantity)
+	}
+	expectStatus = []int64{types.OrderStatusFilled, types.OrderStatusOpen, types.OrderStatusFilled}
+	expectRemain = []string{"0", "0.6", "0"}
+	expectAvgPrice := []string{"10.0", "9.9", "9.9"}
+	for i, order := range newOrders {
+		order = keeper.GetOrder(ctx, order.OrderID)
+		require.EqualValues(t, expectStatus[i], order.Status)
+		require.Equal(t, sdk.MustNewDecFromStr(expectRemain[i]), order.RemainQuantity)
+		require.Equal(t, sdk.MustNewDecFromStr(expectAvgPrice[i]), order.FilledAvgPrice)
+	}
+
+	// check depth book
+	depthBook := keeper.GetDepthBookCopy(types.TestTokenPair)
+	require.Equal(t, 2, len(depthBook.Items))
+	require.Equal(t, sdk.MustNewDecFromStr("10.0"), depthBook.Items[0].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("0.5"), depthBook.Items[0].SellQuantity)
+	require.True(t, depthBook.Items[0].BuyQuantity.IsZero())
+	require.Equal(t, sdk.MustNewDecFromStr("9.9"), depthBook.Items[1].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("0.6"), depthBook.Items[1].BuyQuantity)
+	require.True(t, depthBook.Items[1].SellQuantity.IsZero())
+
+	// check orderIDsMap
+	orderIDsMap := keeper.GetDiskCache().GetOrderIDsMapCopy()
+	sellKey := types.FormatOrderIDsKey(types.TestTokenPair, sdk.MustNewDecFromStr("10.0"), types.SellOrder)
+	require.Equal(t, []string{restingOrders[1].OrderID}, orderIDsMap.Data[sellKey])
+	buyKey := types.FormatOrderIDsKey(types.TestTokenPair, sdk.MustNewDecFromStr("9.9"), types.BuyOrder)
+	require.Equal(t, []string{newOrders[1].OrderID}, orderIDsMap.Data[buyKey])
+	require.Equal(t, 2, len(orderIDsMap.Data))
+
+	// check match result and deals
+	require.Equal(t, sdk.MustNewDecFromStr("9.9"), keeper.GetLastPrice(ctx, types.TestTokenPair))
+	blockMatchResult := keeper.GetBlockMatchResult()
+	require.NotNil(t, blockMatchResult)
+	require.EqualValues(t, 10, blockMatchResult.BlockHeight)
+	matchResult := blockMatchResult.ResultMap[types.TestTokenPair]
+	require.Equal(t, sdk.MustNewDecFromStr("9.9"), matchResult.Price)
+	require.Equal(t, sdk.MustNewDecFromStr("1.9"), matchResult.Quantity)
+	expectDeals := []struct {
+		orderID  string
+		price    string
+		quantity string
+	}{
+		{restingOrders[0].OrderID, "10.0", "1.0"},
+		{newOrders[0].OrderID, "10.0", "1.0"},
+		{restingOrders[1].OrderID, "10.0", "0.5"},
+		{newOrders[0].OrderID, "10.0", "0.5"},
+		{newOrders[1].OrderID, "9.9", "0.4"},
+		{newOrders[2].OrderID, "9.9", "0.4"},
+	}
+	require.Equal(t, len(expectDeals), len(matchResult.Deals))
+	for i, deal := range matchResult.Deals {
+		require.Equal(t, expectDeals[i].orderID, deal.OrderID)
+		require.Equal(t, sdk.MustNewDecFromStr(expectDeals[i].price), deal.Price)
+		require.Equal(t, sdk.MustNewDecFromStr(expectDeals[i].quantity), deal.Quantity)
+	}
+}
+
+func TestPaEngine_SkipContinuousAuctionProducts(t *testing.T) {
+	testInput := createContinuousAuctionTestInput(t)
+	keeper := testInput.OrderKeeper
+	ctx := testInput.Ctx.WithBlockHeight(10)
+	keeper.ResetCache(ctx)
+
+	orders := []*types.Order{
+		mockOrder("", types.TestTokenPair, types.SellOrder, "10.0", "1.0"),
+		mockOrder("", types.TestTokenPair, types.BuyOrder, "10.0", "1.0"),
+	}
+	orders[0].Sender = testInput.TestAddrs[1]
+	orders[1].Sender = testInput.TestAddrs[0]
+	for _, order := range orders {
+		require.NoError(t, keeper.PlaceOrder(ctx, order))
+	}
+
+	// the periodic auction leaves the continuous auction product to the continuous engine
+	paEngine := &periodicauction.PaEngine{}
+	paEngine.Run(ctx, keeper)
+	for _, order := range orders {
+		require.EqualValues(t, types.OrderStatusOpen, keeper.GetOrder(ctx, order.OrderID).Status)
+	}
+
+	caEngine := &CaEngine{}
+	caEngine.Run(ctx, keeper)
+	for _, order := range orders {
+		require.EqualValues(t, types.OrderStatusFilled, keeper.GetOrder(ctx, order.OrderID).Status)
+	}
+	require.Equal(t, 0, len(keeper.GetDepthBookCopy(types.TestTokenPair).Items))
+}
+
+func TestCaEngine_RunImmediateOrders(t *testing.T) {
+	testInput := createContinuousAuctionTestInput(t)
+	keeper := testInput.OrderKeeper
+	ctx := testInput.Ctx.WithBlockHeight(10)
+	keeper.ResetCache(ctx)
+
+	orders := []*types.Order{
+		mockOrder("", types.TestTokenPair, types.SellOrder, "10.0", "1.0"),
+		// can't be filled entirely by the sell order above
+		mockOrder("", types.TestTokenPair, types.BuyOrder, "10.0", "1.5"),
+		// takes the whole sell order and the rest is killed
+		mockOrder("", types.TestTokenPair, types.BuyOrder, "10.0", "1.5"),
+		// arrives after the sell order is taken
+		mockOrder("", types.TestTokenPair, types.BuyOrder, "10.0", "1.0"),
+	}
+	orders[1].Type = types.OrderTypeFOK
+	orders[2].Type = types.OrderTypeIOC
+	orders[3].Type = types.OrderTypeMarket
+	orders[0].Sender = testInput.TestAddrs[1]
+	for _, order := range orders[1:] {
+		order.Sender = testInput.TestAddrs[0]
+	}
+	for _, order := range orders {
+		require.NoError(t, keeper.PlaceOrder(ctx, order))
+	}
+
+	engine := &CaEngine{}
+	engine.Run(ctx, keeper)
+
+	expectStatus := []int64{types.OrderStatusFilled, types.OrderStatusKilled,
+		types.OrderStatusPartialFilledKilled, types.OrderStatusKilled}
+	expectRemain := []string{"0", "1.5", "0.5", "1.0"}
+	for i, order := range orders {
+		order = keeper.GetOrder(ctx, order.OrderID)
+		require.EqualValues(t, expectStatus[i], order.Status)
+		require.Equal(t, sdk.MustNewDecFromStr(expectRemain[i]), order.RemainQuantity)
+		require.True(t, order.RemainLocked.IsZero())
+	}
+	require.Equal(t, 0, len(keeper.GetDepthBookCopy(types.TestTokenPair).Items))
+	require.Equal(t, 0, len(keeper.GetDiskCache().GetOrderIDsMapCopy().Data))
+}
+
+func TestCaEngine_RunMaxDealsPerBlock(t *testing.T) {
+	testInput := createContinuousAuctionTestInput(t)
+	keeper := testInput.OrderKeeper
+	keeper.ResetCache(testInput.Ctx)
+	params := keeper.GetParams(testInput.Ctx)
+	params.MaxDealsPerBlock = 3
+	keeper.SetParams(testInput.Ctx, params)
+
+	ctx := testInput.Ctx.WithBlockHeight(9)
+	restingOrders := []*types.Order{
+		mockOrder("", types.TestTokenPair, types.SellOrder, "10.0", "1.0"),
+		mockOrder("", types.TestTokenPair, types.SellOrder, "10.0", "1.0"),
+	}
+	for _, order := range restingOrders {
+		order.Sender = testInput.TestAddrs[1]
+		require.NoError(t, keeper.PlaceOrder(ctx, order))
+	}
+
+	ctx = testInput.Ctx.WithBlockHeight(10)
+	newOrders := []*types.Order{
+		// only the first resting order is filled within the deals of the block
+		mockOrder("", types.TestTokenPair, types.BuyOrder, "10.0", "2.0"),
+		// still crosses the book when the deals run out
+		mockOrder("", types.TestTokenPair, types.BuyOrder, "10.0", "1.0"),
+		// doesn't cross the book and rests
+		mockOrder("", types.TestTokenPair, types.BuyOrder, "9.0", "1.0"),
+	}
+	for _, order := range newOrders {
+		order.Sender = testInput.TestAddrs[0]
+		require.NoError(t, keeper.PlaceOrder(ctx, order))
+	}
+
+	engine := &CaEngine{}
+	engine.Run(ctx, keeper)
+
+	expectStatus := []int64{types.OrderStatusFilled, types.OrderStatusOpen}
+	for i, order := range restingOrders {
+		require.EqualValues(t, expectStatus[i], keeper.GetOrder(ctx, order.OrderID).Status)
+	}
+	// the takers left when the deals run out are deferred to the next block in the order they arrive
+	expectRemain := []string{"1.0", "1.0", "1.0"}
+	for i, order := range newOrders {
+		order = keeper.GetOrder(ctx, order.OrderID)
+		require.EqualValues(t, types.OrderStatusOpen, order.Status)
+		require.Equal(t, sdk.MustNewDecFromStr(expectRemain[i]), order.RemainQuantity)
+	}
+	require.Equal(t, 2, len(keeper.GetBlockMatchResult().ResultMap[types.TestTokenPair].Deals))
+	require.Equal(t, []string{newOrders[0].OrderID, newOrders[1].OrderID, newOrders[2].OrderID},
+		keeper.GetDeferredTakerOrderIDs(ctx))
+
+	// the deferred takers are filled first in the next block, the crossing GTC order survives
+	ctx = testInput.Ctx.WithBlockHeight(11)
+	keeper.ResetCache(ctx)
+	engine.Run(ctx, keeper)
+	require.EqualValues(t, types.OrderStatusFilled, keeper.GetOrder(ctx, restingOrders[1].OrderID).Status)
+	require.EqualValues(t, types.OrderStatusFilled, keeper.GetOrder(ctx, newOrders[0].OrderID).Status)
+	require.EqualValues(t, types.OrderStatusOpen, keeper.GetOrder(ctx, newOrders[1].OrderID).Status)
+	require.Equal(t, []string{newOrders[1].OrderID, newOrders[2].OrderID}, keeper.GetDeferredTakerOrderIDs(ctx))
+
+	// nothing crosses the book any more, the takers rest
+	ctx = testInput.Ctx.WithBlockHeight(12)
+	keeper.ResetCache(ctx)
+	engine.Run(ctx, keeper)
+	for _, order := range newOrders[1:] {
+		require.EqualValues(t, types.OrderStatusOpen, keeper.GetOrder(ctx, order.OrderID).Status)
+	}
+	require.Empty(t, keeper.GetDeferredTakerOrderIDs(ctx))
+
+	depthBook := keeper.GetDepthBookCopy(types.TestTokenPair)
+	require.Equal(t, 2, len(depthBook.Items))
+	require.Equal(t, sdk.MustNewDecFromStr("10.0"), depthBook.Items[0].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("1.0"), depthBook.Items[0].BuyQuantity)
+	require.True(t, depthBook.Items[0].SellQuantity.IsZero())
+	require.Equal(t, sdk.MustNewDecFromStr("9.0"), depthBook.Items[1].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("1.0"), depthBook.Items[1].BuyQuantity)
+}
+
+func TestCaEngine_SkipLockedProducts(t *testing.T) {
+	testInput := createContinuousAuctionTestInput(t)
+	keeper := testInput.OrderKeeper
+	ctx := testInput.Ctx.WithBlockHeight(10)
+	keeper.ResetCache(ctx)
+
+	orders := []*types.Order{
+		mockOrder("", types.TestTokenPair, types.SellOrder, "10.0", "1.0"),
+		mockOrder("", types.TestTokenPair, types.BuyOrder, "10.0", "1.0"),
+	}
+	orders[0].Sender = testInput.TestAddrs[1]
+	orders[1].Sender = testInput.TestAddrs[0]
+	for _, order := range orders {
+		require.NoError(t, keeper.PlaceOrder(ctx, order))
+	}
+	keeper.SetProductLock(ctx, types.TestTokenPair, &types.ProductLock{})
+
+	engine := &CaEngine{}
+	engine.Run(ctx, keeper)
+	for _, order := range orders {
+		require.EqualValues(t, types.OrderStatusOpen, keeper.GetOrder(ctx, order.OrderID).Status)
+	}
+	require.Empty(t, keeper.GetBlockMatchResult().ResultMap)
+	require.Equal(t, []string{orders[0].OrderID, orders[1].OrderID}, keeper.GetDeferredTakerOrderIDs(ctx))
+
+	// the takers are filled once the product is unlocked
+	ctx = testInput.Ctx.WithBlockHeight(11)
+	keeper.ResetCache(ctx)
+	keeper.UnlockProduct(ctx, types.TestTokenPair)
+	engine.Run(ctx, keeper)
+	for _, order := range orders {
+		require.EqualValues(t, types.OrderStatusFilled, keeper.GetOrder(ctx, order.OrderID).Status)
+	}
+	require.Empty(t, keeper.GetDeferredTakerOrderIDs(ctx))
+}
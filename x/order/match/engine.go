@@ -1,8 +1,6 @@
 package match
 
 import (
-	"sync"
-
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 
 	"github.com/okex/exchain/x/order/keeper"
@@ -11,25 +9,17 @@ import (
 )
 
 // nolint
-const DefaultAuctionType = "periodicauction"
-
-// nolint
-var (
-	once        sync.Once
-	engine      Engine
-	auctionType = DefaultAuctionType
-)
+var engines = []Engine{
+	// the periodic auction engine cleans up expired orders and orders of delisted products as well,
+	// so it runs before the continuous auction engine
+	&periodicauction.PaEngine{},
+	&continuousauction.CaEngine{},
+}
 
-// GetEngine : periodic auction only today
-func GetEngine() Engine {
-	once.Do(func() {
-		if auctionType == DefaultAuctionType {
-			engine = &periodicauction.PaEngine{}
-		} else {
-			engine = &continuousauction.CaEngine{}
-		}
-	})
-	return engine
+// GetEngines returns the match engines in the order they run at the end of a block.
+// Every product is matched by only one of them, selected by its token pair.
+func GetEngines() []Engine {
+	return engines
 }
 
 // nolint
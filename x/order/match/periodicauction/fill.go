@@ -156,14 +156,16 @@ func fillOrderByKey(ctx sdk.Context, keeper orderkeeper.Keeper, key string,
 		}
 		if filledAmount.Add(order.RemainQuantity).LTE(needFillAmount) {
 			filledAmount = filledAmount.Add(order.RemainQuantity)
-			if deal := fillOrder(order, ctx, keeper, fillPrice, order.RemainQuantity, feeParams); deal != nil {
+			if deal := FillOrder(order, ctx, keeper, fillPrice, order.RemainQuantity, feeParams,
+				isMakerOrder(ctx, order)); deal != nil {
 				deals = append(deals, *deal)
 			}
 
 			filledDealsCnt++
 			index++
 		} else {
-			if deal := fillOrder(order, ctx, keeper, fillPrice, needFillAmount.Sub(filledAmount), feeParams); deal != nil {
+			if deal := FillOrder(order, ctx, keeper, fillPrice, needFillAmount.Sub(filledAmount), feeParams,
+				isMakerOrder(ctx, order)); deal != nil {
 				deals = append(deals, *deal)
 			}
 			filledAmount = needFillAmount
@@ -183,6 +185,13 @@ func fillOrderByKey(ctx sdk.Context, keeper orderkeeper.Keeper, key string,
 	return deals, filledAmount, filledDealsCnt
 }
 
+// isMakerOrder tells whether an order filled by the periodic auction is a maker. All orders of a product are filled
+// at once at the match price, so the ones resting in the depth book since earlier blocks make the liquidity taken
+// by the ones placed in the current block
+func isMakerOrder(ctx sdk.Context, order *types.Order) bool {
+	return types.GetBlockHeightFromOrderID(order.OrderID) < ctx.BlockHeight()
+}
+
 func balanceAccount(order *types.Order, ctx sdk.Context, keeper orderkeeper.Keeper,
 	fillPrice, fillQuantity sdk.Dec) {
 
@@ -200,7 +209,7 @@ func balanceAccount(order *types.Order, ctx sdk.Context, keeper orderkeeper.Keep
 }
 
 func chargeFee(order *types.Order, ctx sdk.Context, keeper orderkeeper.Keeper, fillQuantity sdk.Dec,
-	feeParams *types.Params) (dealFee sdk.SysCoins, feeReceiver string) {
+	feeParams *types.Params, isMaker bool) (dealFee sdk.SysCoins, feeReceiver string) {
 	// charge fee
 	fee := orderkeeper.GetZeroFee()
 	if order.Status == types.OrderStatusFilled {
@@ -217,7 +226,8 @@ func chargeFee(order *types.Order, ctx sdk.Context, keeper orderkeeper.Keeper, f
 			ctx.Logger().Error(fmt.Sprintf("Send fee failed:%s\n", err.Error()))
 		}
 	}
-	dealFee = orderkeeper.GetDealFee(order, fillQuantity, ctx, keeper, feeParams)
+	feeRate, scheduled := keeper.GetDealFeeRate(ctx, order, isMaker, feeParams)
+	dealFee = orderkeeper.GetDealFee(order, fillQuantity, ctx, keeper, feeRate, scheduled)
 	feeReceiver, err := keeper.SendFeesToProductOwner(ctx, dealFee, order.Sender, types.FeeTypeOrderDeal, order.Product)
 	if err == nil {
 		order.RecordOrderDealFee(fee)
@@ -225,10 +235,10 @@ func chargeFee(order *types.Order, ctx sdk.Context, keeper orderkeeper.Keeper, f
 	return
 }
 
-// Fill an order. Update order, charge fee and transfer tokens. Return a deal.
+// FillOrder fills an order as a maker or a taker. Update order, charge fee and transfer tokens. Return a deal.
 // If an order is fully filled but still lock some coins, unlock it.
-func fillOrder(order *types.Order, ctx sdk.Context, keeper orderkeeper.Keeper,
-	fillPrice, fillQuantity sdk.Dec, feeParams *types.Params) *types.Deal {
+func FillOrder(order *types.Order, ctx sdk.Context, keeper orderkeeper.Keeper,
+	fillPrice, fillQuantity sdk.Dec, feeParams *types.Params, isMaker bool) *types.Deal {
 
 	// update order
 	order.Fill(fillPrice, fillQuantity)
@@ -241,7 +251,10 @@ func fillOrder(order *types.Order, ctx sdk.Context, keeper orderkeeper.Keeper,
 		order.Unlock()
 	}
 
-	dealFee, feeReceiver := chargeFee(order, ctx, keeper, fillQuantity, feeParams)
+	dealFee, feeReceiver := chargeFee(order, ctx, keeper, fillQuantity, feeParams, isMaker)
+	// the volume of the deal counts towards the fee tiers of the later deals only
+	keeper.AddTradeVolume(ctx, order.Product, order.Sender, fillPrice.Mul(fillQuantity))
 	keeper.UpdateOrder(order, ctx) // update order info on filled
-	return &types.Deal{OrderID: order.OrderID, Side: order.Side, Quantity: fillQuantity, Fee: dealFee.String(), FeeReceiver: feeReceiver}
+	return &types.Deal{OrderID: order.OrderID, Side: order.Side, Quantity: fillQuantity, Fee: dealFee.String(),
+		FeeReceiver: feeReceiver, Price: fillPrice}
 }
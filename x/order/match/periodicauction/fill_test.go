@@ -639,7 +639,7 @@ func TestFillOrder(t *testing.T) {
 	feeParams := types.DefaultTestParams()
 
 	for _, order := range orders {
-		retDeals := fillOrder(order, ctx, keeper, fillPrice, fillQuantity, &feeParams)
+		retDeals := FillOrder(order, ctx, keeper, fillPrice, fillQuantity, &feeParams, false)
 		require.NotEmpty(t, retDeals)
 	}
 }
@@ -706,7 +706,7 @@ func TestChargeFee(t *testing.T) {
 	feeParams := types.DefaultTestParams()
 
 	for _, order := range orders {
-		retFee, feeReceiver := chargeFee(order, ctx, keeper, fillQuantity, &feeParams)
+		retFee, feeReceiver := chargeFee(order, ctx, keeper, fillQuantity, &feeParams, false)
 		require.NotEmpty(t, retFee)
 		require.NotEmpty(t, feeReceiver)
 	}
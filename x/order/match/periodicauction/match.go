@@ -270,9 +270,10 @@ func matchOrders(ctx sdk.Context, keeper keeper.Keeper) {
 		return
 	}
 
-	// step0: get active products
+	// step0: get active products, products matched by the continuous auction engine are skipped
 	products := keeper.GetDiskCache().GetNewDepthbookKeys()
 	products = keeper.FilterDelistedProducts(ctx, products)
+	products = filterPeriodicAuctionProducts(ctx, keeper, products)
 	keeper.GetDexKeeper().SortProducts(ctx, products) // sort products
 
 	// step1: calc best price and max execution for every active product, save latest price
@@ -289,6 +290,9 @@ func matchOrders(ctx sdk.Context, keeper keeper.Keeper) {
 	// step2: execute match results, fill orders in match results, transfer tokens and collect fees
 	executeMatch(ctx, keeper, products, updatedProductsBasePrice, lockMap)
 
+	// step2.1: kill the unfilled part of immediate orders placed in this block
+	killImmediateOrders(ctx, keeper, blockHeight, "")
+
 	// step3: save match results for querying
 	if len(updatedProductsBasePrice) > 0 {
 		blockMatchResult := &types.BlockMatchResult{
@@ -300,6 +304,18 @@ func matchOrders(ctx sdk.Context, keeper keeper.Keeper) {
 	}
 }
 
+// filterPeriodicAuctionProducts drops the products whose token pair is listed with continuous auction
+func filterPeriodicAuctionProducts(ctx sdk.Context, keeper keeper.Keeper, products []string) []string {
+	var periodicProducts []string
+	for _, product := range products {
+		tokenPair := keeper.GetDexKeeper().GetTokenPair(ctx, product)
+		if tokenPair != nil && !tokenPair.ContinuousAuction {
+			periodicProducts = append(periodicProducts, product)
+		}
+	}
+	return periodicProducts
+}
+
 func calcMatchPriceAndExecution(ctx sdk.Context, k keeper.Keeper, products []string) map[string]types.MatchResult {
 	resultMap := make(map[string]types.MatchResult)
 
@@ -311,6 +327,20 @@ func calcMatchPriceAndExecution(ctx sdk.Context, k keeper.Keeper, products []str
 		book := k.GetDepthBookCopy(product)
 		bestPrice, maxExecution := periodicAuctionMatchPrice(book, tokenPair.MaxPriceDigit,
 			k.GetLastPrice(ctx, product))
+		// FOK orders which can't be filled entirely are killed before filling, then match again without them.
+		// The new match may leave other FOK orders unfilled, so it's repeated until no order is killed, and
+		// all the FOK orders left are killed in the last round if that doesn't happen before.
+		for round := 1; killUnfilledFOKOrders(ctx, k, product, book, bestPrice, maxExecution) > 0; round++ {
+			if round == maxFOKRematchRounds {
+				killFOKOrders(ctx, k, openFOKOrders(ctx, k, product))
+			}
+			book = k.GetDepthBookCopy(product)
+			bestPrice, maxExecution = periodicAuctionMatchPrice(book, tokenPair.MaxPriceDigit,
+				k.GetLastPrice(ctx, product))
+			if round == maxFOKRematchRounds {
+				break
+			}
+		}
 		if maxExecution.IsPositive() {
 			k.SetLastPrice(ctx, product, bestPrice)
 			resultMap[product] = types.MatchResult{BlockHeight: ctx.BlockHeight(), Price: bestPrice,
@@ -407,6 +437,8 @@ func executeLockedProduct(ctx sdk.Context, k keeper.Keeper,
 		k.UnlockProduct(ctx, product)
 		logger.Info(fmt.Sprintf("BlockHeight<%d> unlock product(%s<%d>)", blockHeight,
 			product, lock.BlockHeight))
+		// the immediate orders of the locked match are done now
+		killImmediateOrders(ctx, k, lock.BlockHeight, product)
 	} else {
 		// update product lock
 		k.SetProductLock(ctx, product, lock)
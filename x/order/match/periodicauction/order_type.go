@@ -0,0 +1,114 @@
+package periodicauction
+
+import (
+	"fmt"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+
+	"github.com/okex/exchain/x/order/keeper"
+	"github.com/okex/exchain/x/order/types"
+)
+
+// maxFOKRematchRounds bounds the rounds of killing the unfilled FOK orders of a product and matching again
+const maxFOKRematchRounds = 4
+
+// killUnfilledFOKOrders kills in one pass the open FOK orders placed in this block which won't be filled
+// entirely with bestPrice and maxExecution. It returns the number of orders killed, the match result
+// changes if it's not 0.
+func killUnfilledFOKOrders(ctx sdk.Context, k keeper.Keeper, product string, book *types.DepthBook,
+	bestPrice, maxExecution sdk.Dec) int {
+
+	// all the orders are checked before any is killed, since killing changes the orders queued at a price
+	var unfilledOrders []*types.Order
+	for _, order := range openFOKOrders(ctx, k, product) {
+		if !isOrderFilledEntirely(ctx, k, book, order, bestPrice, maxExecution) {
+			unfilledOrders = append(unfilledOrders, order)
+		}
+	}
+	killFOKOrders(ctx, k, unfilledOrders)
+	return len(unfilledOrders)
+}
+
+// openFOKOrders returns the open FOK orders of product placed in this block
+func openFOKOrders(ctx sdk.Context, k keeper.Keeper, product string) []*types.Order {
+	var orders []*types.Order
+	blockHeight := ctx.BlockHeight()
+	orderNum := k.GetBlockOrderNum(ctx, blockHeight)
+	for i := int64(1); i <= orderNum; i++ {
+		order := k.GetOrder(ctx, types.FormatOrderID(blockHeight, i))
+		if order == nil || order.Product != product || order.Type != types.OrderTypeFOK ||
+			order.Status != types.OrderStatusOpen {
+			continue
+		}
+		orders = append(orders, order)
+	}
+	return orders
+}
+
+func killFOKOrders(ctx sdk.Context, k keeper.Keeper, orders []*types.Order) {
+	for _, order := range orders {
+		k.KillOrder(ctx, order, ctx.Logger())
+		ctx.Logger().Info(fmt.Sprintf("BlockHeight<%d> kill FOK order(%s)", ctx.BlockHeight(), order.OrderID))
+	}
+}
+
+// isOrderFilledEntirely checks whether the order is filled entirely by the auction. Orders are filled with
+// price priority and then time priority, so it's filled entirely if the quantity of the orders before it
+// and its own quantity is no more than maxExecution.
+func isOrderFilledEntirely(ctx sdk.Context, k keeper.Keeper, book *types.DepthBook, order *types.Order,
+	bestPrice, maxExecution sdk.Dec) bool {
+
+	if !maxExecution.IsPositive() {
+		return false
+	}
+	if (order.Side == types.BuyOrder && order.Price.LT(bestPrice)) ||
+		(order.Side == types.SellOrder && order.Price.GT(bestPrice)) {
+		return false
+	}
+
+	// quantity of the orders with better prices
+	quantity := order.RemainQuantity
+	for _, item := range book.Items {
+		if order.Side == types.BuyOrder && item.Price.GT(order.Price) {
+			quantity = quantity.Add(item.BuyQuantity)
+		} else if order.Side == types.SellOrder && item.Price.LT(order.Price) {
+			quantity = quantity.Add(item.SellQuantity)
+		}
+	}
+
+	// quantity of the orders placed before it with the same price
+	key := types.FormatOrderIDsKey(order.Product, order.Price, order.Side)
+	for _, orderID := range k.GetProductPriceOrderIDs(key) {
+		if orderID == order.OrderID {
+			break
+		}
+		if o := k.GetOrder(ctx, orderID); o != nil {
+			quantity = quantity.Add(o.RemainQuantity)
+		}
+	}
+
+	return quantity.LTE(maxExecution)
+}
+
+// killImmediateOrders kills the unfilled part of the open immediate orders placed at blockHeight.
+// Orders of locked products are skipped since they are still being filled, and so are the ones of
+// continuous auction products which are not matched yet.
+func killImmediateOrders(ctx sdk.Context, k keeper.Keeper, blockHeight int64, product string) {
+	logger := ctx.Logger().With("module", "order")
+	orderNum := k.GetBlockOrderNum(ctx, blockHeight)
+	for i := int64(1); i <= orderNum; i++ {
+		order := k.GetOrder(ctx, types.FormatOrderID(blockHeight, i))
+		if order == nil || !order.IsImmediate() || order.Status != types.OrderStatusOpen {
+			continue
+		}
+		if (product != "" && order.Product != product) || k.IsProductLocked(ctx, order.Product) {
+			continue
+		}
+		if tokenPair := k.GetDexKeeper().GetTokenPair(ctx, order.Product); tokenPair != nil && tokenPair.ContinuousAuction {
+			continue
+		}
+
+		k.KillOrder(ctx, order, logger)
+		logger.Info(fmt.Sprintf("BlockHeight<%d> kill %s order(%s)", ctx.BlockHeight(), order.Type, order.OrderID))
+	}
+}
@@ -0,0 +1,134 @@
+package periodicauction
+
+import (
+	"testing"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/exchain/x/common"
+	"github.com/okex/exchain/x/dex"
+	orderkeeper "github.com/okex/exchain/x/order/keeper"
+	"github.com/okex/exchain/x/order/types"
+)
+
+func mockTypedOrder(orderType, side, price, quantity string, sender sdk.AccAddress) *types.Order {
+	order := mockOrder("", types.TestTokenPair, side, price, quantity)
+	order.Type = orderType
+	order.Sender = sender
+	return order
+}
+
+func TestPaEngine_RunImmediateOrders(t *testing.T) {
+	common.InitConfig()
+
+	testCases := []struct {
+		name         string
+		orders       func(addrs []sdk.AccAddress) []*types.Order
+		expectStatus []int64
+		expectRemain []string
+	}{
+		{
+			"fok killed, ioc partially filled",
+			func(addrs []sdk.AccAddress) []*types.Order {
+				return []*types.Order{
+					mockTypedOrder("", types.SellOrder, "10.0", "1.0", addrs[1]),
+					mockTypedOrder(types.OrderTypeFOK, types.BuyOrder, "10.0", "3.0", addrs[0]),
+					mockTypedOrder(types.OrderTypeIOC, types.BuyOrder, "10.0", "2.0", addrs[0]),
+				}
+			},
+			[]int64{types.OrderStatusFilled, types.OrderStatusKilled, types.OrderStatusPartialFilledKilled},
+			[]string{"0", "3.0", "1.0"},
+		},
+		{
+			"fok filled entirely",
+			func(addrs []sdk.AccAddress) []*types.Order {
+				return []*types.Order{
+					mockTypedOrder("", types.SellOrder, "9.9", "1.0", addrs[1]),
+					mockTypedOrder("", types.SellOrder, "10.0", "1.0", addrs[1]),
+					mockTypedOrder(types.OrderTypeFOK, types.BuyOrder, "10.0", "2.0", addrs[0]),
+				}
+			},
+			[]int64{types.OrderStatusFilled, types.OrderStatusFilled, types.OrderStatusFilled},
+			[]string{"0", "0", "0"},
+		},
+		{
+			"unfilled fok orders killed together",
+			func(addrs []sdk.AccAddress) []*types.Order {
+				return []*types.Order{
+					mockTypedOrder("", types.SellOrder, "10.0", "1.0", addrs[1]),
+					mockTypedOrder(types.OrderTypeFOK, types.BuyOrder, "10.0", "3.0", addrs[0]),
+					mockTypedOrder(types.OrderTypeFOK, types.BuyOrder, "10.0", "2.0", addrs[0]),
+					mockTypedOrder("", types.BuyOrder, "10.0", "1.0", addrs[0]),
+				}
+			},
+			[]int64{types.OrderStatusFilled, types.OrderStatusKilled, types.OrderStatusKilled, types.OrderStatusFilled},
+			[]string{"0", "3.0", "2.0", "0"},
+		},
+		{
+			"fok killed after the kill of the opposite fok",
+			func(addrs []sdk.AccAddress) []*types.Order {
+				return []*types.Order{
+					mockTypedOrder("", types.SellOrder, "10.0", "1.0", addrs[1]),
+					mockTypedOrder(types.OrderTypeFOK, types.SellOrder, "10.0", "1.0", addrs[1]),
+					mockTypedOrder(types.OrderTypeFOK, types.BuyOrder, "10.0", "3.0", addrs[0]),
+				}
+			},
+			[]int64{types.OrderStatusOpen, types.OrderStatusKilled, types.OrderStatusKilled},
+			[]string{"1.0", "1.0", "3.0"},
+		},
+		{
+			"unmatched market order killed, limit order rests",
+			func(addrs []sdk.AccAddress) []*types.Order {
+				return []*types.Order{
+					mockTypedOrder("", types.SellOrder, "10.0", "1.0", addrs[1]),
+					mockTypedOrder(types.OrderTypeMarket, types.BuyOrder, "9.5", "1.0", addrs[0]),
+				}
+			},
+			[]int64{types.OrderStatusOpen, types.OrderStatusKilled},
+			[]string{"1.0", "1.0"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			testInput := orderkeeper.CreateTestInput(t)
+			keeper := testInput.OrderKeeper
+			ctx := testInput.Ctx.WithBlockHeight(10)
+			tokenPair := dex.GetBuiltInTokenPair()
+			require.Nil(t, testInput.DexKeeper.SaveTokenPair(ctx, tokenPair))
+			testInput.DexKeeper.SetOperator(ctx, dex.DEXOperator{
+				Address:            tokenPair.Owner,
+				HandlingFeeAddress: tokenPair.Owner,
+			})
+			keeper.ResetCache(ctx)
+
+			orders := tc.orders(testInput.TestAddrs)
+			for _, order := range orders {
+				require.NoError(t, keeper.PlaceOrder(ctx, order))
+			}
+
+			engine := &PaEngine{}
+			engine.Run(ctx, keeper)
+
+			restingQuantity := sdk.ZeroDec()
+			for i, order := range orders {
+				order = keeper.GetOrder(ctx, order.OrderID)
+				require.EqualValues(t, tc.expectStatus[i], order.Status)
+				require.Equal(t, sdk.MustNewDecFromStr(tc.expectRemain[i]), order.RemainQuantity)
+				if order.Status == types.OrderStatusOpen {
+					restingQuantity = restingQuantity.Add(order.RemainQuantity)
+				} else {
+					require.True(t, order.RemainLocked.IsZero())
+				}
+			}
+
+			// only open orders rest in the depth book
+			bookQuantity := sdk.ZeroDec()
+			for _, item := range keeper.GetDepthBookCopy(types.TestTokenPair).Items {
+				bookQuantity = bookQuantity.Add(item.BuyQuantity).Add(item.SellQuantity)
+			}
+			require.Equal(t, restingQuantity, bookQuantity)
+		})
+	}
+}
@@ -10,9 +10,34 @@ const (
 	FeeTypeOrderNew     = "new"
 	FeeTypeOrderCancel  = "cancel"
 	FeeTypeOrderExpire  = "expire"
+	FeeTypeOrderKill    = "kill"
+	FeeTypeOrderTrigger = "trigger"
 	FeeTypeOrderDeal    = "deal"
 	FeeTypeOrderReceive = "receive"
 	TestTokenPair       = common.TestToken + "_" + sdk.DefaultBondDenom
 	BuyOrder            = "BUY"
 	SellOrder           = "SELL"
 )
+
+// order types, orders without a type are limit orders
+const (
+	OrderTypeLimit = "LIMIT"
+	// OrderTypeIOC orders are matched in the block they are placed in, the unfilled part is killed then
+	OrderTypeIOC = "IOC"
+	// OrderTypeFOK orders are killed in the block they are placed in if they can't be filled entirely
+	OrderTypeFOK = "FOK"
+	// OrderTypePostOnly orders are refused if they would take liquidity from the depth book
+	OrderTypePostOnly = "POST_ONLY"
+	// OrderTypeMarket orders are IOC orders priced by the last price and a max slippage
+	OrderTypeMarket = "MARKET"
+)
+
+// trigger types of trigger orders
+const (
+	// TriggerTypeStopLoss orders are triggered when the last price moves against the side of the order:
+	// sell orders at or below the trigger price, buy orders at or above it
+	TriggerTypeStopLoss = "STOP_LOSS"
+	// TriggerTypeTakeProfit orders are triggered when the last price moves in favor of the side of the order:
+	// sell orders at or above the trigger price, buy orders at or below it
+	TriggerTypeTakeProfit = "TAKE_PROFIT"
+)
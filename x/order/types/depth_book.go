@@ -13,6 +13,9 @@ type Deal struct {
 	Quantity    sdk.Dec `json:"quantity"`
 	Fee         string  `json:"fee"`
 	FeeReceiver string  `json:"fee_receiver"`
+	// Price is the price the deal was filled at. The periodic auction fills all deals of a product at
+	// the match price, while the continuous auction fills every deal at the price of its maker order.
+	Price sdk.Dec `json:"price"`
 }
 
 // nolint
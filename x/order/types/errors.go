@@ -38,6 +38,12 @@ const (
 	CodeNotOrderOwner                         uint32 = 63026
 	CodeProductIsEmpty                        uint32 = 63027
 	CodeAllOrderFailedToExecute               uint32 = 63028
+	CodeInvalidOrderType                      uint32 = 63029
+	CodeInvalidMaxSlippage                    uint32 = 63030
+	CodePostOnlyOrderWouldTake                uint32 = 63031
+	CodeInvalidTriggerType                    uint32 = 63032
+	CodeTriggerOrderIsNotExist                uint32 = 63033
+	CodeTriggerConditionIsMet                 uint32 = 63034
 )
 
 func ErrInvalidAddress(address string) sdk.EnvelopedErr {
@@ -152,3 +158,27 @@ func ErrNotOrderOwner(orderID string) sdk.EnvelopedErr {
 func ErrAllOrderFailedToExecute() sdk.EnvelopedErr {
 	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeAllOrderFailedToExecute, "all order items failed to execute")}
 }
+
+func ErrInvalidOrderType(orderType string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInvalidOrderType, fmt.Sprintf("invalid order type: %s", orderType))}
+}
+
+func ErrInvalidMaxSlippage(reason string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInvalidMaxSlippage, fmt.Sprintf("invalid max slippage: %s", reason))}
+}
+
+func ErrPostOnlyOrderWouldTake(product string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodePostOnlyOrderWouldTake, fmt.Sprintf("post-only order would take liquidity from the depth book of %s", product))}
+}
+
+func ErrInvalidTriggerType(triggerType string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInvalidTriggerType, fmt.Sprintf("invalid trigger type: %s", triggerType))}
+}
+
+func ErrTriggerOrderIsNotExist(triggerOrderID string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeTriggerOrderIsNotExist, fmt.Sprintf("trigger order(%s) does not exist or has been closed", triggerOrderID))}
+}
+
+func ErrTriggerConditionIsMet(triggerPrice, lastPrice sdk.Dec) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeTriggerConditionIsMet, fmt.Sprintf("trigger price %s is already reached by the last price %s", triggerPrice, lastPrice))}
+}
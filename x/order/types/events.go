@@ -0,0 +1,11 @@
+package types
+
+// order module event types
+const (
+	EventTypeTriggerOrder = "trigger-order"
+
+	AttributeKeyTriggerOrderID = "trigger_order_id"
+	AttributeKeyOrderID        = "order_id"
+	AttributeKeyFee            = "fee"
+	AttributeKeyStatus         = "status"
+)
@@ -20,11 +20,12 @@ const (
 	RouterKey = ModuleName
 
 	// QueryOrderDetail query endpoints supported by the governance Querier
-	QueryOrderDetail = "detail"
-	QueryDepthBook   = "depthbook"
-	QueryParameters  = "params"
-	QueryStore       = "store"
-	QueryDepthBookV2 = "depthbookV2"
+	QueryOrderDetail  = "detail"
+	QueryDepthBook    = "depthbook"
+	QueryParameters   = "params"
+	QueryStore        = "store"
+	QueryDepthBookV2  = "depthbookV2"
+	QueryTriggerOrder = "trigger"
 
 	OrderStoreKey = ModuleName
 )
@@ -40,12 +41,19 @@ var (
 	PriceKey             = []byte{0x14}
 	ExpireBlockHeightKey = []byte{0x15}
 	OrderNumPerBlockKey  = []byte{0x16}
+	TriggerOrderKey      = []byte{0x21}
+	TradeVolumeKey       = []byte{0x23}
+	TriggerPriceKey      = []byte{0x24}
+	TriggerExpireKey     = []byte{0x25}
+	TriggerProductKey    = []byte{0x26}
 
 	// none iterator keys
 	RecentlyClosedOrderIDsKey = []byte{0x17}
 	LastExpiredBlockHeightKey = []byte{0x18}
 	OpenOrderNumKey           = []byte{0x19}
 	StoreOrderNumKey          = []byte{0x20}
+	TriggerOrderNumKey        = []byte{0x22}
+	DeferredTakerOrderIDsKey  = []byte{0x27}
 )
 
 // nolint
@@ -73,6 +81,47 @@ func GetOrderNumPerBlockKey(blockHeight int64) []byte {
 	return append(OrderNumPerBlockKey, sdk.Uint64ToBigEndian(uint64(blockHeight))...)
 }
 
+// nolint
+func GetTriggerOrderKey(key string) []byte {
+	return append(TriggerOrderKey, []byte(key)...)
+}
+
+// GetTriggerPricePrefix returns the prefix of the trigger orders of a product, which are triggered by the last price
+// falling or rising to their trigger prices
+func GetTriggerPricePrefix(product string, byFall bool) []byte {
+	direction := "rise"
+	if byFall {
+		direction = "fall"
+	}
+	return append(TriggerPriceKey, []byte(fmt.Sprintf("%s:%s:", product, direction))...)
+}
+
+// GetTriggerPriceKey returns the key indexing a trigger order by its product and trigger price
+func GetTriggerPriceKey(product string, byFall bool, triggerPrice sdk.Dec, triggerOrderID string) []byte {
+	key := append(GetTriggerPricePrefix(product, byFall), sdk.SortableDecBytes(triggerPrice)...)
+	return append(key, []byte(":"+triggerOrderID)...)
+}
+
+// GetTriggerExpirePrefix returns the prefix of the trigger orders expiring at the block height
+func GetTriggerExpirePrefix(blockHeight int64) []byte {
+	return append(TriggerExpireKey, sdk.Uint64ToBigEndian(uint64(blockHeight))...)
+}
+
+// GetTriggerExpireKey returns the key indexing a trigger order by the block height it expires at
+func GetTriggerExpireKey(blockHeight int64, triggerOrderID string) []byte {
+	return append(GetTriggerExpirePrefix(blockHeight), []byte(triggerOrderID)...)
+}
+
+// GetTriggerProductKey returns the key of the num of open trigger orders of a product
+func GetTriggerProductKey(product string) []byte {
+	return append(TriggerProductKey, []byte(product)...)
+}
+
+// nolint
+func GetTradeVolumeKey(product string, addr sdk.AccAddress) []byte {
+	return append(append(TradeVolumeKey, []byte(product+":")...), addr.Bytes()...)
+}
+
 // nolint
 func GetExpireBlockHeightKey(blockHeight int64) []byte {
 	return append(ExpireBlockHeightKey, sdk.Uint64ToBigEndian(uint64(blockHeight))...)
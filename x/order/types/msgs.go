@@ -14,11 +14,13 @@ const (
 
 // nolint
 type MsgNewOrder struct {
-	Sender   sdk.AccAddress `json:"sender"`   // order maker address
-	Product  string         `json:"product"`  // product for trading pair in full name of the tokens
-	Side     string         `json:"side"`     // BUY/SELL
-	Price    sdk.Dec        `json:"price"`    // price of the order
-	Quantity sdk.Dec        `json:"quantity"` // quantity of the order
+	Sender      sdk.AccAddress `json:"sender"`       // order maker address
+	Product     string         `json:"product"`      // product for trading pair in full name of the tokens
+	Side        string         `json:"side"`         // BUY/SELL
+	Price       sdk.Dec        `json:"price"`        // price of the order
+	Quantity    sdk.Dec        `json:"quantity"`     // quantity of the order
+	Type        string         `json:"type"`         // type of the order
+	MaxSlippage string         `json:"max_slippage"` // max slippage of market orders
 }
 
 // NewMsgNewOrder is a constructor function for MsgNewOrder
@@ -64,8 +66,12 @@ type MsgNewOrders struct {
 type OrderItem struct {
 	Product  string  `json:"product"`  // product for trading pair in full name of the tokens
 	Side     string  `json:"side"`     // BUY/SELL
-	Price    sdk.Dec `json:"price"`    // price of the order
+	Price    sdk.Dec `json:"price"`    // price of the order, zero for market orders
 	Quantity sdk.Dec `json:"quantity"` // quantity of the order
+	// Type is the order type, see OrderTypeXXX. Limit orders leave it empty to keep their sign bytes.
+	Type string `json:"type,omitempty"`
+	// MaxSlippage caps the price of market orders, e.g. "0.05" prices a buy order at 105% of the last price
+	MaxSlippage string `json:"max_slippage,omitempty"`
 }
 
 // nolint
@@ -105,24 +111,56 @@ func (msg MsgNewOrders) ValidateBasic() sdk.Error {
 		return ErrOrderItemCountsBiggerThanLimit(OrderItemLimit)
 	}
 	for _, item := range msg.OrderItems {
-		if len(item.Product) == 0 {
-			return ErrOrderItemProductCountsIsEmpty()
+		if err := validateOrderItem(item); err != nil {
+			return err
 		}
-		symbols := strings.Split(item.Product, "_")
-		if len(symbols) != 2 {
-			return ErrOrderItemProductFormat()
-		}
-		if symbols[0] == symbols[1] {
-			return ErrOrderItemProductSymbolIsEqual()
-		}
-		if item.Side != BuyOrder && item.Side != SellOrder {
-			return ErrOrderItemSideIsNotBuyAndSell()
+	}
+
+	return nil
+}
+
+func validateOrderItem(item OrderItem) sdk.Error {
+	if len(item.Product) == 0 {
+		return ErrOrderItemProductCountsIsEmpty()
+	}
+	symbols := strings.Split(item.Product, "_")
+	if len(symbols) != 2 {
+		return ErrOrderItemProductFormat()
+	}
+	if symbols[0] == symbols[1] {
+		return ErrOrderItemProductSymbolIsEqual()
+	}
+	if item.Side != BuyOrder && item.Side != SellOrder {
+		return ErrOrderItemSideIsNotBuyAndSell()
+	}
+	return validateOrderItemType(item)
+}
+
+func validateOrderItemType(item OrderItem) sdk.Error {
+	if !IsValidOrderType(item.Type) {
+		return ErrInvalidOrderType(item.Type)
+	}
+	if item.Type != OrderTypeMarket {
+		if len(item.MaxSlippage) != 0 {
+			return ErrInvalidMaxSlippage("only market orders have a max slippage")
 		}
 		if !(item.Price.IsPositive() && item.Quantity.IsPositive()) {
 			return ErrOrderItemPriceOrQuantityIsNotPositive()
 		}
+		return nil
 	}
 
+	// market orders are priced by the last price and the max slippage when placed
+	if !(item.Price.IsNil() || item.Price.IsZero()) || !item.Quantity.IsPositive() {
+		return ErrOrderItemPriceOrQuantityIsNotPositive()
+	}
+	maxSlippage, err := sdk.NewDecFromStr(item.MaxSlippage)
+	if err != nil {
+		return ErrInvalidMaxSlippage(err.Error())
+	}
+	if !maxSlippage.IsPositive() || maxSlippage.GTE(sdk.OneDec()) {
+		return ErrInvalidMaxSlippage("it should be greater than 0 and less than 1")
+	}
 	return nil
 }
 
@@ -214,6 +252,122 @@ func (msg MsgCancelOrders) CalculateGas(gasUnit uint64) uint64 {
 	return uint64(len(msg.OrderIDs)) * gasUnit
 }
 
+// nolint
+type MsgNewTriggerOrder struct {
+	Sender       sdk.AccAddress `json:"sender"`        // order maker address
+	Product      string         `json:"product"`       // product for trading pair in full name of the tokens
+	Side         string         `json:"side"`          // BUY/SELL
+	TriggerType  string         `json:"trigger_type"`  // STOP_LOSS/TAKE_PROFIT
+	TriggerPrice sdk.Dec        `json:"trigger_price"` // the order is triggered when the last price reaches it
+	Price        sdk.Dec        `json:"price"`         // price of the triggered order, zero for market orders
+	Quantity     sdk.Dec        `json:"quantity"`      // quantity of the triggered order
+	// OrderType is the type of the triggered order, see OrderTypeXXX
+	OrderType string `json:"order_type,omitempty"`
+	// MaxSlippage caps the price of the triggered market order
+	MaxSlippage string `json:"max_slippage,omitempty"`
+}
+
+// NewMsgNewTriggerOrder is a constructor function for MsgNewTriggerOrder
+func NewMsgNewTriggerOrder(sender sdk.AccAddress, triggerType string, triggerPrice sdk.Dec,
+	item OrderItem) MsgNewTriggerOrder {
+	return MsgNewTriggerOrder{
+		Sender:       sender,
+		Product:      item.Product,
+		Side:         item.Side,
+		TriggerType:  triggerType,
+		TriggerPrice: triggerPrice,
+		Price:        item.Price,
+		Quantity:     item.Quantity,
+		OrderType:    item.Type,
+		MaxSlippage:  item.MaxSlippage,
+	}
+}
+
+// nolint
+func (msg MsgNewTriggerOrder) Route() string { return "order" }
+
+// nolint
+func (msg MsgNewTriggerOrder) Type() string { return "new_trigger" }
+
+// ValidateBasic : Implements Msg.
+func (msg MsgNewTriggerOrder) ValidateBasic() sdk.Error {
+	if msg.Sender.Empty() {
+		return ErrInvalidAddress(msg.Sender.String())
+	}
+	if !IsValidTriggerType(msg.TriggerType) {
+		return ErrInvalidTriggerType(msg.TriggerType)
+	}
+	if !msg.TriggerPrice.IsPositive() {
+		return ErrOrderItemPriceOrQuantityIsNotPositive()
+	}
+	return validateOrderItem(msg.GetOrderItem())
+}
+
+// GetOrderItem returns the order item placed once the trigger order is triggered
+func (msg MsgNewTriggerOrder) GetOrderItem() OrderItem {
+	return OrderItem{
+		Product:     msg.Product,
+		Side:        msg.Side,
+		Price:       msg.Price,
+		Quantity:    msg.Quantity,
+		Type:        msg.OrderType,
+		MaxSlippage: msg.MaxSlippage,
+	}
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgNewTriggerOrder) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgNewTriggerOrder) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// nolint
+type MsgCancelTriggerOrder struct {
+	Sender         sdk.AccAddress `json:"sender"`
+	TriggerOrderID string         `json:"trigger_order_id"`
+}
+
+// NewMsgCancelTriggerOrder is a constructor function for MsgCancelTriggerOrder
+func NewMsgCancelTriggerOrder(sender sdk.AccAddress, triggerOrderID string) MsgCancelTriggerOrder {
+	return MsgCancelTriggerOrder{
+		Sender:         sender,
+		TriggerOrderID: triggerOrderID,
+	}
+}
+
+// nolint
+func (msg MsgCancelTriggerOrder) Route() string { return "order" }
+
+// nolint
+func (msg MsgCancelTriggerOrder) Type() string { return "cancel_trigger" }
+
+// nolint
+func (msg MsgCancelTriggerOrder) ValidateBasic() sdk.Error {
+	if msg.Sender.Empty() {
+		return ErrInvalidAddress(msg.Sender.String())
+	}
+	if msg.TriggerOrderID == "" {
+		return ErrUserInputOrderIDIsEmpty()
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgCancelTriggerOrder) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgCancelTriggerOrder) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
 // nolint
 type OrderResult struct {
 	Error   error  `json:"error"`
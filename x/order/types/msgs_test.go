@@ -93,6 +93,51 @@ func TestMsgNewOrderInvalid(t *testing.T) {
 	require.NotNil(t, err)
 }
 
+func TestMsgNewOrderType(t *testing.T) {
+	addr, err := hex.DecodeString("1212121212121212123412121212121212121234")
+	require.Nil(t, err)
+	product := "btc_" + common.NativeToken
+
+	testCases := []struct {
+		name        string
+		orderType   string
+		price       string
+		maxSlippage string
+		expectPass  bool
+	}{
+		{"limit", OrderTypeLimit, testPrice, "", true},
+		{"ioc", OrderTypeIOC, testPrice, "", true},
+		{"fok", OrderTypeFOK, testPrice, "", true},
+		{"post only", OrderTypePostOnly, testPrice, "", true},
+		{"market", OrderTypeMarket, "0", "0.05", true},
+		{"unknown type", "GTC", testPrice, "", false},
+		{"limit with max slippage", OrderTypeLimit, testPrice, "0.05", false},
+		{"market with price", OrderTypeMarket, testPrice, "0.05", false},
+		{"market without max slippage", OrderTypeMarket, "0", "", false},
+		{"market with invalid max slippage", OrderTypeMarket, "0", "abc", false},
+		{"market with zero max slippage", OrderTypeMarket, "0", "0", false},
+		{"market with max slippage of one", OrderTypeMarket, "0", "1", false},
+	}
+
+	for _, tc := range testCases {
+		item := NewOrderItem(product, BuyOrder, tc.price, testQuantity)
+		item.Type = tc.orderType
+		item.MaxSlippage = tc.maxSlippage
+		err := NewMsgNewOrders(addr, []OrderItem{item}).ValidateBasic()
+		if tc.expectPass {
+			require.Nil(t, err, tc.name)
+		} else {
+			require.NotNil(t, err, tc.name)
+		}
+	}
+
+	// the sign bytes of limit orders don't change
+	orderMsg := NewMsgNewOrder(addr, product, BuyOrder, testPrice, testQuantity)
+	require.Equal(t, `{"type":"okexchain/order/MsgNew","value":{"order_items":[{"price":"0.100000000000000000",`+
+		`"product":"btc_okt","quantity":"1.000000000000000000","side":"BUY"}],`+
+		`"sender":"cosmos1zgfpyysjzgfpyy35zgfpyysjzgfpyy35endkk8"}}`, string(orderMsg.GetSignBytes()))
+}
+
 func TestMsgCancelOrder(t *testing.T) {
 	orderID := testOrderID
 	addr, err := hex.DecodeString("1212121212121212123412121212121212121234")
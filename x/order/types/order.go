@@ -20,6 +20,9 @@ const (
 	Expired
 	PartialFilledCancelled
 	PartialFilledExpired
+	_ // reserved for PartialFilled
+	Killed
+	PartialFilledKilled
 )
 
 func (p OrderStatus) String() string {
@@ -36,6 +39,10 @@ func (p OrderStatus) String() string {
 		return "PartialFilledCancelled"
 	case PartialFilledExpired:
 		return "PartialFilledExpired"
+	case Killed:
+		return "Killed"
+	case PartialFilledKilled:
+		return "PartialFilledKilled"
 	default:
 		return "Unknown"
 	}
@@ -50,6 +57,8 @@ const (
 	OrderStatusPartialFilledCancelled = 4
 	OrderStatusPartialFilledExpired   = 5
 	//OrderStatusPartialFilled          = 6
+	OrderStatusKilled              = 7
+	OrderStatusPartialFilledKilled = 8
 )
 
 // nolint
@@ -77,7 +86,8 @@ type Order struct {
 	Timestamp         int64          `json:"timestamp"`        // created timestamp
 	OrderExpireBlocks int64          `json:"order_expire_blocks"`
 	FeePerBlock       sdk.SysCoin    `json:"fee_per_block"`
-	ExtraInfo         string         `json:"extra_info"` // extra info of order in json format
+	ExtraInfo         string         `json:"extra_info"`     // extra info of order in json format
+	Type              string         `json:"type,omitempty"` // order type, see OrderTypeXXX, empty for limit orders
 }
 
 // nolint
@@ -207,6 +217,28 @@ func (order *Order) Cancel() {
 	}
 }
 
+// Kill quits the unfilled part of an order by its type
+func (order *Order) Kill() {
+	if order.RemainQuantity.Equal(order.Quantity) {
+		order.Status = OrderStatusKilled
+	} else {
+		order.Status = OrderStatusPartialFilledKilled
+	}
+}
+
+// GetType returns the type of the order, OrderTypeLimit if not set
+func (order *Order) GetType() string {
+	if order.Type == "" {
+		return OrderTypeLimit
+	}
+	return order.Type
+}
+
+// IsImmediate returns true if the unfilled part of the order is killed after matching in its block
+func (order *Order) IsImmediate() bool {
+	return IsImmediateOrderType(order.Type)
+}
+
 // nolint
 func (order *Order) Expire() {
 	if order.RemainQuantity.Equal(order.Quantity) {
@@ -245,6 +277,21 @@ func (order *Order) Unlock() {
 	order.RemainLocked = sdk.ZeroDec()
 }
 
+// IsValidOrderType returns true if the order type is supported
+func IsValidOrderType(orderType string) bool {
+	switch orderType {
+	case "", OrderTypeLimit, OrderTypeIOC, OrderTypeFOK, OrderTypePostOnly, OrderTypeMarket:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsImmediateOrderType returns true if orders of the type never rest in the depth book after their block
+func IsImmediateOrderType(orderType string) bool {
+	return orderType == OrderTypeIOC || orderType == OrderTypeFOK || orderType == OrderTypeMarket
+}
+
 // nolint
 func FormatOrderID(blockHeight, orderNum int64) string {
 	format := "ID%010d-%d"
@@ -128,6 +128,41 @@ func TestOrderExpire(t *testing.T) {
 	require.EqualValues(t, "PartialFilledExpired", OrderStatus(order2.Status).String())
 }
 
+func TestOrderKill(t *testing.T) {
+	// Full kill
+	order := MockOrder("", TestTokenPair, SellOrder, "0.1", "10.0")
+	order.Type = OrderTypeFOK
+	order.Kill()
+	require.EqualValues(t, OrderStatusKilled, order.Status)
+	require.EqualValues(t, "Killed", OrderStatus(order.Status).String())
+	require.True(t, order.IsImmediate())
+
+	// Partial kill
+	order2 := MockOrder("", TestTokenPair, SellOrder, "0.1", "10.0")
+	order2.Type = OrderTypeIOC
+	order2.Fill(sdk.MustNewDecFromStr("0.2"), sdk.MustNewDecFromStr("5"))
+	order2.Kill()
+	require.EqualValues(t, OrderStatusPartialFilledKilled, order2.Status)
+	require.EqualValues(t, "PartialFilledKilled", OrderStatus(order2.Status).String())
+}
+
+func TestOrderType(t *testing.T) {
+	order := MockOrder("", TestTokenPair, SellOrder, "0.1", "10.0")
+	require.Equal(t, OrderTypeLimit, order.GetType())
+	require.False(t, order.IsImmediate())
+
+	order.Type = OrderTypePostOnly
+	require.Equal(t, OrderTypePostOnly, order.GetType())
+	require.False(t, order.IsImmediate())
+
+	order.Type = OrderTypeMarket
+	require.True(t, order.IsImmediate())
+
+	require.True(t, IsValidOrderType(""))
+	require.True(t, IsValidOrderType(OrderTypeLimit))
+	require.False(t, IsValidOrderType("GTC"))
+}
+
 func TestOrderNeedLockCoins(t *testing.T) {
 	order := MockOrder("", TestTokenPair, BuyOrder, "0.1", "10.0")
 	decCoins := order.NeedLockCoins()
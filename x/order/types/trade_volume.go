@@ -0,0 +1,40 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+// TradeVolumeWindowBlocks is the number of blocks of a window in which the trade volumes are accumulated, about
+// 30 days of blocks, and the trade volumes restart from zero in every new window
+const TradeVolumeWindowBlocks = 864000
+
+// TradeVolume is the total quantity of the quote asset an address has traded on a product in a window of blocks,
+// which decides the volume tier of the fee schedule of the dex operator applied to its deals
+type TradeVolume struct {
+	Product string         `json:"product"`
+	Address sdk.AccAddress `json:"address"`
+	Volume  sdk.Dec        `json:"volume"`
+	Window  int64          `json:"window"`
+}
+
+// NewTradeVolume creates a new instance of TradeVolume
+func NewTradeVolume(product string, addr sdk.AccAddress, volume sdk.Dec, window int64) TradeVolume {
+	return TradeVolume{
+		Product: product,
+		Address: addr,
+		Volume:  volume,
+		Window:  window,
+	}
+}
+
+// GetTradeVolumeWindow returns the window of trade volumes the block height is in
+func GetTradeVolumeWindow(blockHeight int64) int64 {
+	return blockHeight / TradeVolumeWindowBlocks
+}
+
+// String returns a human readable string representation of TradeVolume
+func (tv TradeVolume) String() string {
+	return fmt.Sprintf("%s %s: %s in window %d", tv.Product, tv.Address, tv.Volume, tv.Window)
+}
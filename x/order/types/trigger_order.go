@@ -0,0 +1,162 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+// TriggerOrder is a stop-loss or take-profit order which rests in the order store until the last price
+// of its product reaches the trigger price, then it is converted into a normal limit or market order
+type TriggerOrder struct {
+	TxHash         string         `json:"txhash"`           // txHash of the place trigger order tx
+	TriggerOrderID string         `json:"trigger_order_id"` // trigger order id
+	Sender         sdk.AccAddress `json:"sender"`           // order maker address
+	Product        string         `json:"product"`          // product for trading pair
+	Side           string         `json:"side"`             // BUY/SELL
+	TriggerType    string         `json:"trigger_type"`     // STOP_LOSS/TAKE_PROFIT
+	TriggerPrice   sdk.Dec        `json:"trigger_price"`    // the order is triggered when the last price reaches it
+	Price          sdk.Dec        `json:"price"`            // price of the triggered order, zero for market orders
+	Quantity       sdk.Dec        `json:"quantity"`         // quantity of the triggered order
+	OrderType      string         `json:"order_type"`       // type of the triggered order, empty for limit orders
+	MaxSlippage    string         `json:"max_slippage"`     // max slippage of the triggered market order
+	RemainLocked   sdk.Dec        `json:"remain_locked"`    // locked quantity of token
+	Timestamp      int64          `json:"timestamp"`        // created timestamp
+	ExpireBlocks   int64          `json:"expire_blocks"`
+	FeePerBlock    sdk.SysCoin    `json:"fee_per_block"`
+}
+
+// NewTriggerOrder creates a new trigger order, the coins to lock are computed from the trigger price for
+// market buy orders
+func NewTriggerOrder(txHash string, msg MsgNewTriggerOrder, timestamp int64, expireBlocks int64,
+	feePerBlock sdk.SysCoin) *TriggerOrder {
+	order := &TriggerOrder{
+		TxHash:       txHash,
+		Sender:       msg.Sender,
+		Product:      msg.Product,
+		Side:         msg.Side,
+		TriggerType:  msg.TriggerType,
+		TriggerPrice: msg.TriggerPrice,
+		Price:        msg.Price,
+		Quantity:     msg.Quantity,
+		OrderType:    msg.OrderType,
+		MaxSlippage:  msg.MaxSlippage,
+		Timestamp:    timestamp,
+		ExpireBlocks: expireBlocks,
+		FeePerBlock:  feePerBlock,
+	}
+	if order.Price.IsNil() {
+		order.Price = sdk.ZeroDec()
+	}
+	order.RemainLocked = order.Quantity
+	if order.Side == BuyOrder {
+		order.RemainLocked = order.lockPrice().Mul(order.Quantity)
+	}
+	return order
+}
+
+func (order *TriggerOrder) String() string {
+	if orderJSON, err := json.Marshal(order); err != nil {
+		panic(err)
+	} else {
+		return string(orderJSON)
+	}
+}
+
+// lockPrice returns the price the coins of a buy order are locked at, which the triggered order is capped at
+func (order *TriggerOrder) lockPrice() sdk.Dec {
+	if order.OrderType != OrderTypeMarket {
+		return order.Price
+	}
+	maxSlippage, err := sdk.NewDecFromStr(order.MaxSlippage)
+	if err != nil {
+		return order.TriggerPrice
+	}
+	return order.TriggerPrice.Mul(sdk.OneDec().Add(maxSlippage))
+}
+
+// IsTriggeredByFall returns true if the order waits for the last price to fall to the trigger price, which is the
+// case of stop-loss sell orders and take-profit buy orders
+func (order *TriggerOrder) IsTriggeredByFall() bool {
+	return (order.TriggerType == TriggerTypeStopLoss) == (order.Side == SellOrder)
+}
+
+// IsTriggered returns true if the last price has reached the trigger price
+func (order *TriggerOrder) IsTriggered(lastPrice sdk.Dec) bool {
+	if order.IsTriggeredByFall() {
+		return lastPrice.LTE(order.TriggerPrice)
+	}
+	return lastPrice.GTE(order.TriggerPrice)
+}
+
+// GetExpireHeight returns the block height the order expires at if not triggered
+func (order *TriggerOrder) GetExpireHeight() int64 {
+	return GetBlockHeightFromTriggerOrderID(order.TriggerOrderID) + order.ExpireBlocks
+}
+
+// CapPrice caps the price of the triggered buy order at the price its coins are locked at, rounded down to
+// priceDigit, so that the order never needs more coins than the trigger order locks
+func (order *TriggerOrder) CapPrice(price sdk.Dec, priceDigit int64) sdk.Dec {
+	lockPrice := order.lockPrice()
+	if order.Side != BuyOrder || price.LTE(lockPrice) {
+		return price
+	}
+	capped := lockPrice.RoundDecimal(priceDigit)
+	if capped.GT(lockPrice) {
+		capped = capped.Sub(sdk.NewDecWithPrec(1, priceDigit))
+	}
+	return capped
+}
+
+// GetOrderItem returns the order item the trigger order is converted into once triggered
+func (order *TriggerOrder) GetOrderItem() OrderItem {
+	return OrderItem{
+		Product:     order.Product,
+		Side:        order.Side,
+		Price:       order.Price,
+		Quantity:    order.Quantity,
+		Type:        order.OrderType,
+		MaxSlippage: order.MaxSlippage,
+	}
+}
+
+// NeedLockCoins : when place a new trigger order, we should lock the coins of sender
+func (order *TriggerOrder) NeedLockCoins() sdk.SysCoins {
+	symbols := strings.Split(order.Product, "_")
+	token := symbols[0]
+	if order.Side == BuyOrder {
+		token = symbols[1]
+	}
+	return sdk.SysCoins{{Denom: token, Amount: order.RemainLocked}}
+}
+
+// IsValidTriggerType returns true if the trigger type is supported
+func IsValidTriggerType(triggerType string) bool {
+	return triggerType == TriggerTypeStopLoss || triggerType == TriggerTypeTakeProfit
+}
+
+// nolint
+func FormatTriggerOrderID(blockHeight, orderNum int64) string {
+	format := "TR%010d-%d"
+	if blockHeight > 9999999999 {
+		format = "TR%d-%d"
+	}
+	return fmt.Sprintf(format, blockHeight, orderNum)
+}
+
+// nolint
+func GetBlockHeightFromTriggerOrderID(triggerOrderID string) int64 {
+	var blockHeight int64
+	var id int64
+	format := "TR%d-%d"
+	_, err := fmt.Sscanf(triggerOrderID, format, &blockHeight, &id)
+	if err != nil {
+		log.Println(err)
+		return 0
+	}
+
+	return blockHeight
+}
@@ -0,0 +1,99 @@
+package types
+
+import (
+	"encoding/hex"
+	"testing"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/exchain/x/common"
+)
+
+func TestTriggerOrderIsTriggered(t *testing.T) {
+	triggerPrice := sdk.MustNewDecFromStr("10")
+	lower, higher := sdk.MustNewDecFromStr("9.9"), sdk.MustNewDecFromStr("10.1")
+	testCases := []struct {
+		side, triggerType string
+		triggeredByLower  bool
+	}{
+		{SellOrder, TriggerTypeStopLoss, true},
+		{BuyOrder, TriggerTypeStopLoss, false},
+		{SellOrder, TriggerTypeTakeProfit, false},
+		{BuyOrder, TriggerTypeTakeProfit, true},
+	}
+	for _, tc := range testCases {
+		order := TriggerOrder{Side: tc.side, TriggerType: tc.triggerType, TriggerPrice: triggerPrice}
+		require.True(t, order.IsTriggered(triggerPrice), tc)
+		require.Equal(t, tc.triggeredByLower, order.IsTriggered(lower), tc)
+		require.Equal(t, !tc.triggeredByLower, order.IsTriggered(higher), tc)
+	}
+}
+
+func TestNewTriggerOrder(t *testing.T) {
+	params := DefaultTestParams()
+	addr, err := hex.DecodeString("1212121212121212123412121212121212121234")
+	require.Nil(t, err)
+
+	// limit orders lock coins by their prices
+	msg := NewMsgNewTriggerOrder(addr, TriggerTypeStopLoss, sdk.MustNewDecFromStr("1.2"),
+		NewOrderItem(TestTokenPair, BuyOrder, "1.1", "10.0"))
+	order := NewTriggerOrder("hash", msg, 123, params.OrderExpireBlocks, params.FeePerBlock)
+	require.Equal(t, sdk.SysCoins{sdk.NewDecCoinFromDec(common.NativeToken, sdk.MustNewDecFromStr("11"))},
+		order.NeedLockCoins())
+	msg.Side = SellOrder
+	order = NewTriggerOrder("hash", msg, 123, params.OrderExpireBlocks, params.FeePerBlock)
+	require.Equal(t, sdk.SysCoins{sdk.NewDecCoinFromDec(common.TestToken, sdk.MustNewDecFromStr("10"))},
+		order.NeedLockCoins())
+
+	// market buy orders lock coins by the trigger price and the max slippage
+	msg = NewMsgNewTriggerOrder(addr, TriggerTypeStopLoss, sdk.MustNewDecFromStr("1.2"), OrderItem{
+		Product: TestTokenPair, Side: BuyOrder, Price: sdk.ZeroDec(), Quantity: sdk.MustNewDecFromStr("10.0"),
+		Type: OrderTypeMarket, MaxSlippage: "0.1"})
+	order = NewTriggerOrder("hash", msg, 123, params.OrderExpireBlocks, params.FeePerBlock)
+	require.Equal(t, sdk.SysCoins{sdk.NewDecCoinFromDec(common.NativeToken, sdk.MustNewDecFromStr("13.2"))},
+		order.NeedLockCoins())
+	require.Equal(t, msg.GetOrderItem(), order.GetOrderItem())
+
+	// the triggered market buy order is capped at the lock price rounded down
+	require.Equal(t, sdk.MustNewDecFromStr("1.3"), order.CapPrice(sdk.MustNewDecFromStr("1.3"), 1))
+	require.Equal(t, sdk.MustNewDecFromStr("1.32"), order.CapPrice(sdk.MustNewDecFromStr("1.5"), 2))
+	require.Equal(t, sdk.MustNewDecFromStr("1.3"), order.CapPrice(sdk.MustNewDecFromStr("1.5"), 1))
+	msg.Side = SellOrder
+	order = NewTriggerOrder("hash", msg, 123, params.OrderExpireBlocks, params.FeePerBlock)
+	require.Equal(t, sdk.MustNewDecFromStr("1.5"), order.CapPrice(sdk.MustNewDecFromStr("1.5"), 1))
+
+	// trigger order id
+	require.Equal(t, "TR0000000010-2", FormatTriggerOrderID(10, 2))
+	require.EqualValues(t, 10, GetBlockHeightFromTriggerOrderID(FormatTriggerOrderID(10, 2)))
+}
+
+func TestMsgNewTriggerOrder(t *testing.T) {
+	addr, err := hex.DecodeString("1212121212121212123412121212121212121234")
+	require.Nil(t, err)
+	item := NewOrderItem(TestTokenPair, SellOrder, "1.0", "10.0")
+
+	msg := NewMsgNewTriggerOrder(addr, TriggerTypeStopLoss, sdk.MustNewDecFromStr("1.1"), item)
+	require.Nil(t, msg.ValidateBasic())
+	require.Equal(t, "new_trigger", msg.Type())
+	require.Equal(t, []sdk.AccAddress{addr}, msg.GetSigners())
+
+	invalidMsgs := []MsgNewTriggerOrder{
+		NewMsgNewTriggerOrder(nil, TriggerTypeStopLoss, sdk.MustNewDecFromStr("1.1"), item),
+		NewMsgNewTriggerOrder(addr, "STOP", sdk.MustNewDecFromStr("1.1"), item),
+		NewMsgNewTriggerOrder(addr, TriggerTypeTakeProfit, sdk.ZeroDec(), item),
+		NewMsgNewTriggerOrder(addr, TriggerTypeTakeProfit, sdk.MustNewDecFromStr("1.1"),
+			NewOrderItem(TestTokenPair, "SIDE", "1.0", "10.0")),
+		NewMsgNewTriggerOrder(addr, TriggerTypeTakeProfit, sdk.MustNewDecFromStr("1.1"),
+			OrderItem{Product: TestTokenPair, Side: SellOrder, Price: sdk.ZeroDec(),
+				Quantity: sdk.MustNewDecFromStr("10.0"), Type: OrderTypeMarket}),
+	}
+	for _, msg := range invalidMsgs {
+		require.NotNil(t, msg.ValidateBasic(), msg)
+	}
+
+	cancelMsg := NewMsgCancelTriggerOrder(addr, FormatTriggerOrderID(10, 1))
+	require.Nil(t, cancelMsg.ValidateBasic())
+	require.NotNil(t, NewMsgCancelTriggerOrder(addr, "").ValidateBasic())
+	require.NotNil(t, NewMsgCancelTriggerOrder(nil, FormatTriggerOrderID(10, 1)).ValidateBasic())
+}
@@ -40,12 +40,15 @@ var (
 	GetValidatorsByPowerIndexKey       = types.GetValidatorsByPowerIndexKey
 	NewMsgCreateValidator              = types.NewMsgCreateValidator
 	NewMsgEditValidator                = types.NewMsgEditValidator
+	NewMsgEditValidatorCommissionRate  = types.NewMsgEditValidatorCommissionRate
 	NewMsgDeposit                      = types.NewMsgDeposit
 	NewMsgWithdraw                     = types.NewMsgWithdraw
 	DefaultParams                      = types.DefaultParams
 	NewValidator                       = types.NewValidator
 	NewDescription                     = types.NewDescription
 	NewMsgAddShares                    = types.NewMsgAddShares
+	NewMsgRedelegateShares             = types.NewMsgRedelegateShares
+	NewMsgSetAutoRestake               = types.NewMsgSetAutoRestake
 	NewGenesisState                    = types.NewGenesisState
 	DelegatorAddSharesInvariant        = keeper.DelegatorAddSharesInvariant
 
@@ -66,4 +69,6 @@ type (
 	UndelegationInfo          = types.UndelegationInfo
 	ProxyDelegatorKeyExported = types.ProxyDelegatorKeyExported
 	SharesResponses           = types.SharesResponses
+	Redelegation              = types.Redelegation
+	Redelegations             = types.Redelegations
 )
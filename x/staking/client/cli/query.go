@@ -32,6 +32,7 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdQueryValidator(queryRoute, cdc),
 		GetCmdQueryValidators(queryRoute, cdc),
 		GetCmdQueryProxy(queryRoute, cdc),
+		GetCmdQueryRedelegations(queryRoute, cdc),
 		GetCmdQueryParams(queryRoute, cdc),
 		GetCmdQueryPool(queryRoute, cdc))...)
 
@@ -218,6 +219,49 @@ $ %s query staking proxy ex1cftp8q8g4aa65nw9s5trwexe77d9t6cr8ndu02
 	}
 }
 
+// GetCmdQueryRedelegations gets command for querying the immature redelegations of a delegator
+func GetCmdQueryRedelegations(storeName string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "redelegations [address]",
+		Short: "query the immature redelegations of a delegator",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the redelegations of a delegator whose unbonding time hasn't passed yet
+
+Example:
+$ %s query staking redelegations ex1cftp8q8g4aa65nw9s5trwexe77d9t6cr8ndu02
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			delAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid address：%s", args[0])
+			}
+
+			bytes, err := cdc.MarshalJSON(types.NewQueryDelegatorParams(delAddr))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryRedelegations)
+			resp, _, err := cliCtx.QueryWithData(route, bytes)
+			if err != nil {
+				return err
+			}
+
+			var reds types.Redelegations
+			if err := cdc.UnmarshalJSON(resp, &reds); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(reds)
+		},
+	}
+}
+
 // Delegators is a type alias of sdk.AccAddress slice
 type Delegators []sdk.AccAddress
 
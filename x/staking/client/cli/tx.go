@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/okex/exchain/x/common"
 
@@ -20,6 +21,7 @@ import (
 	"github.com/okex/exchain/libs/cosmos-sdk/client/context"
 	"github.com/okex/exchain/libs/cosmos-sdk/codec"
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/version"
 	"github.com/okex/exchain/libs/cosmos-sdk/x/auth"
 	"github.com/okex/exchain/libs/cosmos-sdk/x/auth/client/utils"
 	"github.com/okex/exchain/x/staking/types"
@@ -40,9 +42,12 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 			GetCmdCreateValidator(cdc),
 			GetCmdDestroyValidator(cdc),
 			GetCmdEditValidator(cdc),
+			GetCmdEditValidatorCommissionRate(cdc),
 			GetCmdDeposit(cdc),
 			GetCmdWithdraw(cdc),
 			GetCmdAddShares(cdc),
+			GetCmdRedelegateShares(cdc),
+			GetCmdSetAutoRestake(cdc),
 		)...)
 
 	stakingTxCmd.AddCommand(GetCmdProxy(cdc))
@@ -134,6 +139,39 @@ func GetCmdEditValidator(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetCmdEditValidatorCommissionRate gets the edit validator commission rate command
+func GetCmdEditValidatorCommissionRate(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "edit-validator-commission-rate [commission-rate]",
+		Short: "edit the commission rate of an existing validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Edit the commission rate of an existing validator, the rest of the rewards allocated to the
+validator are distributed to the delegators who added shares to it. The rate is a fraction between 0 and 1, it can't
+be raised and can only be changed once within 24 hours.
+
+Example:
+$ %s tx staking edit-validator-commission-rate 0.2 --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			rate, err := sdk.NewDecFromStr(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid commission rate: %s", args[0])
+			}
+
+			msg := types.NewMsgEditValidatorCommissionRate(sdk.ValAddress(cliCtx.GetFromAddress()), rate)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
 //__________________________________________________________
 
 var (
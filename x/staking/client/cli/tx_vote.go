@@ -3,6 +3,7 @@ package cli
 import (
 	"bufio"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/okex/exchain/libs/cosmos-sdk/client/flags"
@@ -144,6 +145,72 @@ func GetCmdAddShares(cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+// GetCmdRedelegateShares gets command for moving the shares from a validator to another without unbonding
+func GetCmdRedelegateShares(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "redelegate-shares [src-validator-addr] [dst-validator-addr] [flags]",
+		Args:  cobra.ExactArgs(2),
+		Short: "move the shares added to a validator to another one without unbonding",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Move the shares added to a validator to another one without unbonding. The shares moved can't be
+moved on from the destination validator until the unbonding time passes.
+
+Example:
+$ %s tx staking redelegate-shares exvaloper1alq9na49n9yycysh889rl90g9nhe58lcqkfpfg exvaloper1svzxp4ts5le2s4zugx34ajt6shz2hg42dnwst5 --from mykey
+`,
+				version.ClientName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(auth.DefaultTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			valSrcAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			valDstAddr, err := sdk.ValAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRedelegateShares(cliCtx.GetFromAddress(), valSrcAddr, valDstAddr)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdSetAutoRestake gets command for opting in or out of restaking the rewards automatically
+func GetCmdSetAutoRestake(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-auto-restake [true|false] [flags]",
+		Args:  cobra.ExactArgs(1),
+		Short: "opt in or out of restaking the rewards automatically at the end of every epoch",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Opt in or out of restaking the rewards automatically. When opted in, the %s rewards withdrawn from the
+validators that the delegator added shares to are deposited at the end of every epoch.
+
+Example:
+$ %s tx staking set-auto-restake true --from mykey
+`,
+				sdk.DefaultBondDenom, version.ClientName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(auth.DefaultTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			enabled, err := strconv.ParseBool(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAutoRestake(cliCtx.GetFromAddress(), enabled)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
 // GetCmdProxy gets subcommands for proxy voting
 func GetCmdProxy(cdc *codec.Codec) *cobra.Command {
 
@@ -32,6 +32,18 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		delegatorProxyHandlerFn(cliCtx),
 	).Methods("GET")
 
+	// query the immature redelegations of a delegator
+	r.HandleFunc(
+		"/staking/delegators/{delegatorAddr}/redelegations",
+		delegatorRedelegationsHandlerFn(cliCtx),
+	).Methods("GET")
+
+	// query whether a delegator restakes its rewards automatically
+	r.HandleFunc(
+		"/staking/delegators/{delegatorAddr}/auto_restake",
+		delegatorAutoRestakeHandlerFn(cliCtx),
+	).Methods("GET")
+
 	// query the all shares on a validator
 	r.HandleFunc(
 		"/staking/validators/{validatorAddr}/shares",
@@ -87,6 +99,16 @@ func delegatorProxyHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return queryDelegator(cliCtx, fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryProxy))
 }
 
+// HTTP request handler to query the immature redelegations of a delegator
+func delegatorRedelegationsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return queryDelegator(cliCtx, fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryRedelegations))
+}
+
+// HTTP request handler to query whether a delegator restakes its rewards automatically
+func delegatorAutoRestakeHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return queryDelegator(cliCtx, fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAutoRestake))
+}
+
 // HTTP request handler to query the info of delegator's unbonding delegation
 func delegatorUnbondingDelegationsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return queryDelegator(cliCtx, fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryUnbondingDelegation))
@@ -7,8 +7,11 @@ import (
 
 // DelegatorI expected delegator functions
 type DelegatorI interface {
+	GetDelegatorAddress() sdk.AccAddress
 	GetShareAddedValidatorAddresses() []sdk.ValAddress
 	GetLastAddedShares() sdk.Dec
+	GetTokens() sdk.Dec
+	GetProxyAddress() sdk.AccAddress
 }
 
 // ValidatorI expected validator functions
@@ -28,6 +31,7 @@ type ValidatorI interface {
 	GetCommission() sdk.Dec                                 // validator commission rate
 	GetMinSelfDelegation() sdk.Dec                          // validator minimum self delegation
 	GetDelegatorShares() sdk.Dec                            // total outstanding delegator shares
+	GetAddedShares() sdk.Dec                                // delegator shares without the ones of the min self delegation
 	TokensFromShares(sdk.Dec) sdk.Dec                       // token worth of provided delegator shares
 	TokensFromSharesTruncated(sdk.Dec) sdk.Dec              // token worth of provided delegator shares, truncated
 	TokensFromSharesRoundUp(sdk.Dec) sdk.Dec                // token worth of provided delegator shares, rounded up
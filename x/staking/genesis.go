@@ -45,6 +45,12 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, accountKeeper types.AccountKeep
 	for _, proxyDelegatorKeyExported := range data.ProxyDelegatorKeys {
 		keeper.SetProxyBinding(ctx, proxyDelegatorKeyExported.ProxyAddr, proxyDelegatorKeyExported.DelAddr, false)
 	}
+	for _, red := range data.Redelegations {
+		initRedelegation(ctx, red, keeper)
+	}
+	for _, delAddr := range data.AutoRestakeDelegators {
+		keeper.SetAutoRestake(ctx, delAddr, true)
+	}
 
 	checkPools(ctx, keeper, sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, bondedTokens),
 		sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, notBondedTokens), data.Exported)
@@ -104,6 +110,13 @@ func initUnbondingDelegation(ctx sdk.Context, ubd UndelegationInfo, keeper Keepe
 	*notBondedTokens = notBondedTokens.Add(ubd.Quantity)
 }
 
+func initRedelegation(ctx sdk.Context, red types.Redelegation, keeper Keeper) {
+	keeper.SetRedelegation(ctx, red)
+	for _, entry := range red.Entries {
+		keeper.InsertRedelegationQueue(ctx, red, entry.CompletionTime)
+	}
+}
+
 func initDelegator(ctx sdk.Context, delegator Delegator, keeper Keeper, pBondedTokens *sdk.Dec) {
 	keeper.SetDelegator(ctx, delegator)
 	*pBondedTokens = pBondedTokens.Add(delegator.Tokens)
@@ -164,16 +177,29 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 		return false
 	})
 
+	var redelegations []types.Redelegation
+	keeper.IterateRedelegations(ctx, func(_ int64, red types.Redelegation) (stop bool) {
+		redelegations = append(redelegations, red)
+		return false
+	})
+	var autoRestakeDelegators []sdk.AccAddress
+	keeper.IterateAutoRestakeDelegators(ctx, func(_ int64, delAddr sdk.AccAddress) (stop bool) {
+		autoRestakeDelegators = append(autoRestakeDelegators, delAddr)
+		return false
+	})
+
 	return types.GenesisState{
-		Params:               params,
-		LastTotalPower:       lastTotalPower,
-		LastValidatorPowers:  lastValidatorPowers,
-		Validators:           validators.Export(),
-		Delegators:           delegators,
-		UnbondingDelegations: undelegationInfos,
-		AllShares:            sharesExportedSlice,
-		ProxyDelegatorKeys:   proxyDelegatorKeys,
-		Exported:             true,
+		Params:                params,
+		LastTotalPower:        lastTotalPower,
+		LastValidatorPowers:   lastValidatorPowers,
+		Validators:            validators.Export(),
+		Delegators:            delegators,
+		UnbondingDelegations:  undelegationInfos,
+		AllShares:             sharesExportedSlice,
+		ProxyDelegatorKeys:    proxyDelegatorKeys,
+		Redelegations:         redelegations,
+		AutoRestakeDelegators: autoRestakeDelegators,
+		Exported:              true,
 	}
 }
 
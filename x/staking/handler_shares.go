@@ -1,6 +1,7 @@
 package staking
 
 import (
+	"strconv"
 	"time"
 
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
@@ -52,6 +53,7 @@ func handleMsgBindProxy(ctx sdk.Context, msg types.MsgBindProxy, k keeper.Keeper
 	}
 
 	// bind proxy relationship
+	k.BeforeProxyTokensModified(ctx, proxyDelegator.DelegatorAddress)
 	delegator.BindProxy(msg.ProxyAddress)
 
 	// update proxy's shares weight
@@ -82,6 +84,7 @@ func unbindProxy(ctx sdk.Context, delAddr sdk.AccAddress, k keeper.Keeper) error
 	}
 
 	// update proxy's shares weight
+	k.BeforeProxyTokensModified(ctx, proxyDelegator.DelegatorAddress)
 	if k.UpdateProxy(ctx, delegator, delegator.Tokens.Mul(sdk.NewDec(-1))) != nil {
 		return types.ErrInvalidDelegation(delAddr.String())
 	}
@@ -136,6 +139,7 @@ func unregProxy(ctx sdk.Context, proxyAddr sdk.AccAddress, k keeper.Keeper) (*sd
 		return types.ErrProxyNotFound(proxyAddr.String()).Result()
 	}
 
+	k.BeforeProxyTokensModified(ctx, proxy.DelegatorAddress)
 	proxy.RegProxy(false)
 	// unreg action, we need to erase all proxy relationship
 	proxy.TotalDelegatedTokens = sdk.ZeroDec()
@@ -179,7 +183,13 @@ func handleMsgAddShares(ctx sdk.Context, msg types.MsgAddShares, k keeper.Keeper
 	// 1. get last validators which were added shares to and existing in the store
 	lastVals, lastShares := k.GetLastValsAddedSharesExisted(ctx, msg.DelAddr)
 
-	// 2. withdraw the shares last time
+	// 2. withdraw the shares last time, except that the shares received by an immature redelegation can't leave
+	for _, val := range lastVals {
+		if k.HasReceivingRedelegation(ctx, msg.DelAddr, val.OperatorAddress) &&
+			!containsValAddr(msg.ValAddrs, val.OperatorAddress) {
+			return nil, types.ErrTransitiveRedelegation(msg.DelAddr.String(), val.OperatorAddress.String())
+		}
+	}
 	k.WithdrawLastShares(ctx, msg.DelAddr, lastVals, lastShares)
 
 	// 3. get validators to add shares this time (if the validator doesn't exist, return error)
@@ -209,6 +219,53 @@ func handleMsgAddShares(ctx sdk.Context, msg types.MsgAddShares, k keeper.Keeper
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
 
+func handleMsgRedelegateShares(ctx sdk.Context, msg types.MsgRedelegateShares, k keeper.Keeper) (*sdk.Result, error) {
+	completionTime, err := k.RedelegateShares(ctx, msg.DelegatorAddress, msg.ValidatorSrcAddress, msg.ValidatorDstAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	shares, _ := k.GetShares(ctx, msg.DelegatorAddress, msg.ValidatorDstAddress)
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRedelegateShares,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress.String()),
+			sdk.NewAttribute(types.AttributeKeySrcValidator, msg.ValidatorSrcAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyDstValidator, msg.ValidatorDstAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyShares, shares.String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgSetAutoRestake(ctx sdk.Context, msg types.MsgSetAutoRestake, k keeper.Keeper) (*sdk.Result, error) {
+	if _, found := k.GetDelegator(ctx, msg.DelegatorAddress); !found {
+		return nil, types.ErrNoDelegatorExisted(msg.DelegatorAddress.String())
+	}
+
+	k.SetAutoRestake(ctx, msg.DelegatorAddress, msg.Enabled)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetAutoRestake,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyAutoRestake, strconv.FormatBool(msg.Enabled)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
 // validateSharesAdding gives a quick validity of target validators before shares adding
 func validateSharesAdding(vals types.Validators) error {
 	if len(vals) == 0 {
@@ -325,3 +382,13 @@ func handleMsgDestroyValidator(ctx sdk.Context, msg types.MsgDestroyValidator, k
 	return &sdk.Result{Data: completionTimeBytes, Events: ctx.EventManager().Events()}, nil
 
 }
+
+// containsValAddr tells whether the validator address is among the shares adding targets
+func containsValAddr(valAddrs []sdk.ValAddress, valAddr sdk.ValAddress) bool {
+	for _, addr := range valAddrs {
+		if addr.Equals(valAddr) {
+			return true
+		}
+	}
+	return false
+}
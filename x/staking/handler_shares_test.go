@@ -2,6 +2,7 @@ package staking
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 	"github.com/okex/exchain/x/staking/types"
@@ -82,3 +83,116 @@ func TestHandlerBadMessage(t *testing.T) {
 	r, err := handler(ctx, msg)
 	require.NotNil(t, err, r)
 }
+
+func TestHandlerRedelegateShares(t *testing.T) {
+	ctx, _, mockKeeper := CreateTestInput(t, false, SufficientInitPower)
+	ctx = ctx.WithBlockTime(time.Now())
+	keeper := mockKeeper.Keeper
+	handler := NewHandler(keeper)
+
+	for i := 0; i < 3; i++ {
+		_, err := handler(ctx, NewTestMsgCreateValidator(addrVals[i], PKs[i], DefaultMSD))
+		require.Nil(t, err)
+	}
+	delAddr := ValidDelegator1
+	_, err := handler(ctx, types.NewMsgDeposit(delAddr, sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, DelegatedToken1)))
+	require.Nil(t, err)
+	_, err = handler(ctx, types.NewMsgAddShares(delAddr, addrVals[:2]))
+	require.Nil(t, err)
+	shares, found := keeper.GetShares(ctx, delAddr, addrVals[0])
+	require.True(t, found)
+
+	// redelegating to an added validator or from a validator without shares fails
+	_, err = handler(ctx, types.NewMsgRedelegateShares(delAddr, addrVals[0], addrVals[1]))
+	require.NotNil(t, err)
+	_, err = handler(ctx, types.NewMsgRedelegateShares(delAddr, addrVals[2], addrVals[0]))
+	require.NotNil(t, err)
+
+	// move the shares from validator 0 to validator 2
+	_, err = handler(ctx, types.NewMsgRedelegateShares(delAddr, addrVals[0], addrVals[2]))
+	require.Nil(t, err)
+	_, found = keeper.GetShares(ctx, delAddr, addrVals[0])
+	require.False(t, found)
+	movedShares, found := keeper.GetShares(ctx, delAddr, addrVals[2])
+	require.True(t, found)
+	require.Equal(t, shares, movedShares)
+	delegator, found := keeper.GetDelegator(ctx, delAddr)
+	require.True(t, found)
+	require.Equal(t, []sdk.ValAddress{addrVals[2], addrVals[1]}, delegator.ValidatorAddresses)
+
+	// the shares received can't be moved on until the redelegation matures
+	_, err = handler(ctx, types.NewMsgRedelegateShares(delAddr, addrVals[2], addrVals[0]))
+	require.NotNil(t, err)
+	reds := keeper.GetRedelegations(ctx, delAddr)
+	require.Equal(t, 1, len(reds))
+	require.Equal(t, 1, len(reds[0].Entries))
+	require.Equal(t, shares, reds[0].Entries[0].Shares)
+
+	// nor be withdrawn by adding shares to the other validators, while adding shares to them again keeps them
+	_, err = handler(ctx, types.NewMsgAddShares(delAddr, addrVals[:1]))
+	require.NotNil(t, err)
+	_, found = keeper.GetShares(ctx, delAddr, addrVals[2])
+	require.True(t, found)
+	_, err = handler(ctx, types.NewMsgAddShares(delAddr, []sdk.ValAddress{addrVals[2], addrVals[0]}))
+	require.Nil(t, err)
+	_, found = keeper.GetShares(ctx, delAddr, addrVals[2])
+	require.True(t, found)
+
+	// the redelegations are exported to genesis
+	genesisState := ExportGenesis(ctx, keeper)
+	require.Equal(t, 1, len(genesisState.Redelegations))
+	require.Equal(t, 1, len(genesisState.Redelegations[0].Entries))
+
+	// all the entries mature after the unbonding time
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(keeper.UnbondingTime(ctx)))
+	EndBlocker(ctx, keeper)
+	require.Equal(t, 0, len(keeper.GetRedelegations(ctx, delAddr)))
+	_, err = handler(ctx, types.NewMsgAddShares(delAddr, addrVals[:1]))
+	require.Nil(t, err)
+
+	// a pair of validators holds one immature entry at most, the destination validator stays added meanwhile
+	_, err = handler(ctx, types.NewMsgRedelegateShares(delAddr, addrVals[0], addrVals[2]))
+	require.Nil(t, err)
+	_, err = handler(ctx, types.NewMsgAddShares(delAddr, []sdk.ValAddress{addrVals[2], addrVals[0]}))
+	require.Nil(t, err)
+	_, err = handler(ctx, types.NewMsgRedelegateShares(delAddr, addrVals[0], addrVals[2]))
+	require.Equal(t, types.ErrRedelegateToAddedValidator(delAddr.String(), addrVals[2].String()).Error(), err.Error())
+	red, found := keeper.GetRedelegation(ctx, delAddr, addrVals[0], addrVals[2])
+	require.True(t, found)
+	require.Equal(t, 1, len(red.Entries))
+
+	// the redelegations are removed once all the tokens are withdrawn
+	_, err = handler(ctx, types.NewMsgWithdraw(delAddr, sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, DelegatedToken1)))
+	require.Nil(t, err)
+	require.Equal(t, 0, len(keeper.GetRedelegations(ctx, delAddr)))
+}
+
+func TestHandlerSetAutoRestake(t *testing.T) {
+	ctx, _, mockKeeper := CreateTestInput(t, false, SufficientInitPower)
+	keeper := mockKeeper.Keeper
+	handler := NewHandler(keeper)
+	delAddr := ValidDelegator1
+
+	// only an existing delegator is able to opt in
+	_, err := handler(ctx, types.NewMsgSetAutoRestake(delAddr, true))
+	require.NotNil(t, err)
+	_, err = handler(ctx, types.NewMsgDeposit(delAddr, sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, DelegatedToken1)))
+	require.Nil(t, err)
+
+	_, err = handler(ctx, types.NewMsgSetAutoRestake(delAddr, true))
+	require.Nil(t, err)
+	require.True(t, keeper.IsAutoRestake(ctx, delAddr))
+	require.Equal(t, []sdk.AccAddress{delAddr}, ExportGenesis(ctx, keeper).AutoRestakeDelegators)
+
+	_, err = handler(ctx, types.NewMsgSetAutoRestake(delAddr, false))
+	require.Nil(t, err)
+	require.False(t, keeper.IsAutoRestake(ctx, delAddr))
+
+	// the flag is removed with the delegator once all the tokens are withdrawn
+	_, err = handler(ctx, types.NewMsgSetAutoRestake(delAddr, true))
+	require.Nil(t, err)
+	_, err = handler(ctx, types.NewMsgWithdraw(delAddr, sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, DelegatedToken1)))
+	require.Nil(t, err)
+	require.False(t, keeper.IsAutoRestake(ctx, delAddr))
+	require.Equal(t, 0, len(ExportGenesis(ctx, keeper).AutoRestakeDelegators))
+}
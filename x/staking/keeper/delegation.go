@@ -27,6 +27,16 @@ func (k Keeper) UpdateProxy(ctx sdk.Context, delegator types.Delegator, tokens s
 	return sdk.ErrInvalidAddress(delegator.ProxyAddress.String())
 }
 
+// beforeDelegatorTokensModified calls the hooks on the shares of the proxy before the tokens of the proxy or one of its
+// bound delegators are modified
+func (k Keeper) beforeDelegatorTokensModified(ctx sdk.Context, delegator types.Delegator) {
+	if delegator.HasProxy() {
+		k.BeforeProxyTokensModified(ctx, delegator.ProxyAddress)
+	} else if delegator.IsProxy {
+		k.BeforeProxyTokensModified(ctx, delegator.DelegatorAddress)
+	}
+}
+
 // Delegate handles the process of delegating
 func (k Keeper) Delegate(ctx sdk.Context, delAddr sdk.AccAddress, token sdk.SysCoin) error {
 
@@ -48,6 +58,7 @@ func (k Keeper) Delegate(ctx sdk.Context, delAddr sdk.AccAddress, token sdk.SysC
 	}
 
 	// 3.update delegator
+	k.beforeDelegatorTokensModified(ctx, delegator)
 	delegator.Tokens = delegator.Tokens.Add(delQuantity)
 	k.SetDelegator(ctx, delegator)
 
@@ -86,6 +97,7 @@ func (k Keeper) Withdraw(ctx sdk.Context, delAddr sdk.AccAddress, token sdk.SysC
 
 	// 1.some okt transfer bondPool into unbondPool
 	k.bondedTokensToNotBonded(ctx, token)
+	k.beforeDelegatorTokensModified(ctx, delegator)
 
 	// 2.delete delegator in store, or set back
 	if delegator.HasProxy() {
@@ -97,6 +109,8 @@ func (k Keeper) Withdraw(ctx sdk.Context, delAddr sdk.AccAddress, token sdk.SysC
 		// withdraw all shares
 		lastVals, lastShares := k.GetLastValsAddedSharesExisted(ctx, delAddr)
 		k.WithdrawLastShares(ctx, delAddr, lastVals, lastShares)
+		// the redelegated shares are unbonded as well, so the redelegations don't restrict them any more
+		k.removeRedelegations(ctx, delAddr)
 		if delegator.HasProxy() {
 			k.SetProxyBinding(ctx, delegator.ProxyAddress, delAddr, true)
 		}
@@ -23,9 +23,11 @@ func (k Keeper) SetDelegator(ctx sdk.Context, delegator types.Delegator) {
 	ctx.KVStore(k.storeKey).Set(key, bytes)
 }
 
-// DeleteDelegator deletes Delegator info from store
+// DeleteDelegator deletes Delegator info from store, together with its auto-restake flag
 func (k Keeper) DeleteDelegator(ctx sdk.Context, delAddr sdk.AccAddress) {
-	ctx.KVStore(k.storeKey).Delete(types.GetDelegatorKey(delAddr))
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetDelegatorKey(delAddr))
+	store.Delete(types.GetAutoRestakeKey(delAddr))
 }
 
 // IterateDelegator iterates through all of the delegators info from the store
@@ -86,5 +86,5 @@ func (k Keeper) addSharesAsDefaultMinSelfDelegation(ctx sdk.Context, pValidator
 
 // RULES: any msd -> 1 shares
 func (k Keeper) getSharesFromDefaultMinSelfDelegation() sdk.Dec {
-	return sdk.OneDec()
+	return types.MinSelfDelegationShares()
 }
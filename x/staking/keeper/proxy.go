@@ -78,12 +78,14 @@ func (k Keeper) UpdateShares(ctx sdk.Context, delAddr sdk.AccAddress, tokens sdk
 		k.DeleteValidatorByPowerIndex(ctx, vals[i])
 
 		// 2.update shares
+		k.BeforeDelegationSharesModified(ctx, delAddr, vals[i].OperatorAddress)
 		k.SetShares(ctx, delAddr, vals[i].OperatorAddress, shares)
 
 		// 3.update validator
 		vals[i].DelegatorShares = vals[i].DelegatorShares.Sub(lastShares).Add(shares)
 		k.SetValidator(ctx, vals[i])
 		k.SetValidatorByPowerIndex(ctx, vals[i])
+		k.AfterDelegationModified(ctx, delAddr, vals[i].OperatorAddress)
 	}
 
 	// update the delegator struct
@@ -118,6 +120,7 @@ func (k Keeper) WithdrawLastShares(ctx sdk.Context, delAddr sdk.AccAddress, last
 
 func (k Keeper) withdrawShares(ctx sdk.Context, delAddr sdk.AccAddress, val types.Validator, shares types.Shares) {
 	// 1.delete shares entity
+	k.BeforeDelegationSharesModified(ctx, delAddr, val.OperatorAddress)
 	k.DeleteShares(ctx, val.OperatorAddress, delAddr)
 
 	// 2.update validator entity
@@ -138,6 +141,7 @@ func (k Keeper) withdrawShares(ctx sdk.Context, delAddr sdk.AccAddress, val type
 
 func (k Keeper) addShares(ctx sdk.Context, delAddr sdk.AccAddress, val types.Validator, shares types.Shares) {
 	// 1.update shares entity
+	k.BeforeDelegationSharesModified(ctx, delAddr, val.OperatorAddress)
 	k.SetShares(ctx, delAddr, val.OperatorAddress, shares)
 
 	// 2.update validator entity
@@ -145,6 +149,16 @@ func (k Keeper) addShares(ctx sdk.Context, delAddr sdk.AccAddress, val types.Val
 	val.DelegatorShares = val.GetDelegatorShares().Add(shares)
 	k.SetValidator(ctx, val)
 	k.SetValidatorByPowerIndex(ctx, val)
+	k.AfterDelegationModified(ctx, delAddr, val.OperatorAddress)
+}
+
+// BeforeProxyTokensModified calls the hooks on the shares added by a proxy before the tokens of the proxy or its bound
+// delegators are modified, so that what was accumulated on the shares is split among them by the former tokens
+func (k Keeper) BeforeProxyTokensModified(ctx sdk.Context, proxyAddr sdk.AccAddress) {
+	vals, _ := k.GetLastValsAddedSharesExisted(ctx, proxyAddr)
+	for _, val := range vals {
+		k.BeforeDelegationSharesModified(ctx, proxyAddr, val.OperatorAddress)
+	}
 }
 
 // GetLastValsAddedSharesExisted gets last validators that the delegator added shares to last time
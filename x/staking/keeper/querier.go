@@ -39,6 +39,10 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryProxy(ctx, req, k)
 		case types.QueryDelegator:
 			return queryDelegator(ctx, req, k)
+		case types.QueryRedelegations:
+			return queryRedelegations(ctx, req, k)
+		case types.QueryAutoRestake:
+			return queryAutoRestake(ctx, req, k)
 		default:
 			return nil, types.ErrUnknownStakingQueryType()
 		}
@@ -169,6 +173,39 @@ func queryProxy(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error
 	return resp, nil
 }
 
+func queryRedelegations(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryDelegatorParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, common.ErrUnMarshalJSONFailed(err.Error())
+	}
+
+	reds := k.GetRedelegations(ctx, params.DelegatorAddr)
+	if len(reds) == 0 {
+		return nil, types.ErrNoRedelegation(params.DelegatorAddr.String())
+	}
+
+	resp, err := codec.MarshalJSONIndent(types.ModuleCdc, reds)
+	if err != nil {
+		return nil, common.ErrMarshalJSONFailed(err.Error())
+	}
+
+	return resp, nil
+}
+
+func queryAutoRestake(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryDelegatorParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, common.ErrUnMarshalJSONFailed(err.Error())
+	}
+
+	resp, err := codec.MarshalJSONIndent(types.ModuleCdc, k.IsAutoRestake(ctx, params.DelegatorAddr))
+	if err != nil {
+		return nil, common.ErrMarshalJSONFailed(err.Error())
+	}
+
+	return resp, nil
+}
+
 func queryValidatorAllShares(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
 	var params types.QueryValidatorParams
 
@@ -0,0 +1,226 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/staking/types"
+)
+
+// GetRedelegation gets the redelegation of a delegator from a validator to another
+func (k Keeper) GetRedelegation(ctx sdk.Context, delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress) (
+	red types.Redelegation, found bool) {
+	bytes := ctx.KVStore(k.storeKey).Get(types.GetRedelegationKey(delAddr, valSrcAddr, valDstAddr))
+	if bytes == nil {
+		return red, false
+	}
+
+	return types.MustUnMarshalRedelegation(k.cdc, bytes), true
+}
+
+// SetRedelegation sets the redelegation to store
+func (k Keeper) SetRedelegation(ctx sdk.Context, red types.Redelegation) {
+	key := types.GetRedelegationKey(red.DelegatorAddress, red.ValidatorSrcAddress, red.ValidatorDstAddress)
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(red))
+}
+
+// RemoveRedelegation deletes the redelegation from store
+func (k Keeper) RemoveRedelegation(ctx sdk.Context, red types.Redelegation) {
+	key := types.GetRedelegationKey(red.DelegatorAddress, red.ValidatorSrcAddress, red.ValidatorDstAddress)
+	ctx.KVStore(k.storeKey).Delete(key)
+}
+
+// GetRedelegations gets all the redelegations of a delegator
+func (k Keeper) GetRedelegations(ctx sdk.Context, delAddr sdk.AccAddress) (reds types.Redelegations) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GetRedelegationsKey(delAddr))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		reds = append(reds, types.MustUnMarshalRedelegation(k.cdc, iterator.Value()))
+	}
+	return
+}
+
+// IterateRedelegations iterates through all of the redelegations
+func (k Keeper) IterateRedelegations(ctx sdk.Context, fn func(index int64, red types.Redelegation) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.RedelegationKey)
+	defer iterator.Close()
+
+	for i := int64(0); iterator.Valid(); iterator.Next() {
+		red := types.MustUnMarshalRedelegation(k.cdc, iterator.Value())
+		if stop := fn(i, red); stop {
+			break
+		}
+		i++
+	}
+}
+
+// removeRedelegations removes all the redelegations of a delegator, the entries left in the redelegations queue are
+// skipped when they mature
+func (k Keeper) removeRedelegations(ctx sdk.Context, delAddr sdk.AccAddress) {
+	for _, red := range k.GetRedelegations(ctx, delAddr) {
+		k.RemoveRedelegation(ctx, red)
+	}
+}
+
+// HasReceivingRedelegation checks whether the shares of a delegator on a validator come from an immature redelegation
+func (k Keeper) HasReceivingRedelegation(ctx sdk.Context, delAddr sdk.AccAddress, valDstAddr sdk.ValAddress) bool {
+	for _, red := range k.GetRedelegations(ctx, delAddr) {
+		if red.ValidatorDstAddress.Equals(valDstAddr) && len(red.Entries) != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// InsertRedelegationQueue inserts a redelegation entry into the redelegations queue
+func (k Keeper) InsertRedelegationQueue(ctx sdk.Context, red types.Redelegation, completionTime time.Time) {
+	key := types.GetRedelegationTimeWithAddrsKey(completionTime, red.DelegatorAddress, red.ValidatorSrcAddress,
+		red.ValidatorDstAddress)
+	ctx.KVStore(k.storeKey).Set(key, []byte{})
+}
+
+// CompleteRedelegations removes the mature entries of all the redelegations in the queue until the current block time
+func (k Keeper) CompleteRedelegations(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	currentTime := ctx.BlockHeader().Time
+	iterator := store.Iterator(types.RedelegationQueueKey,
+		sdk.PrefixEndBytes(types.GetRedelegationTimeKey(currentTime)))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		store.Delete(iterator.Key())
+		_, delAddr, valSrcAddr, valDstAddr := types.SplitRedelegationTimeWithAddrsKey(iterator.Key())
+		red, found := k.GetRedelegation(ctx, delAddr, valSrcAddr, valDstAddr)
+		if !found {
+			continue
+		}
+
+		matured := red.RemoveMatureEntries(currentTime)
+		if len(red.Entries) == 0 {
+			k.RemoveRedelegation(ctx, red)
+		} else {
+			k.SetRedelegation(ctx, red)
+		}
+
+		for _, entry := range matured {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeCompleteRedelegation,
+					sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+					sdk.NewAttribute(types.AttributeKeySrcValidator, valSrcAddr.String()),
+					sdk.NewAttribute(types.AttributeKeyDstValidator, valDstAddr.String()),
+					sdk.NewAttribute(types.AttributeKeyShares, entry.Shares.String()),
+				),
+			)
+		}
+	}
+}
+
+// RedelegateShares moves the shares of a delegator from a validator to another without unbonding. The move is kept as
+// a redelegation entry until the unbonding time passes, during which the shares aren't allowed to move on from the
+// destination validator, so that a delegator can't hop its shares away from the validators it has just left
+func (k Keeper) RedelegateShares(ctx sdk.Context, delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress) (
+	completionTime time.Time, err error) {
+	if valSrcAddr.Equals(valDstAddr) {
+		return completionTime, types.ErrSelfRedelegation(valSrcAddr.String())
+	}
+
+	delegator, found := k.GetDelegator(ctx, delAddr)
+	if !found {
+		return completionTime, types.ErrNoDelegatorExisted(delAddr.String())
+	}
+	if delegator.HasProxy() {
+		return completionTime, types.ErrAddSharesDuringProxy(delegator.DelegatorAddress.String(),
+			delegator.ProxyAddress.String())
+	}
+
+	// check the validators of the delegator
+	srcIndex := -1
+	for i, valAddr := range delegator.ValidatorAddresses {
+		if valAddr.Equals(valSrcAddr) {
+			srcIndex = i
+		} else if valAddr.Equals(valDstAddr) {
+			return completionTime, types.ErrRedelegateToAddedValidator(delAddr.String(), valDstAddr.String())
+		}
+	}
+	if srcIndex < 0 {
+		return completionTime, types.ErrNoSharesToRedelegate(delAddr.String(), valSrcAddr.String())
+	}
+	shares, found := k.GetShares(ctx, delAddr, valSrcAddr)
+	if !found {
+		return completionTime, types.ErrNoSharesToRedelegate(delAddr.String(), valSrcAddr.String())
+	}
+
+	// redelegation-queue rules. A pair of validators holds one immature entry at most, since the destination
+	// validator stays added to the delegator until the entry matures
+	if k.HasReceivingRedelegation(ctx, delAddr, valSrcAddr) {
+		return completionTime, types.ErrTransitiveRedelegation(delAddr.String(), valSrcAddr.String())
+	}
+	red, found := k.GetRedelegation(ctx, delAddr, valSrcAddr, valDstAddr)
+	if !found {
+		red = types.NewRedelegation(delAddr, valSrcAddr, valDstAddr)
+	}
+
+	// check the destination validator
+	valSrc, found := k.GetValidator(ctx, valSrcAddr)
+	if !found {
+		return completionTime, types.ErrNoValidatorFound(valSrcAddr.String())
+	}
+	valDst, found := k.GetValidator(ctx, valDstAddr)
+	if !found {
+		return completionTime, types.ErrNoValidatorFound(valDstAddr.String())
+	}
+	if valDst.MinSelfDelegation.IsZero() {
+		return completionTime, types.ErrAddSharesToDismission(valDstAddr.String())
+	}
+
+	// move the shares
+	k.withdrawShares(ctx, delAddr, valSrc, shares)
+	k.addShares(ctx, delAddr, valDst, shares)
+	delegator.ValidatorAddresses[srcIndex] = valDstAddr
+	k.SetDelegator(ctx, delegator)
+
+	// record the redelegation entry
+	completionTime = ctx.BlockHeader().Time.Add(k.UnbondingTime(ctx))
+	red.AddEntry(ctx.BlockHeight(), completionTime, shares)
+	k.SetRedelegation(ctx, red)
+	k.InsertRedelegationQueue(ctx, red, completionTime)
+
+	return completionTime, nil
+}
+
+// SetAutoRestake sets whether the rewards of a delegator are restaked automatically
+func (k Keeper) SetAutoRestake(ctx sdk.Context, delAddr sdk.AccAddress, enabled bool) {
+	store := ctx.KVStore(k.storeKey)
+	if enabled {
+		store.Set(types.GetAutoRestakeKey(delAddr), []byte{})
+	} else {
+		store.Delete(types.GetAutoRestakeKey(delAddr))
+	}
+}
+
+// IsAutoRestake tells whether the rewards of a delegator are restaked automatically
+func (k Keeper) IsAutoRestake(ctx sdk.Context, delAddr sdk.AccAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetAutoRestakeKey(delAddr))
+}
+
+// IterateAutoRestakeDelegators iterates through all of the delegators who opt in to restake their rewards
+func (k Keeper) IterateAutoRestakeDelegators(ctx sdk.Context, fn func(index int64, delAddr sdk.AccAddress) (stop bool)) {
+	k.IterateAutoRestakeDelegatorsFrom(ctx, nil, fn)
+}
+
+// IterateAutoRestakeDelegatorsFrom iterates through the delegators who opt in to restake their rewards, starting from
+// the delegator address start in order, or from the first one if start is empty
+func (k Keeper) IterateAutoRestakeDelegatorsFrom(ctx sdk.Context, start sdk.AccAddress,
+	fn func(index int64, delAddr sdk.AccAddress) (stop bool)) {
+	iterator := ctx.KVStore(k.storeKey).Iterator(types.GetAutoRestakeKey(start), sdk.PrefixEndBytes(types.AutoRestakeKey))
+	defer iterator.Close()
+
+	for i := int64(0); iterator.Valid(); iterator.Next() {
+		if stop := fn(i, sdk.AccAddress(iterator.Key()[1:])); stop {
+			break
+		}
+		i++
+	}
+}
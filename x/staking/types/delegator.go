@@ -32,6 +32,11 @@ func NewDelegator(delAddr sdk.AccAddress) Delegator {
 	}
 }
 
+// GetDelegatorAddress gets the address of the delegator for other module
+func (d Delegator) GetDelegatorAddress() sdk.AccAddress {
+	return d.DelegatorAddress
+}
+
 // GetShareAddedValidatorAddresses gets validator address that the delegator added shares to for other module
 func (d Delegator) GetShareAddedValidatorAddresses() []sdk.ValAddress {
 	return d.ValidatorAddresses
@@ -42,6 +47,16 @@ func (d Delegator) GetLastAddedShares() sdk.Dec {
 	return d.Shares
 }
 
+// GetTokens gets the self-delegated tokens of a delegator for other module
+func (d Delegator) GetTokens() sdk.Dec {
+	return d.Tokens
+}
+
+// GetProxyAddress gets the address of the proxy bound by a delegator for other module, it's nil if no proxy is bound
+func (d Delegator) GetProxyAddress() sdk.AccAddress {
+	return d.ProxyAddress
+}
+
 // RegProxy registers or deregisters the identity of proxy
 func (d *Delegator) RegProxy(reg bool) {
 	d.IsProxy = reg
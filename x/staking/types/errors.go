@@ -58,6 +58,11 @@ const (
 	CodeNoDelegatorExisted              uint32 = 67044
 	CodeTargetValsDuplicate             uint32 = 67045
 	CodeAlreadyBound                    uint32 = 67046
+	CodeSelfRedelegation                uint32 = 67047
+	CodeNoSharesToRedelegate            uint32 = 67048
+	CodeRedelegateToAddedValidator      uint32 = 67049
+	CodeTransitiveRedelegation          uint32 = 67050
+	CodeNoRedelegation                  uint32 = 67052
 )
 
 // ErrNoValidatorFound returns an error when a validator doesn't exist
@@ -312,3 +317,37 @@ func ErrAlreadyBound(delAddr string) sdk.EnvelopedErr {
 		fmt.Sprintf("failed. %s has already bound a proxy. it's necessary to unbind before proxy register",
 			delAddr))}
 }
+
+// ErrSelfRedelegation returns an error when the source and destination validators of a redelegation are the same
+func ErrSelfRedelegation(valAddr string) sdk.Error {
+	return sdkerrors.New(DefaultCodespace, CodeSelfRedelegation,
+		fmt.Sprintf("failed. can't redelegate shares from %s to itself", valAddr))
+}
+
+// ErrNoSharesToRedelegate returns an error when a delegator tries to redelegate from a validator it hasn't added
+// shares to
+func ErrNoSharesToRedelegate(delAddr, valAddr string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeNoSharesToRedelegate,
+		fmt.Sprintf("failed. delegator %s hasn't added shares to validator %s", delAddr, valAddr))}
+}
+
+// ErrRedelegateToAddedValidator returns an error when a delegator tries to redelegate to a validator it has already
+// added shares to
+func ErrRedelegateToAddedValidator(delAddr, valAddr string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeRedelegateToAddedValidator,
+		fmt.Sprintf("failed. delegator %s has already added shares to validator %s", delAddr, valAddr))}
+}
+
+// ErrTransitiveRedelegation returns an error when a delegator tries to redelegate from a validator which the shares
+// were just redelegated to
+func ErrTransitiveRedelegation(delAddr, valAddr string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeTransitiveRedelegation,
+		fmt.Sprintf("failed. the shares of delegator %s on validator %s come from an immature redelegation",
+			delAddr, valAddr))}
+}
+
+// ErrNoRedelegation returns an error when a delegator has no redelegation
+func ErrNoRedelegation(delAddr string) sdk.Error {
+	return sdkerrors.New(DefaultCodespace, CodeNoRedelegation,
+		fmt.Sprintf("failed. delegator %s has no redelegation", delAddr))
+}
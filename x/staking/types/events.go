@@ -2,21 +2,28 @@ package types
 
 // staking module event types
 const (
-	EventTypeCompleteUnbonding = "complete_unbonding"
-	EventTypeCreateValidator   = "create_validator"
-	EventTypeEditValidator     = "edit_validator"
-	EventTypeDelegate          = "delegate"
-	EventTypeUnbond            = "unbond"
+	EventTypeCompleteUnbonding           = "complete_unbonding"
+	EventTypeCreateValidator             = "create_validator"
+	EventTypeEditValidator               = "edit_validator"
+	EventTypeEditValidatorCommissionRate = "edit_validator_commission_rate"
+	EventTypeDelegate                    = "delegate"
+	EventTypeUnbond                      = "unbond"
+	EventTypeRedelegateShares            = "redelegate_shares"
+	EventTypeCompleteRedelegation        = "complete_redelegation"
+	EventTypeSetAutoRestake              = "set_auto_restake"
 
 	AttributeKeyValidator         = "validator"
 	AttributeKeyCommissionRate    = "commission_rate"
 	AttributeKeyMinSelfDelegation = "min_self_delegation"
 	AttributeKeyDelegator         = "delegator"
 	AttributeKeyCompletionTime    = "completion_time"
+	AttributeKeySrcValidator      = "source_validator"
+	AttributeKeyDstValidator      = "destination_validator"
+	AttributeKeyAutoRestake       = "auto_restake"
 	AttributeValueCategory        = ModuleName
 
 	EventTypeAddShares = "add_shares"
 
 	AttributeKeyValidatorToAddShares = "validator_to_add_shares"
-	AttributeKeyShares              = "shares"
+	AttributeKeyShares               = "shares"
 )
@@ -17,7 +17,10 @@ type GenesisState struct {
 	UnbondingDelegations []UndelegationInfo          `json:"unbonding_delegations" yaml:"unbonding_delegations"`
 	AllShares            []SharesExported            `json:"all_shares" yaml:"all_shares"`
 	ProxyDelegatorKeys   []ProxyDelegatorKeyExported `json:"proxy_delegator_keys" yaml:"proxy_delegator_keys"`
-	Exported             bool                        `json:"exported" yaml:"exported"`
+	// Redelegations and AutoRestakeDelegators are omitted by the genesis files exported before they were introduced
+	Redelegations         []Redelegation   `json:"redelegations,omitempty" yaml:"redelegations,omitempty"`
+	AutoRestakeDelegators []sdk.AccAddress `json:"auto_restake_delegators,omitempty" yaml:"auto_restake_delegators,omitempty"`
+	Exported              bool             `json:"exported" yaml:"exported"`
 }
 
 // LastValidatorPower is needed for validator set update logic
@@ -49,6 +49,10 @@ var (
 	UnDelegateQueueKey  = []byte{0x54}
 	ProxyKey            = []byte{0x55}
 
+	RedelegationKey      = []byte{0x56} // prefix for each key to a redelegation
+	RedelegationQueueKey = []byte{0x57} // prefix for the timestamps in redelegations queue
+	AutoRestakeKey       = []byte{0x58} // prefix for the delegators who opt in to restake their rewards
+
 	// prefix key for vals info to enforce the update of validator-set
 	ValidatorAbandonedKey = []byte{0x60}
 
@@ -165,6 +169,52 @@ func SplitCompleteTimeWithAddrKey(key []byte) (time.Time, sdk.AccAddress) {
 	return endTime, delAddr
 }
 
+// GetRedelegationsKey gets the prefix for all the redelegations from a delegator
+func GetRedelegationsKey(delAddr sdk.AccAddress) []byte {
+	return append(RedelegationKey, delAddr.Bytes()...)
+}
+
+// GetRedelegationKey gets the key for a redelegation of a delegator from a validator to another
+// VALUE: staking/Redelegation
+func GetRedelegationKey(delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress) []byte {
+	key := make([]byte, 0, 1+3*sdk.AddrLen)
+	key = append(key, GetRedelegationsKey(delAddr)...)
+	key = append(key, valSrcAddr.Bytes()...)
+	return append(key, valDstAddr.Bytes()...)
+}
+
+// GetRedelegationTimeKey gets the prefix of the redelegations queue for a timestamp
+func GetRedelegationTimeKey(timestamp time.Time) []byte {
+	bz := sdk.FormatTimeBytes(timestamp)
+	return append(RedelegationQueueKey, bz...)
+}
+
+// GetRedelegationTimeWithAddrsKey gets the key of the redelegations queue for a redelegation entry
+func GetRedelegationTimeWithAddrsKey(timestamp time.Time, delAddr sdk.AccAddress, valSrcAddr,
+	valDstAddr sdk.ValAddress) []byte {
+	return append(GetRedelegationTimeKey(timestamp), GetRedelegationKey(delAddr, valSrcAddr, valDstAddr)[1:]...)
+}
+
+// SplitRedelegationTimeWithAddrsKey splits the key of the redelegations queue and returns the completion time and
+// the addresses of the redelegation
+func SplitRedelegationTimeWithAddrsKey(key []byte) (time.Time, sdk.AccAddress, sdk.ValAddress, sdk.ValAddress) {
+	if len(key[1:]) != lenTime+3*sdk.AddrLen {
+		panic(fmt.Sprintf("unexpected key length (%d ≠ %d)", len(key[1:]), lenTime+3*sdk.AddrLen))
+	}
+	endTime, err := sdk.ParseTimeBytes(key[1 : 1+lenTime])
+	if err != nil {
+		panic(err)
+	}
+	addrs := key[1+lenTime:]
+	return endTime, sdk.AccAddress(addrs[:sdk.AddrLen]), sdk.ValAddress(addrs[sdk.AddrLen : 2*sdk.AddrLen]),
+		sdk.ValAddress(addrs[2*sdk.AddrLen:])
+}
+
+// GetAutoRestakeKey gets the key for the auto-restake flag of a delegator
+func GetAutoRestakeKey(delAddr sdk.AccAddress) []byte {
+	return append(AutoRestakeKey, delAddr.Bytes()...)
+}
+
 // Bech32ifyConsPub returns a Bech32 encoded string containing the
 // Bech32PrefixConsPub prefixfor a given consensus node's PubKey.
 func Bech32ifyConsPub(pub crypto.PubKey) (string, error) {
@@ -8,6 +8,8 @@ import (
 var (
 	_ sdk.Msg = (*MsgAddShares)(nil)
 	_ sdk.Msg = (*MsgDestroyValidator)(nil)
+	_ sdk.Msg = (*MsgRedelegateShares)(nil)
+	_ sdk.Msg = (*MsgSetAutoRestake)(nil)
 )
 
 // MsgDestroyValidator - struct for transactions to deregister a validator
@@ -286,3 +288,81 @@ func (msg MsgWithdraw) GetSignBytes() []byte {
 	bz := ModuleCdc.MustMarshalJSON(msg)
 	return sdk.MustSortJSON(bz)
 }
+
+// MsgRedelegateShares - structure for moving the shares of a delegator from a validator to another without unbonding
+type MsgRedelegateShares struct {
+	DelegatorAddress    sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
+	ValidatorSrcAddress sdk.ValAddress `json:"validator_src_address" yaml:"validator_src_address"`
+	ValidatorDstAddress sdk.ValAddress `json:"validator_dst_address" yaml:"validator_dst_address"`
+}
+
+// NewMsgRedelegateShares creates a new instance of MsgRedelegateShares
+func NewMsgRedelegateShares(delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress) MsgRedelegateShares {
+	return MsgRedelegateShares{
+		DelegatorAddress:    delAddr,
+		ValidatorSrcAddress: valSrcAddr,
+		ValidatorDstAddress: valDstAddr,
+	}
+}
+
+// nolint
+func (msg MsgRedelegateShares) Route() string { return RouterKey }
+func (msg MsgRedelegateShares) Type() string  { return "redelegate_shares" }
+func (msg MsgRedelegateShares) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelegatorAddress}
+}
+
+// ValidateBasic gives a quick validity check
+func (msg MsgRedelegateShares) ValidateBasic() error {
+	if msg.DelegatorAddress.Empty() {
+		return ErrNilDelegatorAddr()
+	}
+	if msg.ValidatorSrcAddress.Empty() || msg.ValidatorDstAddress.Empty() {
+		return ErrNilValidatorAddr()
+	}
+	if msg.ValidatorSrcAddress.Equals(msg.ValidatorDstAddress) {
+		return ErrSelfRedelegation(msg.ValidatorSrcAddress.String())
+	}
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over
+func (msg MsgRedelegateShares) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// MsgSetAutoRestake - structure for opting in or out of restaking the rewards of a delegator automatically
+type MsgSetAutoRestake struct {
+	DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
+	Enabled          bool           `json:"enabled" yaml:"enabled"`
+}
+
+// NewMsgSetAutoRestake creates a new instance of MsgSetAutoRestake
+func NewMsgSetAutoRestake(delAddr sdk.AccAddress, enabled bool) MsgSetAutoRestake {
+	return MsgSetAutoRestake{
+		DelegatorAddress: delAddr,
+		Enabled:          enabled,
+	}
+}
+
+// nolint
+func (msg MsgSetAutoRestake) Route() string { return RouterKey }
+func (msg MsgSetAutoRestake) Type() string  { return "set_auto_restake" }
+func (msg MsgSetAutoRestake) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelegatorAddress}
+}
+
+// ValidateBasic gives a quick validity check
+func (msg MsgSetAutoRestake) ValidateBasic() error {
+	if msg.DelegatorAddress.Empty() {
+		return ErrNilDelegatorAddr()
+	}
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over
+func (msg MsgSetAutoRestake) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
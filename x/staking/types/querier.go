@@ -17,6 +17,8 @@ const (
 	QueryProxy               = "proxy"
 	QueryValidatorAllShares  = "validatorAllShares"
 	QueryDelegator           = "delegator"
+	QueryRedelegations       = "redelegations"
+	QueryAutoRestake         = "autoRestake"
 )
 
 // QueryDelegatorParams defines the params for the following queries:
@@ -0,0 +1,106 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+// RedelegationEntry is the struct of the shares moved from a validator to another at a certain height
+type RedelegationEntry struct {
+	CreationHeight int64     `json:"creation_height" yaml:"creation_height"`
+	CompletionTime time.Time `json:"completion_time" yaml:"completion_time"`
+	Shares         Shares    `json:"shares" yaml:"shares"`
+}
+
+// NewRedelegationEntry creates a new instance of RedelegationEntry
+func NewRedelegationEntry(creationHeight int64, completionTime time.Time, shares Shares) RedelegationEntry {
+	return RedelegationEntry{
+		CreationHeight: creationHeight,
+		CompletionTime: completionTime,
+		Shares:         shares,
+	}
+}
+
+// IsMature tells whether the entry has reached its completion time
+func (e RedelegationEntry) IsMature(currentTime time.Time) bool {
+	return !e.CompletionTime.After(currentTime)
+}
+
+// Redelegation is the struct of the immature shares moved by a delegator from a validator to another. Until an entry
+// is mature, the validator it's moved from is still responsible for the shares, and the delegator isn't allowed to
+// move the shares on from the destination validator
+type Redelegation struct {
+	DelegatorAddress    sdk.AccAddress      `json:"delegator_address" yaml:"delegator_address"`
+	ValidatorSrcAddress sdk.ValAddress      `json:"validator_src_address" yaml:"validator_src_address"`
+	ValidatorDstAddress sdk.ValAddress      `json:"validator_dst_address" yaml:"validator_dst_address"`
+	Entries             []RedelegationEntry `json:"entries" yaml:"entries"`
+}
+
+// NewRedelegation creates a new instance of Redelegation
+func NewRedelegation(delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress) Redelegation {
+	return Redelegation{
+		DelegatorAddress:    delAddr,
+		ValidatorSrcAddress: valSrcAddr,
+		ValidatorDstAddress: valDstAddr,
+	}
+}
+
+// AddEntry appends a new entry to the redelegation
+func (red *Redelegation) AddEntry(creationHeight int64, completionTime time.Time, shares Shares) {
+	red.Entries = append(red.Entries, NewRedelegationEntry(creationHeight, completionTime, shares))
+}
+
+// RemoveMatureEntries removes the entries that have reached their completion time and returns them
+func (red *Redelegation) RemoveMatureEntries(currentTime time.Time) (matured []RedelegationEntry) {
+	immature := red.Entries[:0]
+	for _, entry := range red.Entries {
+		if entry.IsMature(currentTime) {
+			matured = append(matured, entry)
+		} else {
+			immature = append(immature, entry)
+		}
+	}
+	red.Entries = immature
+	return
+}
+
+// MustUnMarshalRedelegation must return the Redelegation object by unmarshaling
+func MustUnMarshalRedelegation(cdc *codec.Codec, value []byte) Redelegation {
+	var red Redelegation
+	cdc.MustUnmarshalBinaryLengthPrefixed(value, &red)
+	return red
+}
+
+// String returns a human readable string representation of Redelegation
+func (red Redelegation) String() string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf(`Redelegation:
+  Delegator:             %s
+  Source Validator:      %s
+  Destination Validator: %s
+  Entries:`, red.DelegatorAddress, red.ValidatorSrcAddress, red.ValidatorDstAddress))
+	for i, entry := range red.Entries {
+		sb.WriteString(fmt.Sprintf(`
+    Redelegation %d:
+      Creation Height: %d
+      Completion Time: %s
+      Shares:          %s`, i, entry.CreationHeight, entry.CompletionTime.Format(time.RFC3339), entry.Shares))
+	}
+	return sb.String()
+}
+
+// Redelegations is a collection of redelegation
+type Redelegations []Redelegation
+
+// String returns a human readable string representation of Redelegations
+func (reds Redelegations) String() string {
+	strs := make([]string, len(reds))
+	for i, red := range reds {
+		strs[i] = red.String()
+	}
+	return strings.Join(strs, "\n")
+}
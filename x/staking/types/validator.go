@@ -614,6 +614,21 @@ func (v Validator) UpdateStatus(newStatus sdk.BondStatus) Validator {
 	return v
 }
 
+// MinSelfDelegationShares returns the shares added to a validator for its min self delegation.
+// RULES: any msd -> 1 shares
+func MinSelfDelegationShares() sdk.Dec {
+	return sdk.OneDec()
+}
+
+// GetAddedShares returns the shares added by the delegators, which earn the delegator rewards. The shares of the min
+// self delegation are left out since they belong to no delegator.
+func (v Validator) GetAddedShares() sdk.Dec {
+	if v.MinSelfDelegation.IsZero() {
+		return v.DelegatorShares
+	}
+	return v.DelegatorShares.Sub(MinSelfDelegationShares())
+}
+
 // nolint - for ValidatorI
 func (v Validator) IsJailed() bool                { return v.Jailed }
 func (v Validator) GetMoniker() string            { return v.Description.Moniker }
@@ -23,6 +23,7 @@ type OrderKeeper interface {
 	GetDepthBookCopy(product string) *order.DepthBook
 	GetProductPriceOrderIDs(key string) []string
 	GetTxHandlerMsgResult() []bitset.BitSet
+	GetParams(ctx sdk.Context) *order.Params
 }
 
 type TokenKeeper interface {